@@ -0,0 +1,389 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	"github.com/dennis-tra/punchr/pkg/maxmind"
+	"github.com/dennis-tra/punchr/pkg/util"
+)
+
+const (
+	ActionAllow = "allow"
+	ActionDeny  = "deny"
+)
+
+const (
+	RelayTypeAny     = "any"
+	RelayTypeRelayed = "relayed"
+	RelayTypeDirect  = "direct"
+)
+
+// defaultRuleName is the rule name that is reported if no rule matched a peer
+// and the default action of the policy was applied.
+const defaultRuleName = "default"
+
+// AdmissionConfig is the declarative representation of an admission policy as
+// it is found in the policy file. The file format is derived from the file
+// extension (yaml, toml or json). An example:
+//
+//	default: deny
+//	rules:
+//	  - name: rust-libp2p
+//	    action: allow
+//	    agent_version: "^rust-libp2p"
+//	    protocols: ["/libp2p/dcutr"]
+//	    private_only: true
+type AdmissionConfig struct {
+	// Default is the action that is applied if no rule matched (allow or deny).
+	Default string `mapstructure:"default"`
+
+	// Rules are evaluated in order. The first matching rule decides.
+	Rules []AdmissionRuleConfig `mapstructure:"rules"`
+}
+
+// AdmissionRuleConfig describes a single admission rule. All configured
+// conditions must hold for the rule to match. Conditions that are left
+// empty are ignored.
+type AdmissionRuleConfig struct {
+	// Name identifies the rule in logs and metrics.
+	Name string `mapstructure:"name"`
+
+	// Action is either allow or deny.
+	Action string `mapstructure:"action"`
+
+	// AgentVersion is a regular expression that must match the agent version
+	// of the peer. Peers without an agent version are matched against the empty string.
+	AgentVersion string `mapstructure:"agent_version"`
+
+	// Protocols is the set of protocols the peer must all support.
+	Protocols []string `mapstructure:"protocols"`
+
+	// ASNs matches if at least one of the peer's addresses belongs to one of the given autonomous systems.
+	ASNs []uint `mapstructure:"asns"`
+
+	// Countries matches if at least one of the peer's addresses is located in one of the given countries (ISO codes).
+	Countries []string `mapstructure:"countries"`
+
+	// RelayType is either any (default), relayed (at least one relay address) or direct (no relay address).
+	RelayType string `mapstructure:"relay_type"`
+
+	// PrivateOnly matches if all non-relay addresses of the peer are private.
+	PrivateOnly bool `mapstructure:"private_only"`
+}
+
+// DefaultAdmissionConfig reflects the behaviour of the honeypot before admission
+// policies were configurable: only admit DCUtR capable peers that don't
+// have a public non-relay address.
+func DefaultAdmissionConfig() *AdmissionConfig {
+	return &AdmissionConfig{
+		Default: ActionDeny,
+		Rules: []AdmissionRuleConfig{
+			{
+				Name:        "dcutr-behind-nat",
+				Action:      ActionAllow,
+				Protocols:   []string{string(holepunch.Protocol)},
+				PrivateOnly: true,
+			},
+		},
+	}
+}
+
+// AdmissionCandidate holds the information about a remote peer that is
+// evaluated against the admission policy.
+type AdmissionCandidate struct {
+	AgentVersion *string
+	Protocols    []string
+
+	// Maddrs are the multi addresses the peer advertises. This excludes
+	// the multi address of the connection.
+	Maddrs []ma.Multiaddr
+
+	// geoInfos is lazily populated by geoInfosFn as only rules with ASN
+	// or country conditions require the (potentially expensive) lookup.
+	geoInfos   []*maxmind.AddrInfo
+	geoInfosFn func() []*maxmind.AddrInfo
+	geoOnce    sync.Once
+}
+
+func (ac *AdmissionCandidate) GeoInfos() []*maxmind.AddrInfo {
+	ac.geoOnce.Do(func() {
+		if ac.geoInfosFn != nil {
+			ac.geoInfos = ac.geoInfosFn()
+		}
+	})
+	return ac.geoInfos
+}
+
+// admissionRule is the compiled version of an AdmissionRuleConfig.
+type admissionRule struct {
+	name         string
+	action       string
+	agentVersion *regexp.Regexp
+	protocols    []string
+	asns         map[uint]struct{}
+	countries    map[string]struct{}
+	relayType    string
+	privateOnly  bool
+}
+
+// AdmissionPolicy decides whether an inbound peer should be saved to the database.
+type AdmissionPolicy struct {
+	defaultAction string
+	rules         []*admissionRule
+}
+
+// NewAdmissionPolicy validates the given configuration and compiles it into an AdmissionPolicy.
+func NewAdmissionPolicy(conf *AdmissionConfig) (*AdmissionPolicy, error) {
+	defaultAction := strings.ToLower(conf.Default)
+	if defaultAction == "" {
+		defaultAction = ActionDeny
+	}
+	if defaultAction != ActionAllow && defaultAction != ActionDeny {
+		return nil, fmt.Errorf("invalid default action %q", conf.Default)
+	}
+
+	ap := &AdmissionPolicy{
+		defaultAction: defaultAction,
+		rules:         make([]*admissionRule, len(conf.Rules)),
+	}
+
+	names := map[string]struct{}{defaultRuleName: {}}
+	for i, rc := range conf.Rules {
+		if rc.Name == "" {
+			rc.Name = fmt.Sprintf("rule-%d", i)
+		}
+
+		if _, found := names[rc.Name]; found {
+			return nil, fmt.Errorf("duplicate rule name %q", rc.Name)
+		}
+		names[rc.Name] = struct{}{}
+
+		rule := &admissionRule{
+			name:        rc.Name,
+			action:      strings.ToLower(rc.Action),
+			protocols:   rc.Protocols,
+			relayType:   strings.ToLower(rc.RelayType),
+			privateOnly: rc.PrivateOnly,
+		}
+
+		if rule.action != ActionAllow && rule.action != ActionDeny {
+			return nil, fmt.Errorf("invalid action %q for rule %q", rc.Action, rc.Name)
+		}
+
+		switch rule.relayType {
+		case "":
+			rule.relayType = RelayTypeAny
+		case RelayTypeAny, RelayTypeRelayed, RelayTypeDirect:
+		default:
+			return nil, fmt.Errorf("invalid relay type %q for rule %q", rc.RelayType, rc.Name)
+		}
+
+		if rc.AgentVersion != "" {
+			re, err := regexp.Compile(rc.AgentVersion)
+			if err != nil {
+				return nil, errors.Wrapf(err, "compile agent version regex for rule %q", rc.Name)
+			}
+			rule.agentVersion = re
+		}
+
+		if len(rc.ASNs) > 0 {
+			rule.asns = map[uint]struct{}{}
+			for _, asn := range rc.ASNs {
+				rule.asns[asn] = struct{}{}
+			}
+		}
+
+		if len(rc.Countries) > 0 {
+			rule.countries = map[string]struct{}{}
+			for _, country := range rc.Countries {
+				rule.countries[strings.ToUpper(country)] = struct{}{}
+			}
+		}
+
+		ap.rules[i] = rule
+	}
+
+	return ap, nil
+}
+
+// Evaluate returns the name of the rule that matched the given candidate and whether the peer should be admitted.
+func (ap *AdmissionPolicy) Evaluate(ac *AdmissionCandidate) (string, bool) {
+	for _, rule := range ap.rules {
+		if rule.matches(ac) {
+			return rule.name, rule.action == ActionAllow
+		}
+	}
+	return defaultRuleName, ap.defaultAction == ActionAllow
+}
+
+func (r *admissionRule) matches(ac *AdmissionCandidate) bool {
+	if r.agentVersion != nil {
+		av := ""
+		if ac.AgentVersion != nil {
+			av = *ac.AgentVersion
+		}
+		if !r.agentVersion.MatchString(av) {
+			return false
+		}
+	}
+
+	if len(r.protocols) > 0 {
+		supported := map[string]struct{}{}
+		for _, p := range ac.Protocols {
+			supported[p] = struct{}{}
+		}
+		for _, p := range r.protocols {
+			if _, found := supported[p]; !found {
+				return false
+			}
+		}
+	}
+
+	if r.relayType != RelayTypeAny {
+		hasRelayAddr := false
+		for _, maddr := range ac.Maddrs {
+			if util.IsRelayedMaddr(maddr) {
+				hasRelayAddr = true
+				break
+			}
+		}
+		if r.relayType == RelayTypeRelayed && !hasRelayAddr {
+			return false
+		} else if r.relayType == RelayTypeDirect && hasRelayAddr {
+			return false
+		}
+	}
+
+	if r.privateOnly {
+		for _, maddr := range ac.Maddrs {
+			if !manet.IsPrivateAddr(maddr) && !util.IsRelayedMaddr(maddr) {
+				return false
+			}
+		}
+	}
+
+	if r.asns != nil {
+		found := false
+		for _, info := range ac.GeoInfos() {
+			if _, found = r.asns[info.ASN]; found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if r.countries != nil {
+		found := false
+		for _, info := range ac.GeoInfos() {
+			if _, found = r.countries[info.Country]; found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AdmissionController holds the currently active admission policy and
+// swaps it whenever the underlying policy file changes.
+type AdmissionController struct {
+	mu     sync.RWMutex
+	policy *AdmissionPolicy
+}
+
+// NewAdmissionController loads the admission policy from the given file and
+// watches it for changes. If the file path is empty the default policy is used.
+func NewAdmissionController(path string) (*AdmissionController, error) {
+	if path == "" {
+		policy, err := NewAdmissionPolicy(DefaultAdmissionConfig())
+		if err != nil {
+			return nil, errors.Wrap(err, "new default admission policy")
+		}
+		return &AdmissionController{policy: policy}, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, errors.Wrap(err, "read admission policy")
+	}
+
+	policy, err := loadAdmissionPolicy(v)
+	if err != nil {
+		return nil, err
+	}
+
+	ac := &AdmissionController{policy: policy}
+
+	v.OnConfigChange(func(evt fsnotify.Event) {
+		logEntry := log.WithField("file", filepath.Base(path))
+
+		policy, err := loadAdmissionPolicy(v)
+		if err != nil {
+			logEntry.WithError(err).Warnln("Could not reload admission policy, keeping the previous one")
+			admissionReloads.With(prometheus.Labels{"status": "error"}).Inc()
+			return
+		}
+
+		ac.mu.Lock()
+		ac.policy = policy
+		ac.mu.Unlock()
+
+		logEntry.Infoln("Reloaded admission policy")
+		admissionReloads.With(prometheus.Labels{"status": "ok"}).Inc()
+	})
+	v.WatchConfig()
+
+	return ac, nil
+}
+
+func loadAdmissionPolicy(v *viper.Viper) (*AdmissionPolicy, error) {
+	conf := &AdmissionConfig{}
+	if err := v.Unmarshal(conf); err != nil {
+		return nil, errors.Wrap(err, "unmarshal admission policy")
+	}
+
+	policy, err := NewAdmissionPolicy(conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "new admission policy")
+	}
+
+	return policy, nil
+}
+
+// Admit evaluates the currently active policy and tracks the decision.
+func (ac *AdmissionController) Admit(candidate *AdmissionCandidate) bool {
+	ac.mu.RLock()
+	policy := ac.policy
+	ac.mu.RUnlock()
+
+	rule, admit := policy.Evaluate(candidate)
+
+	action := ActionDeny
+	if admit {
+		action = ActionAllow
+	}
+	admissionDecisions.With(prometheus.Labels{"rule": rule, "action": action}).Inc()
+
+	log.WithFields(log.Fields{"rule": rule, "action": action}).Debugln("Evaluated admission policy")
+
+	return admit
+}
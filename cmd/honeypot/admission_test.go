@@ -0,0 +1,123 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennis-tra/punchr/pkg/maxmind"
+)
+
+func TestAdmissionPolicy_Evaluate_default(t *testing.T) {
+	policy, err := NewAdmissionPolicy(DefaultAdmissionConfig())
+	require.NoError(t, err)
+
+	privMaddr := ma.StringCast("/ip4/192.168.1.10/tcp/4001")
+	pubMaddr := ma.StringCast("/ip4/1.1.1.1/tcp/4001")
+	relayMaddr := ma.StringCast("/ip4/1.1.1.1/tcp/4001/p2p/QmcZf59bWwK5XFi76CZX8cbJ4BhTzzA3gU1ZjYZcYW3dwt/p2p-circuit")
+
+	tests := []struct {
+		name      string
+		protocols []string
+		maddrs    []ma.Multiaddr
+		wantRule  string
+		wantAdmit bool
+	}{
+		{"no dcutr", []string{"/ipfs/id/1.0.0"}, []ma.Multiaddr{privMaddr, relayMaddr}, defaultRuleName, false},
+		{"public addr", []string{string(holepunch.Protocol)}, []ma.Multiaddr{pubMaddr, relayMaddr}, defaultRuleName, false},
+		{"behind nat", []string{string(holepunch.Protocol)}, []ma.Multiaddr{privMaddr, relayMaddr}, "dcutr-behind-nat", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, admit := policy.Evaluate(&AdmissionCandidate{Protocols: tt.protocols, Maddrs: tt.maddrs})
+			assert.Equal(t, tt.wantRule, rule)
+			assert.Equal(t, tt.wantAdmit, admit)
+		})
+	}
+}
+
+func TestAdmissionPolicy_Evaluate_rules(t *testing.T) {
+	policy, err := NewAdmissionPolicy(&AdmissionConfig{
+		Default: ActionAllow,
+		Rules: []AdmissionRuleConfig{
+			{Name: "old-kubo", Action: ActionDeny, AgentVersion: `^go-ipfs/0\.`},
+			{Name: "german-rust", Action: ActionAllow, AgentVersion: "^rust-libp2p", Countries: []string{"de"}},
+			{Name: "no-rust", Action: ActionDeny, AgentVersion: "^rust-libp2p"},
+			{Name: "direct", Action: ActionDeny, RelayType: RelayTypeDirect, ASNs: []uint{3320}},
+		},
+	})
+	require.NoError(t, err)
+
+	geo := func(country string, asn uint) func() []*maxmind.AddrInfo {
+		return func() []*maxmind.AddrInfo {
+			return []*maxmind.AddrInfo{{Country: country, ASN: asn}}
+		}
+	}
+	av := func(av string) *string { return &av }
+
+	tests := []struct {
+		name      string
+		candidate *AdmissionCandidate
+		wantRule  string
+		wantAdmit bool
+	}{
+		{"old kubo", &AdmissionCandidate{AgentVersion: av("go-ipfs/0.11.0")}, "old-kubo", false},
+		{"rust in germany", &AdmissionCandidate{AgentVersion: av("rust-libp2p/0.49"), geoInfosFn: geo("DE", 1)}, "german-rust", true},
+		{"rust elsewhere", &AdmissionCandidate{AgentVersion: av("rust-libp2p/0.49"), geoInfosFn: geo("US", 1)}, "no-rust", false},
+		{"direct in asn", &AdmissionCandidate{AgentVersion: av("kubo/0.17.0"), geoInfosFn: geo("DE", 3320)}, "direct", false},
+		{"unknown agent", &AdmissionCandidate{geoInfosFn: geo("DE", 1)}, defaultRuleName, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, admit := policy.Evaluate(tt.candidate)
+			assert.Equal(t, tt.wantRule, rule)
+			assert.Equal(t, tt.wantAdmit, admit)
+		})
+	}
+}
+
+func TestNewAdmissionPolicy_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		conf *AdmissionConfig
+	}{
+		{"default action", &AdmissionConfig{Default: "maybe"}},
+		{"rule action", &AdmissionConfig{Rules: []AdmissionRuleConfig{{Name: "a"}}}},
+		{"relay type", &AdmissionConfig{Rules: []AdmissionRuleConfig{{Action: ActionAllow, RelayType: "v1"}}}},
+		{"regex", &AdmissionConfig{Rules: []AdmissionRuleConfig{{Action: ActionAllow, AgentVersion: "("}}}},
+		{"duplicate", &AdmissionConfig{Rules: []AdmissionRuleConfig{{Name: "a", Action: ActionAllow}, {Name: "a", Action: ActionDeny}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAdmissionPolicy(tt.conf)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewAdmissionController_file(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	data := `
+default: allow
+rules:
+  - name: kubo
+    action: deny
+    agent_version: "^kubo/"
+    asns: [3320]
+`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+	ac, err := NewAdmissionController(path)
+	require.NoError(t, err)
+
+	av := "kubo/0.17.0"
+	assert.False(t, ac.Admit(&AdmissionCandidate{AgentVersion: &av, geoInfosFn: func() []*maxmind.AddrInfo {
+		return []*maxmind.AddrInfo{{ASN: 3320}}
+	}}))
+	assert.True(t, ac.Admit(&AdmissionCandidate{AgentVersion: &av}))
+}
@@ -18,7 +18,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 
+	"github.com/dennis-tra/punchr/pkg/config"
 	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/maxmind"
 )
 
 var (
@@ -38,6 +40,22 @@ var (
 		},
 		[]string{"status"},
 	)
+	admissionDecisions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "admission_decisions",
+			Namespace: "honeypot",
+			Help:      "The number of admission policy decisions per rule",
+		},
+		[]string{"rule", "action"},
+	)
+	admissionReloads = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "admission_policy_reloads",
+			Namespace: "honeypot",
+			Help:      "The number of admission policy reloads",
+		},
+		[]string{"status"},
+	)
 	completedWalks = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name:      "completed_walks",
@@ -54,102 +72,97 @@ func main() {
 		Name:      "honeypot",
 		Usage:     "A libp2p host allowing unlimited inbound connections.",
 		UsageText: "honeypot [global options] command [command options] [arguments...]",
+		Before:    config.Before(validateConfig),
 		Action:    RootAction,
 		Version:   Version,
-		Flags: []cli.Flag{
-			&cli.StringFlag{
-				Name:        "port",
-				Usage:       "On which port should the libp2p host listen",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_PORT"},
-				Value:       "11000",
-				DefaultText: "11000",
-			},
-			&cli.StringFlag{
-				Name:        "telemetry-host",
-				Usage:       "To which network address should the telemetry (prometheus, pprof) server bind",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_TELEMETRY_HOST"},
-				Value:       "localhost",
-				DefaultText: "localhost",
-			},
-			&cli.StringFlag{
-				Name:        "telemetry-port",
-				Usage:       "On which port should the telemetry (prometheus, pprof) server listen",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_TELEMETRY_PORT"},
-				Value:       "11001",
-				DefaultText: "11001",
-			},
-			&cli.StringFlag{
-				Name:        "db-host",
-				Usage:       "On which host address can the database be reached",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_DATABASE_HOST"},
-				DefaultText: "localhost",
-				Value:       "localhost",
-			},
-			&cli.StringFlag{
-				Name:        "db-port",
-				Usage:       "On which port can the database be reached",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_DATABASE_PORT"},
-				DefaultText: "5432",
-				Value:       "5432",
-			},
-			&cli.StringFlag{
-				Name:        "db-name",
-				Usage:       "The name of the database to use",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_DATABASE_NAME"},
-				DefaultText: "punchr",
-				Value:       "punchr",
-			},
-			&cli.StringFlag{
-				Name:        "db-password",
-				Usage:       "The password for the database to use",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_DATABASE_PASSWORD"},
-				DefaultText: "password",
-				Value:       "password",
-			},
-			&cli.StringFlag{
-				Name:        "db-user",
-				Usage:       "The user with which to access the database to use",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_DATABASE_USER"},
-				DefaultText: "punchr",
-				Value:       "punchr",
-			},
-			&cli.StringFlag{
-				Name:        "db-sslmode",
-				Usage:       "The sslmode to use when connecting the the database",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_DATABASE_SSL_MODE"},
-				DefaultText: "disable",
-				Value:       "disable",
-			},
-			&cli.StringFlag{
-				Name:        "key",
-				Usage:       "Load private key for peer ID from `FILE`",
-				TakesFile:   true,
-				EnvVars:     []string{"PUNCHR_HONEYPOT_KEY_FILE"},
-				DefaultText: "honeypot.key",
-				Value:       "honeypot.key",
-			},
-			&cli.IntFlag{
-				Name:        "crawler-count",
-				Usage:       "The number of parallel crawlers",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_CRAWLER_COUNT"},
-				DefaultText: "10",
-				Value:       10,
-			},
-			&cli.IntFlag{
-				Name:        "max-crawls",
-				Usage:       "The maximum number of consecutive crawls",
-				EnvVars:     []string{"PUNCHR_HONEYPOT_MAX_CRAWLS"},
-				DefaultText: "1",
-				Value:       1,
+		Flags: config.JoinFlags(
+			[]cli.Flag{
+				&cli.StringFlag{
+					Name:        "port",
+					Usage:       "On which port should the libp2p host listen",
+					EnvVars:     []string{"PUNCHR_HONEYPOT_PORT"},
+					Value:       "11000",
+					DefaultText: "11000",
+				},
+				&cli.StringFlag{
+					Name:        "telemetry-host",
+					Usage:       "To which network address should the telemetry (prometheus, pprof) server bind",
+					EnvVars:     []string{"PUNCHR_HONEYPOT_TELEMETRY_HOST"},
+					Value:       "localhost",
+					DefaultText: "localhost",
+				},
+				&cli.StringFlag{
+					Name:        "telemetry-port",
+					Usage:       "On which port should the telemetry (prometheus, pprof) server listen",
+					EnvVars:     []string{"PUNCHR_HONEYPOT_TELEMETRY_PORT"},
+					Value:       "11001",
+					DefaultText: "11001",
+				},
 			},
-			&cli.StringFlag{
-				Name:        "udger-db",
-				Usage:       "Path to the Udger database",
-				EnvVars:     []string{"PUNCHR_SERVER_UDGER_DATABASE"},
-				DefaultText: "udgerdb_v3.dat",
-				Value:       "udgerdb_v3.dat",
+			db.Flags("PUNCHR_HONEYPOT"),
+			maxmind.Flags("PUNCHR_HONEYPOT"),
+			db.EnrichmentFlags("PUNCHR_HONEYPOT"),
+			[]cli.Flag{
+				&cli.StringFlag{
+					Name:        "key",
+					Usage:       "Load private key for peer ID from `FILE`",
+					TakesFile:   true,
+					EnvVars:     []string{"PUNCHR_HONEYPOT_KEY_FILE"},
+					DefaultText: "honeypot.key",
+					Value:       "honeypot.key",
+				},
+				&cli.IntFlag{
+					Name:        "crawler-count",
+					Usage:       "The number of parallel crawlers",
+					EnvVars:     []string{"PUNCHR_HONEYPOT_CRAWLER_COUNT"},
+					DefaultText: "10",
+					Value:       10,
+				},
+				&cli.IntFlag{
+					Name:        "max-crawls",
+					Usage:       "The maximum number of consecutive crawls",
+					EnvVars:     []string{"PUNCHR_HONEYPOT_MAX_CRAWLS"},
+					DefaultText: "1",
+					Value:       1,
+				},
+				&cli.StringFlag{
+					Name:        "udger-db",
+					Usage:       "Path to the Udger database",
+					EnvVars:     []string{"PUNCHR_SERVER_UDGER_DATABASE"},
+					DefaultText: "udgerdb_v3.dat",
+					Value:       "udgerdb_v3.dat",
+				},
+				&cli.StringFlag{
+					Name:        "network",
+					Usage:       "The network profile to use (ipfs, filecoin, ethereum, custom)",
+					EnvVars:     []string{"PUNCHR_HONEYPOT_NETWORK"},
+					DefaultText: "ipfs",
+					Value:       "ipfs",
+				},
+				&cli.StringFlag{
+					Name:    "network-name",
+					Usage:   "The network name that is saved with each connection event (default: name of the network profile)",
+					EnvVars: []string{"PUNCHR_HONEYPOT_NETWORK_NAME"},
+				},
+				&cli.StringSliceFlag{
+					Name:    "bootstrap-peers",
+					Usage:   "Comma separated list of multi addresses of bootstrap peers (default: bootstrap peers of the network profile)",
+					EnvVars: []string{"PUNCHR_HONEYPOT_BOOTSTRAP_PEERS"},
+				},
+				&cli.StringFlag{
+					Name:    "dht-protocol-prefix",
+					Usage:   "The protocol prefix of the Kademlia DHT, e.g. /ipfs (default: prefix of the network profile)",
+					EnvVars: []string{"PUNCHR_HONEYPOT_DHT_PROTOCOL_PREFIX"},
+				},
+				&cli.StringFlag{
+					Name:      "admission-policy",
+					Usage:     "Load the peer admission policy from `FILE` (yaml, toml or json). Changes are picked up automatically",
+					TakesFile: true,
+					EnvVars:   []string{"PUNCHR_HONEYPOT_ADMISSION_POLICY"},
+				},
 			},
-		},
+			config.Flags("PUNCHR_HONEYPOT", "info"),
+		),
 		EnableBashCompletion: true,
 	}
 
@@ -163,7 +176,28 @@ func main() {
 	}
 }
 
+// validateConfig checks the settings whose flag types allow invalid values.
+func validateConfig(c *cli.Context) error {
+	if c.Int("crawler-count") <= 0 {
+		return fmt.Errorf("crawler-count must be positive")
+	}
+
+	if c.Int("max-crawls") <= 0 {
+		return fmt.Errorf("max-crawls must be positive")
+	}
+
+	return nil
+}
+
 func RootAction(c *cli.Context) error {
+	if c.Bool(config.FlagPrintConfig) {
+		return config.Print(os.Stdout, c)
+	}
+
+	// Reload the log level from the config file on SIGHUP
+	stopWatching := config.Watch(c, nil)
+	defer stopWatching()
+
 	// Start telemetry endpoints
 	go serveTelemetry(c)
 
@@ -173,6 +207,25 @@ func RootAction(c *cli.Context) error {
 		return errors.Wrap(err, "new db client")
 	}
 
+	// Create the partitions of the upcoming months for the connection events
+	go dbClient.MaintainPartitions(c.Context)
+
+	// Derive the geo, ASN and data center information of the saved multi addresses
+	go dbClient.Enricher.Run(c.Context)
+
+	// Determine which libp2p network to participate in
+	network, err := NewNetworkProfile(c)
+	if err != nil {
+		return errors.Wrap(err, "new network profile")
+	}
+	log.WithField("network", network.Name).Infoln("Using network profile")
+
+	// Load the policy that decides which inbound peers are saved
+	admission, err := NewAdmissionController(c.String("admission-policy"))
+	if err != nil {
+		return errors.Wrap(err, "new admission controller")
+	}
+
 	ctx, cancel := context.WithCancel(c.Context)
 
 	var wg sync.WaitGroup
@@ -197,7 +250,7 @@ func RootAction(c *cli.Context) error {
 			log.Infoln("Starting crawl number", crawlCount)
 
 			// Initialize honeypot libp2p host
-			h, err := InitHost(ctx, c, dbClient)
+			h, err := InitHost(ctx, c, dbClient, admission, network)
 			if err != nil {
 				log.WithError(err).Warnln("Could not initialize libp2p host")
 				continue
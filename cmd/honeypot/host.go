@@ -13,6 +13,7 @@ import (
 	"github.com/libp2p/go-libp2p-kad-dht/crawler"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/libp2p/go-libp2p/core/routing"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -35,9 +36,12 @@ type Host struct {
 	DBClient *db.Client
 	DHT      *kaddht.IpfsDHT
 	crawlers int
+
+	admission *AdmissionController
+	network   *NetworkProfile
 }
 
-func InitHost(ctx context.Context, c *cli.Context, dbClient *db.Client) (*Host, error) {
+func InitHost(ctx context.Context, c *cli.Context, dbClient *db.Client, admission *AdmissionController, network *NetworkProfile) (*Host, error) {
 	log.Info("Starting libp2p host...")
 
 	// Load private key data from file or create a new identity
@@ -59,10 +63,15 @@ func InitHost(ctx context.Context, c *cli.Context, dbClient *db.Client) (*Host,
 
 	port := c.String("port")
 
+	bootstrapPeers, err := network.BootstrapAddrInfos()
+	if err != nil {
+		return nil, errors.Wrap(err, "bootstrap peers")
+	}
+
 	// Configure new libp2p host
 	var dht *kaddht.IpfsDHT
 	agentVersion := "punchr/honeypot/" + c.App.Version
-	libp2pHost, err := libp2p.New(
+	opts := []libp2p.Option{
 		libp2p.Identity(privKeys[0]),
 		libp2p.UserAgent(agentVersion),
 		libp2p.ResourceManager(rm),
@@ -70,22 +79,34 @@ func InitHost(ctx context.Context, c *cli.Context, dbClient *db.Client) (*Host,
 		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/0.0.0.0/udp/%s/quic", port)),
 		libp2p.ListenAddrStrings(fmt.Sprintf("/ip6/::/tcp/%s", port)),
 		libp2p.ListenAddrStrings(fmt.Sprintf("/ip6/::/udp/%s/quic", port)),
-		libp2p.Routing(func(h host.Host) (routing.PeerRouting, error) {
+	}
+
+	// Only participate in the DHT if the network has one
+	if network.HasDHT() {
+		opts = append(opts, libp2p.Routing(func(h host.Host) (routing.PeerRouting, error) {
 			var err error
-			dht, err = kaddht.New(ctx, h, kaddht.Mode(kaddht.ModeServer))
+			dht, err = kaddht.New(ctx, h,
+				kaddht.Mode(kaddht.ModeServer),
+				kaddht.ProtocolPrefix(network.ProtocolPrefix),
+				kaddht.BootstrapPeers(bootstrapPeers...),
+			)
 			return dht, err
-		}),
-	)
+		}))
+	}
+
+	libp2pHost, err := libp2p.New(opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "new libp2p host")
 	}
 
 	h := &Host{
-		ctx:      ctx,
-		Host:     libp2pHost,
-		DBClient: dbClient,
-		DHT:      dht,
-		crawlers: c.Int("crawler-count"),
+		ctx:       ctx,
+		Host:      libp2pHost,
+		DBClient:  dbClient,
+		DHT:       dht,
+		crawlers:  c.Int("crawler-count"),
+		admission: admission,
+		network:   network,
 	}
 
 	h.DBPeer, err = h.DBClient.UpsertPeer(ctx, h.DBClient, h.ID(), &agentVersion, h.GetProtocols(h.ID()))
@@ -132,7 +153,12 @@ func (h *Host) GetMultiAddresses(pid peer.ID) []ma.Multiaddr {
 
 // Bootstrap connects this host to bootstrap peers.
 func (h *Host) Bootstrap(ctx context.Context) error {
-	for _, bp := range kaddht.GetDefaultBootstrapPeerAddrInfos() {
+	bps, err := h.network.BootstrapAddrInfos()
+	if err != nil {
+		return errors.Wrap(err, "bootstrap peers")
+	}
+
+	for _, bp := range bps {
 		log.WithField("remoteID", util.FmtPeerID(bp.ID)).Info("Connecting to bootstrap peer...")
 		if err := h.Connect(ctx, bp); err != nil {
 			return errors.Wrap(err, "connecting to bootstrap peer")
@@ -142,15 +168,32 @@ func (h *Host) Bootstrap(ctx context.Context) error {
 }
 
 // WalkDHT slowly enumerates the whole DHT to announce ourselves to the network.
+// If the network doesn't have a DHT, this method just keeps the host connected
+// to the bootstrap peers until the context is cancelled.
 func (h *Host) WalkDHT(ctx context.Context) error {
+	if !h.network.HasDHT() {
+		log.WithField("network", h.network.Name).Infoln("Network has no DHT, waiting for inbound connections...")
+		<-ctx.Done()
+		return nil
+	}
+
 	log.Infoln("Start walking the DHT...")
 
-	c, err := crawler.New(h, crawler.WithParallelism(h.crawlers), crawler.WithConnectTimeout(5*time.Second), crawler.WithMsgTimeout(5*time.Second))
+	c, err := crawler.New(h,
+		crawler.WithProtocols([]protocol.ID{h.network.DHTProtocol()}),
+		crawler.WithParallelism(h.crawlers),
+		crawler.WithConnectTimeout(5*time.Second),
+		crawler.WithMsgTimeout(5*time.Second),
+	)
 	if err != nil {
 		return errors.Wrap(err, "create crawler")
 	}
 
-	bps := kaddht.GetDefaultBootstrapPeerAddrInfos()
+	bps, err := h.network.BootstrapAddrInfos()
+	if err != nil {
+		return errors.Wrap(err, "bootstrap peers")
+	}
+
 	seedPeers := make([]*peer.AddrInfo, len(bps))
 	for i, bp := range bps {
 		tmp := bp
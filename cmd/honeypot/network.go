@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	kaddht "github.com/libp2p/go-libp2p-kad-dht"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	NetworkIPFS     = "ipfs"
+	NetworkFilecoin = "filecoin"
+	NetworkEthereum = "ethereum"
+	NetworkCustom   = "custom"
+)
+
+// NetworkProfile describes the libp2p network the honeypot participates in.
+type NetworkProfile struct {
+	// Name is saved alongside each connection event, so that the server can
+	// allocate peers of a specific network.
+	Name string
+
+	// ProtocolPrefix is the prefix of the Kademlia DHT protocol. If it's empty
+	// the network doesn't use a libp2p Kademlia DHT and no DHT walks are performed.
+	ProtocolPrefix protocol.ID
+
+	// BootstrapPeers are the multi addresses (including the /p2p component) the honeypot connects to after start.
+	BootstrapPeers []string
+}
+
+// filecoinBootstrapPeers are the Filecoin mainnet bootstrap peers from
+// https://github.com/filecoin-project/lotus/blob/master/build/bootstrap/mainnet.pi
+var filecoinBootstrapPeers = []string{
+	"/dns4/bootstrap-0.mainnet.filops.net/tcp/1347/p2p/12D3KooWCVe8MmsEMes2FzgTpt9fXtmCY7wrq91GRiaC8PHSCCBj",
+	"/dns4/bootstrap-1.mainnet.filops.net/tcp/1347/p2p/12D3KooWCwevHg1yLCvktf2nvLu7L9894mcrJR4MsBCcm4syShVc",
+	"/dns4/bootstrap-2.mainnet.filops.net/tcp/1347/p2p/12D3KooWEWVwHGn2yR36gKLozmb4YjDJGerotAPGxmdWZx2nxMC4",
+	"/dns4/bootstrap-3.mainnet.filops.net/tcp/1347/p2p/12D3KooWKhgq8c7NQ9iGjbyK7v7phXvG6492HQfiDaGHLHLQjk7R",
+	"/dns4/bootstrap-4.mainnet.filops.net/tcp/1347/p2p/12D3KooWL6PsFNPhYftrJzGgF5U18hFoaVhfGk7xwzD8yVrHJ3Uc",
+	"/dns4/bootstrap-5.mainnet.filops.net/tcp/1347/p2p/12D3KooWLFynvDQiUpXoHroV1YxKHhPJgysQGH2k3ZGwtWzR4dFH",
+	"/dns4/bootstrap-6.mainnet.filops.net/tcp/1347/p2p/12D3KooWP5MwCiqdMETF9ub1P3MbCvQCcfconnYHbWg6sUJcDRQQ",
+	"/dns4/bootstrap-7.mainnet.filops.net/tcp/1347/p2p/12D3KooWRs3aY1p3juFjPy8gPN95PEQChm2QKGUCAdcDCC4EBMKf",
+	"/dns4/bootstrap-8.mainnet.filops.net/tcp/1347/p2p/12D3KooWScFR7385LTyR4zU1bYdzSiiAb5rnNABfVahPvVSzyTkR",
+	"/dns4/node.glif.io/tcp/1235/p2p/12D3KooWBF8cpp65hp2u9LK5mh19x67ftAam84z9LsfaquTDSBpt",
+}
+
+// NewNetworkProfile derives the network profile from the command line flags.
+// Explicitly set bootstrap peers, protocol prefix and network name take
+// precedence over the values of the selected profile.
+func NewNetworkProfile(c *cli.Context) (*NetworkProfile, error) {
+	var np *NetworkProfile
+
+	switch strings.ToLower(c.String("network")) {
+	case NetworkIPFS:
+		np = &NetworkProfile{Name: NetworkIPFS, ProtocolPrefix: kaddht.DefaultPrefix}
+		for _, maddr := range kaddht.DefaultBootstrapPeers {
+			np.BootstrapPeers = append(np.BootstrapPeers, maddr.String())
+		}
+	case NetworkFilecoin:
+		// Lotus uses "/fil/kad/" + network name. The mainnet network name is "testnetnet".
+		np = &NetworkProfile{
+			Name:           NetworkFilecoin,
+			ProtocolPrefix: "/fil/kad/testnetnet",
+			BootstrapPeers: filecoinBootstrapPeers,
+		}
+	case NetworkEthereum:
+		// Ethereum consensus clients discover each other via discv5 and not via a libp2p Kademlia DHT.
+		// Hence, there are no multi addresses to bootstrap from, and they need to be passed explicitly.
+		np = &NetworkProfile{Name: NetworkEthereum}
+	case NetworkCustom:
+		np = &NetworkProfile{}
+	default:
+		return nil, fmt.Errorf("unknown network profile %q", c.String("network"))
+	}
+
+	if c.IsSet("network-name") {
+		np.Name = c.String("network-name")
+	}
+
+	if c.IsSet("dht-protocol-prefix") {
+		np.ProtocolPrefix = protocol.ID(c.String("dht-protocol-prefix"))
+	}
+
+	if c.IsSet("bootstrap-peers") {
+		np.BootstrapPeers = c.StringSlice("bootstrap-peers")
+	}
+
+	if np.Name == "" {
+		return nil, fmt.Errorf("network name required for network profile %s", NetworkCustom)
+	}
+
+	if len(np.BootstrapPeers) == 0 {
+		return nil, fmt.Errorf("no bootstrap peers for network %s", np.Name)
+	}
+
+	if _, err := np.BootstrapAddrInfos(); err != nil {
+		return nil, err
+	}
+
+	return np, nil
+}
+
+// HasDHT returns true if the network uses a libp2p Kademlia DHT.
+func (np *NetworkProfile) HasDHT() bool {
+	return np.ProtocolPrefix != ""
+}
+
+// DHTProtocol returns the Kademlia protocol ID of the network.
+func (np *NetworkProfile) DHTProtocol() protocol.ID {
+	return np.ProtocolPrefix + "/kad/1.0.0"
+}
+
+// BootstrapAddrInfos parses the bootstrap multi addresses and groups them by peer.
+func (np *NetworkProfile) BootstrapAddrInfos() ([]peer.AddrInfo, error) {
+	maddrs := make([]ma.Multiaddr, len(np.BootstrapPeers))
+	for i, bp := range np.BootstrapPeers {
+		maddr, err := ma.NewMultiaddr(bp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse bootstrap peer multi address %s", bp)
+		}
+		maddrs[i] = maddr
+	}
+
+	addrInfos, err := peer.AddrInfosFromP2pAddrs(maddrs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "bootstrap peer addr infos")
+	}
+
+	return addrInfos, nil
+}
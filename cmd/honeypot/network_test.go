@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkProfile_BootstrapAddrInfos(t *testing.T) {
+	np := &NetworkProfile{Name: NetworkFilecoin, BootstrapPeers: filecoinBootstrapPeers}
+
+	addrInfos, err := np.BootstrapAddrInfos()
+	require.NoError(t, err)
+	assert.Len(t, addrInfos, len(filecoinBootstrapPeers))
+
+	np.BootstrapPeers = []string{"/ip4/1.2.3.4/tcp/4001"}
+	_, err = np.BootstrapAddrInfos()
+	assert.Error(t, err)
+}
+
+func TestNetworkProfile_DHTProtocol(t *testing.T) {
+	np := &NetworkProfile{ProtocolPrefix: "/fil/kad/testnetnet"}
+	assert.True(t, np.HasDHT())
+	assert.EqualValues(t, "/fil/kad/testnetnet/kad/1.0.0", np.DHTProtocol())
+
+	np.ProtocolPrefix = ""
+	assert.False(t, np.HasDHT())
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/maxmind"
 	"github.com/dennis-tra/punchr/pkg/models"
 	"github.com/dennis-tra/punchr/pkg/util"
 )
@@ -54,18 +55,19 @@ func (h *Host) handleNewConnection(remotePeer peer.ID, remoteMultiaddr ma.Multia
 	protocols := h.GetProtocols(remotePeer)
 	maddrs := h.GetMultiAddresses(remotePeer)
 
-	if !util.SupportDCUtR(protocols) {
-		// don't save peer as it doesn't support DCUtR
-		log.Debugln("Incoming connection, peer does not support DCUtR")
-		return nil
+	candidate := &AdmissionCandidate{
+		AgentVersion: agentVersion,
+		Protocols:    protocols,
+		Maddrs:       maddrs,
+		geoInfosFn: func() []*maxmind.AddrInfo {
+			return h.geoInfos(append([]ma.Multiaddr{remoteMultiaddr}, maddrs...))
+		},
 	}
 
-	// Check if the remote peer only has relay addresses
-	for _, maddr := range maddrs {
-		if !manet.IsPrivateAddr(maddr) && !util.IsRelayedMaddr(maddr) {
-			log.Debugln("Incoming connection, has a public non-relay address")
-			return nil
-		}
+	if !h.admission.Admit(candidate) {
+		// don't save peer as it's not covered by the admission policy
+		log.Debugln("Incoming connection, peer was not admitted")
+		return nil
 	}
 
 	// It can happen that the `conn.RemoteMultiaddr()` is not part of the peer store maddrs.
@@ -117,13 +119,14 @@ func (h *Host) handleNewConnection(remotePeer peer.ID, remoteMultiaddr ma.Multia
 		RemoteID:           dbPeer.ID,
 		ConnMultiAddressID: connMaddrID,
 		OpenedAt:           stat.Opened,
+		Network:            h.network.Name,
 	}
 	if err = dbConnEvt.Insert(h.ctx, txn, boil.Infer()); err != nil {
 		return errors.Wrap(err, "insert connection event")
 	}
 
 	// Associate multi addresses with this connection event
-	if err = dbConnEvt.SetMultiAddresses(h.ctx, txn, false, advertisedMaddrs...); err != nil {
+	if err = h.DBClient.InsertConnectionEventMultiAddresses(h.ctx, txn, dbConnEvt, advertisedMaddrs); err != nil {
 		return errors.Wrap(err, "set connection event multi addresses")
 	}
 
@@ -136,6 +139,27 @@ func (h *Host) handleNewConnection(remotePeer peer.ID, remoteMultiaddr ma.Multia
 	return nil
 }
 
+// geoInfos looks up the geo information of all non-relay addresses.
+func (h *Host) geoInfos(maddrs []ma.Multiaddr) []*maxmind.AddrInfo {
+	var infos []*maxmind.AddrInfo
+	for _, maddr := range maddrs {
+		if util.IsRelayedMaddr(maddr) || manet.IsPrivateAddr(maddr) {
+			continue
+		}
+
+		addrInfos, err := h.DBClient.MMClient.MaddrInfo(h.ctx, maddr)
+		if err != nil {
+			log.WithError(err).WithField("maddr", maddr).Debugln("Could not derive geo information")
+			continue
+		}
+
+		for _, info := range addrInfos {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
 // IdentifyWait waits for the "identify" protocol to complete.
 func (h *Host) IdentifyWait(ctx context.Context, pid peer.ID) error {
 	eventTypes := []interface{}{
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+// apiKeyPrefixLen is the number of characters of an API key that are shown when listing keys.
+const apiKeyPrefixLen = 8
+
+// AdminServer implements the API key management RPCs. It shares the
+// API key cache with the Server, so that rotated and revoked keys are
+// evicted immediately.
+type AdminServer struct {
+	pb.UnimplementedPunchrAdminServiceServer
+	server Server
+}
+
+func (a AdminServer) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	username := strings.TrimSpace(req.GetUsername())
+	if username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is missing")
+	}
+
+	role := models.AuthorizationRoleCONTRIBUTOR
+	if req.Role != nil {
+		var err error
+		if role, err = mapAuthorizationRole(req.GetRole()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	dbAuth := &models.Authorization{
+		APIKey:   uuid.NewString(),
+		Username: username,
+		Role:     role,
+	}
+	if err := dbAuth.Insert(ctx, a.server.DBClient, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "insert authorization")
+	}
+
+	log.WithFields(log.Fields{"id": dbAuth.ID, "username": username, "role": role}).Infoln("Created API key")
+
+	return &pb.CreateApiKeyResponse{ApiKey: toProtoApiKey(dbAuth, true)}, nil
+}
+
+func (a AdminServer) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{qm.OrderBy(models.AuthorizationColumns.ID)}
+	if !req.GetIncludeRevoked() {
+		mods = append(mods, models.AuthorizationWhere.RevokedAt.IsNull())
+	}
+
+	dbAuths, err := models.Authorizations(mods...).All(ctx, a.server.DBClient.Reader())
+	if err != nil {
+		return nil, errors.Wrap(err, "get authorizations")
+	}
+
+	apiKeys := make([]*pb.ApiKey, len(dbAuths))
+	for i, dbAuth := range dbAuths {
+		apiKeys[i] = toProtoApiKey(dbAuth, false)
+	}
+
+	return &pb.ListApiKeysResponse{ApiKeys: apiKeys}, nil
+}
+
+func (a AdminServer) RotateApiKey(ctx context.Context, req *pb.RotateApiKeyRequest) (*pb.RotateApiKeyResponse, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	dbAuth, err := a.findAuthorization(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if dbAuth.RevokedAt.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "api key is revoked")
+	}
+
+	txn, err := a.server.DBClient.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "begin txn")
+	}
+	defer db.DeferRollback(txn)
+
+	// Keep the old key as a revoked tombstone. Otherwise, anonymous
+	// registration would accept it again as an unknown key.
+	tombstone := &models.Authorization{
+		APIKey:    dbAuth.APIKey,
+		Username:  dbAuth.Username,
+		Role:      dbAuth.Role,
+		RevokedAt: null.TimeFrom(time.Now()),
+	}
+	if err = tombstone.Insert(ctx, txn, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "insert revoked api key")
+	}
+
+	dbAuth.APIKey = uuid.NewString()
+	if _, err = dbAuth.Update(ctx, txn, boil.Whitelist(models.AuthorizationColumns.APIKey, models.AuthorizationColumns.UpdatedAt)); err != nil {
+		return nil, errors.Wrap(err, "update api key")
+	}
+
+	if err = txn.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit txn")
+	}
+	a.server.apiKeyCache.Remove(tombstone.APIKey)
+
+	log.WithFields(log.Fields{"id": dbAuth.ID, "username": dbAuth.Username}).Infoln("Rotated API key")
+
+	return &pb.RotateApiKeyResponse{ApiKey: toProtoApiKey(dbAuth, true)}, nil
+}
+
+func (a AdminServer) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	authz, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(authz.ID) == req.GetId() {
+		return nil, status.Error(codes.InvalidArgument, "admins can't revoke their own api key")
+	}
+
+	dbAuth, err := a.findAuthorization(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !dbAuth.RevokedAt.Valid {
+		dbAuth.RevokedAt = null.TimeFrom(time.Now())
+		if _, err = dbAuth.Update(ctx, a.server.DBClient, boil.Whitelist(models.AuthorizationColumns.RevokedAt, models.AuthorizationColumns.UpdatedAt)); err != nil {
+			return nil, errors.Wrap(err, "revoke api key")
+		}
+		log.WithFields(log.Fields{"id": dbAuth.ID, "username": dbAuth.Username}).Infoln("Revoked API key")
+	}
+	a.server.apiKeyCache.Remove(dbAuth.APIKey)
+
+	return &pb.RevokeApiKeyResponse{ApiKey: toProtoApiKey(dbAuth, false)}, nil
+}
+
+func (a AdminServer) RenameApiKey(ctx context.Context, req *pb.RenameApiKeyRequest) (*pb.RenameApiKeyResponse, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	username := strings.TrimSpace(req.GetUsername())
+	if username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is missing")
+	}
+
+	dbAuth, err := a.findAuthorization(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	dbAuth.Username = username
+	if _, err = dbAuth.Update(ctx, a.server.DBClient, boil.Whitelist(models.AuthorizationColumns.Username, models.AuthorizationColumns.UpdatedAt)); err != nil {
+		return nil, errors.Wrap(err, "rename api key")
+	}
+
+	return &pb.RenameApiKeyResponse{ApiKey: toProtoApiKey(dbAuth, false)}, nil
+}
+
+// checkAdmin verifies that the request was authenticated with an admin key.
+func checkAdmin(ctx context.Context) (*authorization, error) {
+	authz, found := authFromContext(ctx)
+	if !found || authz.Role != models.AuthorizationRoleADMIN {
+		return nil, status.Error(codes.PermissionDenied, "api key is not an admin key")
+	}
+	return authz, nil
+}
+
+func (a AdminServer) findAuthorization(ctx context.Context, id int64) (*models.Authorization, error) {
+	dbAuth, err := models.FindAuthorization(ctx, a.server.DBClient, int(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "api key %d not found", id)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "find authorization")
+	}
+	return dbAuth, nil
+}
+
+func toProtoApiKey(dbAuth *models.Authorization, full bool) *pb.ApiKey {
+	id := int64(dbAuth.ID)
+
+	apiKey := dbAuth.APIKey
+	if !full && len(apiKey) > apiKeyPrefixLen {
+		apiKey = apiKey[:apiKeyPrefixLen]
+	}
+
+	role := toProtoAuthorizationRole(dbAuth.Role)
+	createdAt := uint64(dbAuth.CreatedAt.UnixNano())
+
+	var revokedAt *uint64
+	if dbAuth.RevokedAt.Valid {
+		r := uint64(dbAuth.RevokedAt.Time.UnixNano())
+		revokedAt = &r
+	}
+
+	return &pb.ApiKey{
+		Id:        &id,
+		ApiKey:    &apiKey,
+		Username:  &dbAuth.Username,
+		Role:      &role,
+		CreatedAt: &createdAt,
+		RevokedAt: revokedAt,
+	}
+}
+
+func mapAuthorizationRole(role pb.AuthorizationRole) (string, error) {
+	switch role {
+	case pb.AuthorizationRole_AUTHORIZATION_ROLE_CONTRIBUTOR:
+		return models.AuthorizationRoleCONTRIBUTOR, nil
+	case pb.AuthorizationRole_AUTHORIZATION_ROLE_ADMIN:
+		return models.AuthorizationRoleADMIN, nil
+	case pb.AuthorizationRole_AUTHORIZATION_ROLE_READ_ONLY:
+		return models.AuthorizationRoleREAD_ONLY, nil
+	default:
+		return "", errors.Errorf("unsupported authorization role: %s", role)
+	}
+}
+
+func toProtoAuthorizationRole(role string) pb.AuthorizationRole {
+	switch role {
+	case models.AuthorizationRoleCONTRIBUTOR:
+		return pb.AuthorizationRole_AUTHORIZATION_ROLE_CONTRIBUTOR
+	case models.AuthorizationRoleADMIN:
+		return pb.AuthorizationRole_AUTHORIZATION_ROLE_ADMIN
+	case models.AuthorizationRoleREAD_ONLY:
+		return pb.AuthorizationRole_AUTHORIZATION_ROLE_READ_ONLY
+	default:
+		return pb.AuthorizationRole_AUTHORIZATION_ROLE_UNKNOWN
+	}
+}
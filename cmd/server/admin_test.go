@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		role    string
+		want    pb.AuthorizationRole
+		wantErr bool
+	}{
+		{role: "contributor", want: pb.AuthorizationRole_AUTHORIZATION_ROLE_CONTRIBUTOR},
+		{role: "ADMIN", want: pb.AuthorizationRole_AUTHORIZATION_ROLE_ADMIN},
+		{role: "read-only", want: pb.AuthorizationRole_AUTHORIZATION_ROLE_READ_ONLY},
+		{role: "read_only", want: pb.AuthorizationRole_AUTHORIZATION_ROLE_READ_ONLY},
+		{role: "superuser", want: pb.AuthorizationRole_AUTHORIZATION_ROLE_UNKNOWN, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			got, err := parseRole(tt.role)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMapAuthorizationRole(t *testing.T) {
+	for _, role := range models.AllAuthorizationRole() {
+		got, err := mapAuthorizationRole(toProtoAuthorizationRole(role))
+		require.NoError(t, err)
+		assert.Equal(t, role, got)
+	}
+
+	_, err := mapAuthorizationRole(pb.AuthorizationRole_AUTHORIZATION_ROLE_UNKNOWN)
+	assert.Error(t, err)
+}
+
+func TestToProtoApiKey(t *testing.T) {
+	dbAuth := &models.Authorization{
+		ID:        3,
+		APIKey:    "1b4e28ba-2fa1-11d2-883f-0016d3cca427",
+		Username:  "alice",
+		Role:      models.AuthorizationRoleREAD_ONLY,
+		CreatedAt: time.Now(),
+	}
+
+	full := toProtoApiKey(dbAuth, true)
+	assert.Equal(t, dbAuth.APIKey, full.GetApiKey())
+	assert.Nil(t, full.RevokedAt)
+
+	dbAuth.RevokedAt = null.TimeFrom(time.Now())
+	abbreviated := toProtoApiKey(dbAuth, false)
+	assert.Equal(t, "1b4e28ba", abbreviated.GetApiKey())
+	assert.EqualValues(t, 3, abbreviated.GetId())
+	assert.Equal(t, pb.AuthorizationRole_AUTHORIZATION_ROLE_READ_ONLY, abbreviated.GetRole())
+	assert.NotNil(t, abbreviated.RevokedAt)
+}
+
+func TestAuthorization_mayContribute(t *testing.T) {
+	assert.True(t, (&authorization{Role: models.AuthorizationRoleCONTRIBUTOR}).mayContribute())
+	assert.True(t, (&authorization{Role: models.AuthorizationRoleADMIN}).mayContribute())
+	assert.False(t, (&authorization{Role: models.AuthorizationRoleREAD_ONLY}).mayContribute())
+}
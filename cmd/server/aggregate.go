@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+
+	"github.com/dennis-tra/punchr/pkg/aggregate"
+	"github.com/dennis-tra/punchr/pkg/db"
+)
+
+// AggregateCommand refreshes the aggregates of the hole punch results once. It uses the database flags of the server.
+var AggregateCommand = &cli.Command{
+	Name:      "aggregate",
+	Usage:     "Refresh the hourly aggregates of the hole punch results",
+	UsageText: "Recomputes the hours since the previous refresh. Run it as a cron job if the servers don't refresh the aggregates (--aggregate-interval=0).",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "rebuild",
+			Usage: "Recompute the aggregates of all hole punch results in the database",
+		},
+	},
+	Action: AggregateAction,
+}
+
+func AggregateAction(c *cli.Context) error {
+	dbh, err := db.Open(c)
+	if err != nil {
+		return errors.Wrap(err, "open database")
+	}
+	defer dbh.Close()
+
+	job := aggregate.NewJob(dbh)
+	if c.Bool("rebuild") {
+		err = job.Rebuild(c.Context)
+	} else {
+		err = job.RunOnce(c.Context)
+	}
+	if err != nil {
+		return errors.Wrap(err, "refresh aggregates")
+	}
+
+	log.Infoln("Refreshed aggregates")
+	return nil
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"math/rand"
+	"time"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+// experimentArm is a protocol filter that the server randomly assigns to an allocation.
+type experimentArm struct {
+	name      string
+	protocols []int32
+	share     float32
+}
+
+// experimentArms are the protocol filters that are handed out to clients
+// with the given probabilities. The remaining allocations are unfiltered.
+var experimentArms = []experimentArm{
+	{name: "ip4-tcp", protocols: []int32{multiaddr.P_IP4, multiaddr.P_TCP}, share: 0.15},
+	{name: "ip4-quic", protocols: []int32{multiaddr.P_IP4, multiaddr.P_QUIC}, share: 0.15},
+	{name: "ip6-tcp", protocols: []int32{multiaddr.P_IP6, multiaddr.P_TCP}, share: 0.15},
+	{name: "ip6-quic", protocols: []int32{multiaddr.P_IP6, multiaddr.P_QUIC}, share: 0.15},
+}
+
+var unfilteredArm = experimentArm{name: "unfiltered", protocols: []int32{}}
+
+// chooseExperimentArm draws a random experiment arm.
+func chooseExperimentArm() experimentArm {
+	r := rand.Float32()
+	for _, arm := range experimentArms {
+		if r < arm.share {
+			return arm
+		}
+		r -= arm.share
+	}
+	return unfilteredArm
+}
+
+// timingArm is a set of DCUtR timing parameters that the server randomly assigns to an allocation.
+// Parameters that aren't set are taken from the configuration of the client.
+type timingArm struct {
+	name   string
+	params *pb.DCUtRParameters
+}
+
+// timingArms are the timing parameters that are handed out to the share of allocations that is
+// configured with --timing-experiment-share. Each arm is equally likely. The default communication
+// timeout of the client is 30s. There are no arms for the retry count because the remote peer runs
+// a fixed number of attempts regardless of how many the client waits for.
+var timingArms = []timingArm{
+	{name: "timeout-15s", params: &pb.DCUtRParameters{CommunicationTimeout: float32Ptr(15)}},
+	{name: "timeout-60s", params: &pb.DCUtRParameters{CommunicationTimeout: float32Ptr(60)}},
+}
+
+// chooseTimingArm draws a timing arm for the given share of allocations. It returns
+// nil for the remaining allocations, for which clients use their own timing.
+func chooseTimingArm(share float64) *timingArm {
+	if len(timingArms) == 0 || rand.Float64() >= share {
+		return nil
+	}
+	return &timingArms[rand.Intn(len(timingArms))]
+}
+
+// findTimingArm returns the timing arm with the given name.
+func findTimingArm(name string) (*timingArm, bool) {
+	for i, arm := range timingArms {
+		if arm.name == name {
+			return &timingArms[i], true
+		}
+	}
+	return nil, false
+}
+
+func float32Ptr(f float32) *float32 {
+	return &f
+}
+
+// allocate stores the given response as an allocation of the remote peer
+// to the client and sets the allocation ID and expiry in the response.
+func (s Server) allocate(ctx context.Context, dbClientID int64, dbRemoteID int64, network string, arm experimentArm, tArm *timingArm, resp *pb.GetAddrInfoResponse) error {
+	maddrs := make([]multiaddr.Multiaddr, len(resp.MultiAddresses))
+	for i, maddrBytes := range resp.MultiAddresses {
+		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+		if err != nil {
+			return errors.Wrap(err, "multi addr from bytes")
+		}
+		maddrs[i] = maddr
+	}
+
+	txn, err := s.DBClient.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin txn")
+	}
+	defer db.DeferRollback(txn)
+
+	dbMaddrs, err := s.DBClient.UpsertMultiAddresses(ctx, txn, maddrs)
+	if err != nil {
+		return errors.Wrap(err, "upsert allocated multi addresses")
+	}
+
+	maddrSetID, err := s.DBClient.UpsertMultiAddressesSet(ctx, txn, dbMaddrs)
+	if err != nil {
+		return errors.Wrap(err, "upsert allocated multi addresses set")
+	}
+
+	filters := make([]int64, len(arm.protocols))
+	for i, p := range arm.protocols {
+		filters[i] = int64(p)
+	}
+
+	now := time.Now()
+	allocation := &models.Allocation{
+		ClientID:            dbClientID,
+		RemoteID:            dbRemoteID,
+		MultiAddressesSetID: null.NewInt(maddrSetID, maddrSetID != 0),
+		Network:             network,
+		ProtocolFilters:     filters,
+		ExperimentArm:       arm.name,
+		ExpiresAt:           now.Add(s.allocationTTL.Load()),
+		CreatedAt:           now,
+	}
+
+	if tArm != nil {
+		allocation.TimingArm = null.StringFrom(tArm.name)
+	}
+
+	if authz, found := authFromContext(ctx); found {
+		allocation.AuthorizationID = null.IntFrom(authz.ID)
+	}
+
+	if err = allocation.Insert(ctx, txn, boil.Infer()); err != nil {
+		return errors.Wrap(err, "insert allocation")
+	}
+
+	if err = txn.Commit(); err != nil {
+		return errors.Wrap(err, "commit allocation")
+	}
+
+	expiresAt := uint64(allocation.ExpiresAt.UnixNano())
+	resp.AllocationId = &allocation.ID
+	resp.AllocationExpiresAt = &expiresAt
+	resp.Protocols = arm.protocols
+	if tArm != nil {
+		resp.DcutrParameters = tArm.params
+	}
+
+	return nil
+}
+
+// allocationMatch is the allocation that a hole punch result was reported for.
+type allocationMatch struct {
+	// allocation is nil if no allocation was found.
+	allocation *models.Allocation
+
+	// completed is true if the allocation already has a result.
+	completed bool
+
+	// localID and remoteID are the database IDs of the peers of the result.
+	localID  int64
+	remoteID int64
+}
+
+// findAllocation looks up the allocation of the given result. Old clients
+// don't report the allocation ID. Their results are matched with the latest
+// allocation of the remote peer to the client.
+func (s Server) findAllocation(ctx context.Context, req *pb.TrackHolePunchRequest, localID int64, remoteID int64) (allocationMatch, error) {
+	am := allocationMatch{localID: localID, remoteID: remoteID}
+
+	var err error
+	if req.AllocationId != nil {
+		am.allocation, err = models.FindAllocation(ctx, s.DBClient, req.GetAllocationId())
+	} else {
+		am.allocation, err = models.Allocations(
+			models.AllocationWhere.ClientID.EQ(localID),
+			models.AllocationWhere.RemoteID.EQ(remoteID),
+			qm.OrderBy(models.AllocationColumns.CreatedAt+" DESC"),
+		).One(ctx, s.DBClient)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return am, nil
+	} else if err != nil {
+		return am, errors.Wrap(err, "find allocation")
+	}
+
+	am.completed, err = models.HolePunchResults(
+		models.HolePunchResultWhere.AllocationID.EQ(null.Int64From(am.allocation.ID)),
+	).Exists(ctx, s.DBClient)
+	if err != nil {
+		return am, errors.Wrap(err, "check allocation completion")
+	}
+
+	return am, nil
+}
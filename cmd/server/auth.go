@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"github.com/dennis-tra/punchr/pkg/auth"
+)
+
+type (
+	authCtxKey   struct{}
+	apiKeyCtxKey struct{}
+)
+
+// legacyApiKeyRequest is implemented by all request messages that carry
+// the API key in the message itself. Old clients only authenticate this way.
+type legacyApiKeyRequest interface {
+	GetApiKey() string
+}
+
+// registerMethod may be called with an unknown API key if anonymous registration is enabled.
+const registerMethod = "/PunchrService/Register"
+
+// publicServices may be called without an API key, e.g., by load balancers and Kubernetes probes.
+var publicServices = map[string]bool{
+	healthpb.Health_ServiceDesc.ServiceName:               true,
+	reflectionpb.ServerReflection_ServiceDesc.ServiceName: true,
+}
+
+// isPublicMethod returns true if the given full method name, e.g.,
+// /grpc.health.v1.Health/Check, belongs to one of the publicServices.
+func isPublicMethod(method string) bool {
+	service, _, found := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	return found && publicServices[service]
+}
+
+// authUnaryInterceptor authenticates every request and places the
+// authorization in the context of the handler (see authFromContext).
+// The API key is taken from the bearer token in the request metadata
+// or from the api_key field of the request message as a fallback.
+func (s Server) authUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isPublicMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	apiKey := auth.FromIncomingContext(ctx)
+	if apiKey == "" {
+		if lreq, ok := req.(legacyApiKeyRequest); ok {
+			apiKey = lreq.GetApiKey()
+		}
+	}
+
+	ctx, err := s.authenticate(ctx, apiKey, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// authStreamInterceptor authenticates streaming RPCs. Streams can only be
+// authenticated with the bearer token because the first message isn't
+// known when the stream is opened.
+func (s Server) authStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isPublicMethod(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	ctx, err := s.authenticate(ss.Context(), auth.FromIncomingContext(ss.Context()), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func (s Server) authenticate(ctx context.Context, apiKey string, method string) (context.Context, error) {
+	if apiKey == "" {
+		return nil, status.Error(codes.Unauthenticated, "api key is missing")
+	}
+
+	ctx = context.WithValue(ctx, apiKeyCtxKey{}, apiKey)
+
+	authz, err := s.checkApiKey(ctx, &apiKey)
+	switch {
+	case err == nil:
+		return context.WithValue(ctx, authCtxKey{}, authz), nil
+	case errors.Is(err, ErrUnauthorized) && method == registerMethod && s.anonymousRegistration:
+		// Register creates an anonymous authorization for the API key
+		return ctx, nil
+	case errors.Is(err, ErrUnauthorized):
+		return nil, status.Error(codes.Unauthenticated, "unknown api key")
+	case errors.Is(err, ErrRevoked):
+		return nil, status.Error(codes.Unauthenticated, "api key revoked")
+	default:
+		log.WithError(err).Warnln("Could not check api key")
+		return nil, status.Error(codes.Internal, "could not check api key")
+	}
+}
+
+// authFromContext returns the authorization of the request. It's only
+// missing for anonymous registrations.
+func authFromContext(ctx context.Context) (*authorization, bool) {
+	authz, ok := ctx.Value(authCtxKey{}).(*authorization)
+	return authz, ok
+}
+
+// apiKeyFromContext returns the API key the request was authenticated with.
+func apiKeyFromContext(ctx context.Context) string {
+	apiKey, _ := ctx.Value(apiKeyCtxKey{}).(string)
+	return apiKey
+}
+
+// requireContributor returns a PermissionDenied status if the authorization may not contribute results.
+func requireContributor(authz *authorization) error {
+	if !authz.mayContribute() {
+		return status.Error(codes.PermissionDenied, "api key may not contribute results")
+	}
+	return nil
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *authServerStream) Context() context.Context {
+	return ss.ctx
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dennis-tra/punchr/pkg/auth"
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func newTestServer(t *testing.T, keys map[string]*authorization) Server {
+	cache, err := lru.New(10)
+	require.NoError(t, err)
+
+	for apiKey, authz := range keys {
+		cache.Add(apiKey, authz)
+	}
+
+	return Server{apiKeyCache: cache, anonymousRegistration: true}
+}
+
+func TestServer_authUnaryInterceptor(t *testing.T) {
+	contributor := &authorization{ID: 1, Role: models.AuthorizationRoleCONTRIBUTOR}
+	s := newTestServer(t, map[string]*authorization{"contributor-key": contributor})
+
+	legacyKey := "contributor-key"
+	emptyKey := ""
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		req      interface{}
+		wantCode codes.Code
+		wantAuth *authorization
+	}{
+		{
+			name:     "bearer token",
+			md:       metadata.Pairs(auth.MetadataKey, "Bearer contributor-key"),
+			req:      &pb.GetAddrInfoRequest{},
+			wantAuth: contributor,
+		},
+		{
+			name:     "legacy field",
+			req:      &pb.GetAddrInfoRequest{ApiKey: &legacyKey},
+			wantAuth: contributor,
+		},
+		{
+			name:     "bearer token takes precedence",
+			md:       metadata.Pairs(auth.MetadataKey, "Bearer contributor-key"),
+			req:      &pb.GetAddrInfoRequest{ApiKey: &emptyKey},
+			wantAuth: contributor,
+		},
+		{
+			name:     "missing key",
+			req:      &pb.GetAddrInfoRequest{},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "empty legacy field",
+			req:      &pb.TrackHolePunchRequest{ApiKey: &emptyKey},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var gotAuth *authorization
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotAuth, _ = authFromContext(ctx)
+				return nil, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: "/PunchrService/GetAddrInfo"}
+			_, err := s.authUnaryInterceptor(ctx, tt.req, info, handler)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				assert.Nil(t, gotAuth)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAuth, gotAuth)
+		})
+	}
+}
+
+func TestIsPublicMethod(t *testing.T) {
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{method: "/grpc.health.v1.Health/Check", want: true},
+		{method: "/grpc.health.v1.Health/Watch", want: true},
+		{method: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", want: true},
+		{method: "/PunchrService/GetAddrInfo", want: false},
+		{method: "/PunchrAdminService/ListApiKeys", want: false},
+		{method: "/grpc.health.v1.Health", want: false},
+		{method: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			assert.Equal(t, tt.want, isPublicMethod(tt.method))
+		})
+	}
+}
+
+func TestServer_authUnaryInterceptor_publicMethod(t *testing.T) {
+	s := newTestServer(t, nil)
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+	_, err := s.authUnaryInterceptor(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestRequireContributor(t *testing.T) {
+	assert.NoError(t, requireContributor(&authorization{Role: models.AuthorizationRoleCONTRIBUTOR}))
+	err := requireContributor(&authorization{Role: models.AuthorizationRoleREAD_ONLY})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestServer_GetAddrInfo_readOnly(t *testing.T) {
+	s := Server{}
+
+	_, err := s.GetAddrInfo(context.Background(), &pb.GetAddrInfoRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := context.WithValue(context.Background(), authCtxKey{}, &authorization{ID: 1, Role: models.AuthorizationRoleREAD_ONLY})
+	_, err = s.GetAddrInfo(ctx, &pb.GetAddrInfoRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestServer_TrackHolePunch_unauthenticated(t *testing.T) {
+	s := Server{}
+
+	_, err := s.TrackHolePunch(context.Background(), &pb.TrackHolePunchRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestCheckAdmin(t *testing.T) {
+	_, err := checkAdmin(context.Background())
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx := context.WithValue(context.Background(), authCtxKey{}, &authorization{ID: 1, Role: models.AuthorizationRoleCONTRIBUTOR})
+	_, err = checkAdmin(ctx)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	admin := &authorization{ID: 2, Role: models.AuthorizationRoleADMIN}
+	ctx = context.WithValue(context.Background(), authCtxKey{}, admin)
+	got, err := checkAdmin(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, admin, got)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/time/rate"
+
+	"github.com/dennis-tra/punchr/pkg/retention"
+)
+
+// reloadableFlags can be changed in the config file while the server is running (see applyConfig).
+var reloadableFlags = []string{
+	"allocation-ttl",
+	"rate-limit-key",
+	"rate-limit-key-burst",
+	"rate-limit-anonymous",
+	"rate-limit-anonymous-burst",
+	"rate-limit-peer",
+	"rate-limit-peer-burst",
+}
+
+// validateConfig checks the settings whose flag types allow invalid values.
+func validateConfig(c *cli.Context) error {
+	for _, name := range []string{"rate-limit-key", "rate-limit-anonymous", "rate-limit-peer"} {
+		if c.Float64(name) < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+
+	for _, name := range []string{"rate-limit-key-burst", "rate-limit-anonymous-burst", "rate-limit-peer-burst"} {
+		if c.Int(name) < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+
+	for _, name := range []string{"allocation-ttl", "shutdown-timeout", "parquet-sink-rotation"} {
+		if c.Duration(name) <= 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+	}
+
+	if r := c.Float64("relay-min-reachability"); r < 0 || r > 1 {
+		return fmt.Errorf("relay-min-reachability must be between 0 and 1")
+	}
+
+	if c.Int("relay-min-pings") < 0 {
+		return fmt.Errorf("relay-min-pings must not be negative")
+	}
+
+	if err := validateRelaySelection(c.String("relay-selection")); err != nil {
+		return err
+	}
+
+	if r := c.Float64("timing-experiment-share"); r < 0 || r > 1 {
+		return fmt.Errorf("timing-experiment-share must be between 0 and 1")
+	}
+
+	if c.Int("sink-queue-size") <= 0 {
+		return fmt.Errorf("sink-queue-size must be positive")
+	}
+
+	for _, name := range []string{"retention-connection-events", "retention-hole-punch-results", "aggregate-interval"} {
+		if c.Duration(name) < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+
+	if _, err := retention.ParseFormat(c.String("archive-format")); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// quotasFromConfig returns the rate limits for API keys, anonymous API keys and client peers.
+func quotasFromConfig(c *cli.Context) (quota, quota, quota) {
+	return quota{Rate: rate.Limit(c.Float64("rate-limit-key")), Burst: c.Int("rate-limit-key-burst")},
+		quota{Rate: rate.Limit(c.Float64("rate-limit-anonymous")), Burst: c.Int("rate-limit-anonymous-burst")},
+		quota{Rate: rate.Limit(c.Float64("rate-limit-peer")), Burst: c.Int("rate-limit-peer-burst")}
+}
+
+// applyConfig returns a function that applies reloaded settings to the running server.
+func applyConfig(server Server) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		server.allocationTTL.Store(c.Duration("allocation-ttl"))
+		server.limiter.setQuotas(quotasFromConfig(c))
+
+		log.WithFields(log.Fields{
+			"allocationTTL": c.Duration("allocation-ttl"),
+			"logLevel":      log.GetLevel(),
+		}).Infoln("Applied reloaded settings")
+
+		return nil
+	}
+}
+
+// duration is a time.Duration that can be changed while it's read concurrently.
+type duration struct {
+	ns int64
+}
+
+func newDuration(d time.Duration) *duration {
+	return &duration{ns: int64(d)}
+}
+
+func (d *duration) Load() time.Duration {
+	return time.Duration(atomic.LoadInt64(&d.ns))
+}
+
+func (d *duration) Store(val time.Duration) {
+	atomic.StoreInt64(&d.ns, int64(val))
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+
+	"github.com/dennis-tra/punchr/pkg/anonymize"
+	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/export"
+)
+
+// ExportCommand writes datasets of the hole punch results to files. It uses the database flags
+// of the server and reads from the read replica if one is configured.
+var ExportCommand = &cli.Command{
+	Name:      "export",
+	Usage:     "Export denormalized datasets of the hole punch results for analyses",
+	UsageText: "Writes a results and an attempts dataset and a manifest.json that documents them to a new directory in the output directory.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "from",
+			Usage:    "Export the hole punch results that were tracked on or after this date (YYYY-MM-DD or RFC 3339)",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:        "to",
+			Usage:       "Export the hole punch results that were tracked before this date (YYYY-MM-DD or RFC 3339)",
+			DefaultText: "today",
+		},
+		&cli.StringFlag{
+			Name:        "out-dir",
+			Usage:       "The directory in which the export is created",
+			DefaultText: ".",
+			Value:       ".",
+		},
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "The file format of the datasets (csv, parquet)",
+			DefaultText: "parquet",
+			Value:       "parquet",
+		},
+		&cli.BoolFlag{
+			Name:  "anonymize",
+			Usage: "Replace peer IDs with pseudonyms and anonymize IP addresses",
+		},
+		&cli.StringFlag{
+			Name:    "anonymization-key",
+			Usage:   "The key of the pseudonyms. Exports with the same key use the same pseudonyms (default: a random key per export)",
+			EnvVars: []string{"PUNCHR_SERVER_ANONYMIZATION_KEY"},
+		},
+		&cli.StringFlag{
+			Name:        "ip-anonymization",
+			Usage:       "How IP addresses are anonymized: replaced with pseudonyms (hash), mapped prefix-preservingly with Crypto-PAn (prefix-preserving) or cut to their network prefix (truncate)",
+			DefaultText: "hash",
+			Value:       "hash",
+		},
+		&cli.IntFlag{
+			Name:        "ipv4-prefix-len",
+			Usage:       "How many bits of IPv4 addresses are kept when truncating",
+			DefaultText: strconv.Itoa(anonymize.DefaultIPv4PrefixLen),
+			Value:       anonymize.DefaultIPv4PrefixLen,
+		},
+		&cli.IntFlag{
+			Name:        "ipv6-prefix-len",
+			Usage:       "How many bits of IPv6 addresses are kept when truncating",
+			DefaultText: strconv.Itoa(anonymize.DefaultIPv6PrefixLen),
+			Value:       anonymize.DefaultIPv6PrefixLen,
+		},
+	},
+	Action: ExportAction,
+}
+
+func ExportAction(c *cli.Context) error {
+	opts := export.Options{
+		Dir:     c.String("out-dir"),
+		Version: Version,
+	}
+
+	var err error
+	if opts.Format, err = export.ParseFormat(c.String("format")); err != nil {
+		return err
+	}
+
+	if opts.From, err = parseDate(c.String("from")); err != nil {
+		return errors.Wrap(err, "invalid from")
+	}
+
+	opts.To = time.Now().UTC().Truncate(24 * time.Hour)
+	if c.IsSet("to") {
+		if opts.To, err = parseDate(c.String("to")); err != nil {
+			return errors.Wrap(err, "invalid to")
+		}
+	}
+
+	if !opts.From.Before(opts.To) {
+		return fmt.Errorf("from must be before to")
+	}
+
+	if c.Bool("anonymize") {
+		ipMode, err := anonymize.ParseIPMode(c.String("ip-anonymization"))
+		if err != nil {
+			return err
+		}
+
+		opts.Anonymizer, err = anonymize.New(anonymize.Config{
+			Key:           []byte(c.String("anonymization-key")),
+			IPMode:        ipMode,
+			IPv4PrefixLen: c.Int("ipv4-prefix-len"),
+			IPv6PrefixLen: c.Int("ipv6-prefix-len"),
+		})
+		if err != nil {
+			return errors.Wrap(err, "new anonymizer")
+		}
+	} else {
+		for _, name := range []string{"anonymization-key", "ip-anonymization", "ipv4-prefix-len", "ipv6-prefix-len"} {
+			if c.IsSet(name) {
+				return fmt.Errorf("%s requires anonymize", name)
+			}
+		}
+	}
+
+	dbh, err := db.OpenReader(c)
+	if err != nil {
+		return errors.Wrap(err, "open database")
+	}
+	defer dbh.Close()
+
+	// Read all datasets from the same snapshot
+	tx, err := dbh.BeginTx(c.Context, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "begin txn")
+	}
+	defer db.DeferRollback(tx)
+
+	// Exporting may take longer than the statement timeout of the pool.
+	if _, err = tx.ExecContext(c.Context, "SET LOCAL statement_timeout = 0"); err != nil {
+		return errors.Wrap(err, "disable statement timeout")
+	}
+
+	path, manifest, err := export.Export(c.Context, tx, opts)
+	if err != nil {
+		return err
+	}
+
+	logEntry := log.WithField("path", path)
+	for _, ds := range manifest.Datasets {
+		logEntry = logEntry.WithField(ds.Name, ds.Rows)
+	}
+	logEntry.Infoln("Exported datasets")
+
+	return nil
+}
+
+// parseDate parses a date in UTC or an RFC 3339 timestamp.
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "2022-10-01", want: time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "2022-10-01T12:00:00Z", want: time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)},
+		{in: "01.10.2022", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseDate(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(got))
+		})
+	}
+}
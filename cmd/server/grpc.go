@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"math/rand"
 	"strconv"
 	"strings"
 	"time"
@@ -15,42 +14,95 @@ import (
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
-	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"github.com/volatiletech/sqlboiler/v4/types"
-	"gonum.org/v1/gonum/floats"
-	"gonum.org/v1/gonum/stat"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/dennis-tra/punchr/pkg/db"
 	"github.com/dennis-tra/punchr/pkg/models"
 	"github.com/dennis-tra/punchr/pkg/pb"
+	"github.com/dennis-tra/punchr/pkg/sink"
+	"github.com/dennis-tra/punchr/pkg/util"
 )
 
-var ErrUnauthorized = fmt.Errorf("unauthorized")
+var (
+	ErrUnauthorized = fmt.Errorf("unauthorized")
+	ErrRevoked      = fmt.Errorf("api key revoked")
+)
+
+// anonymousUsername is the username of API keys that clients created by registering with an unknown key.
+const anonymousUsername = "anonymous"
 
 type Server struct {
 	pb.UnimplementedPunchrServiceServer
 	DBClient    *db.Client
 	apiKeyCache *lru.Cache
+	sink        sink.ResultSink
+	limiter     *rateLimiter
+
+	// allocationTTL is the time after which a result for an allocation
+	// is flagged. It can be reloaded from the config file.
+	allocationTTL *duration
+
+	// relayFilter excludes the addresses of unreliable relays from allocations.
+	relayFilter relayFilter
+
+	// relaySelection determines if and how the server chooses the relay of the remote peer (see relaySelectionClient).
+	relaySelection string
+
+	// timingExperimentShare is the share of allocations to which a timing arm is assigned.
+	timingExperimentShare float64
+
+	// anonymousRegistration indicates whether clients with unknown
+	// API keys are allowed to register themselves.
+	anonymousRegistration bool
+}
+
+// authorization is the information about an API key that's kept in the cache.
+type authorization struct {
+	ID   int
+	Role string
+
+	// Anonymous is true for API keys that were created by anonymous registrations.
+	Anonymous bool
+}
+
+// mayContribute returns true if the API key may register clients, request peers and report results.
+func (a *authorization) mayContribute() bool {
+	return a.Role == models.AuthorizationRoleCONTRIBUTOR || a.Role == models.AuthorizationRoleADMIN
 }
 
 func (s Server) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
-	authID, err := s.checkApiKey(ctx, req.ApiKey)
-	if errors.Is(err, ErrUnauthorized) {
+	authz, found := authFromContext(ctx)
+	if !found {
+		// The interceptor rejects revoked keys, but the key may have been
+		// rotated or revoked after the request was authenticated.
+		revoked, err := models.Authorizations(
+			models.AuthorizationWhere.APIKey.EQ(apiKeyFromContext(ctx)),
+			models.AuthorizationWhere.RevokedAt.IsNotNull(),
+		).Exists(ctx, s.DBClient)
+		if err != nil {
+			return nil, errors.Wrap(err, "checking revoked api keys")
+		} else if revoked {
+			return nil, status.Error(codes.Unauthenticated, "api key revoked")
+		}
+
 		log.Infoln("Creating anonymous authentication")
 		dbAuth := models.Authorization{
-			APIKey:   req.GetApiKey(),
-			Username: "anonymous",
+			APIKey:   apiKeyFromContext(ctx),
+			Username: anonymousUsername,
+			Role:     models.AuthorizationRoleCONTRIBUTOR,
 		}
-		if err = dbAuth.Insert(ctx, s.DBClient, boil.Infer()); err != nil {
+		if err := dbAuth.Insert(ctx, s.DBClient, boil.Infer()); err != nil {
 			return nil, errors.Wrap(err, "inserting authorization")
 		}
-		authID = dbAuth.ID
-	} else if err != nil {
+		authz = &authorization{ID: dbAuth.ID, Role: dbAuth.Role, Anonymous: true}
+	}
+
+	if err := requireContributor(authz); err != nil {
 		return nil, err
 	}
+	authID := authz.ID
 
 	clientID, err := peer.IDFromBytes(req.ClientId)
 	if err != nil {
@@ -96,8 +148,13 @@ func (s Server) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.Regi
 }
 
 func (s Server) GetAddrInfo(ctx context.Context, req *pb.GetAddrInfoRequest) (*pb.GetAddrInfoResponse, error) {
-	_, err := s.checkApiKey(ctx, req.ApiKey)
-	if err != nil {
+	// Read-only keys may not take remote peers because every handed out peer creates an allocation
+	authz, found := authFromContext(ctx)
+	if !found {
+		return nil, status.Error(codes.Unauthenticated, "api key is missing")
+	}
+
+	if err := requireContributor(authz); err != nil {
 		return nil, err
 	}
 
@@ -126,30 +183,44 @@ func (s Server) GetAddrInfo(ctx context.Context, req *pb.GetAddrInfoRequest) (*p
 				log.WithError(err).Warnln("Could not register host")
 			}
 		}
-		return nil, fmt.Errorf("not registered, please restart the client")
+		return nil, status.Error(codes.FailedPrecondition, "not registered, please restart the client")
+	}
+
+	hostID, err := peer.IDFromBytes(req.HostId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid host ID")
 	}
 
+	var dbHost *models.Peer
 	dbHostIDs := make([]string, len(dbHosts))
-	for i, dbHost := range dbHosts {
-		dbHostIDs[i] = strconv.FormatInt(dbHost.ID, 10)
+	for i, h := range dbHosts {
+		dbHostIDs[i] = strconv.FormatInt(h.ID, 10)
+		if h.MultiHash == hostID.String() {
+			dbHost = h
+		}
+	}
+
+	if dbHost == nil {
+		return nil, status.Error(codes.FailedPrecondition, "host not registered, please restart the client")
+	}
+
+	// Clients that don't specify a network hole punch IPFS peers
+	network := req.GetNetwork()
+	if network == "" {
+		network = "ipfs"
 	}
 
-	resp, err := s.queryMaddrs(ctx, dbHostIDs)
+	resp, dbRemoteID, err := s.queryMaddrs(ctx, dbHostIDs, network, req.Implementations)
 	if err != nil {
 		return nil, err
 	}
 
-	r := rand.Float32()
-	if r < 0.15 {
-		resp.Protocols = []int32{multiaddr.P_IP4, multiaddr.P_TCP}
-	} else if r < 0.3 {
-		resp.Protocols = []int32{multiaddr.P_IP4, multiaddr.P_QUIC}
-	} else if r < 0.45 {
-		resp.Protocols = []int32{multiaddr.P_IP6, multiaddr.P_TCP}
-	} else if r < 0.6 {
-		resp.Protocols = []int32{multiaddr.P_IP6, multiaddr.P_QUIC}
-	} else {
-		resp.Protocols = []int32{}
+	if resp.RelayMultiAddress, err = s.selectRelay(ctx, resp.MultiAddresses); err != nil {
+		return nil, errors.Wrap(err, "select relay")
+	}
+
+	if err = s.allocate(ctx, dbHost.ID, dbRemoteID, network, chooseExperimentArm(), chooseTimingArm(s.timingExperimentShare), resp); err != nil {
+		return nil, errors.Wrap(err, "allocate remote peer")
 	}
 
 	return resp, nil
@@ -157,20 +228,25 @@ func (s Server) GetAddrInfo(ctx context.Context, req *pb.GetAddrInfoRequest) (*p
 
 // queryMaddrs queries a single peer from the database and all its multi addresses to hole punch
 // as opposed to querySingleMaddr that quries a single peer with a single multi address.
-func (s Server) queryMaddrs(ctx context.Context, dbHostIDs []string) (*pb.GetAddrInfoResponse, error) {
+// It also returns the database ID of the peer.
+func (s Server) queryMaddrs(ctx context.Context, dbHostIDs []string, network string, implementations []string) (*pb.GetAddrInfoResponse, int64, error) {
 	query := `
--- select all peers that connected to the honeypot within the last 10 mins, listen on a relay address,
--- and support dcutr. Then also select all of their relay multi addresses. But only if:
+-- select all peers of the given network (and implementations if given) that connected to the honeypot
+-- within the last 10 mins, listen on a relay address, and support dcutr. Then also select all of their relay multi addresses. But only if:
 --   1. the peer has not been hole punched more than 10 times in the last minute AND
---   2. the peer/maddr combination was not hole-punched by the same client in the last 30 mins.
+--   2. the peer/maddr combination was not hole-punched by the same client in the last 30 mins AND
+--   3. clients could reach the relay of the maddr often enough (if the relay filter is enabled).
 -- then only return ONE random peer/maddr combination!
-SELECT p.multi_hash, array_agg(DISTINCT ma.maddr)
+SELECT p.id, p.multi_hash, array_agg(DISTINCT ma.maddr)
 FROM connection_events ce
-         INNER JOIN connection_events_x_multi_addresses cexma ON ce.id = cexma.connection_event_id
+         INNER JOIN connection_events_x_multi_addresses cexma ON ce.id = cexma.connection_event_id AND ce.opened_at = cexma.opened_at
          INNER JOIN multi_addresses ma ON cexma.multi_address_id = ma.id
          INNER JOIN peers p ON ce.remote_id = p.id
 WHERE ma.is_relay = true
+  AND ce.network = $1
+  AND (cardinality($2::TEXT[]) = 0 OR p.implementation = ANY ($2::TEXT[]))
   AND ce.opened_at > NOW() - '10min'::INTERVAL -- peer connected to honeypot within last 10min
+  AND cexma.opened_at > NOW() - '10min'::INTERVAL -- only scan the latest partition of the join table
   AND ( -- prevent DoS. Exclude peers that were hole-punched >= 10 times in the last minute
           SELECT count(*)
           FROM hole_punch_results hpr
@@ -187,16 +263,25 @@ WHERE ma.is_relay = true
           AND hprxma.relationship = 'INITIAL'
           AND hpr.created_at > NOW() - '10min'::INTERVAL
     )
+  AND ($3::FLOAT = 0 OR NOT EXISTS( -- exclude the maddrs of relays that clients could rarely reach
+        SELECT
+        FROM relays r
+                 INNER JOIN peers rp ON rp.id = r.peer_id
+        WHERE rp.multi_hash = substring(ma.maddr FROM '/p2p/([^/]+)/p2p-circuit')
+          AND r.pings >= $4
+          AND r.reachability < $3::FLOAT
+    ))
 GROUP BY p.id
 ORDER BY random() -- get random peer/maddr combination
 LIMIT 1
 `
 	start := time.Now()
 	query = fmt.Sprintf(query, strings.Join(dbHostIDs, ","))
-	rows, err := s.DBClient.QueryContext(ctx, query)
+	// The time windows of the query are much larger than the replication lag of a read replica
+	rows, err := s.DBClient.Reader().QueryContext(ctx, query, network, pq.StringArray(implementations), s.relayFilter.minReachability, s.relayFilter.minPings)
 	if err != nil {
 		allocationQueryDurationHistogram.WithLabelValues("all", "false").Observe(time.Since(start).Seconds())
-		return nil, errors.Wrap(err, "query addr infos")
+		return nil, 0, errors.Wrap(err, "query addr infos")
 	}
 	allocationQueryDurationHistogram.WithLabelValues("all", "true").Observe(time.Since(start).Seconds())
 
@@ -207,30 +292,31 @@ LIMIT 1
 	}()
 
 	if !rows.Next() {
-		return nil, status.Error(codes.NotFound, "no peers to hole punch")
+		return nil, 0, status.Error(codes.NotFound, "no peers to hole punch")
 	}
 
+	var dbRemoteID int64
 	var remoteMultiHash string
 	var remoteMaddrStrs []string
-	if err = rows.Scan(&remoteMultiHash, pq.Array(&remoteMaddrStrs)); err != nil {
-		return nil, errors.Wrap(err, "map query results")
+	if err = rows.Scan(&dbRemoteID, &remoteMultiHash, pq.Array(&remoteMaddrStrs)); err != nil {
+		return nil, 0, errors.Wrap(err, "map query results")
 	}
 
 	remoteID, err := peer.Decode(remoteMultiHash)
 	if err != nil {
-		return nil, errors.Wrap(err, "decode remote multi hash")
+		return nil, 0, errors.Wrap(err, "decode remote multi hash")
 	}
 
 	remoteIDBytes, err := remoteID.Marshal()
 	if err != nil {
-		return nil, errors.Wrap(err, "marshal remote ID to bytes")
+		return nil, 0, errors.Wrap(err, "marshal remote ID to bytes")
 	}
 
 	maddrBytes := make([][]byte, len(remoteMaddrStrs))
 	for i, remoteMaddrStr := range remoteMaddrStrs {
 		maddr, err := multiaddr.NewMultiaddr(remoteMaddrStr)
 		if err != nil {
-			return nil, errors.Wrapf(err, "parse multi address %s", remoteMaddrStr)
+			return nil, 0, errors.Wrapf(err, "parse multi address %s", remoteMaddrStr)
 		}
 		maddrBytes[i] = maddr.Bytes()
 	}
@@ -238,29 +324,21 @@ LIMIT 1
 	return &pb.GetAddrInfoResponse{
 		RemoteId:       remoteIDBytes,
 		MultiAddresses: maddrBytes,
-	}, nil
+	}, dbRemoteID, nil
 }
 
 func (s Server) TrackHolePunch(ctx context.Context, req *pb.TrackHolePunchRequest) (*pb.TrackHolePunchResponse, error) {
-	_, err := s.checkApiKey(ctx, req.ApiKey)
-	if err != nil {
-		return nil, err
+	authz, found := authFromContext(ctx)
+	if !found {
+		return nil, status.Error(codes.Unauthenticated, "api key is missing")
 	}
 
-	if req.ConnectStartedAt == nil {
-		return nil, errors.Wrap(err, "connect started at is nil")
-	}
-	if req.ConnectEndedAt == nil {
-		return nil, errors.Wrap(err, "connect ended at is nil")
-	}
-	if req.EndedAt == nil {
-		return nil, errors.Wrap(err, "ended at is nil")
-	}
-	if req.HasDirectConns == nil {
-		return nil, errors.Wrap(err, "has direct conns is nil")
+	if err := requireContributor(authz); err != nil {
+		return nil, err
 	}
-	if len(req.ListenMultiAddresses) == 0 {
-		return nil, errors.Wrap(err, "no listen multi addresses given")
+
+	if err := validateTrackHolePunchRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	clientID, err := peer.IDFromBytes(req.ClientId)
@@ -274,8 +352,10 @@ func (s Server) TrackHolePunch(ctx context.Context, req *pb.TrackHolePunchReques
 	}
 
 	clientExists, err := models.Clients(models.ClientWhere.PeerID.EQ(dbLocalPeer.ID)).Exists(ctx, s.DBClient)
-	if !clientExists {
-		return nil, fmt.Errorf("client not registered")
+	if err != nil {
+		return nil, errors.Wrap(err, "check client registration")
+	} else if !clientExists {
+		return nil, status.Error(codes.FailedPrecondition, "client not registered, please restart the client")
 	}
 
 	remoteID, err := peer.IDFromBytes(req.RemoteId)
@@ -284,340 +364,164 @@ func (s Server) TrackHolePunch(ctx context.Context, req *pb.TrackHolePunchReques
 	}
 
 	dbRemotePeer, err := models.Peers(models.PeerWhere.MultiHash.EQ(remoteID.String())).One(ctx, s.DBClient)
-	if err != nil {
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.InvalidArgument, "unknown remote peer")
+	} else if err != nil {
 		return nil, errors.Wrap(err, "get remote peer from db")
 	}
 
-	// Start a database transaction
-	txn, err := s.DBClient.BeginTx(ctx, nil)
+	am, err := s.findAllocation(ctx, req, dbLocalPeer.ID, dbRemotePeer.ID)
 	if err != nil {
-		return nil, errors.Wrap(err, "begin txn")
+		return nil, err
 	}
-	defer db.DeferRollback(txn)
-
-	dbhpas := make([]*models.HolePunchAttempt, len(req.HolePunchAttempts))
-	for i, hpa := range req.HolePunchAttempts {
-		if hpa.OpenedAt == nil {
-			return nil, errors.Wrapf(err, "opened at in attempt %d is nil", i)
-		}
-
-		if hpa.EndedAt == nil {
-			return nil, errors.Wrapf(err, "ended at in attempt %d is nil", i)
-		}
-
-		if hpa.ElapsedTime == nil {
-			return nil, errors.Wrapf(err, "elapsed time in attempt %d is nil", i)
-		}
-
-		startRtt := ""
-		if hpa.StartRtt != nil {
-			startRtt = fmt.Sprintf("%fs", *hpa.StartRtt)
-		}
 
-		var startedAt *time.Time
-		if hpa.StartedAt != nil {
-			t := time.Unix(0, int64(*hpa.StartedAt))
-			startedAt = &t
-		}
+	receivedAt := time.Now()
+	v := checkPlausibility(req, receivedAt, am)
 
-		dbhpas[i] = &models.HolePunchAttempt{
-			OpenedAt:        time.Unix(0, int64(*hpa.OpenedAt)),
-			StartedAt:       null.TimeFromPtr(startedAt),
-			EndedAt:         time.Unix(0, int64(*hpa.EndedAt)),
-			StartRTT:        null.NewString(startRtt, startRtt != ""),
-			ElapsedTime:     fmt.Sprintf("%fs", *hpa.ElapsedTime),
-			Outcome:         s.mapHolePunchAttemptOutcome(hpa),
-			Error:           null.NewString(hpa.GetError(), hpa.GetError() != ""),
-			DirectDialError: null.NewString(hpa.GetDirectDialError(), hpa.GetDirectDialError() != ""),
-		}
+	result := &sink.Result{
+		ReceivedAt:        receivedAt,
+		AuthorizationID:   authz.ID,
+		AllocationID:      v.allocationID,
+		Request:           req,
+		ValidationStatus:  v.status,
+		ValidationReasons: v.reasons,
 	}
 
-	lmaddrs := make([]multiaddr.Multiaddr, len(req.ListenMultiAddresses))
-	for i, maddrBytes := range req.ListenMultiAddresses {
-		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
-		if err != nil {
-			return nil, errors.Wrap(err, "multi addr from bytes")
-		}
-		lmaddrs[i] = maddr
+	err = s.sink.Write(ctx, result)
+	if v.allocationID != nil && db.IsUniqueViolation(err, "uq_hole_punch_results_allocation_id") {
+		// Another result completed the allocation after findAllocation checked it.
+		// The postgres sink comes first, so no other sink has seen the result yet.
+		am.completed = true
+		v = checkPlausibility(req, receivedAt, am)
+		result.AllocationID = v.allocationID
+		result.ValidationStatus = v.status
+		result.ValidationReasons = v.reasons
+		err = s.sink.Write(ctx, result)
 	}
-
-	dbLMaddrs, err := s.DBClient.UpsertMultiAddresses(ctx, txn, lmaddrs)
-	if err != nil {
-		return nil, errors.Wrap(err, "upsert listen multi addresses")
-	}
-
-	maddrSetID, err := s.DBClient.UpsertMultiAddressesSet(ctx, txn, dbLMaddrs)
 	if err != nil {
-		return nil, errors.Wrap(err, "upsert multi addresses set")
+		return nil, errors.Wrap(err, "write hole punch result")
 	}
 
-	filters := make([]int64, len(req.Protocols))
-	for i, p := range req.Protocols {
-		filters[i] = int64(p)
+	if v.status != models.ValidationStatusACCEPTED {
+		log.WithFields(log.Fields{
+			"clientID": util.FmtPeerID(clientID),
+			"authID":   authz.ID,
+			"status":   v.status,
+			"reasons":  strings.Join(v.reasons, ", "),
+		}).Warnln("Implausible hole punch result")
 	}
 
-	hpr := &models.HolePunchResult{
-		LocalID:                   dbLocalPeer.ID,
-		ListenMultiAddressesSetID: maddrSetID,
-		RemoteID:                  dbRemotePeer.ID,
-		ConnectStartedAt:          time.Unix(0, int64(*req.ConnectStartedAt)),
-		ConnectEndedAt:            time.Unix(0, int64(*req.ConnectEndedAt)),
-		HasDirectConns:            req.GetHasDirectConns(),
-		ProtocolFilters:           filters,
-		Outcome:                   s.mapHolePunchOutcome(req),
-		Error:                     null.StringFromPtr(req.Error),
-		EndedAt:                   time.Unix(0, int64(*req.EndedAt)),
-	}
-
-	if err = hpr.Insert(ctx, txn, boil.Infer()); err != nil {
-		return nil, errors.Wrap(err, "insert hole punch result")
-	}
-
-	for _, mapping := range req.NatMappings {
-		dbPortMapping := models.PortMapping{
-			HolePunchResultID: hpr.ID,
-			InternalPort:      int(mapping.GetInternalPort()),
-			ExternalPort:      int(mapping.GetExternalPort()),
-			Protocol:          mapping.GetProtocol(),
-			Addr:              mapping.GetAddr(),
-			AddrNetwork:       mapping.GetAddrNetwork(),
-		}
+	return &pb.TrackHolePunchResponse{}, nil
+}
 
-		if err := dbPortMapping.Insert(ctx, txn, boil.Infer()); err != nil {
-			return nil, errors.Wrap(err, "insert port mapping")
-		}
+// validateTrackHolePunchRequest checks that all fields that are necessary to store a result are set.
+func validateTrackHolePunchRequest(req *pb.TrackHolePunchRequest) error {
+	if req.ConnectStartedAt == nil {
+		return fmt.Errorf("connect started at is nil")
 	}
-
-	if err = hpr.AddHolePunchAttempts(ctx, txn, true, dbhpas...); err != nil {
-		return nil, errors.Wrap(err, "add attempts to hole punch result")
+	if req.ConnectEndedAt == nil {
+		return fmt.Errorf("connect ended at is nil")
 	}
-
-	for i, hpa := range req.HolePunchAttempts {
-		hpamaddrs := make([]multiaddr.Multiaddr, len(hpa.MultiAddresses))
-		for j, maddrBytes := range hpa.MultiAddresses {
-			maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
-			if err != nil {
-				return nil, errors.Wrap(err, "hole punch attempt multi addr from bytes")
-			}
-			hpamaddrs[j] = maddr
-		}
-
-		dbHPAMaddrs, err := s.DBClient.UpsertMultiAddresses(ctx, txn, hpamaddrs)
-		if err != nil {
-			return nil, errors.Wrap(err, "hole punch attempt multi addresses")
-		}
-
-		if err = dbhpas[i].SetMultiAddresses(ctx, txn, false, dbHPAMaddrs...); err != nil {
-			return nil, errors.Wrap(err, "upsert hole punch attempt multi addresses")
-		}
+	if req.EndedAt == nil {
+		return fmt.Errorf("ended at is nil")
 	}
-
-	omaddrs := make([]multiaddr.Multiaddr, len(req.OpenMultiAddresses))
-	for i, maddrBytes := range req.OpenMultiAddresses {
-		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
-		if err != nil {
-			return nil, errors.Wrap(err, "multi addr from bytes")
-		}
-		omaddrs[i] = maddr
+	if req.HasDirectConns == nil {
+		return fmt.Errorf("has direct conns is nil")
 	}
-
-	dbOMaddrs, err := s.DBClient.UpsertMultiAddresses(ctx, txn, omaddrs)
-	if err != nil {
-		return nil, errors.Wrap(err, "upsert open multi addresses")
+	if req.Outcome == nil {
+		return fmt.Errorf("outcome is nil")
 	}
-
-	for _, dbOMaddr := range dbOMaddrs {
-		hprxma := models.HolePunchResultsXMultiAddress{
-			HolePunchResultID: hpr.ID,
-			MultiAddressID:    dbOMaddr.ID,
-			Relationship:      models.HolePunchMultiAddressRelationshipFINAL,
-		}
-		if err = hprxma.Insert(ctx, txn, boil.Infer()); err != nil {
-			return nil, errors.Wrap(err, "insert open HolePunchResultsXMultiAddress")
-		}
+	if len(req.ListenMultiAddresses) == 0 {
+		return fmt.Errorf("no listen multi addresses given")
 	}
-
-	rmaddrs := make([]multiaddr.Multiaddr, len(req.RemoteMultiAddresses))
-	for i, maddrBytes := range req.RemoteMultiAddresses {
-		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
-		if err != nil {
-			return nil, errors.Wrap(err, "multi addr from bytes")
-		}
-		rmaddrs[i] = maddr
+	if _, err := peer.IDFromBytes(req.ClientId); err != nil {
+		return errors.Wrap(err, "invalid client ID")
 	}
-
-	dbRMaddrs, err := s.DBClient.UpsertMultiAddresses(ctx, txn, rmaddrs)
-	if err != nil {
-		return nil, errors.Wrap(err, "upsert remote multi addresses")
+	if _, err := peer.IDFromBytes(req.RemoteId); err != nil {
+		return errors.Wrap(err, "invalid remote ID")
 	}
 
-	for _, dbRMaddr := range dbRMaddrs {
-		hprxma := models.HolePunchResultsXMultiAddress{
-			HolePunchResultID: hpr.ID,
-			MultiAddressID:    dbRMaddr.ID,
-			Relationship:      models.HolePunchMultiAddressRelationshipINITIAL,
-		}
-		if err = hprxma.Insert(ctx, txn, boil.Infer()); err != nil {
-			return nil, errors.Wrap(err, "insert remote HolePunchResultsXMultiAddress")
+	for i, hpa := range req.HolePunchAttempts {
+		if hpa.OpenedAt == nil {
+			return fmt.Errorf("opened at in attempt %d is nil", i)
 		}
-	}
-
-	if req.NetworkInformation != nil {
-		dbNetInfo := models.NetworkInformation{
-			PeerID:            dbLocalPeer.ID,
-			SupportsIpv6:      null.BoolFromPtr(req.NetworkInformation.SupportsIpv6),
-			SupportsIpv6Error: null.StringFromPtr(req.NetworkInformation.SupportsIpv6Error),
-			RouterHTML:        null.StringFromPtr(req.NetworkInformation.RouterLoginHtml),
-			RouterHTMLError:   null.StringFromPtr(req.NetworkInformation.RouterLoginHtmlError),
+		if hpa.EndedAt == nil {
+			return fmt.Errorf("ended at in attempt %d is nil", i)
 		}
-
-		if err = dbNetInfo.SetPeer(ctx, txn, false, dbLocalPeer); err != nil {
-			return nil, errors.Wrap(err, "set client peer of router information")
+		if hpa.ElapsedTime == nil {
+			return fmt.Errorf("elapsed time in attempt %d is nil", i)
 		}
-
-		if err = dbNetInfo.Insert(ctx, txn, boil.Infer()); err != nil {
-			return nil, errors.Wrap(err, "insert router information")
+		if hpa.Outcome == nil {
+			return fmt.Errorf("outcome in attempt %d is nil", i)
 		}
 	}
 
-	for _, lm := range req.LatencyMeasurements {
-
-		maddr, err := multiaddr.NewMultiaddrBytes(lm.MultiAddress)
-		if err != nil {
-			return nil, errors.Wrap(err, "multi addr from bytes")
-		}
-
-		dbMaddr, err := s.DBClient.UpsertMultiAddress(ctx, txn, maddr)
-		if err != nil {
-			return nil, errors.Wrap(err, "upsert latency measurement multi address")
-		}
-
-		lmRemoteID, err := peer.IDFromBytes(lm.RemoteId)
-		if err != nil {
-			return nil, errors.Wrap(err, "peer ID from remote ID")
-		}
-		dbLmRemotePeer, err := s.DBClient.UpsertPeer(ctx, txn, lmRemoteID, lm.AgentVersion, lm.Protocols)
-		if err != nil {
-			return nil, errors.Wrap(err, "could not upsert latency measurement peer")
-		}
-
-		dbMtype, err := mapMeasurementType(lm.Mtype)
-		if err != nil {
-			return nil, errors.Wrap(err, "map measurement type")
+	for i, evt := range req.HolePunchEvents {
+		if evt.Timestamp == nil {
+			return fmt.Errorf("timestamp of hole punch event %d is nil", i)
 		}
+	}
 
-		dbRtts := make(types.Float64Array, len(lm.Rtts))
-		for i, rtt := range lm.Rtts {
-			dbRtts[i] = float64(rtt)
+	for i, lm := range req.LatencyMeasurements {
+		if lm.Mtype == nil {
+			return fmt.Errorf("type of latency measurement %d is nil", i)
 		}
+	}
 
-		// Max/Min panic for zero length arrays
-		rttMax := float64(-1)
-		rttMin := float64(-1)
-		if len(dbRtts) > 0 {
-			rttMax = floats.Max(dbRtts)
-			rttMin = floats.Min(dbRtts)
+	if params := req.DcutrParameters; params != nil {
+		if params.GetCommunicationTimeout() < 0 || params.GetRetryCount() < 0 || params.GetPingDuration() < 0 || params.GetMaxPingCount() < 0 {
+			return fmt.Errorf("negative dcutr parameters")
 		}
+	}
 
-		dblm := models.LatencyMeasurement{
-			RemoteID:          dbLmRemotePeer.ID,
-			HolePunchResultID: hpr.ID,
-			MultiAddressID:    dbMaddr.ID,
-			Mtype:             dbMtype,
-			RTTS:              dbRtts,
-			RTTErrs:           lm.RttErrs,
-			RTTAvg:            stat.Mean(dbRtts, nil),
-			RTTMax:            rttMax,
-			RTTMin:            rttMin,
-			RTTSTD:            stat.StdDev(dbRtts, nil),
+	for i, dial := range req.RelayDials {
+		if dial.StartedAt == nil || dial.EndedAt == nil {
+			return fmt.Errorf("start or end of relay dial %d is nil", i)
 		}
 
-		if err := dblm.Insert(ctx, txn, boil.Infer()); err != nil {
-			return nil, errors.Wrap(err, "insert latency measurement")
+		if _, err := peer.IDFromBytes(dial.RelayId); err != nil {
+			return errors.Wrapf(err, "invalid relay ID of relay dial %d", i)
 		}
 	}
 
-	return &pb.TrackHolePunchResponse{}, txn.Commit()
-}
-
-func mapMeasurementType(mtype *pb.LatencyMeasurementType) (string, error) {
-	if mtype == nil {
-		return "", fmt.Errorf("latency measurement type is nil")
-	}
-
-	switch *mtype {
-	case pb.LatencyMeasurementType_TO_RELAY:
-		return models.LatencyMeasurementTypeTO_RELAY, nil
-	case pb.LatencyMeasurementType_TO_REMOTE_THROUGH_RELAY:
-		return models.LatencyMeasurementTypeTO_REMOTE_THROUGH_RELAY, nil
-	case pb.LatencyMeasurementType_TO_REMOTE_AFTER_HOLE_PUNCH:
-		return models.LatencyMeasurementTypeTO_REMOTE_AFTER_HOLEPUNCH, nil
-	default:
-		return "", fmt.Errorf("unsupportet latency measurement type: %s", mtype)
-	}
+	return nil
 }
 
-func (s Server) checkApiKey(ctx context.Context, apiKey *string) (int, error) {
+// checkApiKey looks up the authorization of the given API key. It's called by the auth interceptors
+// for every request. Revoked keys are never cached, so that revocations and rotations through the
+// admin service take effect immediately.
+func (s Server) checkApiKey(ctx context.Context, apiKey *string) (*authorization, error) {
 	if apiKey == nil || *apiKey == "" {
-		return 0, fmt.Errorf("API key is missing")
+		return nil, fmt.Errorf("API key is missing")
 	}
 
-	iAuthID, found := s.apiKeyCache.Get(*apiKey)
-	authID, ok := iAuthID.(int)
+	iAuth, found := s.apiKeyCache.Get(*apiKey)
+	auth, ok := iAuth.(*authorization)
 	if found && !ok {
 		s.apiKeyCache.Remove(*apiKey)
 	}
 
-	if found {
-		return authID, nil
+	if found && ok {
+		return auth, nil
 	}
 
 	dbAuthorization, err := s.DBClient.GetAuthorization(ctx, s.DBClient, *apiKey)
 	if errors.Is(err, sql.ErrNoRows) {
-		return 0, ErrUnauthorized
+		return nil, ErrUnauthorized
 	} else if err != nil {
-		return 0, errors.Wrap(err, "checking authorization for api key")
+		return nil, errors.Wrap(err, "checking authorization for api key")
 	}
 
-	s.apiKeyCache.Add(*apiKey, dbAuthorization.ID)
-
-	return dbAuthorization.ID, nil
-}
-
-func (s Server) mapHolePunchAttemptOutcome(hpa *pb.HolePunchAttempt) string {
-	switch *hpa.Outcome {
-	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_DIRECT_DIAL:
-		return models.HolePunchAttemptOutcomeDIRECT_DIAL
-	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_PROTOCOL_ERROR:
-		return models.HolePunchAttemptOutcomePROTOCOL_ERROR
-	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_CANCELLED:
-		return models.HolePunchAttemptOutcomeCANCELLED
-	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_TIMEOUT:
-		return models.HolePunchAttemptOutcomeTIMEOUT
-	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_FAILED:
-		return models.HolePunchAttemptOutcomeFAILED
-	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_SUCCESS:
-		return models.HolePunchAttemptOutcomeSUCCESS
-	default:
-		return models.HolePunchAttemptOutcomeUNKNOWN
+	if dbAuthorization.RevokedAt.Valid {
+		return nil, ErrRevoked
 	}
-}
 
-func (s Server) mapHolePunchOutcome(req *pb.TrackHolePunchRequest) string {
-	switch *req.Outcome {
-	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_CONNECTION:
-		return models.HolePunchOutcomeNO_CONNECTION
-	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM:
-		return models.HolePunchOutcomeNO_STREAM
-	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_CANCELLED:
-		return models.HolePunchOutcomeCANCELLED
-	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_CONNECTION_REVERSED:
-		return models.HolePunchOutcomeCONNECTION_REVERSED
-	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED:
-		return models.HolePunchOutcomeFAILED
-	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS:
-		return models.HolePunchOutcomeSUCCESS
-	default:
-		return models.HolePunchOutcomeUNKNOWN
+	auth = &authorization{
+		ID:        dbAuthorization.ID,
+		Role:      dbAuthorization.Role,
+		Anonymous: dbAuthorization.Username == anonymousUsername,
 	}
+	s.apiKeyCache.Add(*apiKey, auth)
+
+	return auth, nil
 }
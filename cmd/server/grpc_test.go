@@ -0,0 +1,88 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func validTrackHolePunchRequest(t *testing.T) *pb.TrackHolePunchRequest {
+	clientID, err := peer.Decode("12D3KooWKrnZSrdtKBcbYSRzrcuanNvMRiXiBQMrPZeGbSztGCH6")
+	require.NoError(t, err)
+	remoteID, err := peer.Decode("12D3KooWSnLfpFczsHcFhsc2oBhiS9vu6fMjnMXcQiykbsDhGkx5")
+	require.NoError(t, err)
+
+	ts := uint64(1)
+	hasDirectConns := false
+	elapsed := float32(1)
+	outcome := pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS
+	attemptOutcome := pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_DIRECT_DIAL
+
+	return &pb.TrackHolePunchRequest{
+		ClientId:             []byte(clientID),
+		RemoteId:             []byte(remoteID),
+		ConnectStartedAt:     &ts,
+		ConnectEndedAt:       &ts,
+		EndedAt:              &ts,
+		HasDirectConns:       &hasDirectConns,
+		Outcome:              &outcome,
+		ListenMultiAddresses: [][]byte{{}},
+		HolePunchAttempts: []*pb.HolePunchAttempt{{
+			OpenedAt:    &ts,
+			EndedAt:     &ts,
+			ElapsedTime: &elapsed,
+			Outcome:     &attemptOutcome,
+		}},
+	}
+}
+
+func TestValidateTrackHolePunchRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(req *pb.TrackHolePunchRequest)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(req *pb.TrackHolePunchRequest) {}},
+		{name: "missing outcome", modify: func(req *pb.TrackHolePunchRequest) { req.Outcome = nil }, wantErr: true},
+		{name: "missing ended at", modify: func(req *pb.TrackHolePunchRequest) { req.EndedAt = nil }, wantErr: true},
+		{name: "no listen addresses", modify: func(req *pb.TrackHolePunchRequest) { req.ListenMultiAddresses = nil }, wantErr: true},
+		{name: "invalid client id", modify: func(req *pb.TrackHolePunchRequest) { req.ClientId = []byte("invalid") }, wantErr: true},
+		{name: "invalid remote id", modify: func(req *pb.TrackHolePunchRequest) { req.RemoteId = nil }, wantErr: true},
+		{name: "attempt without outcome", modify: func(req *pb.TrackHolePunchRequest) { req.HolePunchAttempts[0].Outcome = nil }, wantErr: true},
+		{name: "attempt without elapsed time", modify: func(req *pb.TrackHolePunchRequest) { req.HolePunchAttempts[0].ElapsedTime = nil }, wantErr: true},
+		{name: "event without timestamp", modify: func(req *pb.TrackHolePunchRequest) {
+			req.HolePunchEvents = []*pb.HolePunchEvent{{}}
+		}, wantErr: true},
+		{name: "latency measurement without type", modify: func(req *pb.TrackHolePunchRequest) {
+			req.LatencyMeasurements = []*pb.LatencyMeasurement{{}}
+		}, wantErr: true},
+		{name: "negative dcutr parameters", modify: func(req *pb.TrackHolePunchRequest) {
+			req.DcutrParameters = &pb.DCUtRParameters{RetryCount: int32Ptr(-1)}
+		}, wantErr: true},
+		{name: "relay dial without end", modify: func(req *pb.TrackHolePunchRequest) {
+			req.RelayDials = []*pb.RelayDial{{RelayId: req.RemoteId, StartedAt: req.EndedAt}}
+		}, wantErr: true},
+		{name: "relay dial with invalid relay id", modify: func(req *pb.TrackHolePunchRequest) {
+			req.RelayDials = []*pb.RelayDial{{RelayId: []byte("invalid"), StartedAt: req.EndedAt, EndedAt: req.EndedAt}}
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validTrackHolePunchRequest(t)
+			tt.modify(req)
+			if tt.wantErr {
+				assert.Error(t, validateTrackHolePunchRequest(req))
+			} else {
+				assert.NoError(t, validateTrackHolePunchRequest(req))
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+const (
+	// healthCheckInterval is the time between two database connectivity checks.
+	healthCheckInterval = 10 * time.Second
+
+	// healthCheckTimeout is the maximum time a database connectivity check may take.
+	healthCheckTimeout = 5 * time.Second
+)
+
+// healthServices are the gRPC services whose status is reported by the health service.
+// The empty name stands for the overall health of the server.
+var healthServices = []string{
+	"",
+	pb.PunchrService_ServiceDesc.ServiceName,
+	pb.PunchrAdminService_ServiceDesc.ServiceName,
+}
+
+// pinger is implemented by the database client.
+type pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// healthChecker tracks whether the server can handle requests. The server is ready while
+// the database is reachable and it isn't shutting down. The result is reported by the
+// standard gRPC health service and the readiness endpoint of the telemetry server.
+type healthChecker struct {
+	grpcHealth *health.Server
+
+	mu       sync.RWMutex
+	ready    bool
+	draining bool
+}
+
+// newHealthChecker returns a health checker that reports the server as not ready until
+// the first successful database connectivity check.
+func newHealthChecker() *healthChecker {
+	hc := &healthChecker{grpcHealth: health.NewServer()}
+	hc.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return hc
+}
+
+// run checks the database connectivity every healthCheckInterval until the context is cancelled.
+func (hc *healthChecker) run(ctx context.Context, db pinger) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		hc.check(ctx, db)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check pings the database and updates the serving status accordingly.
+func (hc *healthChecker) check(ctx context.Context, db pinger) {
+	tCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	err := db.PingContext(tCtx)
+
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	if hc.draining {
+		return
+	}
+
+	if err != nil && hc.ready {
+		log.WithError(err).Warnln("Database unreachable, reporting server as not serving")
+	} else if err == nil && !hc.ready {
+		log.Infoln("Database reachable, reporting server as serving")
+	}
+
+	hc.ready = err == nil
+	if hc.ready {
+		hc.setServingStatus(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		hc.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+func (hc *healthChecker) setServingStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range healthServices {
+		hc.grpcHealth.SetServingStatus(service, status)
+	}
+}
+
+// drain permanently reports the server as not serving, so that load
+// balancers stop routing new requests to it before it shuts down.
+func (hc *healthChecker) drain() {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	hc.draining = true
+	hc.ready = false
+	hc.grpcHealth.Shutdown()
+}
+
+// isReady returns true if the server can handle requests.
+func (hc *healthChecker) isReady() bool {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+	return hc.ready
+}
+
+// livenessHandler reports that the process is running. It deliberately doesn't depend on
+// the database, so that an unreachable database doesn't lead to restarts of all servers.
+func (hc *healthChecker) livenessHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// readinessHandler reports whether the server can handle requests.
+func (hc *healthChecker) readinessHandler(w http.ResponseWriter, r *http.Request) {
+	if !hc.isReady() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("not ready\n"))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n"))
+}
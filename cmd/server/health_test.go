@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+type testPinger struct {
+	err error
+}
+
+func (p *testPinger) PingContext(context.Context) error {
+	return p.err
+}
+
+func servingStatus(t *testing.T, hc *healthChecker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := hc.grpcHealth.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func readinessCode(hc *healthChecker) int {
+	rec := httptest.NewRecorder()
+	hc.readinessHandler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	return rec.Code
+}
+
+func TestHealthChecker(t *testing.T) {
+	ctx := context.Background()
+	db := &testPinger{}
+	hc := newHealthChecker()
+
+	assert.False(t, hc.isReady())
+	assert.Equal(t, http.StatusServiceUnavailable, readinessCode(hc))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, hc, ""))
+
+	hc.check(ctx, db)
+	assert.True(t, hc.isReady())
+	assert.Equal(t, http.StatusOK, readinessCode(hc))
+	for _, service := range []string{"", pb.PunchrService_ServiceDesc.ServiceName, pb.PunchrAdminService_ServiceDesc.ServiceName} {
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, hc, service), service)
+	}
+
+	db.err = fmt.Errorf("connection refused")
+	hc.check(ctx, db)
+	assert.False(t, hc.isReady())
+	assert.Equal(t, http.StatusServiceUnavailable, readinessCode(hc))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, hc, ""))
+
+	db.err = nil
+	hc.check(ctx, db)
+	assert.True(t, hc.isReady())
+
+	// Draining is permanent
+	hc.drain()
+	hc.check(ctx, db)
+	assert.False(t, hc.isReady())
+	assert.Equal(t, http.StatusServiceUnavailable, readinessCode(hc))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, hc, ""))
+
+	// Liveness doesn't depend on the database or draining
+	rec := httptest.NewRecorder()
+	hc.livenessHandler(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,340 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/dennis-tra/punchr/pkg/auth"
+	"github.com/dennis-tra/punchr/pkg/certs"
+	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+var idFlag = &cli.Int64Flag{
+	Name:     "id",
+	Usage:    "The ID of the API key",
+	Required: true,
+}
+
+// KeysCommand manages the API keys of a running server through the admin service.
+var KeysCommand = &cli.Command{
+	Name:  "keys",
+	Usage: "Manage the API keys of a running server",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "server-host",
+			Usage:       "Where does the punchr server listen",
+			EnvVars:     []string{"PUNCHR_SERVER_ADMIN_SERVER_HOST"},
+			Value:       "localhost",
+			DefaultText: "localhost",
+		},
+		&cli.StringFlag{
+			Name:        "server-port",
+			Usage:       "On which port listens the punchr server",
+			EnvVars:     []string{"PUNCHR_SERVER_ADMIN_SERVER_PORT"},
+			Value:       "10000",
+			DefaultText: "10000",
+		},
+		&cli.BoolFlag{
+			Name:    "server-ssl",
+			Usage:   "Whether or not to use a SSL connection to the server.",
+			EnvVars: []string{"PUNCHR_SERVER_ADMIN_SERVER_SSL"},
+		},
+		&cli.BoolFlag{
+			Name:    "server-ssl-skip-verify",
+			Usage:   "Whether or not to skip SSL certificate verification.",
+			EnvVars: []string{"PUNCHR_SERVER_ADMIN_SERVER_SSL_SKIP_VERIFY"},
+		},
+		&cli.StringFlag{
+			Name:      "server-ca-cert",
+			Usage:     "Verify the server certificate against this PEM encoded CA bundle instead of the system roots",
+			EnvVars:   []string{"PUNCHR_SERVER_ADMIN_SERVER_CA_CERT"},
+			TakesFile: true,
+		},
+		&cli.StringFlag{
+			Name:      "client-cert",
+			Usage:     "Authenticate against the server with this PEM encoded client certificate",
+			EnvVars:   []string{"PUNCHR_SERVER_ADMIN_CLIENT_CERT"},
+			TakesFile: true,
+		},
+		&cli.StringFlag{
+			Name:      "client-key",
+			Usage:     "The PEM encoded private key of the client certificate",
+			EnvVars:   []string{"PUNCHR_SERVER_ADMIN_CLIENT_KEY"},
+			TakesFile: true,
+		},
+		&cli.StringFlag{
+			Name:    "api-key",
+			Usage:   "The admin API key to authenticate against the server",
+			EnvVars: []string{"PUNCHR_SERVER_ADMIN_API_KEY"},
+		},
+	},
+	Subcommands: []*cli.Command{
+		{
+			Name:  "create",
+			Usage: "Create a new API key",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "username",
+					Usage:    "The name of the person or organization that uses the key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:        "role",
+					Usage:       "The role of the key (contributor, admin, read-only)",
+					Value:       "contributor",
+					DefaultText: "contributor",
+				},
+			},
+			Action: KeysCreateAction,
+		},
+		{
+			Name:  "list",
+			Usage: "List all API keys",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "include-revoked",
+					Usage: "Whether revoked keys should be listed as well",
+				},
+			},
+			Action: KeysListAction,
+		},
+		{
+			Name:   "rotate",
+			Usage:  "Replace an API key with a newly generated one",
+			Flags:  []cli.Flag{idFlag},
+			Action: KeysRotateAction,
+		},
+		{
+			Name:   "revoke",
+			Usage:  "Permanently disable an API key",
+			Flags:  []cli.Flag{idFlag},
+			Action: KeysRevokeAction,
+		},
+		{
+			Name:  "rename",
+			Usage: "Change the username of an API key",
+			Flags: []cli.Flag{
+				idFlag,
+				&cli.StringFlag{
+					Name:     "username",
+					Usage:    "The new username",
+					Required: true,
+				},
+			},
+			Action: KeysRenameAction,
+		},
+		{
+			Name:      "bootstrap",
+			Usage:     "Create the first admin API key directly in the database",
+			UsageText: "Uses the database flags of the server. Fails if there already is an admin key.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "username",
+					Usage:       "The name of the admin",
+					Value:       "admin",
+					DefaultText: "admin",
+				},
+			},
+			Action: KeysBootstrapAction,
+		},
+	},
+}
+
+func KeysCreateAction(c *cli.Context) error {
+	role, err := parseRole(c.String("role"))
+	if err != nil {
+		return err
+	}
+
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	username := c.String("username")
+	resp, err := client.CreateApiKey(c.Context, &pb.CreateApiKeyRequest{
+		Username: &username,
+		Role:     &role,
+	})
+	if err != nil {
+		return errors.Wrap(err, "create api key")
+	}
+
+	printApiKeys(resp.ApiKey)
+	return nil
+}
+
+func KeysListAction(c *cli.Context) error {
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	includeRevoked := c.Bool("include-revoked")
+	resp, err := client.ListApiKeys(c.Context, &pb.ListApiKeysRequest{IncludeRevoked: &includeRevoked})
+	if err != nil {
+		return errors.Wrap(err, "list api keys")
+	}
+
+	printApiKeys(resp.ApiKeys...)
+	return nil
+}
+
+func KeysRotateAction(c *cli.Context) error {
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	id := c.Int64("id")
+	resp, err := client.RotateApiKey(c.Context, &pb.RotateApiKeyRequest{Id: &id})
+	if err != nil {
+		return errors.Wrap(err, "rotate api key")
+	}
+
+	printApiKeys(resp.ApiKey)
+	return nil
+}
+
+func KeysRevokeAction(c *cli.Context) error {
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	id := c.Int64("id")
+	resp, err := client.RevokeApiKey(c.Context, &pb.RevokeApiKeyRequest{Id: &id})
+	if err != nil {
+		return errors.Wrap(err, "revoke api key")
+	}
+
+	printApiKeys(resp.ApiKey)
+	return nil
+}
+
+func KeysRenameAction(c *cli.Context) error {
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	id := c.Int64("id")
+	username := c.String("username")
+	resp, err := client.RenameApiKey(c.Context, &pb.RenameApiKeyRequest{Id: &id, Username: &username})
+	if err != nil {
+		return errors.Wrap(err, "rename api key")
+	}
+
+	printApiKeys(resp.ApiKey)
+	return nil
+}
+
+// KeysBootstrapAction creates the first admin key. All subsequent keys should
+// be managed through the admin service so that the server can evict them from its cache.
+func KeysBootstrapAction(c *cli.Context) error {
+	dbClient, err := db.NewClient(c)
+	if err != nil {
+		return errors.Wrap(err, "new db client")
+	}
+	defer dbClient.Close()
+
+	adminExists, err := models.Authorizations(
+		models.AuthorizationWhere.Role.EQ(models.AuthorizationRoleADMIN),
+		models.AuthorizationWhere.RevokedAt.IsNull(),
+	).Exists(c.Context, dbClient)
+	if err != nil {
+		return errors.Wrap(err, "check for admin keys")
+	} else if adminExists {
+		return fmt.Errorf("there already is an admin api key, use the other keys subcommands instead")
+	}
+
+	dbAuth := &models.Authorization{
+		APIKey:   uuid.NewString(),
+		Username: c.String("username"),
+		Role:     models.AuthorizationRoleADMIN,
+	}
+	if err = dbAuth.Insert(c.Context, dbClient, boil.Infer()); err != nil {
+		return errors.Wrap(err, "insert admin authorization")
+	}
+
+	printApiKeys(toProtoApiKey(dbAuth, true))
+	return nil
+}
+
+func newAdminClient(c *cli.Context) (pb.PunchrAdminServiceClient, *grpc.ClientConn, error) {
+	if c.String("api-key") == "" {
+		return nil, nil, fmt.Errorf("no admin api key given")
+	}
+
+	var tc credentials.TransportCredentials
+	if c.Bool("server-ssl") {
+		config, err := certs.ClientConfig(c.String("server-ca-cert"), c.String("client-cert"), c.String("client-key"), c.Bool("server-ssl-skip-verify"))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "client tls config")
+		}
+		tc = credentials.NewTLS(config)
+	} else {
+		tc = insecure.NewCredentials()
+	}
+
+	addr := fmt.Sprintf("%s:%s", c.String("server-host"), c.String("server-port"))
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(tc), grpc.WithPerRPCCredentials(auth.BearerToken(c.String("api-key"))))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "dial server")
+	}
+
+	return pb.NewPunchrAdminServiceClient(conn), conn, nil
+}
+
+func parseRole(role string) (pb.AuthorizationRole, error) {
+	switch strings.ToLower(strings.ReplaceAll(role, "_", "-")) {
+	case "contributor":
+		return pb.AuthorizationRole_AUTHORIZATION_ROLE_CONTRIBUTOR, nil
+	case "admin":
+		return pb.AuthorizationRole_AUTHORIZATION_ROLE_ADMIN, nil
+	case "read-only":
+		return pb.AuthorizationRole_AUTHORIZATION_ROLE_READ_ONLY, nil
+	default:
+		return pb.AuthorizationRole_AUTHORIZATION_ROLE_UNKNOWN, fmt.Errorf("unknown role %q", role)
+	}
+}
+
+func printApiKeys(apiKeys ...*pb.ApiKey) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tAPI KEY\tUSERNAME\tROLE\tCREATED AT\tREVOKED AT")
+	for _, apiKey := range apiKeys {
+		revokedAt := "-"
+		if apiKey.RevokedAt != nil {
+			revokedAt = time.Unix(0, int64(apiKey.GetRevokedAt())).Format(time.RFC3339)
+		}
+
+		role := strings.ToLower(strings.TrimPrefix(apiKey.GetRole().String(), "AUTHORIZATION_ROLE_"))
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			apiKey.GetId(),
+			apiKey.GetApiKey(),
+			apiKey.GetUsername(),
+			strings.ReplaceAll(role, "_", "-"),
+			time.Unix(0, int64(apiKey.GetCreatedAt())).Format(time.RFC3339),
+			revokedAt,
+		)
+	}
+	_ = tw.Flush()
+}
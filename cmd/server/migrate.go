@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+
+	"github.com/dennis-tra/punchr/pkg/db"
+)
+
+// MigrateCommand manages the schema of the database. It uses the database flags of the server.
+var MigrateCommand = &cli.Command{
+	Name:  "migrate",
+	Usage: "Manage the database schema",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "up",
+			Usage:     "Apply all or the next N pending migrations",
+			ArgsUsage: "[N]",
+			Action:    MigrateUpAction,
+		},
+		{
+			Name:      "down",
+			Usage:     "Roll back the last N migrations",
+			ArgsUsage: "[N]",
+			UsageText: "Rolls back the last migration if N isn't given. Rolling back may delete data.",
+			Action:    MigrateDownAction,
+		},
+		{
+			Name:   "status",
+			Usage:  "Show the schema version and pending migrations",
+			Action: MigrateStatusAction,
+		},
+		{
+			Name:      "force",
+			Usage:     "Set the schema version without running migrations and clear the dirty flag",
+			ArgsUsage: "VERSION",
+			UsageText: "Use it after fixing the schema manually following a failed migration.",
+			Action:    MigrateForceAction,
+		},
+	},
+}
+
+func MigrateUpAction(c *cli.Context) error {
+	n, err := parseIntArg(c, 0)
+	if err != nil {
+		return err
+	}
+
+	return withMigrator(c, func(mg *db.Migrator) error {
+		if err := mg.Up(n); err != nil {
+			return err
+		}
+		return printMigrationStatus(mg)
+	})
+}
+
+func MigrateDownAction(c *cli.Context) error {
+	n, err := parseIntArg(c, 1)
+	if err != nil {
+		return err
+	}
+
+	return withMigrator(c, func(mg *db.Migrator) error {
+		if err := mg.Down(n); err != nil {
+			return err
+		}
+		return printMigrationStatus(mg)
+	})
+}
+
+func MigrateStatusAction(c *cli.Context) error {
+	return withMigrator(c, printMigrationStatus)
+}
+
+func MigrateForceAction(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("expected exactly one version")
+	}
+
+	version, err := parseIntArg(c, 0)
+	if err != nil {
+		return err
+	}
+
+	return withMigrator(c, func(mg *db.Migrator) error {
+		if err := mg.Force(version); err != nil {
+			return err
+		}
+		return printMigrationStatus(mg)
+	})
+}
+
+// withMigrator opens the database, waits for the migrations lock and passes the migrator to fn.
+func withMigrator(c *cli.Context, fn func(mg *db.Migrator) error) error {
+	dbh, err := db.Open(c)
+	if err != nil {
+		return errors.Wrap(err, "open database")
+	}
+	defer dbh.Close()
+
+	mg, err := db.NewMigrator(c.Context, dbh)
+	if err != nil {
+		return errors.Wrap(err, "new migrator")
+	}
+	defer func() {
+		if err := mg.Close(); err != nil {
+			log.WithError(err).Warnln("Could not close migrator")
+		}
+	}()
+
+	return fn(mg)
+}
+
+// parseIntArg parses the first argument as a non-negative integer. It returns def if there is no argument.
+func parseIntArg(c *cli.Context, def int) (int, error) {
+	if c.NArg() == 0 {
+		return def, nil
+	} else if c.NArg() > 1 {
+		return 0, fmt.Errorf("unexpected arguments %s", strings.Join(c.Args().Tail(), " "))
+	}
+
+	n, err := strconv.Atoi(c.Args().First())
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid argument %q, expected a non-negative integer", c.Args().First())
+	}
+
+	return n, nil
+}
+
+func printMigrationStatus(mg *db.Migrator) error {
+	status, err := mg.Status()
+	if err != nil {
+		return err
+	}
+
+	pending := make([]string, len(status.Pending))
+	for i, v := range status.Pending {
+		pending[i] = strconv.FormatUint(uint64(v), 10)
+	}
+	if len(pending) == 0 {
+		pending = []string{"-"}
+	}
+
+	fmt.Fprintf(os.Stdout, "Version:  %d\n", status.Version)
+	fmt.Fprintf(os.Stdout, "Dirty:    %t\n", status.Dirty)
+	fmt.Fprintf(os.Stdout, "Latest:   %d\n", status.Latest)
+	fmt.Fprintf(os.Stdout, "Pending:  %s\n", strings.Join(pending, ", "))
+
+	return nil
+}
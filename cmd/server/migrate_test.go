@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+)
+
+func TestParseIntArg(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    int
+		wantErr bool
+	}{
+		{name: "default", args: nil, want: 3},
+		{name: "given", args: []string{"2"}, want: 2},
+		{name: "zero", args: []string{"0"}, want: 0},
+		{name: "negative", args: []string{"-1"}, wantErr: true},
+		{name: "not a number", args: []string{"all"}, wantErr: true},
+		{name: "too many", args: []string{"1", "2"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", flag.ContinueOnError)
+			assert.NoError(t, set.Parse(append([]string{"--"}, tt.args...)))
+
+			got, err := parseIntArg(cli.NewContext(cli.NewApp(), set, nil), 3)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiformats/go-multiaddr"
+
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+	"github.com/dennis-tra/punchr/pkg/util"
+)
+
+const (
+	// timestampTolerance accounts for the difference between monotonic and
+	// wall clock readings when comparing timestamps of the same client.
+	timestampTolerance = time.Second
+
+	// maxClockSkew is the maximum time a result may end after it was received by the server.
+	maxClockSkew = 5 * time.Minute
+
+	// maxResultAge is the maximum time between the end of a hole punch and the time the
+	// server receives its result. Clients retry rate limited results for a few minutes.
+	maxResultAge = time.Hour
+
+	// maxHolePunchDuration is the maximum time a client may spend on a single hole punch.
+	maxHolePunchDuration = 10 * time.Minute
+
+	// maxRTT is the maximum plausible round trip time of a latency measurement.
+	maxRTT = 30 * time.Second
+)
+
+// plausibility collects the findings of the plausibility checks of a single hole punch result.
+// Inconsistencies that a correctly working client can't produce reject the result. Findings
+// that can also stem from, e.g., wrong client clocks or a server restart only flag it.
+type plausibility struct {
+	rejections []string
+	flags      []string
+}
+
+func (p *plausibility) reject(format string, a ...interface{}) {
+	p.rejections = append(p.rejections, fmt.Sprintf(format, a...))
+}
+
+func (p *plausibility) flag(format string, a ...interface{}) {
+	p.flags = append(p.flags, fmt.Sprintf(format, a...))
+}
+
+// status returns the validation status and all reasons that led to it.
+func (p *plausibility) status() (string, []string) {
+	switch {
+	case len(p.rejections) > 0:
+		return models.ValidationStatusREJECTED, append(p.rejections, p.flags...)
+	case len(p.flags) > 0:
+		return models.ValidationStatusFLAGGED, p.flags
+	default:
+		return models.ValidationStatusACCEPTED, nil
+	}
+}
+
+// validation is the outcome of the plausibility checks of a hole punch result.
+type validation struct {
+	status  string
+	reasons []string
+
+	// allocationID is the allocation that the result completes. It's
+	// nil if the result doesn't belong to a valid allocation.
+	allocationID *int64
+}
+
+// checkPlausibility validates a hole punch result that already passed validateTrackHolePunchRequest.
+func checkPlausibility(req *pb.TrackHolePunchRequest, receivedAt time.Time, am allocationMatch) validation {
+	p := &plausibility{}
+
+	allocationID := checkAllocation(p, req, am, receivedAt)
+	checkTimestamps(p, req, receivedAt)
+	checkOutcome(p, req)
+	checkLatencies(p, req)
+
+	status, reasons := p.status()
+	return validation{status: status, reasons: reasons, allocationID: allocationID}
+}
+
+// checkAllocation verifies that the result was reported for an allocation of the remote
+// peer to the client. It returns the ID of the allocation if the result completes it.
+func checkAllocation(p *plausibility, req *pb.TrackHolePunchRequest, am allocationMatch, receivedAt time.Time) *int64 {
+	alloc := am.allocation
+	switch {
+	case alloc == nil:
+		p.reject("remote peer was not allocated to the client")
+		return nil
+	case alloc.ClientID != am.localID:
+		p.reject("allocation %d belongs to another client", alloc.ID)
+		return nil
+	case alloc.RemoteID != am.remoteID:
+		p.reject("allocation %d is for another remote peer", alloc.ID)
+		return nil
+	case am.completed:
+		p.reject("allocation %d already has a result", alloc.ID)
+		return nil
+	}
+
+	if receivedAt.After(alloc.ExpiresAt) {
+		p.flag("result was reported %s after allocation %d expired", receivedAt.Sub(alloc.ExpiresAt).Round(time.Second), alloc.ID)
+	}
+
+	if !appliedProtocols(req.Protocols, alloc.ProtocolFilters) {
+		p.flag("protocol filters differ from allocation %d", alloc.ID)
+	}
+
+	if tArm, found := findTimingArm(alloc.TimingArm.String); found && !appliedTiming(req.DcutrParameters, tArm.params) {
+		p.flag("dcutr parameters differ from allocation %d", alloc.ID)
+	}
+
+	return &alloc.ID
+}
+
+// appliedTiming returns true if the client reported that it used all timing parameters that the
+// server set. Clients that don't report their parameters can't be checked.
+func appliedTiming(reported *pb.DCUtRParameters, set *pb.DCUtRParameters) bool {
+	if reported == nil {
+		return true
+	}
+
+	return (set.CommunicationTimeout == nil || set.GetCommunicationTimeout() == reported.GetCommunicationTimeout()) &&
+		(set.RetryCount == nil || set.GetRetryCount() == reported.GetRetryCount()) &&
+		(set.PingDuration == nil || set.GetPingDuration() == reported.GetPingDuration()) &&
+		(set.MaxPingCount == nil || set.GetMaxPingCount() == reported.GetMaxPingCount())
+}
+
+// appliedProtocols returns true if the client reported that it used the protocol filters that the
+// server set. Clients that don't report their filters, e.g., the Rust client, can't be checked.
+func appliedProtocols(protocols []int32, filters []int64) bool {
+	if len(protocols) == 0 {
+		return true
+	}
+
+	if len(protocols) != len(filters) {
+		return false
+	}
+	for i := range protocols {
+		if int64(protocols[i]) != filters[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func checkTimestamps(p *plausibility, req *pb.TrackHolePunchRequest, receivedAt time.Time) {
+	connectStartedAt := time.Unix(0, int64(req.GetConnectStartedAt()))
+	connectEndedAt := time.Unix(0, int64(req.GetConnectEndedAt()))
+	endedAt := time.Unix(0, int64(req.GetEndedAt()))
+
+	if connectEndedAt.Before(connectStartedAt) {
+		p.reject("connect ended before it started")
+	}
+	if endedAt.Before(connectEndedAt) {
+		p.reject("hole punch ended before the connection was established")
+	}
+	if endedAt.Sub(connectStartedAt) > maxHolePunchDuration {
+		p.flag("hole punch took longer than %s", maxHolePunchDuration)
+	}
+	if endedAt.After(receivedAt.Add(maxClockSkew)) {
+		p.flag("hole punch ended %s in the future", endedAt.Sub(receivedAt).Round(time.Second))
+	}
+	if receivedAt.Sub(endedAt) > maxResultAge {
+		p.flag("result was reported %s after the hole punch ended", receivedAt.Sub(endedAt).Round(time.Second))
+	}
+
+	for i, hpa := range req.HolePunchAttempts {
+		openedAt := time.Unix(0, int64(hpa.GetOpenedAt()))
+		attemptEndedAt := time.Unix(0, int64(hpa.GetEndedAt()))
+
+		if attemptEndedAt.Before(openedAt) {
+			p.reject("attempt %d ended before it was opened", i)
+		}
+		if openedAt.Before(connectEndedAt.Add(-timestampTolerance)) {
+			p.reject("attempt %d was opened before the connection was established", i)
+		}
+		if attemptEndedAt.After(endedAt.Add(timestampTolerance)) {
+			p.reject("attempt %d ended after the hole punch", i)
+		}
+		if hpa.StartedAt != nil {
+			startedAt := time.Unix(0, int64(hpa.GetStartedAt()))
+			if startedAt.Before(openedAt.Add(-timestampTolerance)) || startedAt.After(attemptEndedAt.Add(timestampTolerance)) {
+				p.reject("attempt %d started outside of its lifetime", i)
+			}
+		}
+		if hpa.GetElapsedTime() < 0 {
+			p.reject("attempt %d has a negative elapsed time", i)
+		} else if elapsed := time.Duration(float64(hpa.GetElapsedTime()) * float64(time.Second)); elapsed > attemptEndedAt.Sub(openedAt)+timestampTolerance {
+			p.flag("elapsed time of attempt %d exceeds its lifetime", i)
+		}
+	}
+
+	for i, evt := range req.HolePunchEvents {
+		ts := time.Unix(0, int64(evt.GetTimestamp()))
+		if ts.Before(connectStartedAt.Add(-timestampTolerance)) || ts.After(endedAt.Add(timestampTolerance)) {
+			p.flag("hole punch event %d happened outside of the hole punch", i)
+		}
+	}
+}
+
+// checkOutcome verifies that the reported outcome is consistent with the hole punch
+// attempts and the connections that were open at the end (the FINAL multi addresses).
+func checkOutcome(p *plausibility, req *pb.TrackHolePunchRequest) {
+	hasDirectMaddr := false
+	for _, maddrBytes := range req.OpenMultiAddresses {
+		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+		if err != nil {
+			p.reject("invalid open multi address")
+			continue
+		}
+		if !util.IsRelayedMaddr(maddr) {
+			hasDirectMaddr = true
+		}
+	}
+
+	if req.GetHasDirectConns() != hasDirectMaddr {
+		p.reject("direct connection claim contradicts the open multi addresses")
+	}
+
+	successfulAttempts := 0
+	for _, hpa := range req.HolePunchAttempts {
+		switch hpa.GetOutcome() {
+		case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_SUCCESS,
+			pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_DIRECT_DIAL:
+			successfulAttempts += 1
+		}
+	}
+
+	switch req.GetOutcome() {
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS:
+		if successfulAttempts == 0 {
+			p.reject("success without a successful attempt")
+		}
+		if !hasDirectMaddr {
+			p.reject("success without a direct connection")
+		}
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED:
+		if successfulAttempts > 0 {
+			p.reject("failure with a successful attempt")
+		}
+		if len(req.HolePunchAttempts) == 0 {
+			p.flag("failure without attempts")
+		}
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_CONNECTION_REVERSED:
+		if !hasDirectMaddr {
+			p.reject("connection reversal without a direct connection")
+		}
+		fallthrough
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_CONNECTION,
+		pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM:
+		// Failed attempts may precede these outcomes, e.g., if the client
+		// gave up waiting for further attempts or the remote reversed the
+		// connection after a failed attempt. A successful attempt may not.
+		if successfulAttempts > 0 {
+			p.reject("%s with a successful attempt", req.GetOutcome())
+		} else if len(req.HolePunchAttempts) > 0 {
+			p.flag("%s with hole punch attempts", req.GetOutcome())
+		}
+	}
+
+	// The client stops waiting for attempts after the retry count
+	if retryCount := req.GetDcutrParameters().GetRetryCount(); retryCount > 0 && len(req.HolePunchAttempts) > int(retryCount) {
+		p.reject("%d attempts exceed the retry count of %d", len(req.HolePunchAttempts), retryCount)
+	}
+}
+
+func checkLatencies(p *plausibility, req *pb.TrackHolePunchRequest) {
+	for i, hpa := range req.HolePunchAttempts {
+		if hpa.StartRtt == nil {
+			continue
+		}
+		if hpa.GetStartRtt() < 0 {
+			p.reject("attempt %d has a negative start RTT", i)
+		} else if hpa.GetStartRtt() > float32(maxRTT.Seconds()) {
+			p.flag("attempt %d has a start RTT above %s", i, maxRTT)
+		}
+	}
+
+	for i, lm := range req.LatencyMeasurements {
+		for _, rtt := range lm.Rtts {
+			// Clients report failed pings as -1
+			if rtt < 0 && rtt != -1 {
+				p.reject("latency measurement %d has a negative RTT", i)
+				break
+			} else if rtt > float32(maxRTT.Seconds()) {
+				p.flag("latency measurement %d has a RTT above %s", i, maxRTT)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func plausibleTrackHolePunchRequest(t *testing.T, now time.Time) *pb.TrackHolePunchRequest {
+	req := validTrackHolePunchRequest(t)
+
+	directMaddr, err := multiaddr.NewMultiaddr("/ip4/1.2.3.4/tcp/4001")
+	require.NoError(t, err)
+
+	nanos := func(d time.Duration) *uint64 {
+		ts := uint64(now.Add(d).UnixNano())
+		return &ts
+	}
+
+	hasDirectConns := true
+	elapsed := float32(0.5)
+	startRtt := float32(0.1)
+	req.ConnectStartedAt = nanos(-10 * time.Second)
+	req.ConnectEndedAt = nanos(-9 * time.Second)
+	req.EndedAt = nanos(-time.Second)
+	req.HasDirectConns = &hasDirectConns
+	req.OpenMultiAddresses = [][]byte{directMaddr.Bytes()}
+	req.HolePunchAttempts[0].OpenedAt = nanos(-8 * time.Second)
+	req.HolePunchAttempts[0].StartedAt = nanos(-7 * time.Second)
+	req.HolePunchAttempts[0].EndedAt = nanos(-6 * time.Second)
+	req.HolePunchAttempts[0].ElapsedTime = &elapsed
+	req.HolePunchAttempts[0].StartRtt = &startRtt
+	req.HolePunchEvents = []*pb.HolePunchEvent{{Timestamp: nanos(-5 * time.Second)}}
+	req.LatencyMeasurements = []*pb.LatencyMeasurement{{Rtts: []float32{0.1, -1, 0.2}}}
+
+	return req
+}
+
+func TestCheckPlausibility(t *testing.T) {
+	now := time.Now()
+	relayMaddr, err := multiaddr.NewMultiaddr("/ip4/5.6.7.8/tcp/4001/p2p/12D3KooWSnLfpFczsHcFhsc2oBhiS9vu6fMjnMXcQiykbsDhGkx5/p2p-circuit")
+	require.NoError(t, err)
+
+	ts := func(d time.Duration) *uint64 {
+		t := uint64(now.Add(d).UnixNano())
+		return &t
+	}
+
+	tests := []struct {
+		name   string
+		modify func(req *pb.TrackHolePunchRequest, am *allocationMatch)
+		want   string
+
+		// wantUnlinked is true if the result must not complete the allocation
+		wantUnlinked bool
+	}{
+		{
+			name:   "plausible",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {},
+			want:   models.ValidationStatusACCEPTED,
+		},
+		{
+			name:         "not allocated",
+			modify:       func(req *pb.TrackHolePunchRequest, am *allocationMatch) { am.allocation = nil },
+			want:         models.ValidationStatusREJECTED,
+			wantUnlinked: true,
+		},
+		{
+			name:         "allocated to another client",
+			modify:       func(req *pb.TrackHolePunchRequest, am *allocationMatch) { am.allocation.ClientID = 3 },
+			want:         models.ValidationStatusREJECTED,
+			wantUnlinked: true,
+		},
+		{
+			name:         "allocated with another remote",
+			modify:       func(req *pb.TrackHolePunchRequest, am *allocationMatch) { am.allocation.RemoteID = 3 },
+			want:         models.ValidationStatusREJECTED,
+			wantUnlinked: true,
+		},
+		{
+			name:         "allocation already completed",
+			modify:       func(req *pb.TrackHolePunchRequest, am *allocationMatch) { am.completed = true },
+			want:         models.ValidationStatusREJECTED,
+			wantUnlinked: true,
+		},
+		{
+			name: "allocation expired",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				am.allocation.ExpiresAt = now.Add(-time.Minute)
+			},
+			want: models.ValidationStatusFLAGGED,
+		},
+		{
+			name:   "protocol filters differ from allocation",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) { req.Protocols = []int32{4, 6} },
+			want:   models.ValidationStatusFLAGGED,
+		},
+		{
+			name: "protocol filters not reported",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				am.allocation.ProtocolFilters = types.Int64Array{4}
+				req.Protocols = nil
+			},
+			want: models.ValidationStatusACCEPTED,
+		},
+		{
+			name: "dcutr parameters of allocation applied",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				am.allocation.TimingArm = null.StringFrom("timeout-15s")
+				req.DcutrParameters = &pb.DCUtRParameters{RetryCount: int32Ptr(3), CommunicationTimeout: float32Ptr(15)}
+			},
+			want: models.ValidationStatusACCEPTED,
+		},
+		{
+			name: "dcutr parameters differ from allocation",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				am.allocation.TimingArm = null.StringFrom("timeout-15s")
+				req.DcutrParameters = &pb.DCUtRParameters{CommunicationTimeout: float32Ptr(30)}
+			},
+			want: models.ValidationStatusFLAGGED,
+		},
+		{
+			name: "attempts exceed retry count",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				req.DcutrParameters = &pb.DCUtRParameters{RetryCount: int32Ptr(1)}
+				req.HolePunchAttempts = append(req.HolePunchAttempts, req.HolePunchAttempts[0])
+			},
+			want: models.ValidationStatusREJECTED,
+		},
+		{
+			name:   "connect ended before it started",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) { req.ConnectEndedAt = ts(-time.Minute) },
+			want:   models.ValidationStatusREJECTED,
+		},
+		{
+			name: "attempt ended after hole punch",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				req.HolePunchAttempts[0].EndedAt = ts(time.Second)
+			},
+			want: models.ValidationStatusREJECTED,
+		},
+		{
+			name: "attempt started before it was opened",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				req.HolePunchAttempts[0].StartedAt = ts(-10 * time.Second)
+			},
+			want: models.ValidationStatusREJECTED,
+		},
+		{
+			name: "ended in the future",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				req.ConnectStartedAt = ts(time.Hour)
+				req.ConnectEndedAt = ts(time.Hour)
+				req.EndedAt = ts(time.Hour + time.Minute)
+				req.HolePunchAttempts = nil
+				req.HolePunchEvents = nil
+				req.OpenMultiAddresses = nil
+				req.HasDirectConns = new(bool)
+				outcome := pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM
+				req.Outcome = &outcome
+			},
+			want: models.ValidationStatusFLAGGED,
+		},
+		{
+			name: "success without direct connection",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				req.OpenMultiAddresses = [][]byte{relayMaddr.Bytes()}
+				req.HasDirectConns = new(bool)
+			},
+			want: models.ValidationStatusREJECTED,
+		},
+		{
+			name:   "direct connection claim contradicts final addresses",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) { req.HasDirectConns = new(bool) },
+			want:   models.ValidationStatusREJECTED,
+		},
+		{
+			name: "success without successful attempt",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				outcome := pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_TIMEOUT
+				req.HolePunchAttempts[0].Outcome = &outcome
+			},
+			want: models.ValidationStatusREJECTED,
+		},
+		{
+			name: "failure with successful attempt",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				outcome := pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED
+				req.Outcome = &outcome
+			},
+			want: models.ValidationStatusREJECTED,
+		},
+		{
+			name: "no stream with successful attempt",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				outcome := pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM
+				req.Outcome = &outcome
+			},
+			want: models.ValidationStatusREJECTED,
+		},
+		{
+			name: "FAILED attempt followed by NO_STREAM",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				attemptOutcome := pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_FAILED
+				req.HolePunchAttempts[0].Outcome = &attemptOutcome
+				outcome := pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM
+				req.Outcome = &outcome
+			},
+			want: models.ValidationStatusFLAGGED,
+		},
+		{
+			name: "FAILED attempt followed by CONNECTION_REVERSED",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				attemptOutcome := pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_FAILED
+				req.HolePunchAttempts[0].Outcome = &attemptOutcome
+				outcome := pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_CONNECTION_REVERSED
+				req.Outcome = &outcome
+			},
+			want: models.ValidationStatusFLAGGED,
+		},
+		{
+			name: "negative rtt",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				req.LatencyMeasurements[0].Rtts = []float32{-0.5}
+			},
+			want: models.ValidationStatusREJECTED,
+		},
+		{
+			name: "huge start rtt",
+			modify: func(req *pb.TrackHolePunchRequest, am *allocationMatch) {
+				rtt := float32(60)
+				req.HolePunchAttempts[0].StartRtt = &rtt
+			},
+			want: models.ValidationStatusFLAGGED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := plausibleTrackHolePunchRequest(t, now)
+			am := allocationMatch{
+				allocation: &models.Allocation{
+					ID:              7,
+					ClientID:        1,
+					RemoteID:        2,
+					ProtocolFilters: types.Int64Array{},
+					ExpiresAt:       now.Add(time.Minute),
+				},
+				localID:  1,
+				remoteID: 2,
+			}
+			tt.modify(req, &am)
+
+			v := checkPlausibility(req, now, am)
+			assert.Equal(t, tt.want, v.status, v.reasons)
+			if tt.want == models.ValidationStatusACCEPTED {
+				assert.Empty(t, v.reasons)
+			} else {
+				assert.NotEmpty(t, v.reasons)
+			}
+
+			if tt.wantUnlinked {
+				assert.Nil(t, v.allocationID)
+			} else {
+				require.NotNil(t, v.allocationID)
+				assert.Equal(t, int64(7), *v.allocationID)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var throttledRequestsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "grpc_throttled_requests_total",
+	Help: "The number of requests that were rejected because a rate limit was exceeded",
+}, []string{"grpc_method", "limit"})
+
+// retryAfterHeader carries the number of seconds after which a throttled
+// request may be retried. Go clients use the RetryInfo status detail instead.
+const retryAfterHeader = "retry-after"
+
+// bucketCacheSize is the maximum number of token buckets that are kept in memory.
+// Evicting a bucket of an inactive API key or peer resets its limit.
+const bucketCacheSize = 10_000
+
+// Names of the limits in the grpc_throttled_requests_total metric.
+const (
+	limitApiKey    = "api_key"
+	limitAnonymous = "anonymous"
+	limitPeer      = "peer"
+)
+
+// quota configures a token bucket. A rate of zero disables the limit.
+type quota struct {
+	Rate  rate.Limit
+	Burst int
+}
+
+func (q quota) enabled() bool {
+	return q.Rate > 0
+}
+
+// clientIDRequest is implemented by all request messages that are sent on behalf of a single
+// client peer. GetAddrInfoRequest carries the ID as host_id instead of client_id.
+type (
+	clientIDRequest interface{ GetClientId() []byte }
+	hostIDRequest   interface{ GetHostId() []byte }
+)
+
+// rateLimiter enforces token bucket limits per API key and per client peer.
+// Every RPC method has its own buckets, so that e.g. a client that
+// exhausted its GetAddrInfo quota can still report its results.
+type rateLimiter struct {
+	mu        sync.RWMutex
+	apiKey    quota
+	anonymous quota
+	peer      quota
+
+	buckets *lru.Cache
+}
+
+func newRateLimiter(apiKey quota, anonymous quota, peer quota) (*rateLimiter, error) {
+	buckets, err := lru.New(bucketCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rateLimiter{
+		apiKey:    apiKey,
+		anonymous: anonymous,
+		peer:      peer,
+		buckets:   buckets,
+	}, nil
+}
+
+// setQuotas changes the limits while the server is running. Existing
+// buckets keep their tokens but fill up at the new rates.
+func (rl *rateLimiter) setQuotas(apiKey quota, anonymous quota, peer quota) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.apiKey = apiKey
+	rl.anonymous = anonymous
+	rl.peer = peer
+
+	for _, key := range rl.buckets.Keys() {
+		iBucket, found := rl.buckets.Peek(key)
+		if !found {
+			continue
+		}
+
+		limit, _, _ := strings.Cut(key.(string), "/")
+		q := rl.quota(limit)
+		if !q.enabled() {
+			// Buckets of disabled limits are recreated once the limit is enabled again
+			rl.buckets.Remove(key)
+			continue
+		}
+
+		bucket := iBucket.(*rate.Limiter)
+		bucket.SetLimit(q.Rate)
+		bucket.SetBurst(q.Burst)
+	}
+}
+
+// quota returns the quota of the limit with the given name.
+func (rl *rateLimiter) quota(limit string) quota {
+	switch limit {
+	case limitApiKey:
+		return rl.apiKey
+	case limitAnonymous:
+		return rl.anonymous
+	case limitPeer:
+		return rl.peer
+	default:
+		return quota{}
+	}
+}
+
+// unaryInterceptor must run after the auth interceptor because the
+// limits depend on the authorization of the request.
+func (rl *rateLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isPublicMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	rl.mu.RLock()
+	apiKeyQuota, anonymousQuota, peerQuota := rl.apiKey, rl.anonymous, rl.peer
+	rl.mu.RUnlock()
+
+	// Anonymous registrations don't have an authorization yet
+	limit, q, id := limitAnonymous, anonymousQuota, apiKeyFromContext(ctx)
+	if authz, found := authFromContext(ctx); found {
+		id = strconv.Itoa(authz.ID)
+		if !authz.Anonymous {
+			limit, q = limitApiKey, apiKeyQuota
+		}
+	}
+
+	if err := rl.allow(ctx, info.FullMethod, limit, q, id); err != nil {
+		return nil, err
+	}
+
+	if peerID := requestPeerID(req); peerID != "" {
+		if err := rl.allow(ctx, info.FullMethod, limitPeer, peerQuota, peerID); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// allow takes a token from the bucket of the given limit and ID. If the
+// bucket is empty it returns a ResourceExhausted status with a retry hint.
+func (rl *rateLimiter) allow(ctx context.Context, method string, limit string, q quota, id string) error {
+	if !q.enabled() {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s/%s/%s", limit, method, id)
+	rl.buckets.ContainsOrAdd(key, rate.NewLimiter(q.Rate, q.Burst))
+	iBucket, _ := rl.buckets.Get(key)
+	bucket, ok := iBucket.(*rate.Limiter)
+	if !ok {
+		// Evicted between adding and getting it
+		return nil
+	}
+
+	r := bucket.Reserve()
+	delay := r.Delay()
+	if r.OK() && delay == 0 {
+		return nil
+	}
+	r.Cancel()
+
+	// Reservations fail if the burst is zero. Clients should back off considerably in that case.
+	if !r.OK() || delay == rate.InfDuration {
+		delay = time.Duration(math.Max(float64(time.Second)/float64(q.Rate), float64(time.Minute)))
+	}
+
+	throttledRequestsCounter.WithLabelValues(method, limit).Inc()
+	log.WithFields(log.Fields{"method": method, "limit": limit, "retryAfter": delay}).Debugln("Throttled request")
+
+	seconds := int(math.Ceil(delay.Seconds()))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.Itoa(seconds))); err != nil {
+		log.WithError(err).Debugln("Could not set retry-after header")
+	}
+
+	st := status.Newf(codes.ResourceExhausted, "%s rate limit exceeded, retry in %s", limit, delay.Round(time.Millisecond))
+	if stWithDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+		st = stWithDetails
+	}
+
+	return st.Err()
+}
+
+// requestPeerID returns the peer ID of the client on whose behalf the request was sent.
+// It returns an empty string for requests without or with an invalid peer ID.
+func requestPeerID(req interface{}) string {
+	var bytesID []byte
+	switch r := req.(type) {
+	case clientIDRequest:
+		bytesID = r.GetClientId()
+	case hostIDRequest:
+		bytesID = r.GetHostId()
+	default:
+		return ""
+	}
+
+	peerID, err := peer.IDFromBytes(bytesID)
+	if err != nil {
+		return ""
+	}
+
+	return peerID.String()
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func TestRateLimiter_unaryInterceptor(t *testing.T) {
+	peerID, err := peer.Decode("12D3KooWKrnZSrdtKBcbYSRzrcuanNvMRiXiBQMrPZeGbSztGCH6")
+	require.NoError(t, err)
+	otherPeerID, err := peer.Decode("12D3KooWSnLfpFczsHcFhsc2oBhiS9vu6fMjnMXcQiykbsDhGkx5")
+	require.NoError(t, err)
+
+	contributor := &authorization{ID: 1, Role: models.AuthorizationRoleCONTRIBUTOR}
+	anonymous := &authorization{ID: 2, Role: models.AuthorizationRoleCONTRIBUTOR, Anonymous: true}
+
+	tests := []struct {
+		name     string
+		authz    *authorization
+		reqs     []interface{}
+		methods  []string
+		wantCode []codes.Code
+	}{
+		{
+			name:     "api key burst",
+			authz:    contributor,
+			reqs:     []interface{}{&pb.GetAddrInfoRequest{}, &pb.GetAddrInfoRequest{}, &pb.GetAddrInfoRequest{}, &pb.GetAddrInfoRequest{}},
+			wantCode: []codes.Code{codes.OK, codes.OK, codes.OK, codes.ResourceExhausted},
+		},
+		{
+			name:     "anonymous burst",
+			authz:    anonymous,
+			reqs:     []interface{}{&pb.GetAddrInfoRequest{}, &pb.GetAddrInfoRequest{}},
+			wantCode: []codes.Code{codes.OK, codes.ResourceExhausted},
+		},
+		{
+			name:     "peer burst",
+			authz:    contributor,
+			reqs:     []interface{}{&pb.GetAddrInfoRequest{HostId: []byte(peerID)}, &pb.GetAddrInfoRequest{HostId: []byte(peerID)}, &pb.GetAddrInfoRequest{HostId: []byte(otherPeerID)}},
+			wantCode: []codes.Code{codes.OK, codes.ResourceExhausted, codes.OK},
+		},
+		{
+			name:     "separate buckets per method",
+			authz:    anonymous,
+			reqs:     []interface{}{&pb.GetAddrInfoRequest{}, &pb.TrackHolePunchRequest{}},
+			methods:  []string{"/PunchrService/GetAddrInfo", "/PunchrService/TrackHolePunch"},
+			wantCode: []codes.Code{codes.OK, codes.OK},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rl, err := newRateLimiter(quota{Rate: 0.001, Burst: 3}, quota{Rate: 0.001, Burst: 1}, quota{Rate: 0.001, Burst: 1})
+			require.NoError(t, err)
+
+			ctx := context.WithValue(context.Background(), authCtxKey{}, tt.authz)
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			}
+
+			for i, req := range tt.reqs {
+				method := "/PunchrService/GetAddrInfo"
+				if tt.methods != nil {
+					method = tt.methods[i]
+				}
+
+				_, err = rl.unaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+				assert.Equal(t, tt.wantCode[i], status.Code(err), "request %d", i)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_allow_retryInfo(t *testing.T) {
+	rl, err := newRateLimiter(quota{Rate: 1, Burst: 1}, quota{}, quota{})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, rl.allow(ctx, "method", limitApiKey, rl.apiKey, "1"))
+
+	err = rl.allow(ctx, "method", limitApiKey, rl.apiKey, "1")
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	ri, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.InDelta(t, time.Second, ri.RetryDelay.AsDuration(), float64(100*time.Millisecond))
+
+	// Disabled limits allow everything
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, rl.allow(ctx, "method", limitPeer, rl.peer, "peer"))
+	}
+}
+
+func TestRateLimiter_setQuotas(t *testing.T) {
+	rl, err := newRateLimiter(quota{Rate: 0.001, Burst: 1}, quota{Rate: 0.001, Burst: 1}, quota{})
+	require.NoError(t, err)
+
+	contributor := &authorization{ID: 1, Role: models.AuthorizationRoleCONTRIBUTOR}
+	ctx := context.WithValue(context.Background(), authCtxKey{}, contributor)
+	info := &grpc.UnaryServerInfo{FullMethod: "/PunchrService/GetAddrInfo"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	_, err = rl.unaryInterceptor(ctx, &pb.GetAddrInfoRequest{}, info, handler)
+	require.NoError(t, err)
+	_, err = rl.unaryInterceptor(ctx, &pb.GetAddrInfoRequest{}, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// A higher rate refills the existing bucket faster
+	rl.setQuotas(quota{Rate: 1000, Burst: 1}, quota{Rate: 0.001, Burst: 1}, quota{})
+	time.Sleep(10 * time.Millisecond)
+	_, err = rl.unaryInterceptor(ctx, &pb.GetAddrInfoRequest{}, info, handler)
+	assert.NoError(t, err)
+
+	// Disabling a limit removes its buckets
+	rl.setQuotas(quota{}, quota{Rate: 0.001, Burst: 1}, quota{})
+	assert.Equal(t, 0, rl.buckets.Len())
+	for i := 0; i < 5; i++ {
+		_, err = rl.unaryInterceptor(ctx, &pb.GetAddrInfoRequest{}, info, handler)
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+
+	"github.com/lib/pq"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"gonum.org/v1/gonum/stat"
+
+	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/pb"
+	"github.com/dennis-tra/punchr/pkg/util"
+)
+
+// relayFilter excludes the addresses of relays that clients could rarely reach from allocations.
+type relayFilter struct {
+	// minReachability is the share of pings that must have reached a relay. 0 disables the filter.
+	minReachability float64
+
+	// minPings is the number of pings after which a relay is filtered, so
+	// that a few unlucky pings don't exclude new relays.
+	minPings int
+}
+
+// The relay selections determine if and how the server chooses the relay through which
+// a client connects to the remote peer.
+const (
+	// relaySelectionClient leaves the choice to the relay strategy of the client.
+	relaySelectionClient = "client"
+
+	// relaySelectionRandom chooses a random relay of the remote peer.
+	relaySelectionRandom = "random"
+
+	// relaySelectionSuccessRate chooses the relay through which the most hole punches succeeded
+	// recently. It falls back to a random relay if there are no statistics for any of them.
+	relaySelectionSuccessRate = "success-rate"
+)
+
+// validateRelaySelection returns an error if the given relay selection is unknown.
+func validateRelaySelection(selection string) error {
+	switch selection {
+	case relaySelectionClient, relaySelectionRandom, relaySelectionSuccessRate:
+		return nil
+	default:
+		return fmt.Errorf("unknown relay selection %s (%s, %s, %s)", selection, relaySelectionClient, relaySelectionRandom, relaySelectionSuccessRate)
+	}
+}
+
+// selectRelay returns the relayed multi address through which the client should connect
+// to the remote peer. It returns nil if the client should choose the relay itself.
+func (s Server) selectRelay(ctx context.Context, maddrs [][]byte) ([]byte, error) {
+	if s.relaySelection == relaySelectionClient {
+		return nil, nil
+	}
+
+	var relayIDs []string
+	relayMaddrs := map[string][]byte{}
+	for _, maddrBytes := range maddrs {
+		relayID, found := relayOf(maddrBytes)
+		if !found {
+			continue
+		}
+
+		if _, found = relayMaddrs[relayID.String()]; !found {
+			relayIDs = append(relayIDs, relayID.String())
+			relayMaddrs[relayID.String()] = maddrBytes
+		}
+	}
+
+	if len(relayIDs) == 0 {
+		return nil, nil
+	}
+
+	if s.relaySelection == relaySelectionSuccessRate {
+		query := `
+SELECT rp.multi_hash
+FROM relays r
+         INNER JOIN peers rp ON rp.id = r.peer_id
+WHERE rp.multi_hash = ANY ($1::TEXT[])
+  AND r.hole_punch_success_rate IS NOT NULL
+ORDER BY r.hole_punch_success_rate DESC, r.rtt NULLS LAST
+LIMIT 1
+`
+		var multiHash string
+		err := s.DBClient.Reader().QueryRowContext(ctx, query, pq.StringArray(relayIDs)).Scan(&multiHash)
+		if err == nil {
+			return relayMaddrs[multiHash], nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(err, "query relay success rates")
+		}
+	}
+
+	return relayMaddrs[relayIDs[rand.Intn(len(relayIDs))]], nil
+}
+
+// relayObservations derives from the hole punch result which relays the client could
+// reach, through which relay it connected to the remote peer, and whether the hole
+// punch through that relay succeeded. The database IDs of the observations are not set.
+// It also returns the relay through which the client connected to the remote peer,
+// which is empty if the client couldn't connect or the relay is unknown.
+func relayObservations(req *pb.TrackHolePunchRequest) (map[peer.ID]*db.RelayObservation, peer.ID) {
+	observations := map[peer.ID]*db.RelayObservation{}
+	observation := func(relayID peer.ID) *db.RelayObservation {
+		if _, found := observations[relayID]; !found {
+			observations[relayID] = &db.RelayObservation{}
+		}
+		return observations[relayID]
+	}
+
+	// Clients that measure the round trip times to relays try to reach all relays of
+	// the remote peer after the hole punch but only report measurements for the relays
+	// they could connect to. Other clients, e.g., the Rust client, and cancelled hole
+	// punches don't ping relays at all, so their results don't contain any measurement.
+	pingedRelays := false
+	for _, lm := range req.LatencyMeasurements {
+		if lm.GetMtype() == pb.LatencyMeasurementType_TO_RELAY {
+			pingedRelays = true
+			break
+		}
+	}
+
+	for _, maddrBytes := range req.RemoteMultiAddresses {
+		relayID, found := relayOf(maddrBytes)
+		if !found {
+			continue
+		}
+		observation(relayID).Pinged = pingedRelays
+	}
+
+	var circuitRelay peer.ID
+	for _, lm := range req.LatencyMeasurements {
+		switch lm.GetMtype() {
+		case pb.LatencyMeasurementType_TO_RELAY:
+			relayID, err := peer.IDFromBytes(lm.RemoteId)
+			if err != nil {
+				continue
+			}
+
+			o := observation(relayID)
+			o.Pinged = true
+
+			var rtts []float64
+			for i, rtt := range lm.Rtts {
+				if rtt > 0 && (i >= len(lm.RttErrs) || lm.RttErrs[i] == "") {
+					rtts = append(rtts, float64(rtt))
+				}
+			}
+			if len(rtts) == 0 {
+				continue
+			}
+
+			o.Reachable = true
+			o.RTT = null.Float64From(stat.Mean(rtts, nil))
+
+		case pb.LatencyMeasurementType_TO_REMOTE_THROUGH_RELAY:
+			// The multi address of the measurement is the one of the relayed connection
+			if relayID, found := relayOf(lm.MultiAddress); found {
+				circuitRelay = relayID
+			}
+		}
+	}
+
+	// Newer clients report the relayed address through which they connected
+	if relayID, found := relayOf(req.RelayMultiAddress); found {
+		circuitRelay = relayID
+	}
+
+	if !connectedThroughRelay(req.GetOutcome()) {
+		return observations, ""
+	}
+
+	// Without a measurement through the relay the circuit can only be attributed if the remote peer had one relay
+	if circuitRelay == "" && len(observations) == 1 {
+		for relayID := range observations {
+			circuitRelay = relayID
+		}
+	}
+
+	if circuitRelay == "" {
+		return observations, ""
+	}
+
+	o := observation(circuitRelay)
+	o.Circuit = true
+	switch req.GetOutcome() {
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS:
+		o.HolePunched = true
+		o.HolePunchSucceeded = true
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED:
+		o.HolePunched = true
+	}
+
+	return observations, circuitRelay
+}
+
+// connectedThroughRelay returns true if the outcome implies that the client connected
+// to the remote peer through a relay. A cancelled hole punch may have been stopped
+// before the client connected.
+func connectedThroughRelay(outcome pb.HolePunchOutcome) bool {
+	switch outcome {
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS,
+		pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED,
+		pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM,
+		pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_CONNECTION_REVERSED:
+		return true
+	default:
+		return false
+	}
+}
+
+// relayOf returns the relay of the given relayed multi address.
+func relayOf(maddrBytes []byte) (peer.ID, bool) {
+	maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+	if err != nil || !util.IsRelayedMaddr(maddr) {
+		return "", false
+	}
+
+	relayInfo, err := util.ExtractRelayMaddr(maddr)
+	if err != nil {
+		return "", false
+	}
+
+	return relayInfo.ID, true
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func TestRelayObservations(t *testing.T) {
+	relay1, err := peer.Decode("12D3KooWKrnZSrdtKBcbYSRzrcuanNvMRiXiBQMrPZeGbSztGCH6")
+	require.NoError(t, err)
+	relay2, err := peer.Decode("12D3KooWSnLfpFczsHcFhsc2oBhiS9vu6fMjnMXcQiykbsDhGkx5")
+	require.NoError(t, err)
+
+	relayMaddr := func(relayID peer.ID) []byte {
+		return multiaddr.StringCast("/ip4/1.2.3.4/tcp/4001/p2p/" + relayID.String() + "/p2p-circuit").Bytes()
+	}
+
+	toRelay := func(relayID peer.ID, rtts []float32, rttErrs []string) *pb.LatencyMeasurement {
+		mtype := pb.LatencyMeasurementType_TO_RELAY
+		return &pb.LatencyMeasurement{RemoteId: []byte(relayID), Mtype: &mtype, Rtts: rtts, RttErrs: rttErrs}
+	}
+
+	throughRelay := func(relayID peer.ID) *pb.LatencyMeasurement {
+		mtype := pb.LatencyMeasurementType_TO_REMOTE_THROUGH_RELAY
+		return &pb.LatencyMeasurement{Mtype: &mtype, MultiAddress: relayMaddr(relayID), Rtts: []float32{0.1}, RttErrs: []string{""}}
+	}
+
+	tests := []struct {
+		name         string
+		outcome      pb.HolePunchOutcome
+		remoteMaddrs [][]byte
+		relayMaddr   []byte
+		lms          []*pb.LatencyMeasurement
+		want         map[peer.ID]*db.RelayObservation
+		wantCircuit  peer.ID
+	}{
+		{
+			name:         "successful hole punch through measured relay",
+			outcome:      pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS,
+			remoteMaddrs: [][]byte{relayMaddr(relay1), relayMaddr(relay2)},
+			lms: []*pb.LatencyMeasurement{
+				throughRelay(relay2),
+				toRelay(relay1, []float32{0.1, 0.3, -1}, []string{"", "", "timeout"}),
+			},
+			want: map[peer.ID]*db.RelayObservation{
+				relay1: {Pinged: true, Reachable: true, RTT: null.Float64From(0.2)},
+				relay2: {Pinged: true, Circuit: true, HolePunched: true, HolePunchSucceeded: true},
+			},
+			wantCircuit: relay2,
+		},
+		{
+			name:         "reported relay takes precedence",
+			outcome:      pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED,
+			remoteMaddrs: [][]byte{relayMaddr(relay1), relayMaddr(relay2)},
+			relayMaddr:   relayMaddr(relay1),
+			lms:          []*pb.LatencyMeasurement{throughRelay(relay2)},
+			want: map[peer.ID]*db.RelayObservation{
+				relay1: {Circuit: true, HolePunched: true},
+				relay2: {},
+			},
+			wantCircuit: relay1,
+		},
+		{
+			name:         "failed hole punch through single relay",
+			outcome:      pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED,
+			remoteMaddrs: [][]byte{relayMaddr(relay1)},
+			want: map[peer.ID]*db.RelayObservation{
+				relay1: {Circuit: true, HolePunched: true},
+			},
+			wantCircuit: relay1,
+		},
+		{
+			name:         "no stream is not a hole punch",
+			outcome:      pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM,
+			remoteMaddrs: [][]byte{relayMaddr(relay1)},
+			lms:          []*pb.LatencyMeasurement{toRelay(relay1, []float32{-1}, []string{"timeout"})},
+			want: map[peer.ID]*db.RelayObservation{
+				relay1: {Pinged: true, Circuit: true},
+			},
+			wantCircuit: relay1,
+		},
+		{
+			name:         "no connection",
+			outcome:      pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_CONNECTION,
+			remoteMaddrs: [][]byte{relayMaddr(relay1), relayMaddr(relay2)},
+			lms:          []*pb.LatencyMeasurement{toRelay(relay2, []float32{0.1}, []string{""})},
+			want: map[peer.ID]*db.RelayObservation{
+				relay1: {Pinged: true},
+				relay2: {Pinged: true, Reachable: true, RTT: null.Float64From(0.1)},
+			},
+		},
+		{
+			name:         "ambiguous circuit",
+			outcome:      pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS,
+			remoteMaddrs: [][]byte{relayMaddr(relay1), relayMaddr(relay2)},
+			want: map[peer.ID]*db.RelayObservation{
+				relay1: {},
+				relay2: {},
+			},
+		},
+		{
+			name:         "cancelled hole punches don't ping or count as circuit",
+			outcome:      pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_CANCELLED,
+			remoteMaddrs: [][]byte{relayMaddr(relay1)},
+			relayMaddr:   relayMaddr(relay1),
+			want: map[peer.ID]*db.RelayObservation{
+				relay1: {},
+			},
+		},
+		{
+			name:         "direct addresses",
+			outcome:      pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS,
+			remoteMaddrs: [][]byte{multiaddr.StringCast("/ip4/1.2.3.4/tcp/4001").Bytes()},
+			want:         map[peer.ID]*db.RelayObservation{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.TrackHolePunchRequest{
+				Outcome:              &tt.outcome,
+				RemoteMultiAddresses: tt.remoteMaddrs,
+				RelayMultiAddress:    tt.relayMaddr,
+				LatencyMeasurements:  tt.lms,
+			}
+
+			got, gotCircuit := relayObservations(req)
+			assert.Equal(t, tt.wantCircuit, gotCircuit)
+			require.Len(t, got, len(tt.want))
+			for relayID, want := range tt.want {
+				require.Contains(t, got, relayID)
+				assert.InDelta(t, want.RTT.Float64, got[relayID].RTT.Float64, 1e-6)
+				got[relayID].RTT.Float64 = want.RTT.Float64
+				assert.Equal(t, want, got[relayID])
+			}
+		})
+	}
+}
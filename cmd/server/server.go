@@ -17,104 +17,276 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
+	"github.com/dennis-tra/punchr/pkg/aggregate"
+	"github.com/dennis-tra/punchr/pkg/certs"
+	"github.com/dennis-tra/punchr/pkg/config"
 	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/maxmind"
 	"github.com/dennis-tra/punchr/pkg/pb"
+	"github.com/dennis-tra/punchr/pkg/retention"
+	"github.com/dennis-tra/punchr/pkg/sink"
 )
 
 var Version = "dev"
 
-var allocationQueryDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+var allocationQueryDurationHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
 	Name: "db_allocation_query_duration_seconds",
 	Help: "Histogram of database query times for client allocations",
 }, []string{"type", "success"})
 
-func init() {
-	prometheus.MustRegister(allocationQueryDurationHistogram)
-}
-
 func main() {
 	app := &cli.App{
 		Name:      "punchrserver",
 		Usage:     "A gRPC server that exposes peers to hole punch and tracks the results.",
 		UsageText: "punchrserver [global options] command [command options] [arguments...]",
+		Before:    config.Before(validateConfig),
 		Action:    RootAction,
 		Version:   Version,
-		Flags: []cli.Flag{
-			&cli.StringFlag{
-				Name:        "port",
-				Usage:       "On which port should the gRPC host listen",
-				EnvVars:     []string{"PUNCHR_SERVER_PORT"},
-				Value:       "10000",
-				DefaultText: "10000",
-			},
-			&cli.StringFlag{
-				Name:        "telemetry-host",
-				Usage:       "To which network address should the telemetry (prometheus, pprof) server bind",
-				EnvVars:     []string{"PUNCHR_SERVER_TELEMETRY_HOST"},
-				Value:       "localhost",
-				DefaultText: "localhost",
-			},
-			&cli.StringFlag{
-				Name:        "telemetry-port",
-				Usage:       "On which port should the telemetry (prometheus, pprof) server listen",
-				EnvVars:     []string{"PUNCHR_SERVER_TELEMETRY_PORT"},
-				Value:       "10001",
-				DefaultText: "10001",
-			},
-			&cli.StringFlag{
-				Name:        "db-host",
-				Usage:       "On which host address can the database be reached",
-				EnvVars:     []string{"PUNCHR_SERVER_DATABASE_HOST"},
-				DefaultText: "localhost",
-				Value:       "localhost",
-			},
-			&cli.StringFlag{
-				Name:        "db-port",
-				Usage:       "On which port can the database be reached",
-				EnvVars:     []string{"PUNCHR_SERVER_DATABASE_PORT"},
-				DefaultText: "5432",
-				Value:       "5432",
-			},
-			&cli.StringFlag{
-				Name:        "db-name",
-				Usage:       "The name of the database to use",
-				EnvVars:     []string{"PUNCHR_SERVER_DATABASE_NAME"},
-				DefaultText: "punchr",
-				Value:       "punchr",
+		Flags: config.JoinFlags(
+			[]cli.Flag{
+				&cli.StringFlag{
+					Name:        "port",
+					Usage:       "On which port should the gRPC host listen",
+					EnvVars:     []string{"PUNCHR_SERVER_PORT"},
+					Value:       "10000",
+					DefaultText: "10000",
+				},
+				&cli.DurationFlag{
+					Name:        "shutdown-timeout",
+					Usage:       "How long in-flight requests may take to finish on shutdown before they are aborted",
+					EnvVars:     []string{"PUNCHR_SERVER_SHUTDOWN_TIMEOUT"},
+					DefaultText: "30s",
+					Value:       30 * time.Second,
+				},
+				&cli.BoolFlag{
+					Name:    "grpc-reflection",
+					Usage:   "Expose the gRPC server reflection service, e.g., for grpcurl",
+					EnvVars: []string{"PUNCHR_SERVER_GRPC_REFLECTION"},
+				},
+				&cli.StringFlag{
+					Name:      "tls-cert",
+					Usage:     "Serve gRPC over TLS with this PEM encoded certificate (chain). The file is reloaded when it changes",
+					EnvVars:   []string{"PUNCHR_SERVER_TLS_CERT"},
+					TakesFile: true,
+				},
+				&cli.StringFlag{
+					Name:      "tls-key",
+					Usage:     "The PEM encoded private key of the TLS certificate. The file is reloaded when it changes",
+					EnvVars:   []string{"PUNCHR_SERVER_TLS_KEY"},
+					TakesFile: true,
+				},
+				&cli.StringFlag{
+					Name:      "tls-client-ca",
+					Usage:     "Verify client certificates against this PEM encoded CA bundle. The file is reloaded when it changes",
+					EnvVars:   []string{"PUNCHR_SERVER_TLS_CLIENT_CA"},
+					TakesFile: true,
+				},
+				&cli.BoolFlag{
+					Name:    "tls-require-client-cert",
+					Usage:   "Reject clients that don't present a certificate issued by the client CA",
+					EnvVars: []string{"PUNCHR_SERVER_TLS_REQUIRE_CLIENT_CERT"},
+				},
+				&cli.StringFlag{
+					Name:        "telemetry-host",
+					Usage:       "To which network address should the telemetry (prometheus, pprof) server bind",
+					EnvVars:     []string{"PUNCHR_SERVER_TELEMETRY_HOST"},
+					Value:       "localhost",
+					DefaultText: "localhost",
+				},
+				&cli.StringFlag{
+					Name:        "telemetry-port",
+					Usage:       "On which port should the telemetry (prometheus, pprof) server listen",
+					EnvVars:     []string{"PUNCHR_SERVER_TELEMETRY_PORT"},
+					Value:       "10001",
+					DefaultText: "10001",
+				},
 			},
-			&cli.StringFlag{
-				Name:        "db-password",
-				Usage:       "The password for the database to use",
-				EnvVars:     []string{"PUNCHR_SERVER_DATABASE_PASSWORD"},
-				DefaultText: "password",
-				Value:       "password",
-			},
-			&cli.StringFlag{
-				Name:        "db-user",
-				Usage:       "The user with which to access the database to use",
-				EnvVars:     []string{"PUNCHR_SERVER_DATABASE_USER"},
-				DefaultText: "punchr",
-				Value:       "punchr",
-			},
-			&cli.StringFlag{
-				Name:        "db-sslmode",
-				Usage:       "The sslmode to use when connecting the the database",
-				EnvVars:     []string{"PUNCHR_SERVER_DATABASE_SSL_MODE"},
-				DefaultText: "disable",
-				Value:       "disable",
-			},
-			&cli.StringFlag{
-				Name:        "udger-db",
-				Usage:       "Path to the Udger database",
-				EnvVars:     []string{"PUNCHR_SERVER_UDGER_DATABASE"},
-				DefaultText: "udgerdb_v3.dat",
-				Value:       "udgerdb_v3.dat",
+			db.Flags("PUNCHR_SERVER"),
+			[]cli.Flag{db.ReplicaFlag("PUNCHR_SERVER")},
+			maxmind.Flags("PUNCHR_SERVER"),
+			db.EnrichmentFlags("PUNCHR_SERVER"),
+			[]cli.Flag{
+				&cli.StringFlag{
+					Name:        "udger-db",
+					Usage:       "Path to the Udger database",
+					EnvVars:     []string{"PUNCHR_SERVER_UDGER_DATABASE"},
+					DefaultText: "udgerdb_v3.dat",
+					Value:       "udgerdb_v3.dat",
+				},
+				&cli.StringFlag{
+					Name:    "jsonl-sink-file",
+					Usage:   "Additionally append all hole punch results as JSON lines to this file",
+					EnvVars: []string{"PUNCHR_SERVER_JSONL_SINK_FILE"},
+				},
+				&cli.StringFlag{
+					Name:    "parquet-sink-dir",
+					Usage:   "Additionally write all hole punch results as parquet files to this directory",
+					EnvVars: []string{"PUNCHR_SERVER_PARQUET_SINK_DIR"},
+				},
+				&cli.DurationFlag{
+					Name:        "parquet-sink-rotation",
+					Usage:       "After which time a new parquet file should be started",
+					EnvVars:     []string{"PUNCHR_SERVER_PARQUET_SINK_ROTATION"},
+					DefaultText: "1h",
+					Value:       time.Hour,
+				},
+				&cli.StringFlag{
+					Name:    "kafka-sink-brokers",
+					Usage:   "Additionally publish all hole punch results to these comma separated Kafka brokers",
+					EnvVars: []string{"PUNCHR_SERVER_KAFKA_SINK_BROKERS"},
+				},
+				&cli.StringFlag{
+					Name:        "kafka-sink-topic",
+					Usage:       "The Kafka topic to publish hole punch results to",
+					EnvVars:     []string{"PUNCHR_SERVER_KAFKA_SINK_TOPIC"},
+					DefaultText: "punchr-results",
+					Value:       "punchr-results",
+				},
+				&cli.StringFlag{
+					Name:    "nats-sink-url",
+					Usage:   "Additionally publish all hole punch results to this NATS server",
+					EnvVars: []string{"PUNCHR_SERVER_NATS_SINK_URL"},
+				},
+				&cli.StringFlag{
+					Name:        "nats-sink-subject",
+					Usage:       "The NATS subject to publish hole punch results to",
+					EnvVars:     []string{"PUNCHR_SERVER_NATS_SINK_SUBJECT"},
+					DefaultText: "punchr.results",
+					Value:       "punchr.results",
+				},
+				&cli.IntFlag{
+					Name:        "sink-queue-size",
+					Usage:       "How many hole punch results may be queued per additional sink before they are dropped",
+					EnvVars:     []string{"PUNCHR_SERVER_SINK_QUEUE_SIZE"},
+					DefaultText: "1000",
+					Value:       1000,
+				},
+				&cli.BoolFlag{
+					Name:    "disable-anonymous-registration",
+					Usage:   "Reject clients with unknown API keys instead of creating anonymous API keys for them",
+					EnvVars: []string{"PUNCHR_SERVER_DISABLE_ANONYMOUS_REGISTRATION"},
+				},
+				&cli.DurationFlag{
+					Name:        "allocation-ttl",
+					Usage:       "How long clients have to report the result for a peer that was handed out to them",
+					EnvVars:     []string{"PUNCHR_SERVER_ALLOCATION_TTL"},
+					DefaultText: "15m",
+					Value:       15 * time.Minute,
+				},
+				&cli.Float64Flag{
+					Name:        "relay-min-reachability",
+					Usage:       "Only hand out relay addresses of relays that clients could reach at least this often (0-1, 0 disables the filter)",
+					EnvVars:     []string{"PUNCHR_SERVER_RELAY_MIN_REACHABILITY"},
+					DefaultText: "0",
+				},
+				&cli.IntFlag{
+					Name:        "relay-min-pings",
+					Usage:       "How often clients must have tried to reach a relay before its addresses are filtered by its reachability",
+					EnvVars:     []string{"PUNCHR_SERVER_RELAY_MIN_PINGS"},
+					DefaultText: "10",
+					Value:       10,
+				},
+				&cli.StringFlag{
+					Name:        "relay-selection",
+					Usage:       "How the server chooses the relay through which clients connect to remote peers (client, random, success-rate)",
+					EnvVars:     []string{"PUNCHR_SERVER_RELAY_SELECTION"},
+					DefaultText: relaySelectionClient,
+					Value:       relaySelectionClient,
+				},
+				&cli.Float64Flag{
+					Name:        "timing-experiment-share",
+					Usage:       "The share of allocations for which the server sets other DCUtR timing parameters than the client defaults (0-1)",
+					EnvVars:     []string{"PUNCHR_SERVER_TIMING_EXPERIMENT_SHARE"},
+					DefaultText: "0",
+				},
+				&cli.Float64Flag{
+					Name:        "rate-limit-key",
+					Usage:       "How many requests per second and RPC method an API key may send on average (0 disables the limit)",
+					EnvVars:     []string{"PUNCHR_SERVER_RATE_LIMIT_KEY"},
+					DefaultText: "10",
+					Value:       10,
+				},
+				&cli.IntFlag{
+					Name:        "rate-limit-key-burst",
+					Usage:       "How many requests per RPC method an API key may send at once",
+					EnvVars:     []string{"PUNCHR_SERVER_RATE_LIMIT_KEY_BURST"},
+					DefaultText: "100",
+					Value:       100,
+				},
+				&cli.Float64Flag{
+					Name:        "rate-limit-anonymous",
+					Usage:       "How many requests per second and RPC method an anonymous API key may send on average (0 disables the limit)",
+					EnvVars:     []string{"PUNCHR_SERVER_RATE_LIMIT_ANONYMOUS"},
+					DefaultText: "1",
+					Value:       1,
+				},
+				&cli.IntFlag{
+					Name:        "rate-limit-anonymous-burst",
+					Usage:       "How many requests per RPC method an anonymous API key may send at once",
+					EnvVars:     []string{"PUNCHR_SERVER_RATE_LIMIT_ANONYMOUS_BURST"},
+					DefaultText: "10",
+					Value:       10,
+				},
+				&cli.Float64Flag{
+					Name:        "rate-limit-peer",
+					Usage:       "How many requests per second and RPC method a single client peer may send on average (0 disables the limit)",
+					EnvVars:     []string{"PUNCHR_SERVER_RATE_LIMIT_PEER"},
+					DefaultText: "0.5",
+					Value:       0.5,
+				},
+				&cli.IntFlag{
+					Name:        "rate-limit-peer-burst",
+					Usage:       "How many requests per RPC method a single client peer may send at once",
+					EnvVars:     []string{"PUNCHR_SERVER_RATE_LIMIT_PEER_BURST"},
+					DefaultText: "10",
+					Value:       10,
+				},
+				&cli.DurationFlag{
+					Name:    "retention-connection-events",
+					Usage:   "Delete connection events a month at a time once the month is older than this (0 keeps them forever)",
+					EnvVars: []string{"PUNCHR_SERVER_RETENTION_CONNECTION_EVENTS"},
+				},
+				&cli.DurationFlag{
+					Name:    "retention-hole-punch-results",
+					Usage:   "Delete hole punch results and allocations a month at a time once the month is older than this (0 keeps them forever)",
+					EnvVars: []string{"PUNCHR_SERVER_RETENTION_HOLE_PUNCH_RESULTS"},
+				},
+				&cli.StringFlag{
+					Name:    "archive-dir",
+					Usage:   "Archive expired data to files in this directory before deleting it",
+					EnvVars: []string{"PUNCHR_SERVER_ARCHIVE_DIR"},
+				},
+				&cli.StringFlag{
+					Name:        "archive-format",
+					Usage:       "The file format of the archives (csv, parquet)",
+					EnvVars:     []string{"PUNCHR_SERVER_ARCHIVE_FORMAT"},
+					DefaultText: "csv",
+					Value:       "csv",
+				},
+				&cli.DurationFlag{
+					Name:        "aggregate-interval",
+					Usage:       "How often the hourly aggregates of the hole punch results are refreshed (0 disables the refresh, e.g., if punchrserver aggregate runs as a cron job)",
+					EnvVars:     []string{"PUNCHR_SERVER_AGGREGATE_INTERVAL"},
+					DefaultText: "5m",
+					Value:       5 * time.Minute,
+				},
 			},
+			config.Flags("PUNCHR_SERVER", "debug"),
+		),
+		Commands: []*cli.Command{
+			KeysCommand,
+			MigrateCommand,
+			AggregateCommand,
+			ExportCommand,
 		},
 		EnableBashCompletion: true,
 	}
@@ -123,6 +295,12 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, os.Interrupt, os.Kill)
 	defer stop()
 
+	// Restore the default behavior of the signals after the first one, so that a second one terminates immediately.
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
 	if err := app.RunContext(ctx, os.Args); err != nil {
 		log.Errorf("error: %v\n", err)
 		os.Exit(1)
@@ -130,8 +308,13 @@ func main() {
 }
 
 func RootAction(c *cli.Context) error {
+	if c.Bool(config.FlagPrintConfig) {
+		return config.Print(os.Stdout, c)
+	}
+
 	// Start telemetry endpoints
-	go serveTelemetry(c)
+	hc := newHealthChecker()
+	go serveTelemetry(c, hc)
 
 	// Initialize database connection
 	dbClient, err := db.NewClient(c)
@@ -139,18 +322,82 @@ func RootAction(c *cli.Context) error {
 		return errors.Wrap(err, "new db client")
 	}
 
-	// Initialize gRPC server
-	s, lis, err := initGrpcServer(c, err)
-	if err != nil {
-		return err
+	// Check database connectivity for health reporting
+	go hc.run(c.Context, dbClient)
+
+	// Create the partitions of the upcoming months
+	go dbClient.MaintainPartitions(c.Context)
+
+	// Derive the geo, ASN and data center information of the saved multi addresses
+	go dbClient.Enricher.Run(c.Context)
+
+	// Archive and delete expired data
+	if err = startRetentionJob(c, dbClient); err != nil {
+		return errors.Wrap(err, "start retention job")
+	}
+
+	// Keep the aggregates of the hole punch results up to date
+	if interval := c.Duration("aggregate-interval"); interval > 0 {
+		go aggregate.NewJob(dbClient.DB).Run(c.Context, interval)
 	}
 
+	// Classify agent versions of peers that were saved before the classification was introduced
+	go func() {
+		count, err := dbClient.ClassifyPeers(c.Context)
+		if err != nil {
+			log.WithError(err).Warnln("Could not classify peers")
+		} else if count > 0 {
+			log.WithField("count", count).Infoln("Classified agent versions of peers")
+		}
+	}()
+
 	cache, err := lru.New(1000)
 	if err != nil {
 		return errors.Wrap(err, "new lru api key cache")
 	}
 
-	pb.RegisterPunchrServiceServer(s, &Server{DBClient: dbClient, apiKeyCache: cache})
+	// Initialize result sinks
+	resultSink, err := initResultSink(c, dbClient)
+	if err != nil {
+		return errors.Wrap(err, "init result sinks")
+	}
+
+	limiter, err := newRateLimiter(quotasFromConfig(c))
+	if err != nil {
+		return errors.Wrap(err, "new rate limiter")
+	}
+
+	server := Server{
+		DBClient:              dbClient,
+		apiKeyCache:           cache,
+		sink:                  resultSink,
+		limiter:               limiter,
+		allocationTTL:         newDuration(c.Duration("allocation-ttl")),
+		relayFilter:           relayFilter{minReachability: c.Float64("relay-min-reachability"), minPings: c.Int("relay-min-pings")},
+		relaySelection:        c.String("relay-selection"),
+		timingExperimentShare: c.Float64("timing-experiment-share"),
+		anonymousRegistration: !c.Bool("disable-anonymous-registration"),
+	}
+
+	// Load TLS certificates
+	reloader, err := initCertReloader(c)
+	if err != nil {
+		return err
+	}
+
+	// Initialize gRPC server
+	s, lis, err := initGrpcServer(c, server, reloader)
+	if err != nil {
+		return err
+	}
+
+	pb.RegisterPunchrServiceServer(s, server)
+	pb.RegisterPunchrAdminServiceServer(s, AdminServer{server: server})
+	healthpb.RegisterHealthServer(s, hc.grpcHealth)
+
+	if c.Bool("grpc-reflection") {
+		reflection.Register(s)
+	}
 
 	// Start gRPC server
 	log.WithField("addr", lis.Addr().String()).Infoln("Starting server")
@@ -160,47 +407,195 @@ func RootAction(c *cli.Context) error {
 		}
 	}()
 
+	// Reload settings from the config file on SIGHUP
+	stopWatching := config.Watch(c, applyConfig(server), reloadableFlags...)
+	defer stopWatching()
+
 	// Waiting for shutdown signal
 	<-c.Context.Done()
 	log.Info("Shutting down gracefully, press Ctrl+C again to force")
 
+	// Stop reporting the server as serving
+	hc.drain()
+
+	// Stopping gRPC server after in-flight requests have finished
+	stopGrpcServer(s, c.Duration("shutdown-timeout"))
+
+	// Stop watching TLS certificates
+	if reloader != nil {
+		if err = reloader.Close(); err != nil {
+			log.WithError(err).Warnln("closing certificate reloader")
+		}
+	}
+
+	// Flushing and closing result sinks
+	if err = resultSink.Close(); err != nil {
+		log.WithError(err).Warnln("closing result sinks")
+	}
+
 	// Closing database connection
 	if err = dbClient.Close(); err != nil {
 		log.WithError(err).Warnln("closing db client")
 	}
 
-	// Stopping gRPC server
-	s.Stop()
-
 	log.Info("Done!")
 	return nil
 }
 
-// serveTelemetry starts an HTTP server for the prometheus and pprof handler.
-func serveTelemetry(c *cli.Context) {
+// stopGrpcServer stops accepting new connections and waits for in-flight requests to finish.
+// Requests that are still running after the timeout are aborted.
+func stopGrpcServer(s *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.WithField("timeout", timeout).Warnln("Aborting in-flight requests")
+		s.Stop()
+	}
+}
+
+// serveTelemetry starts an HTTP server for the prometheus and pprof handler
+// and the liveness (/healthz) and readiness (/readyz) endpoints.
+func serveTelemetry(c *cli.Context, hc *healthChecker) {
 	addr := fmt.Sprintf("%s:%s", c.String("telemetry-host"), c.String("telemetry-port"))
 	log.WithField("addr", addr).Infoln("Starting telemetry endpoints")
 	http.Handle("/metrics", promhttp.Handler())
+	http.HandleFunc("/healthz", hc.livenessHandler)
+	http.HandleFunc("/readyz", hc.readinessHandler)
 	if err := http.ListenAndServe(addr, nil); err != nil {
 		log.WithError(err).Warnln("Error serving prometheus")
 	}
 }
 
-func initGrpcServer(c *cli.Context, err error) (*grpc.Server, net.Listener, error) {
-	logger := log.StandardLogger()
-	logger.SetLevel(log.DebugLevel)
-	logEntry := log.NewEntry(logger)
+// initResultSink returns a sink that writes hole punch results to the
+// database and all additionally configured sinks. Only the database sink can
+// fail a request. All other sinks are written to asynchronously.
+func initResultSink(c *cli.Context, dbClient *db.Client) (sink.ResultSink, error) {
+	sinks := []sink.ResultSink{newPostgresSink(dbClient)}
+
+	var secondary []sink.ResultSink
+	if path := c.String("jsonl-sink-file"); path != "" {
+		jsonl, err := sink.NewJSONL(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "new jsonl sink")
+		}
+		secondary = append(secondary, jsonl)
+	}
+
+	if dir := c.String("parquet-sink-dir"); dir != "" {
+		parquet, err := sink.NewParquet(dir, c.Duration("parquet-sink-rotation"))
+		if err != nil {
+			return nil, errors.Wrap(err, "new parquet sink")
+		}
+		secondary = append(secondary, parquet)
+	}
+
+	if brokers := c.String("kafka-sink-brokers"); brokers != "" {
+		secondary = append(secondary, sink.NewStream("kafka", sink.NewKafkaPublisher(brokers, c.String("kafka-sink-topic"))))
+	}
+
+	if url := c.String("nats-sink-url"); url != "" {
+		publisher, err := sink.NewNATSPublisher(url, c.String("nats-sink-subject"))
+		if err != nil {
+			return nil, errors.Wrap(err, "new nats publisher")
+		}
+		secondary = append(secondary, sink.NewStream("nats", publisher))
+	}
+
+	for _, s := range secondary {
+		log.WithField("sink", s.Name()).Infoln("Writing hole punch results to additional sink")
+		sinks = append(sinks, sink.NewAsync(s, c.Int("sink-queue-size")))
+	}
+
+	return sink.NewFanout(sinks...), nil
+}
+
+// startRetentionJob applies the retention policy in the background if any data expires.
+func startRetentionJob(c *cli.Context, dbClient *db.Client) error {
+	policy := retention.Policy{
+		ConnectionEvents: c.Duration("retention-connection-events"),
+		HolePunchResults: c.Duration("retention-hole-punch-results"),
+	}
+	if !policy.Enabled() {
+		return nil
+	}
+
+	var archiver *retention.Archiver
+	if dir := c.String("archive-dir"); dir != "" {
+		format, err := retention.ParseFormat(c.String("archive-format"))
+		if err != nil {
+			return err
+		}
+
+		if archiver, err = retention.NewArchiver(dir, format); err != nil {
+			return errors.Wrap(err, "new archiver")
+		}
+	} else {
+		log.Warnln("Expired data is deleted without archiving it")
+	}
+
+	go retention.NewJob(dbClient, policy, archiver).Run(c.Context)
+
+	return nil
+}
+
+// initCertReloader loads the TLS certificates of the gRPC server. It returns nil if the server
+// should serve plaintext gRPC, e.g., because TLS is terminated by a reverse proxy.
+func initCertReloader(c *cli.Context) (*certs.Reloader, error) {
+	certFile, keyFile := c.String("tls-cert"), c.String("tls-key")
+	if certFile == "" && keyFile == "" {
+		if c.IsSet("tls-client-ca") || c.Bool("tls-require-client-cert") {
+			return nil, fmt.Errorf("client certificates need a TLS certificate and key")
+		}
+		return nil, nil
+	} else if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("TLS certificate and key must be given together")
+	}
+
+	reloader, err := certs.NewReloader(certFile, keyFile, c.String("tls-client-ca"))
+	if err != nil {
+		return nil, errors.Wrap(err, "new certificate reloader")
+	}
+
+	return reloader, nil
+}
+
+func initGrpcServer(c *cli.Context, server Server, reloader *certs.Reloader) (*grpc.Server, net.Listener, error) {
+	logEntry := log.NewEntry(log.StandardLogger())
 	grpc_logrus.ReplaceGrpcLogger(logEntry)
 	opts := []grpc_logrus.Option{
 		grpc_logrus.WithDurationField(func(duration time.Duration) (key string, value interface{}) {
 			return "grpc.time_s", duration.Seconds()
 		}),
 	}
-	s := grpc.NewServer(
+	serverOpts := []grpc.ServerOption{
 		grpc_middleware.WithUnaryServerChain(
 			grpc_logrus.UnaryServerInterceptor(logEntry, opts...),
 			grpc_prometheus.UnaryServerInterceptor,
-		))
+			server.authUnaryInterceptor,
+			server.limiter.unaryInterceptor,
+		),
+		grpc_middleware.WithStreamServerChain(
+			grpc_logrus.StreamServerInterceptor(logEntry, opts...),
+			grpc_prometheus.StreamServerInterceptor,
+			server.authStreamInterceptor,
+		),
+	}
+
+	if reloader != nil {
+		tlsConfig, err := reloader.ServerConfig(c.Bool("tls-require-client-cert"))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "server tls config")
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	s := grpc.NewServer(serverOpts...)
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	grpc_prometheus.Register(s)
 
@@ -0,0 +1,548 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/stat"
+
+	"github.com/dennis-tra/punchr/pkg/db"
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/pb"
+	"github.com/dennis-tra/punchr/pkg/sink"
+)
+
+// postgresSink is the primary result sink that stores hole punch results in the database.
+type postgresSink struct {
+	dbClient *db.Client
+}
+
+var _ sink.ResultSink = (*postgresSink)(nil)
+
+func newPostgresSink(dbClient *db.Client) *postgresSink {
+	return &postgresSink{dbClient: dbClient}
+}
+
+func (p *postgresSink) Name() string {
+	return "postgres"
+}
+
+// Close is a no-op as the database client is shared with the gRPC server and closed separately.
+func (p *postgresSink) Close() error {
+	return nil
+}
+
+// Write stores the result in a single transaction. The request must have passed
+// validateTrackHolePunchRequest, which checks all fields that are dereferenced here.
+func (p *postgresSink) Write(ctx context.Context, result *sink.Result) error {
+	req := result.Request
+
+	clientID, err := peer.IDFromBytes(req.ClientId)
+	if err != nil {
+		return errors.Wrap(err, "peer ID from client ID")
+	}
+
+	dbLocalPeer, err := models.Peers(models.PeerWhere.MultiHash.EQ(clientID.String())).One(ctx, p.dbClient)
+	if err != nil {
+		return errors.Wrap(err, "get client peer from db")
+	}
+
+	remoteID, err := peer.IDFromBytes(req.RemoteId)
+	if err != nil {
+		return errors.Wrap(err, "peer ID from remote ID")
+	}
+
+	dbRemotePeer, err := models.Peers(models.PeerWhere.MultiHash.EQ(remoteID.String())).One(ctx, p.dbClient)
+	if err != nil {
+		return errors.Wrap(err, "get remote peer from db")
+	}
+
+	// Start a database transaction
+	txn, err := p.dbClient.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin txn")
+	}
+	defer db.DeferRollback(txn)
+
+	dbhpas := make([]*models.HolePunchAttempt, len(req.HolePunchAttempts))
+	for i, hpa := range req.HolePunchAttempts {
+		startRtt := ""
+		if hpa.StartRtt != nil {
+			startRtt = fmt.Sprintf("%fs", *hpa.StartRtt)
+		}
+
+		var startedAt *time.Time
+		if hpa.StartedAt != nil {
+			t := time.Unix(0, int64(*hpa.StartedAt))
+			startedAt = &t
+		}
+
+		dbhpas[i] = &models.HolePunchAttempt{
+			OpenedAt:        time.Unix(0, int64(*hpa.OpenedAt)),
+			StartedAt:       null.TimeFromPtr(startedAt),
+			EndedAt:         time.Unix(0, int64(*hpa.EndedAt)),
+			StartRTT:        null.NewString(startRtt, startRtt != ""),
+			ElapsedTime:     fmt.Sprintf("%fs", *hpa.ElapsedTime),
+			Outcome:         mapHolePunchAttemptOutcome(hpa),
+			Error:           null.NewString(hpa.GetError(), hpa.GetError() != ""),
+			DirectDialError: null.NewString(hpa.GetDirectDialError(), hpa.GetDirectDialError() != ""),
+		}
+	}
+
+	lmaddrs := make([]multiaddr.Multiaddr, len(req.ListenMultiAddresses))
+	for i, maddrBytes := range req.ListenMultiAddresses {
+		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+		if err != nil {
+			return errors.Wrap(err, "multi addr from bytes")
+		}
+		lmaddrs[i] = maddr
+	}
+
+	dbLMaddrs, err := p.dbClient.UpsertMultiAddresses(ctx, txn, lmaddrs)
+	if err != nil {
+		return errors.Wrap(err, "upsert listen multi addresses")
+	}
+
+	maddrSetID, err := p.dbClient.UpsertMultiAddressesSet(ctx, txn, dbLMaddrs)
+	if err != nil {
+		return errors.Wrap(err, "upsert multi addresses set")
+	}
+
+	filters := make([]int64, len(req.Protocols))
+	for i, p := range req.Protocols {
+		filters[i] = int64(p)
+	}
+
+	observations, circuitRelay := relayObservations(req)
+	relayIDs := make([]peer.ID, 0, len(observations)+len(req.RelayDials))
+	for relayID := range observations {
+		relayIDs = append(relayIDs, relayID)
+	}
+
+	for _, dial := range req.RelayDials {
+		relayID, err := peer.IDFromBytes(dial.RelayId)
+		if err != nil {
+			return errors.Wrap(err, "peer ID from relay ID")
+		}
+		relayIDs = append(relayIDs, relayID)
+	}
+
+	relayPeerIDs, err := p.dbClient.UpsertRelayPeers(ctx, txn, relayIDs)
+	if err != nil {
+		return errors.Wrap(err, "upsert relay peers")
+	}
+
+	var relayMaddrID null.Int64
+	if req.RelayMultiAddress != nil {
+		relayMaddr, err := multiaddr.NewMultiaddrBytes(req.RelayMultiAddress)
+		if err != nil {
+			return errors.Wrap(err, "relay multi addr from bytes")
+		}
+
+		dbRelayMaddr, err := p.dbClient.UpsertMultiAddress(ctx, txn, relayMaddr)
+		if err != nil {
+			return errors.Wrap(err, "upsert relay multi address")
+		}
+		relayMaddrID = null.Int64From(dbRelayMaddr.ID)
+	}
+
+	// Clients that don't report the timing parameters leave the columns NULL
+	dcutrParams := req.GetDcutrParameters()
+	if dcutrParams == nil {
+		dcutrParams = &pb.DCUtRParameters{}
+	}
+
+	hpr := &models.HolePunchResult{
+		LocalID:                   dbLocalPeer.ID,
+		ListenMultiAddressesSetID: maddrSetID,
+		RemoteID:                  dbRemotePeer.ID,
+		ConnectStartedAt:          time.Unix(0, int64(*req.ConnectStartedAt)),
+		ConnectEndedAt:            time.Unix(0, int64(*req.ConnectEndedAt)),
+		HasDirectConns:            req.GetHasDirectConns(),
+		ProtocolFilters:           filters,
+		Outcome:                   mapHolePunchOutcome(req),
+		Error:                     null.StringFromPtr(req.Error),
+		EndedAt:                   time.Unix(0, int64(*req.EndedAt)),
+		AuthorizationID:           null.IntFrom(result.AuthorizationID),
+		AllocationID:              null.Int64FromPtr(result.AllocationID),
+		RelayID:                   null.NewInt64(relayPeerIDs[circuitRelay], circuitRelay != ""),
+		RelayStrategy:             mapRelayStrategy(req.GetRelayStrategy()),
+		RelayMultiAddressID:       relayMaddrID,
+		CommunicationTimeout:      toInterval(dcutrParams.CommunicationTimeout),
+		RetryCount:                toNullInt(dcutrParams.RetryCount),
+		PingDuration:              toInterval(dcutrParams.PingDuration),
+		MaxPingCount:              toNullInt(dcutrParams.MaxPingCount),
+		ValidationStatus:          result.ValidationStatus,
+		ValidationReasons:         result.ValidationReasons,
+	}
+
+	if err = hpr.Insert(ctx, txn, boil.Infer()); err != nil {
+		return errors.Wrap(err, "insert hole punch result")
+	}
+
+	for _, mapping := range req.NatMappings {
+		dbPortMapping := models.PortMapping{
+			HolePunchResultID: hpr.ID,
+			InternalPort:      int(mapping.GetInternalPort()),
+			ExternalPort:      int(mapping.GetExternalPort()),
+			Protocol:          mapping.GetProtocol(),
+			Addr:              mapping.GetAddr(),
+			AddrNetwork:       mapping.GetAddrNetwork(),
+		}
+
+		if err := dbPortMapping.Insert(ctx, txn, boil.Infer()); err != nil {
+			return errors.Wrap(err, "insert port mapping")
+		}
+	}
+
+	if err = hpr.AddHolePunchAttempts(ctx, txn, true, dbhpas...); err != nil {
+		return errors.Wrap(err, "add attempts to hole punch result")
+	}
+
+	for i, hpa := range req.HolePunchAttempts {
+		hpamaddrs := make([]multiaddr.Multiaddr, len(hpa.MultiAddresses))
+		for j, maddrBytes := range hpa.MultiAddresses {
+			maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+			if err != nil {
+				return errors.Wrap(err, "hole punch attempt multi addr from bytes")
+			}
+			hpamaddrs[j] = maddr
+		}
+
+		dbHPAMaddrs, err := p.dbClient.UpsertMultiAddresses(ctx, txn, hpamaddrs)
+		if err != nil {
+			return errors.Wrap(err, "hole punch attempt multi addresses")
+		}
+
+		if err = dbhpas[i].SetMultiAddresses(ctx, txn, false, dbHPAMaddrs...); err != nil {
+			return errors.Wrap(err, "upsert hole punch attempt multi addresses")
+		}
+	}
+
+	omaddrs := make([]multiaddr.Multiaddr, len(req.OpenMultiAddresses))
+	for i, maddrBytes := range req.OpenMultiAddresses {
+		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+		if err != nil {
+			return errors.Wrap(err, "multi addr from bytes")
+		}
+		omaddrs[i] = maddr
+	}
+
+	dbOMaddrs, err := p.dbClient.UpsertMultiAddresses(ctx, txn, omaddrs)
+	if err != nil {
+		return errors.Wrap(err, "upsert open multi addresses")
+	}
+
+	for _, dbOMaddr := range dbOMaddrs {
+		hprxma := models.HolePunchResultsXMultiAddress{
+			HolePunchResultID: hpr.ID,
+			MultiAddressID:    dbOMaddr.ID,
+			Relationship:      models.HolePunchMultiAddressRelationshipFINAL,
+		}
+		if err = hprxma.Insert(ctx, txn, boil.Infer()); err != nil {
+			return errors.Wrap(err, "insert open HolePunchResultsXMultiAddress")
+		}
+	}
+
+	rmaddrs := make([]multiaddr.Multiaddr, len(req.RemoteMultiAddresses))
+	for i, maddrBytes := range req.RemoteMultiAddresses {
+		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+		if err != nil {
+			return errors.Wrap(err, "multi addr from bytes")
+		}
+		rmaddrs[i] = maddr
+	}
+
+	dbRMaddrs, err := p.dbClient.UpsertMultiAddresses(ctx, txn, rmaddrs)
+	if err != nil {
+		return errors.Wrap(err, "upsert remote multi addresses")
+	}
+
+	for _, dbRMaddr := range dbRMaddrs {
+		hprxma := models.HolePunchResultsXMultiAddress{
+			HolePunchResultID: hpr.ID,
+			MultiAddressID:    dbRMaddr.ID,
+			Relationship:      models.HolePunchMultiAddressRelationshipINITIAL,
+		}
+		if err = hprxma.Insert(ctx, txn, boil.Infer()); err != nil {
+			return errors.Wrap(err, "insert remote HolePunchResultsXMultiAddress")
+		}
+	}
+
+	if req.NetworkInformation != nil {
+		dbNetInfo := models.NetworkInformation{
+			PeerID:            dbLocalPeer.ID,
+			SupportsIpv6:      null.BoolFromPtr(req.NetworkInformation.SupportsIpv6),
+			SupportsIpv6Error: null.StringFromPtr(req.NetworkInformation.SupportsIpv6Error),
+			RouterHTML:        null.StringFromPtr(req.NetworkInformation.RouterLoginHtml),
+			RouterHTMLError:   null.StringFromPtr(req.NetworkInformation.RouterLoginHtmlError),
+		}
+
+		if err = dbNetInfo.SetPeer(ctx, txn, false, dbLocalPeer); err != nil {
+			return errors.Wrap(err, "set client peer of router information")
+		}
+
+		if err = dbNetInfo.Insert(ctx, txn, boil.Infer()); err != nil {
+			return errors.Wrap(err, "insert router information")
+		}
+	}
+
+	for _, lm := range req.LatencyMeasurements {
+
+		maddr, err := multiaddr.NewMultiaddrBytes(lm.MultiAddress)
+		if err != nil {
+			return errors.Wrap(err, "multi addr from bytes")
+		}
+
+		dbMaddr, err := p.dbClient.UpsertMultiAddress(ctx, txn, maddr)
+		if err != nil {
+			return errors.Wrap(err, "upsert latency measurement multi address")
+		}
+
+		lmRemoteID, err := peer.IDFromBytes(lm.RemoteId)
+		if err != nil {
+			return errors.Wrap(err, "peer ID from remote ID")
+		}
+		dbLmRemotePeer, err := p.dbClient.UpsertPeer(ctx, txn, lmRemoteID, lm.AgentVersion, lm.Protocols)
+		if err != nil {
+			return errors.Wrap(err, "could not upsert latency measurement peer")
+		}
+
+		dbMtype, err := mapMeasurementType(lm.Mtype)
+		if err != nil {
+			return errors.Wrap(err, "map measurement type")
+		}
+
+		dbRtts := make(types.Float64Array, len(lm.Rtts))
+		for i, rtt := range lm.Rtts {
+			dbRtts[i] = float64(rtt)
+		}
+
+		// Max/Min panic for zero length arrays
+		rttMax := float64(-1)
+		rttMin := float64(-1)
+		if len(dbRtts) > 0 {
+			rttMax = floats.Max(dbRtts)
+			rttMin = floats.Min(dbRtts)
+		}
+
+		dblm := models.LatencyMeasurement{
+			RemoteID:          dbLmRemotePeer.ID,
+			HolePunchResultID: hpr.ID,
+			MultiAddressID:    dbMaddr.ID,
+			Mtype:             dbMtype,
+			RTTS:              dbRtts,
+			RTTErrs:           lm.RttErrs,
+			RTTAvg:            stat.Mean(dbRtts, nil),
+			RTTMax:            rttMax,
+			RTTMin:            rttMin,
+			RTTSTD:            stat.StdDev(dbRtts, nil),
+		}
+
+		if err := dblm.Insert(ctx, txn, boil.Infer()); err != nil {
+			return errors.Wrap(err, "insert latency measurement")
+		}
+	}
+
+	for _, evt := range req.HolePunchEvents {
+		maddrStrs := make(types.StringArray, len(evt.MultiAddresses))
+		for j, maddrBytes := range evt.MultiAddresses {
+			maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+			if err != nil {
+				return errors.Wrap(err, "hole punch event multi addr from bytes")
+			}
+			maddrStrs[j] = maddr.String()
+		}
+
+		var attempt *int
+		if evt.Attempt != nil {
+			a := int(*evt.Attempt)
+			attempt = &a
+		}
+
+		dbEvt := models.HolePunchEvent{
+			HolePunchResultID: hpr.ID,
+			Type:              mapHolePunchEventType(evt.GetType()),
+			OccurredAt:        time.Unix(0, int64(*evt.Timestamp)),
+			MultiAddresses:    maddrStrs,
+			Attempt:           null.IntFromPtr(attempt),
+			Success:           null.BoolFromPtr(evt.Success),
+			ElapsedTime:       toInterval(evt.ElapsedTime),
+			RTT:               toInterval(evt.Rtt),
+			Error:             null.StringFromPtr(evt.Error),
+			Direction:         null.StringFromPtr(evt.Direction),
+		}
+
+		if err := dbEvt.Insert(ctx, txn, boil.Infer()); err != nil {
+			return errors.Wrap(err, "insert hole punch event")
+		}
+	}
+
+	for _, dial := range req.RelayDials {
+		relayID, err := peer.IDFromBytes(dial.RelayId)
+		if err != nil {
+			return errors.Wrap(err, "peer ID from relay ID")
+		}
+
+		maddrStrs := make(types.StringArray, len(dial.MultiAddresses))
+		for j, maddrBytes := range dial.MultiAddresses {
+			maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+			if err != nil {
+				return errors.Wrap(err, "relay dial multi addr from bytes")
+			}
+			maddrStrs[j] = maddr.String()
+		}
+
+		startedAt := time.Unix(0, int64(*dial.StartedAt))
+		endedAt := time.Unix(0, int64(*dial.EndedAt))
+
+		dbDial := models.RelayDial{
+			HolePunchResultID: hpr.ID,
+			RelayID:           relayPeerIDs[relayID],
+			MultiAddresses:    maddrStrs,
+			StartedAt:         startedAt,
+			EndedAt:           endedAt,
+			ElapsedTime:       fmt.Sprintf("%fs", endedAt.Sub(startedAt).Seconds()),
+			RTT:               toInterval(dial.Rtt),
+			Error:             null.StringFromPtr(dial.Error),
+			Selected:          dial.GetSelected(),
+		}
+
+		if err := dbDial.Insert(ctx, txn, boil.Infer()); err != nil {
+			return errors.Wrap(err, "insert relay dial")
+		}
+	}
+
+	// Implausible results would distort the statistics of the relays
+	if result.ValidationStatus != models.ValidationStatusREJECTED {
+		dbObservations := make([]*db.RelayObservation, 0, len(observations))
+		for relayID, o := range observations {
+			o.PeerID = relayPeerIDs[relayID]
+			dbObservations = append(dbObservations, o)
+		}
+
+		if err = p.dbClient.UpdateRelays(ctx, txn, dbObservations); err != nil {
+			return errors.Wrap(err, "update relays")
+		}
+	}
+
+	return txn.Commit()
+}
+
+// toInterval converts the given duration in seconds into a postgres interval
+func toInterval(seconds *float32) null.String {
+	if seconds == nil {
+		return null.NewString("", false)
+	}
+	return null.StringFrom(fmt.Sprintf("%fs", *seconds))
+}
+
+// toNullInt converts the given optional integer into a nullable database integer
+func toNullInt(i *int32) null.Int {
+	if i == nil {
+		return null.NewInt(0, false)
+	}
+	return null.IntFrom(int(*i))
+}
+
+func mapHolePunchEventType(evtType pb.HolePunchEventType) string {
+	switch evtType {
+	case pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_DIRECT_DIAL:
+		return models.HolePunchEventTypeDIRECT_DIAL
+	case pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_PROTOCOL_ERROR:
+		return models.HolePunchEventTypePROTOCOL_ERROR
+	case pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_START_HOLE_PUNCH:
+		return models.HolePunchEventTypeSTART_HOLE_PUNCH
+	case pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_END_HOLE_PUNCH:
+		return models.HolePunchEventTypeEND_HOLE_PUNCH
+	case pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_HOLE_PUNCH_ATTEMPT:
+		return models.HolePunchEventTypeHOLE_PUNCH_ATTEMPT
+	case pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_CONNECTION_OPENED:
+		return models.HolePunchEventTypeCONNECTION_OPENED
+	case pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_CONNECTION_CLOSED:
+		return models.HolePunchEventTypeCONNECTION_CLOSED
+	default:
+		return models.HolePunchEventTypeUNKNOWN
+	}
+}
+
+func mapMeasurementType(mtype *pb.LatencyMeasurementType) (string, error) {
+	if mtype == nil {
+		return "", fmt.Errorf("latency measurement type is nil")
+	}
+
+	switch *mtype {
+	case pb.LatencyMeasurementType_TO_RELAY:
+		return models.LatencyMeasurementTypeTO_RELAY, nil
+	case pb.LatencyMeasurementType_TO_REMOTE_THROUGH_RELAY:
+		return models.LatencyMeasurementTypeTO_REMOTE_THROUGH_RELAY, nil
+	case pb.LatencyMeasurementType_TO_REMOTE_AFTER_HOLE_PUNCH:
+		return models.LatencyMeasurementTypeTO_REMOTE_AFTER_HOLEPUNCH, nil
+	default:
+		return "", fmt.Errorf("unsupportet latency measurement type: %s", mtype)
+	}
+}
+
+func mapHolePunchAttemptOutcome(hpa *pb.HolePunchAttempt) string {
+	switch *hpa.Outcome {
+	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_DIRECT_DIAL:
+		return models.HolePunchAttemptOutcomeDIRECT_DIAL
+	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_PROTOCOL_ERROR:
+		return models.HolePunchAttemptOutcomePROTOCOL_ERROR
+	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_CANCELLED:
+		return models.HolePunchAttemptOutcomeCANCELLED
+	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_TIMEOUT:
+		return models.HolePunchAttemptOutcomeTIMEOUT
+	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_FAILED:
+		return models.HolePunchAttemptOutcomeFAILED
+	case pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_SUCCESS:
+		return models.HolePunchAttemptOutcomeSUCCESS
+	default:
+		return models.HolePunchAttemptOutcomeUNKNOWN
+	}
+}
+
+// mapRelayStrategy returns NULL for clients that don't report how they chose the relay.
+func mapRelayStrategy(strategy pb.RelayStrategy) null.String {
+	switch strategy {
+	case pb.RelayStrategy_RELAY_STRATEGY_ALL:
+		return null.StringFrom(models.RelayStrategyALL)
+	case pb.RelayStrategy_RELAY_STRATEGY_FIRST:
+		return null.StringFrom(models.RelayStrategyFIRST)
+	case pb.RelayStrategy_RELAY_STRATEGY_LOWEST_RTT:
+		return null.StringFrom(models.RelayStrategyLOWEST_RTT)
+	case pb.RelayStrategy_RELAY_STRATEGY_RANDOM:
+		return null.StringFrom(models.RelayStrategyRANDOM)
+	case pb.RelayStrategy_RELAY_STRATEGY_SERVER:
+		return null.StringFrom(models.RelayStrategySERVER)
+	default:
+		return null.NewString("", false)
+	}
+}
+
+func mapHolePunchOutcome(req *pb.TrackHolePunchRequest) string {
+	switch *req.Outcome {
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_CONNECTION:
+		return models.HolePunchOutcomeNO_CONNECTION
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM:
+		return models.HolePunchOutcomeNO_STREAM
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_CANCELLED:
+		return models.HolePunchOutcomeCANCELLED
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_CONNECTION_REVERSED:
+		return models.HolePunchOutcomeCONNECTION_REVERSED
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED:
+		return models.HolePunchOutcomeFAILED
+	case pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS:
+		return models.HolePunchOutcomeSUCCESS
+	default:
+		return models.HolePunchOutcomeUNKNOWN
+	}
+}
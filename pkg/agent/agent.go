@@ -0,0 +1,143 @@
+// Package agent classifies the free-form agent versions that peers announce
+// via the identify protocol into the libp2p implementation and its version.
+package agent
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	ImplKubo       = "kubo"
+	ImplGoLibp2p   = "go-libp2p"
+	ImplRustLibp2p = "rust-libp2p"
+	ImplJSLibp2p   = "js-libp2p"
+	ImplNimLibp2p  = "nim-libp2p"
+	ImplPunchr     = "punchr"
+	ImplUnknown    = "unknown"
+)
+
+// Info holds the classification of an agent version.
+type Info struct {
+	// Implementation is one of the Impl* constants.
+	Implementation string
+
+	// Version is the normalized semantic version (MAJOR.MINOR.PATCH[-PRERELEASE])
+	// of the implementation. It's empty if the version could not be derived.
+	Version string
+
+	// GoLibp2pVersion is the version of go-libp2p the implementation was built
+	// with. It's empty if it can't be inferred from the agent version.
+	GoLibp2pVersion string
+}
+
+var semverRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?(-[0-9A-Za-z.-]+)?`)
+
+// kuboGoLibp2pVersions maps kubo (and go-ipfs) minor releases to the go-libp2p
+// version of the corresponding .0 release. Patch releases may ship a newer go-libp2p patch version.
+var kuboGoLibp2pVersions = map[string]string{
+	"0.11": "0.16.0",
+	"0.12": "0.16.0",
+	"0.13": "0.19.4",
+	"0.14": "0.20.3",
+	"0.15": "0.21.0",
+	"0.16": "0.23.2",
+	"0.17": "0.23.4",
+	"0.18": "0.24.2",
+	"0.19": "0.26.3",
+	"0.20": "0.27.3",
+	"0.21": "0.27.7",
+	"0.22": "0.29.2",
+	"0.23": "0.31.0",
+	"0.24": "0.32.1",
+	"0.25": "0.32.2",
+	"0.26": "0.32.2",
+	"0.27": "0.33.0",
+	"0.28": "0.33.2",
+	"0.29": "0.34.1",
+	"0.30": "0.36.3",
+}
+
+// Classify parses the given agent version. It never returns nil.
+// Agent versions that can't be attributed to a known implementation
+// are classified as ImplUnknown.
+func Classify(agentVersion string) *Info {
+	av := strings.TrimSpace(agentVersion)
+
+	// The implementation is identified by the first path segment,
+	// e.g., kubo/0.17.0/4485d6b or js-libp2p/0.40.0 UserAgent=v18.12.1
+	name, rest, _ := strings.Cut(av, "/")
+
+	info := &Info{Implementation: ImplUnknown}
+	switch strings.ToLower(name) {
+	case "kubo", "go-ipfs":
+		info.Implementation = ImplKubo
+		info.Version = ParseVersion(rest)
+		info.GoLibp2pVersion = kuboGoLibp2pVersion(info.Version)
+	case "rust-libp2p":
+		info.Implementation = ImplRustLibp2p
+		info.Version = ParseVersion(rest)
+	case "js-libp2p":
+		info.Implementation = ImplJSLibp2p
+		info.Version = ParseVersion(rest)
+	case "nim-libp2p":
+		info.Implementation = ImplNimLibp2p
+		info.Version = ParseVersion(rest)
+	case "go-libp2p":
+		info.Implementation = ImplGoLibp2p
+		info.Version = ParseVersion(rest)
+		info.GoLibp2pVersion = info.Version
+	case "punchr":
+		// punchr/go-client/0.6.0, punchr/rust-client/0.1.0 or punchr/honeypot/0.6.0
+		info.Implementation = ImplPunchr
+		if _, version, found := strings.Cut(rest, "/"); found {
+			info.Version = ParseVersion(version)
+		}
+	default:
+		// go-libp2p uses its own module path or the main module path
+		// and version of the binary if no user agent was configured,
+		// e.g., github.com/libp2p/go-libp2p or github.com/dennis-tra/nebula-crawler@v1.0.0
+		if isGoModulePath(av) {
+			info.Implementation = ImplGoLibp2p
+		}
+	}
+
+	return info
+}
+
+// ParseVersion extracts the semantic version at the beginning of the given string.
+// Missing patch versions are set to zero. Returns an empty string if no version was found.
+func ParseVersion(s string) string {
+	matches := semverRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return ""
+	}
+
+	patch := matches[3]
+	if patch == "" {
+		patch = "0"
+	}
+
+	return fmt.Sprintf("%s.%s.%s%s", matches[1], matches[2], patch, matches[4])
+}
+
+func kuboGoLibp2pVersion(version string) string {
+	matches := semverRegex.FindStringSubmatch(version)
+	if matches == nil {
+		return ""
+	}
+	return kuboGoLibp2pVersions[matches[1]+"."+matches[2]]
+}
+
+// isGoModulePath checks whether the agent version looks like the default
+// go-libp2p user agent of the form <module path>[@<version>].
+func isGoModulePath(av string) bool {
+	path, _, _ := strings.Cut(av, "@")
+	if strings.ContainsAny(path, " \t") {
+		return false
+	}
+
+	host, _, found := strings.Cut(path, "/")
+	return found && strings.Contains(host, ".")
+}
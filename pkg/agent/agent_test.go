@@ -0,0 +1,46 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		agentVersion string
+		want         Info
+	}{
+		{"kubo/0.17.0/4485d6b", Info{ImplKubo, "0.17.0", "0.23.4"}},
+		{"kubo/0.18.0-rc1/", Info{ImplKubo, "0.18.0-rc1", "0.24.2"}},
+		{"go-ipfs/0.11.0/67220ed", Info{ImplKubo, "0.11.0", "0.16.0"}},
+		{"kubo/0.99.0/", Info{ImplKubo, "0.99.0", ""}},
+		{"rust-libp2p/0.40.0", Info{ImplRustLibp2p, "0.40.0", ""}},
+		{"js-libp2p/0.40.0 UserAgent=v18.12.1", Info{ImplJSLibp2p, "0.40.0", ""}},
+		{"nim-libp2p/0.0.1", Info{ImplNimLibp2p, "0.0.1", ""}},
+		{"punchr/go-client/0.6.0", Info{ImplPunchr, "0.6.0", ""}},
+		{"punchr/rust-client/0.1", Info{ImplPunchr, "0.1.0", ""}},
+		{"github.com/libp2p/go-libp2p", Info{ImplGoLibp2p, "", ""}},
+		{"github.com/dennis-tra/nebula-crawler@v1.0.0", Info{ImplGoLibp2p, "", ""}},
+		{"storm", Info{ImplUnknown, "", ""}},
+		{"", Info{ImplUnknown, "", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.agentVersion, func(t *testing.T) {
+			assert.Equal(t, &tt.want, Classify(tt.agentVersion))
+		})
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := map[string]string{
+		"0.17.0":       "0.17.0",
+		"v1.2.3":       "1.2.3",
+		"0.1":          "0.1.0",
+		"0.18.0-rc1/x": "0.18.0-rc1",
+		"latest":       "",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, ParseVersion(in), in)
+	}
+}
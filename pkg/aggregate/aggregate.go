@@ -0,0 +1,202 @@
+// Package aggregate maintains rollup tables of the hole punch results, so that dashboards
+// and notebooks don't need to scan the raw results to compute success rates. The rollups
+// are refreshed incrementally: every run recomputes the hours since the previous run from
+// the raw results. Hours whose raw results were deleted by the retention job are kept.
+package aggregate
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+var refreshedUntilGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "aggregate_refreshed_until_timestamp_seconds",
+	Help: "The time up to which the hole punch results are aggregated",
+}, []string{"table"})
+
+// ErrLocked is returned if another process is refreshing the aggregates.
+var ErrLocked = errors.New("aggregates are refreshed by another process")
+
+const (
+	// tableHourly is the name of the hourly rollup of the hole punch results.
+	tableHourly = "hole_punch_results_hourly"
+
+	// lateArrivalWindow is the time before the previous refresh from which on the next
+	// refresh recomputes the aggregates. Results are inserted with the time of the server
+	// that received them, but their transactions may commit after a refresh has started.
+	lateArrivalWindow = time.Hour
+
+	// chunkSize is the time range that is recomputed in a single statement.
+	chunkSize = 24 * time.Hour
+
+	// lockID is the key of the advisory lock that prevents multiple
+	// processes from refreshing the aggregates at the same time.
+	lockID int64 = 7_406_192_837
+)
+
+// Job refreshes the aggregates of the hole punch results.
+type Job struct {
+	dbh *sql.DB
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// NewJob initializes a job that refreshes the aggregates in the given database.
+func NewJob(dbh *sql.DB) *Job {
+	return &Job{
+		dbh: dbh,
+		now: time.Now,
+	}
+}
+
+// Run refreshes the aggregates now and then after every interval until the context is cancelled.
+func (j *Job) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.RunOnce(ctx); errors.Is(err, ErrLocked) {
+			log.Debugln("Aggregates are refreshed by another process")
+		} else if err != nil && ctx.Err() == nil {
+			log.WithError(err).Warnln("Could not refresh aggregates")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce recomputes the aggregates of all hours since the previous refresh. If the
+// aggregates were never refreshed, all hole punch results in the database are aggregated.
+func (j *Job) RunOnce(ctx context.Context) error {
+	return j.refresh(ctx, false)
+}
+
+// Rebuild recomputes the aggregates of all hole punch results in the database, e.g., after
+// the aggregation changed. Aggregates of hours that are older than the oldest result are kept.
+func (j *Job) Rebuild(ctx context.Context) error {
+	return j.refresh(ctx, true)
+}
+
+func (j *Job) refresh(ctx context.Context, rebuild bool) error {
+	conn, err := j.dbh.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get connection")
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&locked); err != nil {
+		return errors.Wrap(err, "acquire aggregate lock")
+	} else if !locked {
+		return ErrLocked
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockID); err != nil {
+			log.WithError(err).Warnln("Could not release aggregate lock")
+		}
+	}()
+
+	// Catching up on many hours may take longer than the statement timeout of the pool.
+	// The connection goes back to the pool afterwards, so the timeout is restored.
+	if _, err = conn.ExecContext(ctx, "SET statement_timeout = 0"); err != nil {
+		return errors.Wrap(err, "disable statement timeout")
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "RESET statement_timeout"); err != nil {
+			log.WithError(err).Warnln("Could not reset statement timeout")
+		}
+	}()
+
+	now := j.now()
+
+	from, err := refreshStart(ctx, conn, now, rebuild)
+	if err != nil {
+		return err
+	}
+
+	rows := 0
+	for _, c := range chunks(from, hourStart(now).Add(time.Hour), chunkSize) {
+		var inserted int
+		if err = conn.QueryRowContext(ctx, "SELECT refresh_hole_punch_results_hourly($1, $2)", c.start, c.end).Scan(&inserted); err != nil {
+			return errors.Wrapf(err, "refresh %s from %s", tableHourly, c.start.Format(time.RFC3339))
+		}
+		rows += inserted
+	}
+
+	query := `
+INSERT INTO aggregate_refreshes (name, refreshed_until, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (name) DO UPDATE SET refreshed_until = EXCLUDED.refreshed_until,
+                                 updated_at      = EXCLUDED.updated_at`
+	if _, err = conn.ExecContext(ctx, query, tableHourly, now); err != nil {
+		return errors.Wrap(err, "save refresh time")
+	}
+	refreshedUntilGauge.WithLabelValues(tableHourly).Set(float64(now.Unix()))
+
+	log.WithFields(log.Fields{
+		"table": tableHourly,
+		"from":  from.UTC().Format(time.RFC3339),
+		"rows":  rows,
+	}).Debugln("Refreshed aggregates")
+
+	return nil
+}
+
+// refreshStart returns the time from which on the aggregates need to be recomputed.
+func refreshStart(ctx context.Context, conn *sql.Conn, now time.Time, rebuild bool) (time.Time, error) {
+	if !rebuild {
+		var refreshedUntil time.Time
+		err := conn.QueryRowContext(ctx, "SELECT refreshed_until FROM aggregate_refreshes WHERE name = $1", tableHourly).Scan(&refreshedUntil)
+		if err == nil {
+			return refreshedUntil.Add(-lateArrivalWindow), nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, errors.Wrap(err, "query refresh time")
+		}
+	}
+
+	var oldest sql.NullTime
+	if err := conn.QueryRowContext(ctx, "SELECT min(created_at) FROM hole_punch_results").Scan(&oldest); err != nil {
+		return time.Time{}, errors.Wrap(err, "query oldest hole punch result")
+	} else if !oldest.Valid {
+		// There is nothing to aggregate yet, but the current hour
+		// needs to be recomputed in case a result arrives.
+		return now, nil
+	}
+
+	return oldest.Time, nil
+}
+
+// hourStart returns the start of the hour of t in UTC.
+func hourStart(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Hour)
+}
+
+// chunk is a time range whose aggregates are recomputed in a single statement.
+type chunk struct {
+	start time.Time
+	end   time.Time
+}
+
+// chunks splits the hours between the hour of from and end into ranges of at most the given size.
+func chunks(from time.Time, end time.Time, size time.Duration) []chunk {
+	var cs []chunk
+	for start := hourStart(from); start.Before(end); start = start.Add(size) {
+		c := chunk{start: start, end: start.Add(size)}
+		if c.end.After(end) {
+			c.end = end
+		}
+		cs = append(cs, c)
+	}
+	return cs
+}
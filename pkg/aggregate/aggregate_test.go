@@ -0,0 +1,65 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func hour(day int, h int) time.Time {
+	return time.Date(2022, 10, day, h, 0, 0, 0, time.UTC)
+}
+
+func TestHourStart(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{name: "start of hour", t: hour(15, 12), want: hour(15, 12)},
+		{name: "within hour", t: time.Date(2022, 10, 15, 12, 59, 59, 999, time.UTC), want: hour(15, 12)},
+		{name: "half hour time zone", t: time.Date(2022, 10, 15, 18, 10, 0, 0, time.FixedZone("IST", 330*60)), want: hour(15, 12)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hourStart(tt.t))
+		})
+	}
+}
+
+func TestChunks(t *testing.T) {
+	tests := []struct {
+		name string
+		from time.Time
+		end  time.Time
+		want []chunk
+	}{
+		{
+			name: "empty",
+			from: hour(15, 12),
+			end:  hour(15, 12),
+			want: nil,
+		},
+		{
+			name: "single hour",
+			from: hour(15, 12).Add(30 * time.Minute),
+			end:  hour(15, 13),
+			want: []chunk{{start: hour(15, 12), end: hour(15, 13)}},
+		},
+		{
+			name: "multiple days",
+			from: hour(14, 6),
+			end:  hour(16, 0),
+			want: []chunk{
+				{start: hour(14, 6), end: hour(15, 6)},
+				{start: hour(15, 6), end: hour(16, 0)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, chunks(tt.from, tt.end, 24*time.Hour))
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Package anonymize replaces peer IDs and IP addresses of contributors and remote peers with
+// pseudonyms, so that measurement data can be published. Peer IDs are replaced with keyed
+// hashes. IP addresses are either replaced with keyed hashes, anonymized prefix-preservingly
+// with Crypto-PAn, or truncated to their network prefix. All pseudonyms are derived from a
+// single key: the same key yields the same pseudonyms, so datasets can be joined, and without
+// the key, pseudonyms can't be traced back to their values by hashing candidates.
+package anonymize
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// pseudonymLen is the number of bytes of a keyed hash that are used as a pseudonym.
+const pseudonymLen = 16
+
+// IPMode is the way IP addresses are anonymized.
+type IPMode string
+
+const (
+	// IPModeHash replaces IP addresses with keyed hashes. Nothing about the address is retained.
+	IPModeHash IPMode = "hash"
+
+	// IPModePrefixPreserving anonymizes IP addresses with Crypto-PAn. Addresses that share a
+	// prefix are mapped to addresses that share a prefix of the same length, so analyses of
+	// subnets still work, but the anonymized addresses aren't in the real networks.
+	IPModePrefixPreserving IPMode = "prefix-preserving"
+
+	// IPModeTruncate zeroes all bits after the configured prefix lengths, e.g., 1.2.3.4 becomes 1.2.3.0
+	// with the default IPv4 prefix length of 24. The address stays in the real network.
+	IPModeTruncate IPMode = "truncate"
+)
+
+// ParseIPMode returns the IP mode with the given name.
+func ParseIPMode(name string) (IPMode, error) {
+	switch IPMode(name) {
+	case IPModeHash, IPModePrefixPreserving, IPModeTruncate:
+		return IPMode(name), nil
+	default:
+		return "", fmt.Errorf("unknown ip anonymization mode %s (hash, prefix-preserving, truncate)", name)
+	}
+}
+
+const (
+	// DefaultIPv4PrefixLen is the number of bits of IPv4 addresses that are kept when truncating.
+	DefaultIPv4PrefixLen = 24
+
+	// DefaultIPv6PrefixLen is the number of bits of IPv6 addresses that are kept when truncating.
+	DefaultIPv6PrefixLen = 48
+)
+
+// Config configures an Anonymizer.
+type Config struct {
+	// Key is the secret from which all pseudonyms are derived. If it's
+	// empty, a random key is generated, so the pseudonyms are unlinkable
+	// to the ones of any other anonymizer.
+	Key []byte
+
+	// IPMode is the way IP addresses are anonymized.
+	IPMode IPMode
+
+	// IPv4PrefixLen and IPv6PrefixLen are the number of bits that are kept
+	// when truncating addresses. Zero means the default prefix length.
+	IPv4PrefixLen int
+	IPv6PrefixLen int
+}
+
+// Anonymizer replaces peer IDs and IP addresses with pseudonyms. A nil Anonymizer leaves
+// all values untouched, so callers don't need to distinguish anonymized and raw output.
+type Anonymizer struct {
+	peerIDKey []byte
+	ipKey     []byte
+	pan       *cryptoPAn
+
+	ipMode      IPMode
+	ipv4Mask    net.IPMask
+	ipv6Mask    net.IPMask
+	description string
+}
+
+// New initializes an anonymizer.
+func New(conf Config) (*Anonymizer, error) {
+	key := conf.Key
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, errors.Wrap(err, "generate key")
+		}
+	}
+
+	if conf.IPMode == "" {
+		conf.IPMode = IPModeHash
+	} else if _, err := ParseIPMode(string(conf.IPMode)); err != nil {
+		return nil, err
+	}
+
+	if conf.IPv4PrefixLen == 0 {
+		conf.IPv4PrefixLen = DefaultIPv4PrefixLen
+	}
+	if conf.IPv6PrefixLen == 0 {
+		conf.IPv6PrefixLen = DefaultIPv6PrefixLen
+	}
+	if conf.IPv4PrefixLen < 0 || conf.IPv4PrefixLen > 32 {
+		return nil, fmt.Errorf("ipv4 prefix length must be between 0 and 32, got %d", conf.IPv4PrefixLen)
+	}
+	if conf.IPv6PrefixLen < 0 || conf.IPv6PrefixLen > 128 {
+		return nil, fmt.Errorf("ipv6 prefix length must be between 0 and 128, got %d", conf.IPv6PrefixLen)
+	}
+
+	// Derive independent keys, so that, e.g., a leaked Crypto-PAn
+	// key doesn't allow to reverse the peer ID pseudonyms.
+	pan, err := newCryptoPAn(deriveKey(key, "crypto-pan"))
+	if err != nil {
+		return nil, errors.Wrap(err, "new crypto-pan")
+	}
+
+	a := &Anonymizer{
+		peerIDKey: deriveKey(key, "peer-id"),
+		ipKey:     deriveKey(key, "ip"),
+		pan:       pan,
+		ipMode:    conf.IPMode,
+		ipv4Mask:  net.CIDRMask(conf.IPv4PrefixLen, 32),
+		ipv6Mask:  net.CIDRMask(conf.IPv6PrefixLen, 128),
+	}
+
+	a.description = string(conf.IPMode)
+	if conf.IPMode == IPModeTruncate {
+		a.description = fmt.Sprintf("%s (/%d, /%d)", conf.IPMode, conf.IPv4PrefixLen, conf.IPv6PrefixLen)
+	}
+
+	return a, nil
+}
+
+// deriveKey derives a 32 byte key for the given purpose from the key.
+func deriveKey(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// IPMode returns a description of the way IP addresses are anonymized, e.g., "truncate (/24, /48)".
+// It's empty for a nil Anonymizer.
+func (a *Anonymizer) IPMode() string {
+	if a == nil {
+		return ""
+	}
+	return a.description
+}
+
+// PeerID returns the pseudonym of the given peer ID.
+func (a *Anonymizer) PeerID(id string) string {
+	if a == nil {
+		return id
+	}
+	return pseudonym(a.peerIDKey, id)
+}
+
+// IP returns the anonymized IP address. Values that aren't IP addresses are hashed in all modes.
+func (a *Anonymizer) IP(s string) string {
+	if a == nil {
+		return s
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil || a.ipMode == IPModeHash {
+		return pseudonym(a.ipKey, s)
+	}
+
+	if a.ipMode == IPModePrefixPreserving {
+		return a.pan.anonymize(ip).String()
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(a.ipv4Mask).String()
+	}
+	return ip.Mask(a.ipv6Mask).String()
+}
+
+// IPPtr is IP for nullable addresses. It returns nil if the address is nil.
+func (a *Anonymizer) IPPtr(s *string) *string {
+	if a == nil || s == nil {
+		return s
+	}
+	anonymized := a.IP(*s)
+	return &anonymized
+}
+
+// pseudonym returns the hex encoded truncated keyed hash of the value.
+func pseudonym(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)[:pseudonymLen])
+}
@@ -0,0 +1,115 @@
+package anonymize
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIPMode(t *testing.T) {
+	mode, err := ParseIPMode("truncate")
+	require.NoError(t, err)
+	assert.Equal(t, IPModeTruncate, mode)
+
+	_, err = ParseIPMode("drop")
+	assert.Error(t, err)
+}
+
+func TestNew_invalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		conf Config
+	}{
+		{name: "unknown mode", conf: Config{IPMode: "drop"}},
+		{name: "ipv4 prefix too long", conf: Config{IPv4PrefixLen: 33}},
+		{name: "negative ipv6 prefix", conf: Config{IPv6PrefixLen: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.conf)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAnonymizer_nil(t *testing.T) {
+	var a *Anonymizer
+	ip := "1.2.3.4"
+
+	assert.Equal(t, "peer", a.PeerID("peer"))
+	assert.Equal(t, ip, a.IP(ip))
+	assert.Equal(t, &ip, a.IPPtr(&ip))
+	assert.Empty(t, a.IPMode())
+}
+
+func TestAnonymizer_PeerID(t *testing.T) {
+	a, err := New(Config{Key: []byte("key")})
+	require.NoError(t, err)
+	b, err := New(Config{Key: []byte("key")})
+	require.NoError(t, err)
+	random, err := New(Config{})
+	require.NoError(t, err)
+
+	assert.Len(t, a.PeerID("peer"), 2*pseudonymLen)
+	assert.Equal(t, a.PeerID("peer"), b.PeerID("peer"))
+	assert.NotEqual(t, a.PeerID("peer"), a.PeerID("other"))
+	assert.NotEqual(t, a.PeerID("peer"), random.PeerID("peer"))
+}
+
+func TestAnonymizer_IP(t *testing.T) {
+	tests := []struct {
+		name string
+		conf Config
+		ip   string
+		want string
+	}{
+		{name: "truncate ipv4", conf: Config{IPMode: IPModeTruncate}, ip: "1.2.3.4", want: "1.2.3.0"},
+		{name: "truncate ipv6", conf: Config{IPMode: IPModeTruncate}, ip: "2001:db8:1:2::1", want: "2001:db8:1::"},
+		{name: "truncate ipv4 mapped", conf: Config{IPMode: IPModeTruncate}, ip: "::ffff:1.2.3.4", want: "1.2.3.0"},
+		{name: "truncate custom prefix", conf: Config{IPMode: IPModeTruncate, IPv4PrefixLen: 16, IPv6PrefixLen: 32}, ip: "1.2.3.4", want: "1.2.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := New(tt.conf)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, a.IP(tt.ip))
+		})
+	}
+}
+
+func TestAnonymizer_IP_hash(t *testing.T) {
+	a, err := New(Config{Key: []byte("key"), IPMode: IPModeHash})
+	require.NoError(t, err)
+
+	assert.Len(t, a.IP("1.2.3.4"), 2*pseudonymLen)
+	assert.Equal(t, a.IP("1.2.3.4"), a.IP("1.2.3.4"))
+	assert.NotEqual(t, a.IP("1.2.3.4"), a.PeerID("1.2.3.4"))
+}
+
+func TestAnonymizer_IP_prefixPreserving(t *testing.T) {
+	a, err := New(Config{Key: []byte("key"), IPMode: IPModePrefixPreserving})
+	require.NoError(t, err)
+
+	ip1 := net.ParseIP(a.IP("192.168.1.1"))
+	ip2 := net.ParseIP(a.IP("192.168.1.2"))
+	require.NotNil(t, ip1)
+	require.NotNil(t, ip2)
+	assert.NotNil(t, ip1.To4())
+	assert.Equal(t, ip1.To4()[:3], ip2.To4()[:3])
+	assert.NotEqual(t, "192.168.1.1", ip1.String())
+
+	// Values that aren't IP addresses are hashed
+	assert.Len(t, a.IP("not an ip"), 2*pseudonymLen)
+}
+
+func TestAnonymizer_IPMode(t *testing.T) {
+	a, err := New(Config{IPMode: IPModeTruncate})
+	require.NoError(t, err)
+	assert.Equal(t, "truncate (/24, /48)", a.IPMode())
+
+	a, err = New(Config{})
+	require.NoError(t, err)
+	assert.Equal(t, "hash", a.IPMode())
+}
@@ -0,0 +1,73 @@
+package anonymize
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"net"
+)
+
+// cryptoPAn implements the prefix-preserving IP address anonymization of Xu et al.
+// ("Prefix-Preserving IP Address Anonymization", ICNP 2002). Two addresses that share a
+// prefix of n bits are mapped to anonymized addresses that share a prefix of n bits as
+// well. IPv6 addresses are anonymized with the same construction over all 128 bits.
+type cryptoPAn struct {
+	block cipher.Block
+	pad   [aes.BlockSize]byte
+}
+
+// newCryptoPAn initializes Crypto-PAn with a 32 byte key. The first half is the AES key,
+// the second half is encrypted to derive the pad, as in the reference implementation.
+func newCryptoPAn(key []byte) (*cryptoPAn, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("crypto-pan key must be 32 bytes, got %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key[:16])
+	if err != nil {
+		return nil, err
+	}
+
+	c := &cryptoPAn{block: block}
+	block.Encrypt(c.pad[:], key[16:])
+
+	return c, nil
+}
+
+// anonymize returns the anonymized address. IPv4 addresses stay IPv4 addresses.
+func (c *cryptoPAn) anonymize(ip net.IP) net.IP {
+	if ip4 := ip.To4(); ip4 != nil {
+		return c.anonymizeBytes(ip4)
+	}
+	return c.anonymizeBytes(ip.To16())
+}
+
+// anonymizeBytes anonymizes an address of 4 or 16 bytes. Bit i of the result is bit i of
+// the original address XOR the first bit of the encryption of the first i original bits
+// padded with the pad.
+func (c *cryptoPAn) anonymizeBytes(orig []byte) net.IP {
+	var in, out [aes.BlockSize]byte
+
+	bits := len(orig) * 8
+	otp := make([]byte, len(orig))
+	for pos := 0; pos < bits; pos++ {
+		// The input is the first pos bits of the original address followed by the pad.
+		in = c.pad
+		for i := 0; i < pos/8; i++ {
+			in[i] = orig[i]
+		}
+		if rem := pos % 8; rem > 0 {
+			mask := byte(0xff) << (8 - rem)
+			in[pos/8] = orig[pos/8]&mask | c.pad[pos/8]&^mask
+		}
+
+		c.block.Encrypt(out[:], in[:])
+		otp[pos/8] |= (out[0] >> 7) << (7 - pos%8)
+	}
+
+	anonymized := make(net.IP, len(orig))
+	for i := range orig {
+		anonymized[i] = orig[i] ^ otp[i]
+	}
+	return anonymized
+}
@@ -0,0 +1,79 @@
+package anonymize
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testKey is the key of the sample trace of the Crypto-PAn reference implementation.
+var testKey = []byte{
+	21, 34, 23, 141, 51, 164, 207, 128, 19, 10, 91, 22, 73, 144, 125, 16,
+	216, 152, 143, 131, 121, 121, 101, 39, 98, 87, 76, 45, 42, 132, 34, 2,
+}
+
+func TestCryptoPAn_anonymize(t *testing.T) {
+	pan, err := newCryptoPAn(testKey)
+	require.NoError(t, err)
+
+	// Taken from the sanitized sample trace of the reference implementation
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{ip: "128.11.68.132", want: "135.242.180.132"},
+		{ip: "129.118.74.4", want: "134.136.186.123"},
+		{ip: "130.132.252.244", want: "133.68.164.234"},
+		{ip: "141.223.7.43", want: "141.167.8.160"},
+		{ip: "192.102.249.13", want: "252.138.62.131"},
+		{ip: "199.217.79.101", want: "248.38.184.213"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			assert.Equal(t, tt.want, pan.anonymize(net.ParseIP(tt.ip)).String())
+		})
+	}
+}
+
+func TestCryptoPAn_anonymize_preservesPrefixes(t *testing.T) {
+	pan, err := newCryptoPAn(testKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		a      string
+		b      string
+		prefix int
+	}{
+		{a: "192.168.1.1", b: "192.168.1.200", prefix: 24},
+		{a: "10.0.0.1", b: "10.128.0.1", prefix: 8},
+		{a: "2001:db8:1::1", b: "2001:db8:1::2", prefix: 126},
+		{a: "2001:db8:1::1", b: "2001:db8:2::1", prefix: 46},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			a, b := pan.anonymize(net.ParseIP(tt.a)), pan.anonymize(net.ParseIP(tt.b))
+			assert.Equal(t, tt.prefix, commonPrefixLen(a, b))
+		})
+	}
+}
+
+func TestNewCryptoPAn_invalidKey(t *testing.T) {
+	_, err := newCryptoPAn(testKey[:16])
+	assert.Error(t, err)
+}
+
+func commonPrefixLen(a net.IP, b net.IP) int {
+	for i := range a {
+		if x := a[i] ^ b[i]; x != 0 {
+			n := i * 8
+			for x&0x80 == 0 {
+				x <<= 1
+				n++
+			}
+			return n
+		}
+	}
+	return len(a) * 8
+}
@@ -0,0 +1,48 @@
+// Package auth transports API keys as bearer tokens in gRPC metadata.
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata key that carries the API key.
+const MetadataKey = "authorization"
+
+const bearerPrefix = "bearer "
+
+// BearerToken attaches an API key to every RPC as a bearer token.
+type BearerToken string
+
+var _ credentials.PerRPCCredentials = BearerToken("")
+
+func (t BearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{MetadataKey: "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity returns false because the server may be
+// reached without TLS, e.g., during development. The legacy api_key
+// field in the request messages wasn't protected either.
+func (t BearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
+// FromIncomingContext extracts the bearer token from the incoming gRPC metadata.
+// It returns an empty string if there is none.
+func FromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, value := range md.Get(MetadataKey) {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(value[len(bearerPrefix):])
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFromIncomingContext(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{name: "no metadata", md: nil, want: ""},
+		{name: "bearer", md: metadata.Pairs(MetadataKey, "Bearer abc"), want: "abc"},
+		{name: "lowercase bearer", md: metadata.Pairs(MetadataKey, "bearer abc"), want: "abc"},
+		{name: "other scheme", md: metadata.Pairs(MetadataKey, "Basic abc"), want: ""},
+		{name: "empty token", md: metadata.Pairs(MetadataKey, "Bearer "), want: ""},
+		{name: "second value", md: metadata.Pairs(MetadataKey, "Basic abc", MetadataKey, "Bearer def"), want: "def"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			assert.Equal(t, tt.want, FromIncomingContext(ctx))
+		})
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	md, err := BearerToken("abc").GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	assert.Equal(t, "abc", FromIncomingContext(ctx))
+}
@@ -0,0 +1,259 @@
+// Package certs builds the TLS configurations of the gRPC connection between
+// punchr clients and the server. The server reloads its certificates when
+// the files change, so that they can be renewed without a restart.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Reloader holds the server certificate and the client CA pool and
+// reloads them whenever one of the underlying files changes.
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+// NewReloader loads the given server certificate, private key and optional client
+// CA bundle and starts watching them for changes. The directories of the files
+// are watched instead of the files themselves because tools like certbot or
+// Kubernetes secret mounts replace the files (or symlinks to them) on renewal.
+func NewReloader(certFile string, keyFile string, clientCAFile string) (*Reloader, error) {
+	r := &Reloader{
+		certFile:     filepath.Clean(certFile),
+		keyFile:      filepath.Clean(keyFile),
+		clientCAFile: clientCAFile,
+		done:         make(chan struct{}),
+	}
+	if clientCAFile != "" {
+		r.clientCAFile = filepath.Clean(clientCAFile)
+	}
+
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "new file watcher")
+	}
+
+	for _, dir := range r.dirs() {
+		if err = watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return nil, errors.Wrapf(err, "watch %s", dir)
+		}
+	}
+	r.watcher = watcher
+
+	go r.watch()
+
+	return r, nil
+}
+
+// files returns all files that the reloader depends on.
+func (r *Reloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+	return files
+}
+
+// dirs returns the distinct directories of all files.
+func (r *Reloader) dirs() []string {
+	seen := map[string]bool{}
+	var dirs []string
+	for _, f := range r.files() {
+		dir := filepath.Dir(f)
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+func (r *Reloader) watch() {
+	for {
+		select {
+		case <-r.done:
+			return
+		case evt, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if !r.affects(evt) {
+				continue
+			}
+
+			// A failed reload keeps the previous certificates. It's expected while
+			// a renewal writes the certificate and key one after the other.
+			if err := r.Reload(); err != nil {
+				log.WithError(err).WithField("file", evt.Name).Warnln("Could not reload TLS certificates")
+			} else {
+				log.WithField("file", evt.Name).Infoln("Reloaded TLS certificates")
+			}
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.WithError(err).Warnln("Error watching TLS certificates")
+		}
+	}
+}
+
+// affects returns true if the event concerns one of the files of the reloader.
+// Kubernetes swaps the ..data symlink of secret mounts, which doesn't create
+// events for the files themselves.
+func (r *Reloader) affects(evt fsnotify.Event) bool {
+	if evt.Op == fsnotify.Chmod {
+		return false
+	}
+
+	name := filepath.Clean(evt.Name)
+	if filepath.Base(name) == "..data" {
+		return true
+	}
+
+	for _, f := range r.files() {
+		if f == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reload reads the certificate, private key and client CA bundle from disk.
+func (r *Reloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return errors.Wrap(err, "load server certificate")
+	}
+
+	var clientCAs *x509.CertPool
+	if r.clientCAFile != "" {
+		if clientCAs, err = LoadCertPool(r.clientCAFile); err != nil {
+			return errors.Wrap(err, "load client CA")
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cert = &cert
+	r.clientCAs = clientCAs
+
+	return nil
+}
+
+// Certificate returns the currently loaded server certificate.
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// ServerConfig returns a TLS configuration that always presents the most recently loaded
+// certificate. If requireClientCert is true, clients must present a certificate that was
+// issued by the client CA. Otherwise, client certificates are only verified if given.
+func (r *Reloader) ServerConfig(requireClientCert bool) (*tls.Config, error) {
+	if requireClientCert && r.clientCAFile == "" {
+		return nil, errors.New("requiring client certificates needs a client CA")
+	}
+
+	clientAuth := tls.NoClientCert
+	if requireClientCert {
+		clientAuth = tls.RequireAndVerifyClientCert
+	} else if r.clientCAFile != "" {
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientAuth:   clientAuth,
+				ClientCAs:    r.clientCAs,
+				// The config replaces the one that gRPC prepared, so
+				// we need to announce HTTP/2 support ourselves.
+				NextProtos: []string{"h2"},
+			}, nil
+		},
+	}, nil
+}
+
+// Close stops watching the certificate files.
+func (r *Reloader) Close() error {
+	close(r.done)
+	return r.watcher.Close()
+}
+
+// ClientConfig returns the TLS configuration of a connection to the server. If caFile
+// is empty, the server certificate is verified against the system roots. If certFile
+// and keyFile are given, the client authenticates itself with that certificate.
+func ClientConfig(caFile string, certFile string, keyFile string, skipVerify bool) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: skipVerify,
+	}
+
+	if caFile != "" {
+		rootCAs, err := LoadCertPool(caFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "load server CA")
+		}
+		config.RootCAs = rootCAs
+	}
+
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("client certificate and key must be given together")
+	} else if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "load client certificate")
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// LoadCertPool reads a bundle of PEM encoded certificates.
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "read certificates")
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.Errorf("no PEM encoded certificates in %s", file)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,290 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCert issues a certificate for the given common name. If parent is nil, the certificate is a self-signed CA.
+func newTestCert(t *testing.T, cn string, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{cn},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key}
+}
+
+// write stores the certificate and key as PEM files in dir and returns their paths.
+func (tc *testCert) write(t *testing.T, dir string, name string) (string, string) {
+	t.Helper()
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+
+	keyDER, err := x509.MarshalECPrivateKey(tc.key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tc.cert.Raw}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
+
+// handshake runs a TLS handshake between the given configurations over an in-memory connection.
+func handshake(t *testing.T, serverConfig *tls.Config, clientConfig *tls.Config) (*x509.Certificate, error) {
+	t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- tls.Server(serverConn, serverConfig).Handshake()
+		serverConn.Close()
+	}()
+
+	client := tls.Client(clientConn, clientConfig)
+	if err := client.Handshake(); err != nil {
+		clientConn.Close()
+		<-serverErr
+		return nil, err
+	}
+
+	// TLS 1.3 clients finish the handshake before the server verified their
+	// certificate. Reading drains the server's response until it closes the connection.
+	_, _ = client.Read(make([]byte, 1))
+	if err := <-serverErr; err != nil {
+		return nil, err
+	}
+
+	return client.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestReloader_ServerConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	serverCA := newTestCert(t, "server-ca", nil)
+	clientCA := newTestCert(t, "client-ca", nil)
+	otherCA := newTestCert(t, "other-ca", nil)
+
+	serverCAFile, _ := serverCA.write(t, dir, "server-ca")
+	clientCAFile, _ := clientCA.write(t, dir, "client-ca")
+	certFile, keyFile := newTestCert(t, "localhost", serverCA).write(t, dir, "server")
+	clientCertFile, clientKeyFile := newTestCert(t, "client", clientCA).write(t, dir, "client")
+	otherCertFile, otherKeyFile := newTestCert(t, "client", otherCA).write(t, dir, "other")
+
+	tests := []struct {
+		name              string
+		clientCAFile      string
+		requireClientCert bool
+		clientCertFile    string
+		clientKeyFile     string
+		wantErr           bool
+	}{
+		{
+			name: "server only",
+		},
+		{
+			name:              "client certificate required",
+			clientCAFile:      clientCAFile,
+			requireClientCert: true,
+			clientCertFile:    clientCertFile,
+			clientKeyFile:     clientKeyFile,
+		},
+		{
+			name:              "client certificate missing",
+			clientCAFile:      clientCAFile,
+			requireClientCert: true,
+			wantErr:           true,
+		},
+		{
+			name:              "client certificate of other CA",
+			clientCAFile:      clientCAFile,
+			requireClientCert: true,
+			clientCertFile:    otherCertFile,
+			clientKeyFile:     otherKeyFile,
+			wantErr:           true,
+		},
+		{
+			name:         "optional client certificate missing",
+			clientCAFile: clientCAFile,
+		},
+		{
+			name:           "optional client certificate given",
+			clientCAFile:   clientCAFile,
+			clientCertFile: clientCertFile,
+			clientKeyFile:  clientKeyFile,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReloader(certFile, keyFile, tt.clientCAFile)
+			require.NoError(t, err)
+			defer r.Close()
+
+			serverConfig, err := r.ServerConfig(tt.requireClientCert)
+			require.NoError(t, err)
+
+			clientConfig, err := ClientConfig(serverCAFile, tt.clientCertFile, tt.clientKeyFile, false)
+			require.NoError(t, err)
+			clientConfig.ServerName = "localhost"
+
+			_, err = handshake(t, serverConfig, clientConfig)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReloader_ServerConfig_requireWithoutClientCA(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := newTestCert(t, "localhost", nil).write(t, dir, "server")
+
+	r, err := NewReloader(certFile, keyFile, "")
+	require.NoError(t, err)
+	defer r.Close()
+
+	_, err = r.ServerConfig(true)
+	assert.Error(t, err)
+}
+
+func TestReloader_reload(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newTestCert(t, "ca", nil)
+	caFile, _ := ca.write(t, dir, "ca")
+	certFile, keyFile := newTestCert(t, "localhost", ca).write(t, dir, "server")
+
+	r, err := NewReloader(certFile, keyFile, "")
+	require.NoError(t, err)
+	defer r.Close()
+
+	serverConfig, err := r.ServerConfig(false)
+	require.NoError(t, err)
+
+	clientConfig, err := ClientConfig(caFile, "", "", false)
+	require.NoError(t, err)
+	clientConfig.ServerName = "localhost"
+
+	before, err := handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+
+	renewed := newTestCert(t, "localhost", ca)
+	renewed.write(t, dir, "server")
+
+	require.Eventually(t, func() bool {
+		return string(r.Certificate().Certificate[0]) == string(renewed.cert.Raw)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	after, err := handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, before.SerialNumber, after.SerialNumber)
+	assert.Equal(t, renewed.cert.SerialNumber, after.SerialNumber)
+}
+
+func TestReloader_reloadKeepsCertificateOnError(t *testing.T) {
+	dir := t.TempDir()
+	cert := newTestCert(t, "localhost", nil)
+	certFile, keyFile := cert.write(t, dir, "server")
+
+	r, err := NewReloader(certFile, keyFile, "")
+	require.NoError(t, err)
+	defer r.Close()
+
+	require.NoError(t, os.WriteFile(certFile, []byte("garbage"), 0o600))
+	assert.Error(t, r.Reload())
+	assert.Equal(t, cert.cert.Raw, r.Certificate().Certificate[0])
+}
+
+func TestClientConfig(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", nil)
+	caFile, _ := ca.write(t, dir, "ca")
+	certFile, keyFile := newTestCert(t, "client", ca).write(t, dir, "client")
+
+	garbageFile := filepath.Join(dir, "garbage.pem")
+	require.NoError(t, os.WriteFile(garbageFile, []byte("garbage"), 0o600))
+
+	tests := []struct {
+		name         string
+		caFile       string
+		certFile     string
+		keyFile      string
+		wantRootCAs  bool
+		wantCertsLen int
+		wantErr      bool
+	}{
+		{name: "system roots"},
+		{name: "custom CA", caFile: caFile, wantRootCAs: true},
+		{name: "client certificate", caFile: caFile, certFile: certFile, keyFile: keyFile, wantRootCAs: true, wantCertsLen: 1},
+		{name: "client certificate without key", certFile: certFile, wantErr: true},
+		{name: "client key without certificate", keyFile: keyFile, wantErr: true},
+		{name: "missing CA", caFile: filepath.Join(dir, "missing.pem"), wantErr: true},
+		{name: "invalid CA", caFile: garbageFile, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ClientConfig(tt.caFile, tt.certFile, tt.keyFile, false)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRootCAs, got.RootCAs != nil)
+			assert.Len(t, got.Certificates, tt.wantCertsLen)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"github.com/urfave/cli/v2"
+
+	"github.com/dennis-tra/punchr/pkg/config"
 )
 
 var Version = "dev"
@@ -10,9 +12,10 @@ var App = &cli.App{
 	Name:      "punchrclient",
 	Usage:     "A libp2p host that is capable of DCUtR.",
 	UsageText: "punchrclient [global options] command [command options] [arguments...]",
+	Before:    config.Before(validateConfig),
 	Action:    RootAction,
 	Version:   Version,
-	Flags: []cli.Flag{
+	Flags: config.JoinFlags([]cli.Flag{
 		&cli.StringFlag{
 			Name:        "telemetry-host",
 			Usage:       "To which network address should the telemetry (prometheus, pprof) server bind",
@@ -55,6 +58,24 @@ var App = &cli.App{
 			Value:       false,
 			DefaultText: "false",
 		},
+		&cli.StringFlag{
+			Name:      "server-ca-cert",
+			Usage:     "Verify the server certificate against this PEM encoded CA bundle instead of the system roots",
+			EnvVars:   []string{"PUNCHR_CLIENT_SERVER_CA_CERT"},
+			TakesFile: true,
+		},
+		&cli.StringFlag{
+			Name:      "client-cert",
+			Usage:     "Authenticate against the server with this PEM encoded client certificate",
+			EnvVars:   []string{"PUNCHR_CLIENT_CLIENT_CERT"},
+			TakesFile: true,
+		},
+		&cli.StringFlag{
+			Name:      "client-key",
+			Usage:     "The PEM encoded private key of the client certificate",
+			EnvVars:   []string{"PUNCHR_CLIENT_CLIENT_KEY"},
+			TakesFile: true,
+		},
 		&cli.IntFlag{
 			Name:        "host-count",
 			Usage:       "How many libp2p hosts should be used to hole punch",
@@ -80,11 +101,58 @@ var App = &cli.App{
 			Usage:   "Comma separated list of multi addresses of bootstrap peers",
 			EnvVars: []string{"PUNCHR_BOOTSTRAP_PEERS"},
 		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "The libp2p network (e.g., ipfs, filecoin) of the peers to hole punch",
+			EnvVars:     []string{"PUNCHR_CLIENT_NETWORK"},
+			Value:       "ipfs",
+			DefaultText: "ipfs",
+		},
+		&cli.StringSliceFlag{
+			Name:    "implementations",
+			Usage:   "Comma separated list of implementations (e.g., kubo, rust-libp2p) of the peers to hole punch (default: all)",
+			EnvVars: []string{"PUNCHR_CLIENT_IMPLEMENTATIONS"},
+		},
+		&cli.StringFlag{
+			Name:        "relay-strategy",
+			Usage:       "Through which relay to connect to remote peers: all (dial all at once), first, lowest-rtt, random. The server may choose the relay instead",
+			EnvVars:     []string{"PUNCHR_CLIENT_RELAY_STRATEGY"},
+			Value:       string(RelayStrategyAll),
+			DefaultText: string(RelayStrategyAll),
+		},
+		&cli.DurationFlag{
+			Name:        "communication-timeout",
+			Usage:       "How long to wait for the /libp2p/dcutr stream and for the events of a hole punch attempt. The server may set another value per hole punch",
+			EnvVars:     []string{"PUNCHR_CLIENT_COMMUNICATION_TIMEOUT"},
+			Value:       DefaultTiming.CommunicationTimeout,
+			DefaultText: "30s",
+		},
+		&cli.IntFlag{
+			Name:        "retry-count",
+			Usage:       "How many hole punch attempts of the remote peer to observe. It doesn't change how many attempts the remote peer makes. The server may set another value per hole punch",
+			EnvVars:     []string{"PUNCHR_CLIENT_RETRY_COUNT"},
+			Value:       DefaultTiming.RetryCount,
+			DefaultText: "3",
+		},
+		&cli.DurationFlag{
+			Name:        "ping-duration",
+			Usage:       "How long to measure the round trip time to a peer. The server may set another value per hole punch",
+			EnvVars:     []string{"PUNCHR_CLIENT_PING_DURATION"},
+			Value:       DefaultTiming.PingDuration,
+			DefaultText: "10s",
+		},
+		&cli.IntFlag{
+			Name:        "max-ping-count",
+			Usage:       "How many pings to send to a peer to measure the round trip time. The server may set another value per hole punch",
+			EnvVars:     []string{"PUNCHR_CLIENT_MAX_PING_COUNT"},
+			Value:       DefaultTiming.MaxPingCount,
+			DefaultText: "10",
+		},
 		&cli.BoolFlag{
 			Name:  "disable-router-check",
 			Usage: "Set this flag if you don't want punchr to check your router home page",
 			Value: false,
 		},
-	},
+	}, config.Flags("PUNCHR_CLIENT", "info")),
 	EnableBashCompletion: true,
 }
@@ -5,14 +5,42 @@ import (
 	"fmt"
 	"net/http"
 	_ "net/http/pprof"
+	"os"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
+
+	"github.com/dennis-tra/punchr/pkg/config"
 )
 
+// validateConfig checks the settings whose flag types allow invalid values.
+func validateConfig(c *cli.Context) error {
+	if c.Int("host-count") <= 0 {
+		return fmt.Errorf("host-count must be positive")
+	}
+
+	if _, err := ParseRelayStrategy(c.String("relay-strategy")); err != nil {
+		return err
+	}
+
+	if err := timingFromConfig(c).validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func RootAction(c *cli.Context) error {
+	if c.Bool(config.FlagPrintConfig) {
+		return config.Print(os.Stdout, c)
+	}
+
+	// Reload the log level from the config file on SIGHUP
+	stopWatching := config.Watch(c, nil)
+	defer stopWatching()
+
 	// Start telemetry endpoints
 	go serveTelemetry(c)
 
@@ -27,18 +27,12 @@ import (
 	"github.com/dennis-tra/punchr/pkg/util"
 )
 
-var (
-	CommunicationTimeout = 30 * time.Second
-	RetryCount           = 3
-	PingDuration         = 10 * time.Second
-	MaxPingCount         = 10
-)
-
 // Host holds information of the honeypot libp2p host.
 type Host struct {
 	host.Host
 
 	holePunchEventsPeers sync.Map
+	timelines            sync.Map
 	bpAddrInfos          []peer.AddrInfo
 	rcmgr                *ResourceManager
 	maddrs               map[string]struct{}
@@ -46,11 +40,18 @@ type Host struct {
 	protocolFiltersLk sync.RWMutex
 	protocolFilters   []int32
 	natmngr           basichost.NATManager
+
+	// relayStrategy determines through which relay the host connects to remote peers
+	relayStrategy RelayStrategy
+
+	// timing holds the configured timing parameters unless the server sets others for a hole punch
+	timing Timing
 }
 
 var (
 	_ holepunch.EventTracer = (*Host)(nil)
 	_ holepunch.AddrFilter  = (*Host)(nil)
+	_ network.Notifiee      = (*Host)(nil)
 )
 
 func InitHost(c *cli.Context, privKey crypto.PrivKey) (*Host, error) {
@@ -65,6 +66,11 @@ func InitHost(c *cli.Context, privKey crypto.PrivKey) (*Host, error) {
 		bpAddrInfos = addrInfos
 	}
 
+	relayStrategy, err := ParseRelayStrategy(c.String("relay-strategy"))
+	if err != nil {
+		return nil, err
+	}
+
 	rcmgr, err := NewResourceManager()
 	if err != nil {
 		return nil, errors.Wrap(err, "new resource manager")
@@ -75,6 +81,8 @@ func InitHost(c *cli.Context, privKey crypto.PrivKey) (*Host, error) {
 		bpAddrInfos:          bpAddrInfos,
 		rcmgr:                rcmgr,
 		maddrs:               map[string]struct{}{},
+		relayStrategy:        relayStrategy,
+		timing:               timingFromConfig(c),
 	}
 	var nm basichost.NATManager
 	// Configure new libp2p host
@@ -99,6 +107,9 @@ func InitHost(c *cli.Context, privKey crypto.PrivKey) (*Host, error) {
 	h.Host = libp2pHost
 	h.natmngr = nm
 
+	// Register for connection events to complete the hole punch timelines
+	h.Network().Notify(h)
+
 	return h, nil
 }
 
@@ -224,10 +235,11 @@ func (h *Host) ProtocolFilters() []int32 {
 }
 
 func (h *Host) Close() error {
+	h.Network().StopNotify(h)
 	return h.Host.Close()
 }
 
-func (h *Host) MeasurePing(ctx context.Context, pid peer.ID, mType pb.LatencyMeasurementType) <-chan LatencyMeasurement {
+func (h *Host) MeasurePing(ctx context.Context, pid peer.ID, mType pb.LatencyMeasurementType, timing Timing) <-chan LatencyMeasurement {
 	logEntry := log.WithField("remoteID", util.FmtPeerID(pid)).WithField("type", mType.String())
 
 	resultsChan := make(chan LatencyMeasurement)
@@ -235,7 +247,7 @@ func (h *Host) MeasurePing(ctx context.Context, pid peer.ID, mType pb.LatencyMea
 	go func() {
 		defer close(resultsChan)
 
-		tctx, cancel := context.WithTimeout(ctx, PingDuration)
+		tctx, cancel := context.WithTimeout(ctx, timing.PingDuration)
 		defer cancel()
 
 		logEntry.Infoln("Measuring ping", mType.String())
@@ -258,7 +270,7 @@ func (h *Host) MeasurePing(ctx context.Context, pid peer.ID, mType pb.LatencyMea
 		lm.agentVersion = h.GetAgentVersion(pid)
 		lm.protocols = h.GetProtocols(pid)
 
-		for i := 0; i < MaxPingCount; i++ {
+		for i := 0; i < timing.MaxPingCount; i++ {
 			result, ok := <-rChan
 			if !ok {
 				// channel closed due to e.g. a closed context
@@ -282,7 +294,10 @@ func (h *Host) MeasurePing(ctx context.Context, pid peer.ID, mType pb.LatencyMea
 	return resultsChan
 }
 
-func (h *Host) HolePunch(ctx context.Context, addrInfo peer.AddrInfo) (*HolePunchState, <-chan LatencyMeasurement) {
+// HolePunch connects to the remote peer through a relay and waits for the remote peer to initiate a hole punch.
+// If relayMaddr is given, the host connects through the relay of that multi address if it can reach it. The
+// timing determines how long and how often the host waits for the remote peer.
+func (h *Host) HolePunch(ctx context.Context, addrInfo peer.AddrInfo, relayMaddr multiaddr.Multiaddr, timing Timing) (*HolePunchState, <-chan LatencyMeasurement) {
 	// we received a new peer to hole punch -> log its information
 	h.logAddrInfo(addrInfo)
 
@@ -300,6 +315,12 @@ func (h *Host) HolePunch(ctx context.Context, addrInfo peer.AddrInfo) (*HolePunc
 	}
 
 	hpState := NewHolePunchState(h.ID(), addrInfo.ID, addrInfo.Addrs, h.Addrs(), h.ProtocolFilters(), mappings)
+	hpState.Timing = timing
+
+	// record the timeline of all events for this particular peer
+	h.RegisterTimeline(addrInfo.ID)
+	defer func() { hpState.Events = h.UnregisterTimeline(addrInfo.ID) }()
+
 	defer func() { hpState.EndedAt = time.Now() }()
 
 	// Track open connections after the hole punch
@@ -312,9 +333,23 @@ func (h *Host) HolePunch(ctx context.Context, addrInfo peer.AddrInfo) (*HolePunc
 		}
 	}()
 
+	// choose the relay to connect through unless libp2p should dial all relayed addresses at once
+	connectInfo := addrInfo
+	if relayMaddr != nil || h.relayStrategy != RelayStrategyAll {
+		connectInfo.Addrs, hpState.RelayStrategy, hpState.RelayDials = h.chooseRelay(ctx, addrInfo.Addrs, relayMaddr)
+		if connectInfo.Addrs == nil {
+			h.logEntry(addrInfo.ID).Infoln("Could not connect to any relay of the remote peer")
+			hpState.ConnectStartedAt = time.Now()
+			hpState.ConnectEndedAt = hpState.ConnectStartedAt
+			hpState.Error = "could not connect to any relay of the remote peer"
+			hpState.Outcome = pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_CONNECTION
+			return hpState, nil
+		}
+	}
+
 	// connect to the remote peer via relay
 	hpState.ConnectStartedAt = time.Now()
-	if err := h.Connect(ctx, addrInfo); err != nil {
+	if err := h.Connect(ctx, connectInfo); err != nil {
 		h.logEntry(addrInfo.ID).WithError(err).Infoln("Error connecting to remote peer")
 		hpState.ConnectEndedAt = time.Now()
 		hpState.Error = err.Error()
@@ -326,19 +361,22 @@ func (h *Host) HolePunch(ctx context.Context, addrInfo peer.AddrInfo) (*HolePunc
 
 	for _, conn := range h.Network().ConnsToPeer(addrInfo.ID) {
 		hpState.OpenMaddrsBefore = append(hpState.OpenMaddrsBefore, conn.RemoteMultiaddr())
+		if util.IsRelayedMaddr(conn.RemoteMultiaddr()) {
+			hpState.RelayMaddr = conn.RemoteMultiaddr()
+		}
 	}
 
-	relayedPingChan := h.MeasurePing(ctx, addrInfo.ID, pb.LatencyMeasurementType_TO_REMOTE_THROUGH_RELAY)
+	relayedPingChan := h.MeasurePing(ctx, addrInfo.ID, pb.LatencyMeasurementType_TO_REMOTE_THROUGH_RELAY, timing)
 
 	// we were able to connect to the remote peer.
-	for i := 0; i < RetryCount; i++ {
+	for i := 0; i < timing.RetryCount; i++ {
 		// wait for the DCUtR stream to be opened
 		select {
-		case _, ok := <-h.WaitForDCUtRStream(addrInfo.ID):
+		case _, ok := <-h.WaitForDCUtRStream(addrInfo.ID, timing.CommunicationTimeout):
 			if !ok {
 				// Stream was not opened in time by the remote.
 				hpState.Outcome = pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_NO_STREAM
-				hpState.Error = "/libp2p/dcutr stream was not opened after " + CommunicationTimeout.String()
+				hpState.Error = "/libp2p/dcutr stream was not opened after " + timing.CommunicationTimeout.String()
 				return hpState, relayedPingChan
 			}
 		case <-ctx.Done():
@@ -378,7 +416,7 @@ func (h *Host) HolePunch(ctx context.Context, addrInfo peer.AddrInfo) (*HolePunc
 	}
 
 	hpState.Outcome = pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_FAILED
-	hpState.Error = fmt.Sprintf("none of the %d attempts succeeded", RetryCount)
+	hpState.Error = fmt.Sprintf("none of the %d attempts succeeded", timing.RetryCount)
 
 	return hpState, relayedPingChan
 }
@@ -393,7 +431,7 @@ type LatencyMeasurement struct {
 	rttErrs      []error
 }
 
-func (h *Host) PingRelays(ctx context.Context, addrInfo map[peer.ID]*peer.AddrInfo) []LatencyMeasurement {
+func (h *Host) PingRelays(ctx context.Context, addrInfo map[peer.ID]*peer.AddrInfo, timing Timing) []LatencyMeasurement {
 	var lats []LatencyMeasurement
 
 	for relayID, ri := range addrInfo {
@@ -402,7 +440,7 @@ func (h *Host) PingRelays(ctx context.Context, addrInfo map[peer.ID]*peer.AddrIn
 			continue
 		}
 
-		if lm, ok := <-h.MeasurePing(ctx, relayID, pb.LatencyMeasurementType_TO_RELAY); ok {
+		if lm, ok := <-h.MeasurePing(ctx, relayID, pb.LatencyMeasurementType_TO_RELAY, timing); ok {
 			lats = append(lats, lm)
 		}
 	}
@@ -440,8 +478,8 @@ func (hps HolePunchState) TrackHolePunch(ctx context.Context, remoteID peer.ID,
 			default:
 				panic(fmt.Sprintf("unexpected event %T", evt.Evt))
 			}
-		case <-time.After(CommunicationTimeout):
-			hpa.handleHolePunchTimeout()
+		case <-time.After(hps.Timing.CommunicationTimeout):
+			hpa.handleHolePunchTimeout(hps.Timing.CommunicationTimeout)
 			return *hpa
 		case <-ctx.Done():
 			hpa.handleHolePunchCancelled(ctx.Err())
@@ -450,7 +488,7 @@ func (hps HolePunchState) TrackHolePunch(ctx context.Context, remoteID peer.ID,
 	}
 }
 
-func (h *Host) WaitForDCUtRStream(pid peer.ID) <-chan struct{} {
+func (h *Host) WaitForDCUtRStream(pid peer.ID, timeout time.Duration) <-chan struct{} {
 	dcutrOpenedChan := make(chan struct{})
 
 	go func() {
@@ -468,8 +506,8 @@ func (h *Host) WaitForDCUtRStream(pid peer.ID) <-chan struct{} {
 		}
 
 		select {
-		case <-time.After(CommunicationTimeout):
-			h.logEntry(pid).Infoln("/libp2p/dcutr stream was not opened after " + CommunicationTimeout.String())
+		case <-time.After(timeout):
+			h.logEntry(pid).Infoln("/libp2p/dcutr stream was not opened after " + timeout.String())
 		case <-openedStream:
 			h.logEntry(pid).Infoln("/libp2p/dcutr stream opened!")
 			dcutrOpenedChan <- struct{}{}
@@ -556,6 +594,8 @@ func (h *Host) networkInformation(ctx context.Context) *pb.NetworkInformation {
 
 // Trace is called during the hole punching process
 func (h *Host) Trace(evt *holepunch.Event) {
+	h.recordTracerEvent(evt)
+
 	val, found := h.holePunchEventsPeers.Load(evt.Remote)
 	if !found {
 		h.logEntry(evt.Remote).Infoln("Tracer event for untracked peer")
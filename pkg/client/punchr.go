@@ -2,10 +2,8 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"os"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -17,17 +15,28 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
+	"github.com/dennis-tra/punchr/pkg/auth"
+	"github.com/dennis-tra/punchr/pkg/certs"
 	"github.com/dennis-tra/punchr/pkg/key"
 	"github.com/dennis-tra/punchr/pkg/pb"
 	"github.com/dennis-tra/punchr/pkg/util"
 )
 
+const (
+	// maxTrackRetries is the number of times a rate limited hole punch result is resubmitted.
+	maxTrackRetries = 3
+
+	// defaultRetryDelay is the time to wait after the server rate limited a request without a retry hint.
+	defaultRetryDelay = time.Minute
+)
+
 // Punchr is responsible for fetching information from the server,
 // distributing the work load to different hosts and then reporting
 // the results back.
@@ -38,6 +47,8 @@ type Punchr struct {
 	client             pb.PunchrServiceClient
 	clientConn         *grpc.ClientConn
 	disableRouterCheck bool
+	network            string
+	implementations    []string
 }
 
 func NewPunchr(c *cli.Context) (*Punchr, error) {
@@ -48,17 +59,15 @@ func NewPunchr(c *cli.Context) (*Punchr, error) {
 	// Derive transport credentials from configuration
 	var tc credentials.TransportCredentials
 	if c.Bool("server-ssl") {
-		config := &tls.Config{InsecureSkipVerify: c.Bool("server-ssl-skip-verify")}
+		config, err := certs.ClientConfig(c.String("server-ca-cert"), c.String("client-cert"), c.String("client-key"), c.Bool("server-ssl-skip-verify"))
+		if err != nil {
+			return nil, errors.Wrap(err, "client tls config")
+		}
 		tc = credentials.NewTLS(config)
 	} else {
 		tc = insecure.NewCredentials()
 	}
 
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(tc))
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to dial")
-	}
-
 	apiKey, err := key.LoadApiKey(c)
 	if errors.Is(err, os.ErrNotExist) {
 		apiKey = uuid.NewString()
@@ -69,6 +78,11 @@ func NewPunchr(c *cli.Context) (*Punchr, error) {
 		return nil, errors.Wrap(err, "load api key")
 	}
 
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(tc), grpc.WithPerRPCCredentials(auth.BearerToken(apiKey)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial")
+	}
+
 	keyFile, err := xdg.ConfigFile("punchr/client.keys")
 	if err != nil || c.IsSet("key-file") {
 		keyFile = c.String("key-file")
@@ -82,6 +96,8 @@ func NewPunchr(c *cli.Context) (*Punchr, error) {
 		client:             pb.NewPunchrServiceClient(conn),
 		clientConn:         conn,
 		disableRouterCheck: c.Bool("disable-router-check"),
+		network:            c.String("network"),
+		implementations:    c.StringSlice("implementations"),
 	}, nil
 }
 
@@ -157,11 +173,9 @@ func (p Punchr) Register(c *cli.Context) error {
 		}
 
 		av := "punchr/go-client/" + c.App.Version
-		apiKey := p.apiKey
 		req := &pb.RegisterRequest{
 			ClientId:     bytesLocalPeerID,
 			AgentVersion: &av,
-			ApiKey:       &apiKey,
 			Protocols:    h.GetProtocols(h.ID()),
 		}
 		if _, err = p.client.Register(c.Context, req); err != nil {
@@ -188,24 +202,33 @@ func (p Punchr) StartHolePunching(ctx context.Context) error {
 		h := p.hosts[i]
 
 		// Request peer to hole punch
-		addrInfo, protocols, err := p.RequestAddrInfo(ctx, h.ID())
+		addrInfo, protocols, allocationID, relayMaddr, dcutrParams, err := p.RequestAddrInfo(ctx, h.ID())
 
 		h.protocolFiltersLk.Lock()
 		h.protocolFilters = protocols
 		h.protocolFiltersLk.Unlock()
 
 		if addrInfo == nil {
-			if err != nil && strings.Contains(err.Error(), "please restart the client") {
+			switch statusCode(err) {
+			case codes.FailedPrecondition:
 				return errors.Wrap(err, "restart requested")
+			case codes.Unauthenticated, codes.PermissionDenied:
+				return errors.Wrap(err, "api key rejected")
+			}
+
+			// Wait 30s until next request in either case or as long as the server asks us to if we were throttled
+			wait := 30 * time.Second
+			if delay, throttled := retryDelay(err); throttled {
+				log.WithField("retryAfter", delay).Warnln("Requesting addr info was rate limited")
+				wait = delay
 			} else if err != nil {
 				log.WithError(err).Warnln("Error requesting addr info")
 			} else {
-				log.Infoln("No peer to hole punch received waiting 10s")
+				log.Infoln("No peer to hole punch received waiting 30s")
 			}
 
-			// Wait 30s until next request in either case
 			select {
-			case <-time.After(30 * time.Second):
+			case <-time.After(wait):
 				continue
 			case <-ctx.Done():
 				return ctx.Err()
@@ -219,8 +242,10 @@ func (p Punchr) StartHolePunching(ctx context.Context) error {
 		}
 		log.WithField("remoteID", addrInfo.ID).WithField("filter", protocolNames).Infoln("Received peer to hole punch from server!")
 
-		// Instruct the i-th host to hole punch
-		hpState, relayedPingChan := h.HolePunch(ctx, *addrInfo)
+		// Instruct the i-th host to hole punch with the timing that the server asked for
+		timing := h.timing.apply(dcutrParams)
+		hpState, relayedPingChan := h.HolePunch(ctx, *addrInfo, relayMaddr, timing)
+		hpState.AllocationID = allocationID
 
 		// Conditions for a connection reversal:
 		//   1. /libp2p/dcutr stream was not opened.
@@ -232,7 +257,7 @@ func (p Punchr) StartHolePunching(ctx context.Context) error {
 
 		// If we have a direct connection, measure ping to remote peer
 		if hpState.HasDirectConns {
-			if lm, ok := <-h.MeasurePing(ctx, addrInfo.ID, pb.LatencyMeasurementType_TO_REMOTE_AFTER_HOLE_PUNCH); ok {
+			if lm, ok := <-h.MeasurePing(ctx, addrInfo.ID, pb.LatencyMeasurementType_TO_REMOTE_AFTER_HOLE_PUNCH, timing); ok {
 				hpState.LatencyMeasurements = append(hpState.LatencyMeasurements, lm)
 			}
 		}
@@ -251,7 +276,7 @@ func (p Punchr) StartHolePunching(ctx context.Context) error {
 			hpState.NetworkInformation = h.networkInformation(ctx)
 		}
 
-		relayLatencies := h.PingRelays(ctx, extractRelayInfo(*addrInfo))
+		relayLatencies := h.PingRelays(ctx, extractRelayInfo(*addrInfo), timing)
 		hpState.LatencyMeasurements = append(hpState.LatencyMeasurements, relayLatencies...)
 
 		// Tell the server about the hole punch outcome
@@ -266,60 +291,72 @@ func (p Punchr) StartHolePunching(ctx context.Context) error {
 }
 
 // RequestAddrInfo calls the hole punching server for a new peer + multi address to hole punch.
-func (p Punchr) RequestAddrInfo(ctx context.Context, clientID peer.ID) (*peer.AddrInfo, []int32, error) {
+// It also returns the protocol filters, the allocation ID that must be reported with the result,
+// the relayed multi address through which the server wants the client to connect, if any, and the
+// timing parameters of the DCUtR protocol that the server set for this hole punch, if any.
+func (p Punchr) RequestAddrInfo(ctx context.Context, clientID peer.ID) (*peer.AddrInfo, []int32, *int64, multiaddr.Multiaddr, *pb.DCUtRParameters, error) {
 	log.Infoln("Requesting peer to hole punch from server...")
 
 	// Marshal client ID
 	hostID, err := clientID.Marshal()
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "marshal client id")
+		return nil, nil, nil, nil, nil, errors.Wrap(err, "marshal client id")
 	}
 
 	allHostIDs := [][]byte{}
 	for _, h := range p.hosts {
 		marshalled, err := h.ID().Marshal()
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "marshal client id")
+			return nil, nil, nil, nil, nil, errors.Wrap(err, "marshal client id")
 		}
 		allHostIDs = append(allHostIDs, marshalled)
 	}
 
 	// Request address information
 	req := &pb.GetAddrInfoRequest{
-		ApiKey:     &p.apiKey,
-		HostId:     hostID,
-		AllHostIds: allHostIDs,
+		HostId:          hostID,
+		AllHostIds:      allHostIDs,
+		Network:         &p.network,
+		Implementations: p.implementations,
 	}
 
 	res, err := p.client.GetAddrInfo(ctx, req)
 	if st, ok := status.FromError(err); ok && st != nil {
 		if st.Code() == codes.NotFound {
-			return nil, nil, nil
+			return nil, nil, nil, nil, nil, nil
 		}
-		return nil, nil, errors.Wrap(err, "get addr info RPC")
+		return nil, nil, nil, nil, nil, errors.Wrap(err, "get addr info RPC")
 	}
 
 	// If no remote ID is given the server does not have a peer to hole punch
 	if res.GetRemoteId() == nil {
-		return nil, nil, nil
+		return nil, nil, nil, nil, nil, nil
 	}
 
 	// Parse response
 	remoteID, err := peer.IDFromBytes(res.RemoteId)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "peer ID from bytes")
+		return nil, nil, nil, nil, nil, errors.Wrap(err, "peer ID from bytes")
 	}
 
 	maddrs := make([]multiaddr.Multiaddr, len(res.MultiAddresses))
 	for i, maddrBytes := range res.MultiAddresses {
 		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "multi address from bytes")
+			return nil, nil, nil, nil, nil, errors.Wrap(err, "multi address from bytes")
 		}
 		maddrs[i] = maddr
 	}
 
-	return &peer.AddrInfo{ID: remoteID, Addrs: maddrs}, res.Protocols, nil
+	var relayMaddr multiaddr.Multiaddr
+	if res.RelayMultiAddress != nil {
+		relayMaddr, err = multiaddr.NewMultiaddrBytes(res.RelayMultiAddress)
+		if err != nil {
+			return nil, nil, nil, nil, nil, errors.Wrap(err, "relay multi address from bytes")
+		}
+	}
+
+	return &peer.AddrInfo{ID: remoteID, Addrs: maddrs}, res.Protocols, res.AllocationId, relayMaddr, res.DcutrParameters, nil
 }
 
 func (p Punchr) TrackHolePunchResult(ctx context.Context, hps *HolePunchState) error {
@@ -331,12 +368,26 @@ func (p Punchr) TrackHolePunchResult(ctx context.Context, hps *HolePunchState) e
 		"endReason": hps.Outcome,
 	}).Infoln("Tracking hole punch result")
 
-	req, err := hps.ToProto(p.apiKey)
+	req, err := hps.ToProto()
 	if err != nil {
 		return err
 	}
-	_, err = p.client.TrackHolePunch(ctx, req)
-	return err
+
+	// Retry throttled requests so that the result doesn't get lost
+	for i := 0; ; i++ {
+		_, err = p.client.TrackHolePunch(ctx, req)
+		delay, throttled := retryDelay(err)
+		if !throttled || i == maxTrackRetries {
+			return err
+		}
+
+		log.WithField("retryAfter", delay).Warnln("Tracking hole punch result was rate limited")
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 }
 
 func (p Punchr) Close() error {
@@ -350,3 +401,38 @@ func (p Punchr) Close() error {
 	}
 	return nil
 }
+
+// statusFromError returns the gRPC status of the given, possibly wrapped, error.
+func statusFromError(err error) *status.Status {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus()
+	}
+	return status.Convert(err)
+}
+
+// statusCode returns the gRPC status code of the given, possibly wrapped, error.
+func statusCode(err error) codes.Code {
+	return statusFromError(err).Code()
+}
+
+// retryDelay returns true if the given error indicates that the server rate limited
+// the request. In that case, it also returns the time after which the request may be retried.
+func retryDelay(err error) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+
+	st := statusFromError(err)
+	if st.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+
+	for _, detail := range st.Details() {
+		if ri, ok := detail.(*errdetails.RetryInfo); ok && ri.RetryDelay != nil {
+			return ri.RetryDelay.AsDuration(), true
+		}
+	}
+
+	return defaultRetryDelay, true
+}
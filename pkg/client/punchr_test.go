@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestRetryDelay(t *testing.T) {
+	withRetryInfo, err := status.New(codes.ResourceExhausted, "throttled").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(5 * time.Second)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		err           error
+		wantDelay     time.Duration
+		wantThrottled bool
+	}{
+		{name: "nil", err: nil},
+		{name: "other error", err: fmt.Errorf("some error")},
+		{name: "other status", err: status.Error(codes.Unavailable, "unavailable")},
+		{name: "retry info", err: withRetryInfo.Err(), wantDelay: 5 * time.Second, wantThrottled: true},
+		{name: "wrapped retry info", err: errors.Wrap(withRetryInfo.Err(), "get addr info RPC"), wantDelay: 5 * time.Second, wantThrottled: true},
+		{name: "no retry info", err: status.Error(codes.ResourceExhausted, "throttled"), wantDelay: defaultRetryDelay, wantThrottled: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, throttled := retryDelay(tt.err)
+			assert.Equal(t, tt.wantThrottled, throttled)
+			assert.Equal(t, tt.wantDelay, delay)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+	"github.com/dennis-tra/punchr/pkg/util"
+)
+
+// RelayPingTimeout is the time the client waits for the round trip time of a relay to choose the relay with the lowest one.
+var RelayPingTimeout = 5 * time.Second
+
+// RelayStrategy determines through which relay the client connects to the remote peer.
+type RelayStrategy string
+
+const (
+	// RelayStrategyAll dials all relayed multi addresses at once and keeps the first connection.
+	RelayStrategyAll RelayStrategy = "all"
+
+	// RelayStrategyFirst tries the relays in the order of the multi addresses.
+	RelayStrategyFirst RelayStrategy = "first"
+
+	// RelayStrategyLowestRTT connects to all relays and chooses the one with the lowest round trip time.
+	RelayStrategyLowestRTT RelayStrategy = "lowest-rtt"
+
+	// RelayStrategyRandom tries the relays in random order.
+	RelayStrategyRandom RelayStrategy = "random"
+)
+
+// ParseRelayStrategy returns the relay strategy with the given name.
+func ParseRelayStrategy(name string) (RelayStrategy, error) {
+	switch RelayStrategy(name) {
+	case RelayStrategyAll, RelayStrategyFirst, RelayStrategyLowestRTT, RelayStrategyRandom:
+		return RelayStrategy(name), nil
+	default:
+		return "", fmt.Errorf("unknown relay strategy %s (all, first, lowest-rtt, random)", name)
+	}
+}
+
+func (s RelayStrategy) toProto() pb.RelayStrategy {
+	switch s {
+	case RelayStrategyAll:
+		return pb.RelayStrategy_RELAY_STRATEGY_ALL
+	case RelayStrategyFirst:
+		return pb.RelayStrategy_RELAY_STRATEGY_FIRST
+	case RelayStrategyLowestRTT:
+		return pb.RelayStrategy_RELAY_STRATEGY_LOWEST_RTT
+	case RelayStrategyRandom:
+		return pb.RelayStrategy_RELAY_STRATEGY_RANDOM
+	default:
+		return pb.RelayStrategy_RELAY_STRATEGY_UNKNOWN
+	}
+}
+
+// RelayDial is a connection attempt to a relay of the remote peer before the hole punch.
+type RelayDial struct {
+	RelayID   peer.ID
+	Maddrs    []multiaddr.Multiaddr
+	StartedAt time.Time
+	EndedAt   time.Time
+	Error     error
+
+	// RTT is the round trip time to the relay. It's zero if it wasn't measured.
+	RTT time.Duration
+
+	// Selected is true if the client connected to the remote peer through this relay.
+	Selected bool
+}
+
+func (rd *RelayDial) toProto() (*pb.RelayDial, error) {
+	relayID, err := rd.RelayID.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal relay id")
+	}
+
+	maddrBytes := make([][]byte, len(rd.Maddrs))
+	for i, maddr := range rd.Maddrs {
+		maddrBytes[i] = maddr.Bytes()
+	}
+
+	startedAt := uint64(rd.StartedAt.UnixNano())
+	endedAt := uint64(rd.EndedAt.UnixNano())
+
+	dial := &pb.RelayDial{
+		RelayId:        relayID,
+		MultiAddresses: maddrBytes,
+		StartedAt:      &startedAt,
+		EndedAt:        &endedAt,
+		Selected:       &rd.Selected,
+	}
+
+	if rd.Error != nil {
+		errStr := rd.Error.Error()
+		dial.Error = &errStr
+	}
+
+	if rd.RTT != 0 {
+		rtt := float32(rd.RTT.Seconds())
+		dial.Rtt = &rtt
+	}
+
+	return dial, nil
+}
+
+// relayCandidate is a relay of the remote peer.
+type relayCandidate struct {
+	// info holds the ID and multi addresses of the relay itself.
+	info peer.AddrInfo
+
+	// circuitAddrs are the relayed multi addresses of the remote peer through this relay.
+	circuitAddrs []multiaddr.Multiaddr
+}
+
+// relayCandidates groups the relayed multi addresses by relay in the order
+// in which the relays first appear. Non-relayed multi addresses are ignored.
+func relayCandidates(maddrs []multiaddr.Multiaddr) []*relayCandidate {
+	var candidates []*relayCandidate
+	byID := map[peer.ID]*relayCandidate{}
+	for _, maddr := range maddrs {
+		if !util.IsRelayedMaddr(maddr) {
+			continue
+		}
+
+		relayInfo, err := util.ExtractRelayMaddr(maddr)
+		if err != nil {
+			continue
+		}
+
+		candidate, found := byID[relayInfo.ID]
+		if !found {
+			candidate = &relayCandidate{info: peer.AddrInfo{ID: relayInfo.ID}}
+			byID[relayInfo.ID] = candidate
+			candidates = append(candidates, candidate)
+		}
+		candidate.info.Addrs = append(candidate.info.Addrs, relayInfo.Addrs...)
+		candidate.circuitAddrs = append(candidate.circuitAddrs, maddr)
+	}
+
+	return candidates
+}
+
+// chooseRelay connects to the relays of the remote peer according to the relay strategy and returns the relayed
+// multi addresses of the remote peer through the first relay that it could connect to. If the server chose a relay
+// with relayMaddr, that relay is tried first and the others in their given order. It returns the strategy that was
+// applied and all connection attempts to relays. It returns nil multi addresses if no relay could be reached.
+func (h *Host) chooseRelay(ctx context.Context, maddrs []multiaddr.Multiaddr, relayMaddr multiaddr.Multiaddr) ([]multiaddr.Multiaddr, pb.RelayStrategy, []*RelayDial) {
+	candidates := relayCandidates(maddrs)
+	strategy := h.relayStrategy.toProto()
+
+	switch {
+	case relayMaddr != nil:
+		strategy = pb.RelayStrategy_RELAY_STRATEGY_SERVER
+		if relayInfo, err := util.ExtractRelayMaddr(relayMaddr); err == nil {
+			for i, candidate := range candidates {
+				if candidate.info.ID == relayInfo.ID {
+					candidates[0], candidates[i] = candidates[i], candidates[0]
+					break
+				}
+			}
+		}
+	case h.relayStrategy == RelayStrategyRandom:
+		rand.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+	case h.relayStrategy == RelayStrategyLowestRTT:
+		return h.chooseLowestRTTRelay(ctx, candidates)
+	}
+
+	var dials []*RelayDial
+	for _, candidate := range candidates {
+		dial := h.dialRelay(ctx, candidate)
+		dials = append(dials, dial)
+		if dial.Error == nil {
+			dial.Selected = true
+			return candidate.circuitAddrs, strategy, dials
+		}
+	}
+
+	return nil, strategy, dials
+}
+
+// chooseLowestRTTRelay connects to all relays at once, measures their round trip times,
+// and returns the relayed multi addresses through the relay with the lowest one. If no
+// round trip time could be measured, it falls back to the first relay it connected to.
+func (h *Host) chooseLowestRTTRelay(ctx context.Context, candidates []*relayCandidate) ([]multiaddr.Multiaddr, pb.RelayStrategy, []*RelayDial) {
+	dials := make([]*RelayDial, len(candidates))
+
+	var wg sync.WaitGroup
+	for i, candidate := range candidates {
+		wg.Add(1)
+		go func(i int, candidate *relayCandidate) {
+			defer wg.Done()
+
+			dials[i] = h.dialRelay(ctx, candidate)
+			if dials[i].Error == nil {
+				dials[i].RTT = h.measureRelayRTT(ctx, candidate.info.ID)
+			}
+		}(i, candidate)
+	}
+	wg.Wait()
+
+	best := lowestRTTDial(dials)
+	if best == -1 {
+		return nil, pb.RelayStrategy_RELAY_STRATEGY_LOWEST_RTT, dials
+	}
+	dials[best].Selected = true
+
+	return candidates[best].circuitAddrs, pb.RelayStrategy_RELAY_STRATEGY_LOWEST_RTT, dials
+}
+
+// lowestRTTDial returns the index of the successful dial with the lowest round trip time.
+// If no round trip time could be measured, it returns the first successful dial. It
+// returns -1 if no dial succeeded.
+func lowestRTTDial(dials []*RelayDial) int {
+	best := -1
+	for i, dial := range dials {
+		if dial.Error != nil || dial.RTT == 0 {
+			continue
+		}
+		if best == -1 || dial.RTT < dials[best].RTT {
+			best = i
+		}
+	}
+
+	if best != -1 {
+		return best
+	}
+
+	// The relays may not support the ping protocol
+	for i, dial := range dials {
+		if dial.Error == nil {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// dialRelay connects to the relay and records how long it took.
+func (h *Host) dialRelay(ctx context.Context, candidate *relayCandidate) *RelayDial {
+	dial := &RelayDial{
+		RelayID:   candidate.info.ID,
+		Maddrs:    candidate.info.Addrs,
+		StartedAt: time.Now(),
+	}
+	dial.Error = h.Connect(ctx, candidate.info)
+	dial.EndedAt = time.Now()
+
+	if dial.Error != nil {
+		h.logEntry(candidate.info.ID).WithError(dial.Error).Infoln("Could not connect to relay")
+	}
+
+	return dial
+}
+
+// measureRelayRTT returns the round trip time of a single ping to the relay. It returns zero if the ping failed.
+func (h *Host) measureRelayRTT(ctx context.Context, relayID peer.ID) time.Duration {
+	tctx, cancel := context.WithTimeout(ctx, RelayPingTimeout)
+	defer cancel()
+
+	results, _ := Ping(tctx, h.Host, relayID)
+	result, ok := <-results
+	if !ok || result.Error != nil {
+		return 0
+	}
+
+	return result.RTT
+}
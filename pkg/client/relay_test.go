@@ -0,0 +1,140 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func TestParseRelayStrategy(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    RelayStrategy
+		wantErr bool
+	}{
+		{name: "all", want: RelayStrategyAll},
+		{name: "first", want: RelayStrategyFirst},
+		{name: "lowest-rtt", want: RelayStrategyLowestRTT},
+		{name: "random", want: RelayStrategyRandom},
+		{name: "fastest", wantErr: true},
+		{name: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRelayStrategy(tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NotEqual(t, pb.RelayStrategy_RELAY_STRATEGY_UNKNOWN, got.toProto())
+		})
+	}
+}
+
+func TestRelayCandidates(t *testing.T) {
+	relay1, err := peer.Decode("12D3KooWKrnZSrdtKBcbYSRzrcuanNvMRiXiBQMrPZeGbSztGCH6")
+	require.NoError(t, err)
+	relay2, err := peer.Decode("12D3KooWSnLfpFczsHcFhsc2oBhiS9vu6fMjnMXcQiykbsDhGkx5")
+	require.NoError(t, err)
+
+	circuit := func(transport string, relayID peer.ID) multiaddr.Multiaddr {
+		return multiaddr.StringCast(fmt.Sprintf("/ip4/1.2.3.4/%s/p2p/%s/p2p-circuit", transport, relayID))
+	}
+
+	maddrs := []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/5.6.7.8/tcp/4001"),
+		circuit("tcp/4001", relay2),
+		circuit("tcp/4001", relay1),
+		circuit("udp/4001/quic", relay2),
+	}
+
+	candidates := relayCandidates(maddrs)
+	require.Len(t, candidates, 2)
+
+	assert.Equal(t, relay2, candidates[0].info.ID)
+	assert.Len(t, candidates[0].info.Addrs, 2)
+	assert.Equal(t, []multiaddr.Multiaddr{maddrs[1], maddrs[3]}, candidates[0].circuitAddrs)
+
+	assert.Equal(t, relay1, candidates[1].info.ID)
+	assert.Equal(t, []multiaddr.Multiaddr{maddrs[2]}, candidates[1].circuitAddrs)
+
+	assert.Empty(t, relayCandidates(maddrs[:1]))
+}
+
+func TestLowestRTTDial(t *testing.T) {
+	dialErr := fmt.Errorf("dial backoff")
+
+	tests := []struct {
+		name  string
+		dials []*RelayDial
+		want  int
+	}{
+		{name: "no dials", dials: nil, want: -1},
+		{
+			name:  "all dials failed",
+			dials: []*RelayDial{{Error: dialErr}, {Error: dialErr}},
+			want:  -1,
+		},
+		{
+			name:  "lowest rtt",
+			dials: []*RelayDial{{RTT: 300 * time.Millisecond}, {Error: dialErr}, {RTT: 100 * time.Millisecond}, {RTT: 200 * time.Millisecond}},
+			want:  2,
+		},
+		{
+			name:  "failed pings are ignored",
+			dials: []*RelayDial{{}, {RTT: 300 * time.Millisecond}},
+			want:  1,
+		},
+		{
+			name:  "first connected relay if all pings failed",
+			dials: []*RelayDial{{Error: dialErr}, {}, {}},
+			want:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lowestRTTDial(tt.dials))
+		})
+	}
+}
+
+func TestRelayDial_toProto(t *testing.T) {
+	relayID, err := peer.Decode("12D3KooWKrnZSrdtKBcbYSRzrcuanNvMRiXiBQMrPZeGbSztGCH6")
+	require.NoError(t, err)
+
+	now := time.Now()
+	dial := &RelayDial{
+		RelayID:   relayID,
+		Maddrs:    []multiaddr.Multiaddr{multiaddr.StringCast("/ip4/1.2.3.4/tcp/4001")},
+		StartedAt: now,
+		EndedAt:   now.Add(time.Second),
+	}
+
+	pbDial, err := dial.toProto()
+	require.NoError(t, err)
+	assert.Equal(t, []byte(relayID), pbDial.RelayId)
+	assert.Len(t, pbDial.MultiAddresses, 1)
+	assert.Equal(t, uint64(now.UnixNano()), pbDial.GetStartedAt())
+	assert.False(t, pbDial.GetSelected())
+	assert.Nil(t, pbDial.Error)
+	assert.Nil(t, pbDial.Rtt)
+
+	dial.Error = fmt.Errorf("dial backoff")
+	dial.RTT = 500 * time.Millisecond
+	dial.Selected = true
+
+	pbDial, err = dial.toProto()
+	require.NoError(t, err)
+	assert.Equal(t, "dial backoff", pbDial.GetError())
+	assert.InDelta(t, 0.5, pbDial.GetRtt(), 1e-6)
+	assert.True(t, pbDial.GetSelected())
+}
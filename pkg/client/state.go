@@ -111,6 +111,20 @@ type HolePunchState struct {
 
 	// NAT Mappings
 	NATMappings []nat.Mapping
+
+	// Timeline of all tracer and connection events of the remote peer
+	Events []*HolePunchEvent
+
+	// The ID of the allocation that handed out the remote peer
+	AllocationID *int64
+
+	// How the relay was chosen, the connection attempts to the relays, and the multi address of the relayed connection
+	RelayStrategy pb.RelayStrategy
+	RelayDials    []*RelayDial
+	RelayMaddr    multiaddr.Multiaddr
+
+	// The timing parameters of the DCUtR protocol that were used for the hole punch
+	Timing Timing
 }
 
 func NewHolePunchState(hostID peer.ID, remoteID peer.ID, rmaddrs []multiaddr.Multiaddr, lmaddrs []multiaddr.Multiaddr, filters []int32, mappings []nat.Mapping) *HolePunchState {
@@ -125,6 +139,10 @@ func NewHolePunchState(hostID peer.ID, remoteID peer.ID, rmaddrs []multiaddr.Mul
 		LatencyMeasurements: []LatencyMeasurement{},
 		ProtocolFilters:     filters,
 		NATMappings:         mappings,
+		Events:              []*HolePunchEvent{},
+		RelayStrategy:       pb.RelayStrategy_RELAY_STRATEGY_ALL,
+		RelayDials:          []*RelayDial{},
+		Timing:              DefaultTiming,
 	}
 }
 
@@ -146,7 +164,7 @@ func (hps HolePunchState) onlyRelayRemoteAddrs() bool {
 	return true
 }
 
-func (hps HolePunchState) ToProto(apiKey string) (*pb.TrackHolePunchRequest, error) {
+func (hps HolePunchState) ToProto() (*pb.TrackHolePunchRequest, error) {
 	localID, err := hps.HostID.Marshal()
 	if err != nil {
 		return nil, errors.Wrap(err, "marshal local peer id")
@@ -190,11 +208,29 @@ func (hps HolePunchState) ToProto(apiKey string) (*pb.TrackHolePunchRequest, err
 		filterProtocols[i] = int32(pf)
 	}
 
+	events := make([]*pb.HolePunchEvent, len(hps.Events))
+	for i, evt := range hps.Events {
+		events[i] = evt.ToProto()
+	}
+
 	var errStr *string
 	if hps.Error != "" {
 		errStr = &hps.Error
 	}
 
+	relayDials := make([]*pb.RelayDial, len(hps.RelayDials))
+	for i, rd := range hps.RelayDials {
+		relayDials[i], err = rd.toProto()
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal relay dial")
+		}
+	}
+
+	var relayMaddrBytes []byte
+	if hps.RelayMaddr != nil {
+		relayMaddrBytes = hps.RelayMaddr.Bytes()
+	}
+
 	portMappings := []*pb.NATMapping{}
 	for _, mapping := range hps.NATMappings {
 		eAddr, err := mapping.ExternalAddr()
@@ -218,7 +254,6 @@ func (hps HolePunchState) ToProto(apiKey string) (*pb.TrackHolePunchRequest, err
 	}
 
 	return &pb.TrackHolePunchRequest{
-		ApiKey:               &apiKey,
 		ClientId:             localID,
 		ListenMultiAddresses: lMaddrBytes,
 		RemoteId:             remoteID,
@@ -235,6 +270,12 @@ func (hps HolePunchState) ToProto(apiKey string) (*pb.TrackHolePunchRequest, err
 		LatencyMeasurements:  lms,
 		Protocols:            filterProtocols,
 		NatMappings:          portMappings,
+		HolePunchEvents:      events,
+		AllocationId:         hps.AllocationID,
+		RelayStrategy:        &hps.RelayStrategy,
+		RelayDials:           relayDials,
+		RelayMultiAddress:    relayMaddrBytes,
+		DcutrParameters:      hps.Timing.toProto(),
 	}, nil
 }
 
@@ -313,12 +354,12 @@ func (hpa *HolePunchAttempt) handleDirectDialEvt(event *holepunch.Event, evt *ho
 	}
 }
 
-func (hpa *HolePunchAttempt) handleHolePunchTimeout() {
-	hpa.logEntry().Infoln("no hole punch event after", CommunicationTimeout)
+func (hpa *HolePunchAttempt) handleHolePunchTimeout(timeout time.Duration) {
+	hpa.logEntry().Infoln("no hole punch event after", timeout)
 	hpa.Outcome = pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_TIMEOUT
 	hpa.EndedAt = time.Now()
 	hpa.ElapsedTime = hpa.EndedAt.Sub(hpa.OpenedAt)
-	hpa.Error = fmt.Sprintf("no hole punch event after %s", CommunicationTimeout)
+	hpa.Error = fmt.Sprintf("no hole punch event after %s", timeout)
 }
 
 func (hpa *HolePunchAttempt) handleHolePunchCancelled(err error) {
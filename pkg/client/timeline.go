@@ -0,0 +1,167 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	"github.com/multiformats/go-multiaddr"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+// HolePunchEvent is a single entry in the timeline of a hole punch.
+// It's either a raw hole punch tracer event or a connection event.
+type HolePunchEvent struct {
+	Type        pb.HolePunchEventType
+	Timestamp   time.Time
+	RemoteAddrs []multiaddr.Multiaddr
+	Attempt     *int
+	Success     *bool
+	ElapsedTime time.Duration
+	RTT         time.Duration
+	Error       string
+	Direction   string
+}
+
+// eventTimeline collects the events of a single hole punch in the order they occurred.
+type eventTimeline struct {
+	lk     sync.Mutex
+	events []*HolePunchEvent
+}
+
+func (et *eventTimeline) add(evt *HolePunchEvent) {
+	et.lk.Lock()
+	defer et.lk.Unlock()
+	et.events = append(et.events, evt)
+}
+
+func (et *eventTimeline) Events() []*HolePunchEvent {
+	et.lk.Lock()
+	defer et.lk.Unlock()
+
+	events := make([]*HolePunchEvent, len(et.events))
+	copy(events, et.events)
+
+	return events
+}
+
+// RegisterTimeline starts recording all tracer and connection events for the given peer.
+func (h *Host) RegisterTimeline(pid peer.ID) {
+	h.timelines.Store(pid, &eventTimeline{})
+}
+
+// UnregisterTimeline stops recording events for the given peer and returns the recorded ones.
+func (h *Host) UnregisterTimeline(pid peer.ID) []*HolePunchEvent {
+	val, found := h.timelines.LoadAndDelete(pid)
+	if !found {
+		return []*HolePunchEvent{}
+	}
+	return val.(*eventTimeline).Events()
+}
+
+func (h *Host) recordEvent(pid peer.ID, evt *HolePunchEvent) {
+	val, found := h.timelines.Load(pid)
+	if !found {
+		return
+	}
+	val.(*eventTimeline).add(evt)
+}
+
+// recordTracerEvent converts the given tracer event to a timeline event.
+func (h *Host) recordTracerEvent(evt *holepunch.Event) {
+	hpe := &HolePunchEvent{Timestamp: time.Unix(0, evt.Timestamp)}
+
+	switch event := evt.Evt.(type) {
+	case *holepunch.DirectDialEvt:
+		hpe.Type = pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_DIRECT_DIAL
+		hpe.Success = &event.Success
+		hpe.ElapsedTime = event.EllapsedTime
+		hpe.Error = event.Error
+	case *holepunch.ProtocolErrorEvt:
+		hpe.Type = pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_PROTOCOL_ERROR
+		hpe.Error = event.Error
+	case *holepunch.StartHolePunchEvt:
+		hpe.Type = pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_START_HOLE_PUNCH
+		hpe.RTT = event.RTT
+		for _, addr := range event.RemoteAddrs {
+			maddr, err := multiaddr.NewMultiaddr(addr)
+			if err != nil {
+				log.WithError(err).WithField("maddr", addr).Warn("Could not parse maddr")
+				continue
+			}
+			hpe.RemoteAddrs = append(hpe.RemoteAddrs, maddr)
+		}
+	case *holepunch.EndHolePunchEvt:
+		hpe.Type = pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_END_HOLE_PUNCH
+		hpe.Success = &event.Success
+		hpe.ElapsedTime = event.EllapsedTime
+		hpe.Error = event.Error
+	case *holepunch.HolePunchAttemptEvt:
+		hpe.Type = pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_HOLE_PUNCH_ATTEMPT
+		hpe.Attempt = &event.Attempt
+	default:
+		hpe.Type = pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_UNKNOWN
+	}
+
+	h.recordEvent(evt.Remote, hpe)
+}
+
+// recordConnEvent records the opening or closing of a connection to a traced peer.
+func (h *Host) recordConnEvent(evtType pb.HolePunchEventType, conn network.Conn) {
+	h.recordEvent(conn.RemotePeer(), &HolePunchEvent{
+		Type:        evtType,
+		Timestamp:   time.Now(),
+		RemoteAddrs: []multiaddr.Multiaddr{conn.RemoteMultiaddr()},
+		Direction:   conn.Stat().Direction.String(),
+	})
+}
+
+func (h *Host) Listen(network.Network, multiaddr.Multiaddr)      {}
+func (h *Host) ListenClose(network.Network, multiaddr.Multiaddr) {}
+
+func (h *Host) Connected(_ network.Network, conn network.Conn) {
+	h.recordConnEvent(pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_CONNECTION_OPENED, conn)
+}
+
+func (h *Host) Disconnected(_ network.Network, conn network.Conn) {
+	h.recordConnEvent(pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_CONNECTION_CLOSED, conn)
+}
+
+func (hpe *HolePunchEvent) ToProto() *pb.HolePunchEvent {
+	maddrs := make([][]byte, len(hpe.RemoteAddrs))
+	for i, maddr := range hpe.RemoteAddrs {
+		maddrs[i] = maddr.Bytes()
+	}
+
+	var attempt *int32
+	if hpe.Attempt != nil {
+		a := int32(*hpe.Attempt)
+		attempt = &a
+	}
+
+	var errStr *string
+	if hpe.Error != "" {
+		errStr = &hpe.Error
+	}
+
+	var direction *string
+	if hpe.Direction != "" {
+		direction = &hpe.Direction
+	}
+
+	return &pb.HolePunchEvent{
+		Type:           &hpe.Type,
+		Timestamp:      toUnixNanos(hpe.Timestamp),
+		MultiAddresses: maddrs,
+		Attempt:        attempt,
+		Success:        hpe.Success,
+		ElapsedTime:    toSeconds(hpe.ElapsedTime),
+		Rtt:            toSeconds(hpe.RTT),
+		Error:          errStr,
+		Direction:      direction,
+	}
+}
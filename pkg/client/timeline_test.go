@@ -0,0 +1,52 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func TestHost_recordTracerEvent(t *testing.T) {
+	h := &Host{}
+	remoteID := peer.ID("remote")
+
+	now := time.Now()
+	events := []*holepunch.Event{
+		{Timestamp: now.UnixNano(), Remote: remoteID, Evt: &holepunch.StartHolePunchEvt{RemoteAddrs: []string{"/ip4/1.2.3.4/tcp/1234"}, RTT: time.Second}},
+		{Timestamp: now.UnixNano() + 1, Remote: remoteID, Evt: &holepunch.HolePunchAttemptEvt{Attempt: 1}},
+		{Timestamp: now.UnixNano() + 2, Remote: remoteID, Evt: &holepunch.EndHolePunchEvt{Success: false, Error: "some error"}},
+	}
+
+	// events of untracked peers are not recorded
+	h.recordTracerEvent(events[0])
+	assert.Len(t, h.UnregisterTimeline(remoteID), 0)
+
+	h.RegisterTimeline(remoteID)
+	for _, evt := range events {
+		h.recordTracerEvent(evt)
+	}
+	timeline := h.UnregisterTimeline(remoteID)
+	require.Len(t, timeline, 3)
+
+	start := timeline[0].ToProto()
+	assert.Equal(t, pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_START_HOLE_PUNCH, start.GetType())
+	assert.Equal(t, uint64(now.UnixNano()), start.GetTimestamp())
+	assert.Len(t, start.MultiAddresses, 1)
+	assert.EqualValues(t, 1, start.GetRtt())
+
+	attempt := timeline[1].ToProto()
+	assert.Equal(t, pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_HOLE_PUNCH_ATTEMPT, attempt.GetType())
+	assert.EqualValues(t, 1, attempt.GetAttempt())
+	assert.Nil(t, attempt.Error)
+
+	end := timeline[2].ToProto()
+	assert.Equal(t, pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_END_HOLE_PUNCH, end.GetType())
+	assert.False(t, end.GetSuccess())
+	assert.Equal(t, "some error", end.GetError())
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+// Timing holds the timing parameters of the DCUtR protocol for a hole punch.
+type Timing struct {
+	// CommunicationTimeout is how long the client waits for the /libp2p/dcutr stream and for the events of a hole punch attempt.
+	CommunicationTimeout time.Duration
+
+	// RetryCount is how many hole punch attempts the client waits for. It's an observation window,
+	// not a protocol parameter: the remote peer starts the hole punch and makes its own fixed number
+	// of attempts (3 in go-libp2p). Waiting for more attempts than the remote makes turns failures
+	// into NO_STREAM outcomes, and waiting for fewer misses later successes.
+	RetryCount int
+
+	// PingDuration is how long the client measures the round trip time to a peer.
+	PingDuration time.Duration
+
+	// MaxPingCount is how many pings the client sends to a peer to measure the round trip time.
+	MaxPingCount int
+}
+
+// DefaultTiming is the timing that the client uses if neither the flags nor the server set other values.
+var DefaultTiming = Timing{
+	CommunicationTimeout: 30 * time.Second,
+	RetryCount:           3,
+	PingDuration:         10 * time.Second,
+	MaxPingCount:         10,
+}
+
+// timingFromConfig returns the timing that is configured with flags.
+func timingFromConfig(c *cli.Context) Timing {
+	return Timing{
+		CommunicationTimeout: c.Duration("communication-timeout"),
+		RetryCount:           c.Int("retry-count"),
+		PingDuration:         c.Duration("ping-duration"),
+		MaxPingCount:         c.Int("max-ping-count"),
+	}
+}
+
+// validate returns an error if a parameter isn't positive.
+func (t Timing) validate() error {
+	if t.CommunicationTimeout <= 0 {
+		return fmt.Errorf("communication-timeout must be positive")
+	}
+
+	if t.RetryCount <= 0 {
+		return fmt.Errorf("retry-count must be positive")
+	}
+
+	if t.PingDuration <= 0 {
+		return fmt.Errorf("ping-duration must be positive")
+	}
+
+	if t.MaxPingCount <= 0 {
+		return fmt.Errorf("max-ping-count must be positive")
+	}
+
+	return nil
+}
+
+// apply returns the timing with the parameters that the server set for a hole punch.
+// Parameters that aren't set or aren't positive are kept.
+func (t Timing) apply(params *pb.DCUtRParameters) Timing {
+	if params == nil {
+		return t
+	}
+
+	if params.CommunicationTimeout != nil && params.GetCommunicationTimeout() > 0 {
+		t.CommunicationTimeout = secondsToDuration(params.GetCommunicationTimeout())
+	}
+
+	if params.RetryCount != nil && params.GetRetryCount() > 0 {
+		t.RetryCount = int(params.GetRetryCount())
+	}
+
+	if params.PingDuration != nil && params.GetPingDuration() > 0 {
+		t.PingDuration = secondsToDuration(params.GetPingDuration())
+	}
+
+	if params.MaxPingCount != nil && params.GetMaxPingCount() > 0 {
+		t.MaxPingCount = int(params.GetMaxPingCount())
+	}
+
+	return t
+}
+
+func (t Timing) toProto() *pb.DCUtRParameters {
+	communicationTimeout := float32(t.CommunicationTimeout.Seconds())
+	retryCount := int32(t.RetryCount)
+	pingDuration := float32(t.PingDuration.Seconds())
+	maxPingCount := int32(t.MaxPingCount)
+
+	return &pb.DCUtRParameters{
+		CommunicationTimeout: &communicationTimeout,
+		RetryCount:           &retryCount,
+		PingDuration:         &pingDuration,
+		MaxPingCount:         &maxPingCount,
+	}
+}
+
+func secondsToDuration(seconds float32) time.Duration {
+	return time.Duration(float64(seconds) * float64(time.Second))
+}
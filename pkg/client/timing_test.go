@@ -0,0 +1,82 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func TestTiming_apply(t *testing.T) {
+	float32Ptr := func(f float32) *float32 { return &f }
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	tests := []struct {
+		name   string
+		params *pb.DCUtRParameters
+		want   Timing
+	}{
+		{name: "no parameters", params: nil, want: DefaultTiming},
+		{name: "empty parameters", params: &pb.DCUtRParameters{}, want: DefaultTiming},
+		{
+			name:   "partial parameters",
+			params: &pb.DCUtRParameters{RetryCount: int32Ptr(5), CommunicationTimeout: float32Ptr(15)},
+			want: Timing{
+				CommunicationTimeout: 15 * time.Second,
+				RetryCount:           5,
+				PingDuration:         DefaultTiming.PingDuration,
+				MaxPingCount:         DefaultTiming.MaxPingCount,
+			},
+		},
+		{
+			name: "all parameters",
+			params: &pb.DCUtRParameters{
+				CommunicationTimeout: float32Ptr(0.5),
+				RetryCount:           int32Ptr(1),
+				PingDuration:         float32Ptr(2),
+				MaxPingCount:         int32Ptr(3),
+			},
+			want: Timing{
+				CommunicationTimeout: 500 * time.Millisecond,
+				RetryCount:           1,
+				PingDuration:         2 * time.Second,
+				MaxPingCount:         3,
+			},
+		},
+		{
+			name:   "non-positive parameters are ignored",
+			params: &pb.DCUtRParameters{RetryCount: int32Ptr(0), PingDuration: float32Ptr(-1)},
+			want:   DefaultTiming,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DefaultTiming.apply(tt.params))
+		})
+	}
+}
+
+func TestTiming_toProto(t *testing.T) {
+	timing := Timing{
+		CommunicationTimeout: 15 * time.Second,
+		RetryCount:           5,
+		PingDuration:         2 * time.Second,
+		MaxPingCount:         3,
+	}
+
+	assert.Equal(t, timing, DefaultTiming.apply(timing.toProto()))
+}
+
+func TestTiming_validate(t *testing.T) {
+	assert.NoError(t, DefaultTiming.validate())
+
+	invalid := DefaultTiming
+	invalid.RetryCount = 0
+	assert.Error(t, invalid.validate())
+
+	invalid = DefaultTiming
+	invalid.CommunicationTimeout = 0
+	assert.Error(t, invalid.validate())
+}
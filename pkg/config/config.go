@@ -0,0 +1,421 @@
+// Package config loads the settings of the punchr binaries from configuration files.
+//
+// A configuration file is a flat TOML, YAML or JSON document whose keys are the names of the
+// command line flags of the respective binary, e.g., db-host or rate-limit-key. Flags and
+// environment variables take precedence over the file. A subset of the settings can be
+// reloaded from the file without a restart by sending SIGHUP to the process (see Watch).
+package config
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	// FlagConfig is the name of the flag that points to the configuration file.
+	FlagConfig = "config"
+
+	// FlagPrintConfig is the name of the flag that prints the effective configuration.
+	FlagPrintConfig = "print-config"
+
+	// FlagLogLevel is the name of the flag that configures the log level. It's always reloadable.
+	FlagLogLevel = "log-level"
+)
+
+// metadataKey is the key of the loaded configuration file in the metadata of the cli.App.
+const metadataKey = "config"
+
+// reservedFlags can't be set from a configuration file.
+var reservedFlags = map[string]bool{
+	FlagConfig:      true,
+	FlagPrintConfig: true,
+	"help":          true,
+	"version":       true,
+}
+
+// secretFlags are redacted when the effective configuration is printed.
+var secretFlags = map[string]bool{
+	"db-password":    true,
+	"db-replica-dsn": true,
+	"api-key":        true,
+}
+
+// Flags returns the flags that control the configuration file of a binary. The
+// environment variables of the flags are prefixed with envPrefix, e.g., PUNCHR_SERVER.
+func Flags(envPrefix string, defaultLogLevel string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:      FlagConfig,
+			Usage:     "Load settings from `FILE` (toml, yaml or json). Flags and environment variables take precedence. Send SIGHUP to reload",
+			EnvVars:   []string{envPrefix + "_CONFIG"},
+			TakesFile: true,
+		},
+		&cli.BoolFlag{
+			Name:  FlagPrintConfig,
+			Usage: "Print the effective configuration and exit",
+		},
+		&cli.StringFlag{
+			Name:        FlagLogLevel,
+			Usage:       "The log level (panic, fatal, error, warn, info, debug, trace)",
+			EnvVars:     []string{envPrefix + "_LOG_LEVEL"},
+			Value:       defaultLogLevel,
+			DefaultText: defaultLogLevel,
+		},
+	}
+}
+
+// ValidateFunc checks the semantics of the settings of a binary. It's
+// called with the initial settings and whenever they are reloaded.
+type ValidateFunc func(c *cli.Context) error
+
+// File is a loaded configuration file.
+type File struct {
+	path     string
+	validate ValidateFunc
+
+	// explicit contains all flags that were set on the command line or by
+	// environment variables. The file doesn't override these on reload.
+	explicit map[string]bool
+
+	// values contains the raw values of the last successfully loaded file.
+	values map[string][]string
+}
+
+// Before returns a cli.BeforeFunc that loads the configuration file, applies
+// its values to all flags that weren't set explicitly, validates the result and
+// sets the log level. It also runs if no configuration file is given.
+func Before(validate ValidateFunc) cli.BeforeFunc {
+	return func(c *cli.Context) error {
+		f, err := Load(c, validate)
+		if err != nil {
+			return err
+		}
+
+		if c.App.Metadata == nil {
+			c.App.Metadata = map[string]interface{}{}
+		}
+		c.App.Metadata[metadataKey] = f
+
+		return nil
+	}
+}
+
+// Load reads the configuration file of the --config flag and applies its values to c.
+func Load(c *cli.Context, validate ValidateFunc) (*File, error) {
+	f := &File{
+		path:     c.String(FlagConfig),
+		validate: validate,
+		explicit: map[string]bool{},
+		values:   map[string][]string{},
+	}
+
+	for _, fl := range c.App.Flags {
+		name := fl.Names()[0]
+		if c.IsSet(name) {
+			f.explicit[name] = true
+		}
+	}
+
+	if f.path != "" {
+		values, err := f.read(c.App.Flags)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, vals := range values {
+			if f.explicit[name] {
+				continue
+			}
+			for _, val := range vals {
+				if err = c.Set(name, val); err != nil {
+					return nil, errors.Wrapf(err, "invalid value for %s in %s", name, f.path)
+				}
+			}
+		}
+		f.values = values
+	}
+
+	if err := f.check(c); err != nil {
+		return nil, err
+	}
+
+	return f, setLogLevel(c)
+}
+
+// FromContext returns the configuration file that was loaded by the BeforeFunc.
+func FromContext(c *cli.Context) (*File, bool) {
+	f, ok := c.App.Metadata[metadataKey].(*File)
+	return f, ok
+}
+
+// read parses the configuration file and converts all values to their
+// string representations. Lists are only allowed for slice flags.
+func (f *File) read(flags []cli.Flag) (map[string][]string, error) {
+	v := viper.New()
+	v.SetConfigFile(f.path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, errors.Wrapf(err, "read config file %s", f.path)
+	}
+
+	known := map[string]cli.Flag{}
+	for _, fl := range flags {
+		known[fl.Names()[0]] = fl
+	}
+
+	values := map[string][]string{}
+	for name, raw := range v.AllSettings() {
+		fl, found := known[name]
+		if !found || reservedFlags[name] {
+			return nil, fmt.Errorf("unknown setting %s in %s", name, f.path)
+		}
+
+		vals, err := toStrings(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s in %s", name, f.path)
+		}
+
+		if _, isSlice := fl.(*cli.StringSliceFlag); !isSlice && len(vals) != 1 {
+			return nil, fmt.Errorf("invalid value for %s in %s: expected a single value", name, f.path)
+		}
+
+		values[name] = vals
+	}
+
+	return values, nil
+}
+
+func toStrings(raw interface{}) ([]string, error) {
+	switch val := raw.(type) {
+	case []interface{}:
+		vals := make([]string, 0, len(val))
+		for _, elem := range val {
+			s, err := toString(elem)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, s)
+		}
+		return vals, nil
+	default:
+		s, err := toString(val)
+		if err != nil {
+			return nil, err
+		}
+		return []string{s}, nil
+	}
+}
+
+func toString(raw interface{}) (string, error) {
+	switch val := raw.(type) {
+	case string:
+		return val, nil
+	case bool, int, int64, uint64:
+		return fmt.Sprint(val), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	case time.Duration:
+		return val.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported type %T", raw)
+	}
+}
+
+// check validates the settings in c.
+func (f *File) check(c *cli.Context) error {
+	if _, err := log.ParseLevel(c.String(FlagLogLevel)); err != nil {
+		return errors.Wrap(err, "invalid log level")
+	}
+
+	if f.validate == nil {
+		return nil
+	}
+
+	return errors.Wrap(f.validate(c), "invalid configuration")
+}
+
+func setLogLevel(c *cli.Context) error {
+	level, err := log.ParseLevel(c.String(FlagLogLevel))
+	if err != nil {
+		return errors.Wrap(err, "invalid log level")
+	}
+	log.SetLevel(level)
+	return nil
+}
+
+// Reload reads the configuration file again and returns a context that resolves the given
+// reloadable flags to their new values. All other flags resolve to their values in c. Flags
+// that were set explicitly keep their values. Reloadable flags that were removed from the
+// file fall back to their defaults. The log level is always reloaded.
+func (f *File) Reload(c *cli.Context, reloadable ...string) (*cli.Context, error) {
+	if f.path == "" {
+		return nil, fmt.Errorf("no config file given")
+	}
+
+	values, err := f.read(c.App.Flags)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{FlagLogLevel: true}
+	for _, name := range reloadable {
+		names[name] = true
+	}
+
+	set := flag.NewFlagSet(c.App.Name, flag.ContinueOnError)
+	for _, fl := range c.App.Flags {
+		name := fl.Names()[0]
+		if !names[name] || f.explicit[name] {
+			continue
+		}
+
+		if err = fl.Apply(set); err != nil {
+			return nil, errors.Wrapf(err, "apply flag %s", name)
+		}
+
+		for _, val := range values[name] {
+			if err = set.Set(name, val); err != nil {
+				return nil, errors.Wrapf(err, "invalid value for %s in %s", name, f.path)
+			}
+		}
+	}
+
+	for name, vals := range values {
+		if !names[name] && !f.explicit[name] && !equal(vals, f.values[name]) {
+			log.WithField("setting", name).Warnln("Changing this setting requires a restart")
+		}
+	}
+
+	reloaded := cli.NewContext(c.App, set, c)
+	if err = f.check(reloaded); err != nil {
+		return nil, err
+	}
+
+	f.values = values
+
+	return reloaded, setLogLevel(reloaded)
+}
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Watch reloads the configuration file whenever the process receives SIGHUP and passes the
+// reloaded settings to apply (see Reload). A failed reload keeps the previous settings.
+// Call the returned function to stop watching.
+func Watch(c *cli.Context, apply func(c *cli.Context) error, reloadable ...string) func() {
+	ctx, cancel := context.WithCancel(c.Context)
+
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(sighup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sighup:
+			}
+
+			f, found := FromContext(c)
+			if !found || f.path == "" {
+				log.Warnln("Received SIGHUP but no config file was given")
+				continue
+			}
+
+			logEntry := log.WithField("file", filepath.Base(f.path))
+
+			reloaded, err := f.Reload(c, reloadable...)
+			if err != nil {
+				logEntry.WithError(err).Warnln("Could not reload config file, keeping the previous settings")
+				continue
+			}
+
+			if apply != nil {
+				if err = apply(reloaded); err != nil {
+					logEntry.WithError(err).Warnln("Could not apply reloaded settings")
+					continue
+				}
+			}
+
+			logEntry.Infoln("Reloaded config file")
+		}
+	}()
+
+	return cancel
+}
+
+// Print writes the effective configuration of c to w in the format of a TOML configuration file.
+func Print(w io.Writer, c *cli.Context) error {
+	var lines []string
+	for _, fl := range c.App.Flags {
+		name := fl.Names()[0]
+		if reservedFlags[name] {
+			continue
+		}
+
+		var value string
+		switch fl.(type) {
+		case *cli.StringFlag:
+			value = strconv.Quote(c.String(name))
+		case *cli.BoolFlag:
+			value = strconv.FormatBool(c.Bool(name))
+		case *cli.IntFlag:
+			value = strconv.Itoa(c.Int(name))
+		case *cli.Int64Flag:
+			value = strconv.FormatInt(c.Int64(name), 10)
+		case *cli.Float64Flag:
+			value = strconv.FormatFloat(c.Float64(name), 'f', -1, 64)
+		case *cli.DurationFlag:
+			value = strconv.Quote(c.Duration(name).String())
+		case *cli.StringSliceFlag:
+			quoted := []string{}
+			for _, s := range c.StringSlice(name) {
+				quoted = append(quoted, strconv.Quote(s))
+			}
+			value = "[" + strings.Join(quoted, ", ") + "]"
+		default:
+			continue
+		}
+
+		if secretFlags[name] && c.IsSet(name) {
+			value = strconv.Quote("****")
+		}
+
+		lines = append(lines, fmt.Sprintf("%s = %s", name, value))
+	}
+
+	_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+	return err
+}
+
+// JoinFlags concatenates the given flag lists, e.g., the flags of a binary and the shared Flags.
+func JoinFlags(lists ...[]cli.Flag) []cli.Flag {
+	var flags []cli.Flag
+	for _, list := range lists {
+		flags = append(flags, list...)
+	}
+	return flags
+}
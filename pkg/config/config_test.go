@@ -0,0 +1,239 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newTestApp returns an app with a few flags of every type whose action calls action.
+func newTestApp(validate ValidateFunc, action cli.ActionFunc) *cli.App {
+	return &cli.App{
+		Name:   "test",
+		Before: Before(validate),
+		Action: action,
+		Flags: JoinFlags([]cli.Flag{
+			&cli.StringFlag{Name: "host", Value: "localhost", EnvVars: []string{"PUNCHR_TEST_HOST"}},
+			&cli.StringFlag{Name: "db-password", Value: "password"},
+			&cli.IntFlag{Name: "count", Value: 10},
+			&cli.Float64Flag{Name: "rate", Value: 1.5},
+			&cli.BoolFlag{Name: "enabled"},
+			&cli.DurationFlag{Name: "ttl", Value: time.Minute},
+			&cli.StringSliceFlag{Name: "peers"},
+		}, Flags("PUNCHR_TEST", "info")),
+	}
+}
+
+func writeFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	defer log.SetLevel(log.GetLevel())
+
+	toml := writeFile(t, "punchr.toml", `
+host = "file.host"
+count = 3
+rate = 0.5
+enabled = true
+ttl = "5m"
+peers = ["a", "b"]
+log-level = "debug"
+`)
+
+	yaml := writeFile(t, "punchr.yaml", `
+host: file.host
+count: 3
+rate: 0.5
+enabled: true
+ttl: 5m
+peers:
+  - a
+  - b
+log-level: debug
+`)
+
+	json := writeFile(t, "punchr.json", `{"host": "file.host", "count": 3, "rate": 0.5, "enabled": true, "ttl": "5m", "peers": ["a", "b"], "log-level": "debug"}`)
+
+	for _, path := range []string{toml, yaml, json} {
+		t.Run(filepath.Ext(path), func(t *testing.T) {
+			var called bool
+			app := newTestApp(nil, func(c *cli.Context) error {
+				called = true
+				assert.Equal(t, "file.host", c.String("host"))
+				assert.Equal(t, 3, c.Int("count"))
+				assert.Equal(t, 0.5, c.Float64("rate"))
+				assert.True(t, c.Bool("enabled"))
+				assert.Equal(t, 5*time.Minute, c.Duration("ttl"))
+				assert.Equal(t, []string{"a", "b"}, c.StringSlice("peers"))
+				assert.Equal(t, log.DebugLevel, log.GetLevel())
+				return nil
+			})
+
+			require.NoError(t, app.Run([]string{"test", "--config", path}))
+			assert.True(t, called)
+		})
+	}
+}
+
+func TestLoad_precedence(t *testing.T) {
+	path := writeFile(t, "punchr.toml", `
+host = "file.host"
+count = 3
+rate = 0.5
+`)
+
+	t.Setenv("PUNCHR_TEST_HOST", "env.host")
+
+	app := newTestApp(nil, func(c *cli.Context) error {
+		assert.Equal(t, "env.host", c.String("host"))
+		assert.Equal(t, 5, c.Int("count"))
+		assert.Equal(t, 0.5, c.Float64("rate"))
+		assert.Equal(t, time.Minute, c.Duration("ttl"))
+		return nil
+	})
+
+	require.NoError(t, app.Run([]string{"test", "--config", path, "--count", "5"}))
+}
+
+func TestLoad_invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		validate ValidateFunc
+	}{
+		{name: "unknown setting", content: `foo = 1`},
+		{name: "reserved setting", content: `config = "other.toml"`},
+		{name: "invalid value", content: `count = "many"`},
+		{name: "list for scalar flag", content: `host = ["a", "b"]`},
+		{name: "nested table", content: "[db]\nhost = \"a\""},
+		{name: "invalid log level", content: `log-level = "verbose"`},
+		{
+			name:    "validation",
+			content: `count = -1`,
+			validate: func(c *cli.Context) error {
+				if c.Int("count") < 0 {
+					return fmt.Errorf("count must not be negative")
+				}
+				return nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeFile(t, "punchr.toml", tt.content)
+			app := newTestApp(tt.validate, func(c *cli.Context) error {
+				t.Fatal("action must not be called")
+				return nil
+			})
+			assert.Error(t, app.Run([]string{"test", "--config", path}))
+		})
+	}
+}
+
+func TestLoad_missingFile(t *testing.T) {
+	app := newTestApp(nil, func(c *cli.Context) error { return nil })
+	assert.Error(t, app.Run([]string{"test", "--config", filepath.Join(t.TempDir(), "missing.toml")}))
+}
+
+func TestFile_Reload(t *testing.T) {
+	defer log.SetLevel(log.GetLevel())
+
+	path := writeFile(t, "punchr.toml", `
+host = "file.host"
+count = 3
+rate = 0.5
+ttl = "5m"
+`)
+
+	app := newTestApp(nil, func(c *cli.Context) error {
+		f, found := FromContext(c)
+		require.True(t, found)
+
+		require.NoError(t, os.WriteFile(path, []byte(`
+host = "other.host"
+count = 4
+ttl = "10m"
+log-level = "warn"
+`), 0o600))
+
+		reloaded, err := f.Reload(c, "count", "rate", "ttl")
+		require.NoError(t, err)
+
+		// Not reloadable
+		assert.Equal(t, "file.host", reloaded.String("host"))
+		// Set explicitly
+		assert.Equal(t, 7, reloaded.Int("count"))
+		// Removed from the file
+		assert.Equal(t, 1.5, reloaded.Float64("rate"))
+		// Changed in the file
+		assert.Equal(t, 10*time.Minute, reloaded.Duration("ttl"))
+		assert.Equal(t, log.WarnLevel, log.GetLevel())
+
+		// The original context is untouched
+		assert.Equal(t, 5*time.Minute, c.Duration("ttl"))
+		assert.Equal(t, 0.5, c.Float64("rate"))
+
+		// Invalid files keep the previous settings
+		require.NoError(t, os.WriteFile(path, []byte(`ttl = "soon"`), 0o600))
+		_, err = f.Reload(c, "ttl")
+		assert.Error(t, err)
+		assert.Equal(t, log.WarnLevel, log.GetLevel())
+
+		return nil
+	})
+
+	require.NoError(t, app.Run([]string{"test", "--config", path, "--count", "7"}))
+}
+
+func TestFile_Reload_withoutFile(t *testing.T) {
+	app := newTestApp(nil, func(c *cli.Context) error {
+		f, found := FromContext(c)
+		require.True(t, found)
+
+		_, err := f.Reload(c)
+		assert.Error(t, err)
+		return nil
+	})
+
+	require.NoError(t, app.Run([]string{"test"}))
+}
+
+func TestPrint(t *testing.T) {
+	var printed bytes.Buffer
+	app := newTestApp(nil, func(c *cli.Context) error {
+		return Print(&printed, c)
+	})
+	require.NoError(t, app.Run([]string{"test", "--host", "cli.host", "--peers", "a", "--peers", "b", "--ttl", "90s", "--db-password", "secret"}))
+
+	assert.Equal(t, `host = "cli.host"
+db-password = "****"
+count = 10
+rate = 1.5
+enabled = false
+ttl = "1m30s"
+peers = ["a", "b"]
+log-level = "info"
+`, printed.String())
+
+	// The printed configuration can be loaded again
+	path := writeFile(t, "punchr.toml", printed.String())
+	app = newTestApp(nil, func(c *cli.Context) error {
+		assert.Equal(t, "cli.host", c.String("host"))
+		assert.Equal(t, 90*time.Second, c.Duration("ttl"))
+		assert.Equal(t, []string{"a", "b"}, c.StringSlice("peers"))
+		return nil
+	})
+	require.NoError(t, app.Run([]string{"test", "--config", path}))
+}
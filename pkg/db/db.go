@@ -6,10 +6,7 @@ import (
 	"embed"
 	"fmt"
 	"github.com/dennis-tra/punchr/pkg/udger"
-	"github.com/golang-migrate/migrate/v4"
-	"github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	manet "github.com/multiformats/go-multiaddr/net"
@@ -19,13 +16,12 @@ import (
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
-	"io/fs"
-	"os"
-	"path/filepath"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/dennis-tra/punchr/pkg/agent"
 	"github.com/dennis-tra/punchr/pkg/maxmind"
 	"github.com/dennis-tra/punchr/pkg/models"
 	"github.com/dennis-tra/punchr/pkg/util"
@@ -37,12 +33,20 @@ var migrations embed.FS
 type Client struct {
 	*sql.DB
 
+	// replica is an optional read replica of the database. It's nil if none is configured.
+	replica *sql.DB
+
 	MMClient *maxmind.Client
 
 	UdgerClient *udger.Client
+
+	// Enricher derives the geo, ASN and data center information of the saved multi
+	// addresses. It only does so after its Run method was called.
+	Enricher *Enricher
 }
 
-func NewClient(c *cli.Context) (*Client, error) {
+// Open opens the primary database with the connection settings of the db-* flags.
+func Open(c *cli.Context) (*sql.DB, error) {
 	log.WithFields(log.Fields{
 		"host":     c.String("db-host"),
 		"port":     c.String("db-port"),
@@ -52,7 +56,6 @@ func NewClient(c *cli.Context) (*Client, error) {
 		"sslmode":  c.String("db-sslmode"),
 	}).Infoln("Connecting to postgres database...")
 
-	// Open database handle
 	srcName := fmt.Sprintf(
 		"host=%s port=%s dbname=%s user=%s password=%s sslmode=%s",
 		c.String("db-host"),
@@ -62,21 +65,45 @@ func NewClient(c *cli.Context) (*Client, error) {
 		c.String("db-password"),
 		c.String("db-sslmode"),
 	)
-	dbh, err := sql.Open("postgres", srcName)
+
+	return open(dbNamePrimary, srcName, poolConfigFromContext(c))
+}
+
+// OpenReader connects to the read replica if one is configured and to the primary database otherwise.
+func OpenReader(c *cli.Context) (*sql.DB, error) {
+	if dsn := c.String("db-replica-dsn"); dsn != "" {
+		log.Infoln("Connecting to postgres read replica...")
+		return open(dbNameReplica, dsn, poolConfigFromContext(c))
+	}
+	return Open(c)
+}
+
+// NewClient connects to the database and verifies that its schema is up-to-date. It applies
+// pending migrations first if the db-auto-migrate flag is set. Otherwise, they need to be
+// applied with the migrate command of the server.
+func NewClient(c *cli.Context) (*Client, error) {
+	dbh, err := Open(c)
 	if err != nil {
-		return nil, errors.Wrap(err, "opening database")
+		return nil, err
 	}
 
-	// Ping database to verify connection.
-	if err = dbh.Ping(); err != nil {
-		return nil, errors.Wrap(err, "pinging database")
+	if err = prepareSchema(c, dbh); err != nil {
+		_ = dbh.Close()
+		return nil, err
 	}
 
-	// Set global database handler
-	boil.SetDB(dbh)
+	// Open read replica if configured
+	var replica *sql.DB
+	if dsn := c.String("db-replica-dsn"); dsn != "" {
+		log.Infoln("Connecting to postgres read replica...")
+		if replica, err = open(dbNameReplica, dsn, poolConfigFromContext(c)); err != nil {
+			_ = dbh.Close()
+			return nil, err
+		}
+	}
 
 	// Create maxmind client to derive geo information
-	mmClient, err := maxmind.NewClient()
+	mmClient, err := maxmind.NewClientFromContext(c)
 	if err != nil {
 		return nil, errors.Wrap(err, "new maxmind client")
 	}
@@ -87,60 +114,62 @@ func NewClient(c *cli.Context) (*Client, error) {
 		return nil, errors.Wrap(err, "new udger client")
 	}
 
-	client := &Client{DB: dbh, MMClient: mmClient, UdgerClient: uclient}
-	client.applyMigrations(c, dbh)
+	enricher, err := NewEnricher(dbh, mmClient, uclient, EnricherConfig{
+		Workers:   c.Int("enrichment-workers"),
+		CacheSize: c.Int("enrichment-cache-size"),
+		Interval:  c.Duration("enrichment-interval"),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "new enricher")
+	}
 
-	return client, nil
+	return &Client{DB: dbh, replica: replica, MMClient: mmClient, UdgerClient: uclient, Enricher: enricher}, nil
 }
 
-func (c *Client) applyMigrations(ctx *cli.Context, dbh *sql.DB) {
-	tmpDir, err := os.MkdirTemp("", "punchr-"+ctx.App.Version)
-	if err != nil {
-		log.WithError(err).WithField("pattern", "punchr-"+ctx.App.Version).Warnln("Could not create tmp directory for migrations")
-		return
+// Reader returns the read replica if one is configured and the primary database otherwise.
+// Only use it for queries that tolerate replication lag.
+func (c *Client) Reader() *sql.DB {
+	if c.replica != nil {
+		return c.replica
 	}
-	defer func() {
-		if err = os.RemoveAll(tmpDir); err != nil {
-			log.WithError(err).WithField("tmpDir", tmpDir).Warnln("Could not clean up tmp directory")
-		}
-	}()
-	log.WithField("dir", tmpDir).Debugln("Created temporary directory")
-
-	err = fs.WalkDir(migrations, ".", func(path string, d fs.DirEntry, err error) error {
-		join := filepath.Join(tmpDir, path)
-		if d.IsDir() {
-			return os.MkdirAll(join, 0o755)
-		}
+	return c.DB
+}
 
-		data, err := migrations.ReadFile(path)
-		if err != nil {
-			return errors.Wrap(err, "read file")
+// Close closes the primary database, the read replica, the geo and the Udger databases.
+func (c *Client) Close() error {
+	if err := c.MMClient.Close(); err != nil {
+		log.WithError(err).Warnln("Could not close geo databases")
+	}
+	if err := c.UdgerClient.Close(); err != nil {
+		log.WithError(err).Warnln("Could not close Udger database")
+	}
+	if c.replica != nil {
+		if err := c.replica.Close(); err != nil {
+			log.WithError(err).Warnln("Could not close read replica")
 		}
-
-		return os.WriteFile(join, data, 0o644)
-	})
-	if err != nil {
-		log.WithError(err).Warnln("Could not create migrations files")
-		return
 	}
+	return c.DB.Close()
+}
 
-	// Apply migrations
-	driver, err := postgres.WithInstance(dbh, &postgres.Config{})
+// prepareSchema applies pending migrations if requested and checks that the schema is up-to-date.
+func prepareSchema(c *cli.Context, dbh *sql.DB) error {
+	mg, err := NewMigrator(c.Context, dbh)
 	if err != nil {
-		log.WithError(err).Warnln("Could not create driver instance")
-		return
+		return errors.Wrap(err, "new migrator")
 	}
+	defer func() {
+		if err := mg.Close(); err != nil {
+			log.WithError(err).Warnln("Could not close migrator")
+		}
+	}()
 
-	m, err := migrate.NewWithDatabaseInstance("file://"+filepath.Join(tmpDir, "migrations"), ctx.String("db-name"), driver)
-	if err != nil {
-		log.WithError(err).Warnln("Could not create migrate instance")
-		return
+	if c.Bool("db-auto-migrate") {
+		if err = mg.Up(0); err != nil {
+			return err
+		}
 	}
 
-	if err = m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		log.WithError(err).Warnln("Couldn't apply migrations")
-		return
-	}
+	return mg.Check()
 }
 
 // DeferRollback calls rollback on the given transaction and logs the potential error.
@@ -150,12 +179,19 @@ func DeferRollback(txn *sql.Tx) {
 	}
 }
 
+// IsUniqueViolation returns true if the error was caused by a violation of the given unique constraint.
+func IsUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" && pqErr.Constraint == constraint
+}
+
 func (c *Client) UpsertPeer(ctx context.Context, exec boil.ContextExecutor, pid peer.ID, agentVersion *string, protocols []string) (*models.Peer, error) {
 	dbPeer := &models.Peer{
 		MultiHash:    pid.String(),
 		AgentVersion: null.StringFromPtr(agentVersion),
 		Protocols:    protocols,
 	}
+	setAgentClassification(dbPeer)
 
 	err := dbPeer.Upsert(
 		ctx,
@@ -166,12 +202,68 @@ func (c *Client) UpsertPeer(ctx context.Context, exec boil.ContextExecutor, pid
 			models.PeerColumns.UpdatedAt,
 			models.PeerColumns.AgentVersion,
 			models.PeerColumns.Protocols,
+			models.PeerColumns.Implementation,
+			models.PeerColumns.ImplementationVersion,
+			models.PeerColumns.GoLibp2pVersion,
+			models.PeerColumns.ClassifiedAgentVersion,
 		),
 		boil.Infer(),
 	)
 	return dbPeer, err
 }
 
+// setAgentClassification derives the implementation and version columns from the agent version of the given peer.
+func setAgentClassification(dbPeer *models.Peer) {
+	if !dbPeer.AgentVersion.Valid {
+		dbPeer.Implementation = null.NewString("", false)
+		dbPeer.ImplementationVersion = null.NewString("", false)
+		dbPeer.GoLibp2pVersion = null.NewString("", false)
+		dbPeer.ClassifiedAgentVersion = null.NewString("", false)
+		return
+	}
+
+	info := agent.Classify(dbPeer.AgentVersion.String)
+	dbPeer.Implementation = null.StringFrom(info.Implementation)
+	dbPeer.ImplementationVersion = null.NewString(info.Version, info.Version != "")
+	dbPeer.GoLibp2pVersion = null.NewString(info.GoLibp2pVersion, info.GoLibp2pVersion != "")
+	dbPeer.ClassifiedAgentVersion = dbPeer.AgentVersion
+}
+
+// ClassifyPeers classifies the agent versions of all peers that were saved
+// before the classification columns existed or whose agent version changed
+// since they were classified. It returns the number of updated peers.
+func (c *Client) ClassifyPeers(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		dbPeers, err := models.Peers(
+			models.PeerWhere.AgentVersion.IsNotNull(),
+			qm.Where(models.PeerColumns.ClassifiedAgentVersion+" IS DISTINCT FROM "+models.PeerColumns.AgentVersion),
+			qm.Limit(1000),
+		).All(ctx, c)
+		if err != nil {
+			return count, errors.Wrap(err, "query unclassified peers")
+		}
+
+		if len(dbPeers) == 0 {
+			return count, nil
+		}
+
+		for _, dbPeer := range dbPeers {
+			setAgentClassification(dbPeer)
+			_, err = dbPeer.Update(ctx, c, boil.Whitelist(
+				models.PeerColumns.Implementation,
+				models.PeerColumns.ImplementationVersion,
+				models.PeerColumns.GoLibp2pVersion,
+				models.PeerColumns.ClassifiedAgentVersion,
+			))
+			if err != nil {
+				return count, errors.Wrap(err, "update peer classification")
+			}
+			count += 1
+		}
+	}
+}
+
 func (c *Client) GetAuthorization(ctx context.Context, exec boil.ContextExecutor, apiKey string) (*models.Authorization, error) {
 	return models.Authorizations(models.AuthorizationWhere.APIKey.EQ(apiKey)).One(ctx, exec)
 }
@@ -196,6 +288,28 @@ func (c *Client) UpsertMultiAddresses(ctx context.Context, exec boil.ContextExec
 	return dbMaddrs, nil
 }
 
+// InsertConnectionEventMultiAddresses associates the given multi addresses with the connection event.
+func (c *Client) InsertConnectionEventMultiAddresses(ctx context.Context, exec boil.ContextExecutor, dbConnEvt *models.ConnectionEvent, dbMaddrs []*models.MultiAddress) error {
+	if len(dbMaddrs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(dbMaddrs))
+	for i, dbMaddr := range dbMaddrs {
+		ids[i] = dbMaddr.ID
+	}
+
+	query := `
+INSERT INTO connection_events_x_multi_addresses (connection_event_id, multi_address_id, opened_at)
+SELECT $1, unnest($2::BIGINT[]), $3
+ON CONFLICT DO NOTHING`
+	if _, err := queries.Raw(query, dbConnEvt.ID, pq.Int64Array(ids), dbConnEvt.OpenedAt).ExecContext(ctx, exec); err != nil {
+		return errors.Wrap(err, "insert connection event multi addresses")
+	}
+
+	return nil
+}
+
 func (c *Client) UpsertMultiAddressesSet(ctx context.Context, exec boil.ContextExecutor, dbMaddrs models.MultiAddressSlice) (int, error) {
 	ids := make([]string, len(dbMaddrs))
 	for i, dbMaddr := range dbMaddrs {
@@ -219,104 +333,15 @@ func (c *Client) UpsertMultiAddressesSet(ctx context.Context, exec boil.ContextE
 	return maddrSetID, errors.Wrap(rows.Close(), "close multi address set query rows")
 }
 
-func (c *Client) UpsertIPAddresses(ctx context.Context, exec boil.ContextExecutor, addrInfos map[string]*maxmind.AddrInfo) (models.IPAddressSlice, *string, *string, *int, error) {
-	var (
-		countries     []string
-		continents    []string
-		asns          []int
-		dbIPAddresses []*models.IPAddress
-	)
-
-	for ipAddress, addrInfo := range addrInfos {
-		if addrInfo.Country != "" {
-			countries = append(countries, addrInfo.Country)
-		}
-
-		if addrInfo.Continent != "" {
-			continents = append(continents, addrInfo.Continent)
-		}
-
-		if addrInfo.ASN != 0 {
-			asns = append(asns, int(addrInfo.ASN))
-		}
-
-		dc, err := c.UdgerClient.Datacenter(ipAddress)
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			log.WithError(err).WithField("addr", ipAddress).Warnln("could not extract data center")
-		}
-
-		dbIPAddress := &models.IPAddress{
-			Address:   ipAddress,
-			Country:   null.NewString(addrInfo.Country, addrInfo.Country != ""),
-			Continent: null.NewString(addrInfo.Continent, addrInfo.Continent != ""),
-			Asn:       null.NewInt(int(addrInfo.ASN), addrInfo.ASN != 0),
-			IsCloud:   null.NewInt(dc, dc != 0),
-		}
-
-		err = dbIPAddress.Upsert(
-			ctx,
-			exec,
-			true,
-			[]string{models.IPAddressColumns.Address},
-			boil.Whitelist(models.IPAddressColumns.UpdatedAt),
-			boil.Infer(),
-		)
-		if err != nil {
-			return nil, nil, nil, nil, errors.Wrap(err, "upsert ip address")
-		}
-
-		dbIPAddresses = append(dbIPAddresses, dbIPAddress)
-	}
-
-	return dbIPAddresses, util.Unique(countries), util.Unique(continents), util.Unique(asns), nil
-}
-
+// UpsertMultiAddress saves the multi address. Its geo, ASN and data center information is
+// derived asynchronously by the Enricher, so that DNS lookups and geo database queries
+// don't prolong the transaction of the caller.
 func (c *Client) UpsertMultiAddress(ctx context.Context, exec boil.ContextExecutor, maddr ma.Multiaddr) (*models.MultiAddress, error) {
-	addrInfos, err := c.MMClient.MaddrInfo(ctx, maddr)
-	if err != nil {
-		// That's not an error that should be returned
-		log.WithError(err).Warnln("Could not get multi address information")
-	}
-
 	dbMaddr := &models.MultiAddress{
 		Maddr:    maddr.String(),
 		IsRelay:  null.BoolFrom(util.IsRelayedMaddr(maddr)),
 		IsPublic: null.BoolFrom(manet.IsPublicAddr(maddr)),
 	}
 
-	if len(addrInfos) == 1 {
-		var addrInfo *maxmind.AddrInfo
-		var ipAddress string
-		for ipAddress, addrInfo = range addrInfos {
-			break
-		}
-
-		dc, err := c.UdgerClient.Datacenter(ipAddress)
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			log.WithError(err).WithField("addr", ipAddress).Warnln("could not extract data center")
-		}
-
-		dbMaddr.Asn = null.NewInt(int(addrInfo.ASN), addrInfo.ASN != 0)
-		dbMaddr.IsCloud = null.NewInt(dc, dc != 0)
-		dbMaddr.Addr = null.NewString(ipAddress, ipAddress != "")
-		dbMaddr.Country = null.NewString(addrInfo.Country, addrInfo.Country != "")
-		dbMaddr.Continent = null.NewString(addrInfo.Continent, addrInfo.Continent != "")
-	} else if len(addrInfos) > 1 {
-		dbMaddr.HasManyAddrs = null.NewBool(true, true)
-		dbipAddresses, country, continent, asn, err := c.UpsertIPAddresses(ctx, exec, addrInfos)
-		if err != nil {
-			return nil, errors.Wrap(err, "upsert ip addresses")
-		}
-		dbMaddr.Country = null.StringFromPtr(country)
-		dbMaddr.Continent = null.StringFromPtr(continent)
-		dbMaddr.Asn = null.IntFromPtr(asn)
-
-		for _, ipAddress := range dbipAddresses {
-			if err := ipAddress.SetMultiAddress(ctx, exec, false, dbMaddr); err != nil {
-				return nil, errors.Wrap(err, "assign multi address to ip address")
-			}
-		}
-	}
-
 	return dbMaddr, dbMaddr.Upsert(ctx, exec, true, []string{models.MultiAddressColumns.Maddr}, boil.Whitelist(models.MultiAddressColumns.UpdatedAt), boil.Infer())
 }
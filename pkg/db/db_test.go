@@ -0,0 +1,32 @@
+package db
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	const constraint = "uq_hole_punch_results_allocation_id"
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "no error", err: nil, want: false},
+		{name: "other error", err: fmt.Errorf("connection refused"), want: false},
+		{name: "unique violation", err: &pq.Error{Code: "23505", Constraint: constraint}, want: true},
+		{name: "wrapped unique violation", err: errors.Wrap(&pq.Error{Code: "23505", Constraint: constraint}, "insert hole punch result"), want: true},
+		{name: "other constraint", err: &pq.Error{Code: "23505", Constraint: "uq_other"}, want: false},
+		{name: "other error code", err: &pq.Error{Code: "23503", Constraint: constraint}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsUniqueViolation(tt.err, constraint))
+		})
+	}
+}
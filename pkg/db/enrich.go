@@ -0,0 +1,445 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/dennis-tra/punchr/pkg/maxmind"
+	"github.com/dennis-tra/punchr/pkg/models"
+	"github.com/dennis-tra/punchr/pkg/udger"
+	"github.com/dennis-tra/punchr/pkg/util"
+)
+
+var (
+	enrichedMaddrsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "enrichment_multi_addresses_total",
+		Help: "The number of multi addresses whose geo, ASN and data center information was derived",
+	}, []string{"result"})
+
+	enrichmentCacheCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "enrichment_cache_lookups_total",
+		Help: "The number of IP address lookups that were served from the enrichment cache (hit) or not (miss)",
+	}, []string{"result"})
+)
+
+const (
+	// DefaultEnrichmentWorkers is the default number of multi addresses that are enriched in parallel.
+	DefaultEnrichmentWorkers = 4
+
+	// DefaultEnrichmentCacheSize is the default number of IP addresses whose information is cached.
+	DefaultEnrichmentCacheSize = 10_000
+
+	// DefaultEnrichmentInterval is the default time between two queries for multi addresses to enrich.
+	DefaultEnrichmentInterval = 10 * time.Second
+
+	// enrichmentBatchSize is the number of multi addresses that are queried at once.
+	enrichmentBatchSize = 1000
+)
+
+// EnricherConfig configures an Enricher. Zero values fall back to the defaults.
+type EnricherConfig struct {
+	Workers   int
+	CacheSize int
+	Interval  time.Duration
+}
+
+// enrichedColumns are the columns of a multi address that the Enricher derives.
+var enrichedColumns = boil.Whitelist(
+	models.MultiAddressColumns.Asn,
+	models.MultiAddressColumns.IsCloud,
+	models.MultiAddressColumns.Addr,
+	models.MultiAddressColumns.HasManyAddrs,
+	models.MultiAddressColumns.Country,
+	models.MultiAddressColumns.Continent,
+	models.MultiAddressColumns.City,
+	models.MultiAddressColumns.ConnectionType,
+	models.MultiAddressColumns.EnrichmentVersion,
+)
+
+// enrichTask is a multi address that needs to be enriched.
+type enrichTask struct {
+	id      int64
+	maddr   string
+	version string
+}
+
+// addrEnrichment is the derived information of an IP address.
+type addrEnrichment struct {
+	info       *maxmind.AddrInfo
+	datacenter int
+}
+
+// Enricher derives the geo, ASN and data center information of multi addresses after they
+// were saved. It periodically queries the multi addresses that weren't enriched yet or were
+// enriched with other geo databases and processes them in a pool of workers. The information
+// of IP addresses is cached, so that the DNS resolution is the only per-address cost for the
+// many multi addresses that share an IP address.
+//
+// The geo databases of all processes that run an enricher should be the same. Otherwise,
+// they re-enrich the multi addresses of each other.
+type Enricher struct {
+	dbh   *sql.DB
+	mm    *maxmind.Client
+	udger *udger.Client
+	conf  EnricherConfig
+	cache *lru.Cache
+
+	// lookupAddr derives the information of an IP address. It's replaced in tests.
+	lookupAddr func(addr string) *addrEnrichment
+
+	// inflight holds the IDs of the multi addresses that are queued or being
+	// enriched, so that they aren't queued twice by subsequent queries.
+	inflightLk sync.Mutex
+	inflight   map[int64]struct{}
+
+	// version is the data version with which all multi addresses were enriched
+	// by the last complete run. It's empty until the first run completed.
+	version string
+}
+
+// NewEnricher initializes an enricher. Call Run to start enriching multi addresses.
+func NewEnricher(dbh *sql.DB, mm *maxmind.Client, uclient *udger.Client, conf EnricherConfig) (*Enricher, error) {
+	if conf.Workers <= 0 {
+		conf.Workers = DefaultEnrichmentWorkers
+	}
+	if conf.CacheSize <= 0 {
+		conf.CacheSize = DefaultEnrichmentCacheSize
+	}
+	if conf.Interval <= 0 {
+		conf.Interval = DefaultEnrichmentInterval
+	}
+
+	cache, err := lru.New(conf.CacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "new lru cache")
+	}
+
+	e := &Enricher{
+		dbh:      dbh,
+		mm:       mm,
+		udger:    uclient,
+		conf:     conf,
+		cache:    cache,
+		inflight: map[int64]struct{}{},
+	}
+
+	e.lookupAddr = func(addr string) *addrEnrichment {
+		info, err := mm.AddrInfo(addr)
+		if err != nil {
+			log.WithError(err).WithField("addr", addr).Debugln("Could not derive geo information")
+			info = &maxmind.AddrInfo{}
+		}
+
+		dc, err := uclient.Datacenter(addr)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.WithError(err).WithField("addr", addr).Warnln("could not extract data center")
+		}
+
+		return &addrEnrichment{info: info, datacenter: dc}
+	}
+
+	return e, nil
+}
+
+// Run enriches multi addresses until the context is cancelled.
+func (e *Enricher) Run(ctx context.Context) {
+	tasks := make(chan enrichTask)
+
+	var wg sync.WaitGroup
+	for i := 0; i < e.conf.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range tasks {
+				if err := e.enrich(ctx, task); err != nil {
+					log.WithError(err).WithField("maddr", task.maddr).Warnln("Could not enrich multi address")
+					enrichedMaddrsCounter.WithLabelValues("error").Inc()
+				} else {
+					enrichedMaddrsCounter.WithLabelValues("ok").Inc()
+				}
+				e.finish(task.id)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(e.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.queue(ctx, tasks); err != nil && ctx.Err() == nil {
+			log.WithError(err).Warnln("Could not query multi addresses to enrich")
+		}
+
+		select {
+		case <-ctx.Done():
+			close(tasks)
+			wg.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+const (
+	pendingMaddrsQuery = `
+SELECT id, maddr
+FROM multi_addresses
+WHERE id > $1
+  AND enrichment_version IS NULL
+ORDER BY id
+LIMIT $2`
+
+	outdatedMaddrsQuery = `
+SELECT id, maddr
+FROM multi_addresses
+WHERE id > $1
+  AND enrichment_version IS DISTINCT FROM $3
+ORDER BY id
+LIMIT $2`
+)
+
+// dataVersion identifies the geo and Udger databases with which multi addresses are enriched.
+func (e *Enricher) dataVersion() string {
+	return e.mm.Version() + ";udger@" + e.udger.Version()
+}
+
+// queue sends all multi addresses that need to be enriched to the workers. If the geo or Udger
+// databases changed since the last run, that's every multi address that was enriched with another
+// version. Otherwise, it's only the ones that weren't enriched yet.
+func (e *Enricher) queue(ctx context.Context, tasks chan<- enrichTask) error {
+	version := e.dataVersion()
+
+	query, args := pendingMaddrsQuery, []interface{}{}
+	if version != e.version {
+		log.WithField("version", version).Infoln("Enriching multi addresses with new geo or Udger databases")
+		e.cache.Purge()
+		query, args = outdatedMaddrsQuery, []interface{}{version}
+	}
+
+	var cursor int64
+	for {
+		batch, err := e.queryTasks(ctx, query, append([]interface{}{cursor, enrichmentBatchSize}, args...)...)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range batch {
+			cursor = task.id
+			if !e.start(task.id) {
+				continue
+			}
+
+			task.version = version
+			select {
+			case tasks <- task:
+			case <-ctx.Done():
+				e.finish(task.id)
+				return ctx.Err()
+			}
+		}
+
+		if len(batch) < enrichmentBatchSize {
+			break
+		}
+	}
+
+	e.version = version
+
+	return nil
+}
+
+func (e *Enricher) queryTasks(ctx context.Context, query string, args ...interface{}) ([]enrichTask, error) {
+	rows, err := e.dbh.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query multi addresses")
+	}
+	defer rows.Close()
+
+	var tasks []enrichTask
+	for rows.Next() {
+		var task enrichTask
+		if err = rows.Scan(&task.id, &task.maddr); err != nil {
+			return nil, errors.Wrap(err, "scan multi address")
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, errors.Wrap(rows.Err(), "iterate multi addresses")
+}
+
+// start marks the multi address as in flight. It returns false if it already was.
+func (e *Enricher) start(id int64) bool {
+	e.inflightLk.Lock()
+	defer e.inflightLk.Unlock()
+
+	if _, found := e.inflight[id]; found {
+		return false
+	}
+	e.inflight[id] = struct{}{}
+
+	return true
+}
+
+func (e *Enricher) finish(id int64) {
+	e.inflightLk.Lock()
+	defer e.inflightLk.Unlock()
+
+	delete(e.inflight, id)
+}
+
+// lookup returns the information of the IP address from the cache or derives it.
+func (e *Enricher) lookup(addr string) *addrEnrichment {
+	if cached, found := e.cache.Get(addr); found {
+		enrichmentCacheCounter.WithLabelValues("hit").Inc()
+		return cached.(*addrEnrichment)
+	}
+	enrichmentCacheCounter.WithLabelValues("miss").Inc()
+
+	enrichment := e.lookupAddr(addr)
+	e.cache.Add(addr, enrichment)
+
+	return enrichment
+}
+
+// enrich derives the information of the multi address and saves it. Multi addresses that
+// can't be parsed or resolved are saved without information, so that they aren't retried
+// until the geo databases change.
+func (e *Enricher) enrich(ctx context.Context, task enrichTask) error {
+	enrichments := map[string]*addrEnrichment{}
+	if maddr, err := ma.NewMultiaddr(task.maddr); err != nil {
+		log.WithError(err).WithField("maddr", task.maddr).Debugln("Could not parse multi address")
+	} else if addrs, err := e.mm.Resolve(ctx, maddr); err != nil {
+		log.WithError(err).WithField("maddr", task.maddr).Debugln("Could not resolve multi address")
+	} else {
+		for _, addr := range addrs {
+			enrichments[addr] = e.lookup(addr)
+		}
+	}
+
+	dbMaddr := &models.MultiAddress{ID: task.id, EnrichmentVersion: null.StringFrom(task.version)}
+	applyEnrichments(dbMaddr, enrichments)
+
+	txn, err := e.dbh.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "begin txn")
+	}
+	defer DeferRollback(txn)
+
+	if len(enrichments) > 1 {
+		if err = upsertIPAddresses(ctx, txn, task.id, enrichments); err != nil {
+			return errors.Wrap(err, "upsert ip addresses")
+		}
+	}
+
+	if _, err = dbMaddr.Update(ctx, txn, enrichedColumns); err != nil {
+		return errors.Wrap(err, "update multi address")
+	}
+
+	return txn.Commit()
+}
+
+// applyEnrichments sets the derived columns of the multi address. If it resolved to
+// multiple IP addresses, each column is only set if all addresses with a known value agree on it.
+func applyEnrichments(dbMaddr *models.MultiAddress, enrichments map[string]*addrEnrichment) {
+	if len(enrichments) == 1 {
+		for addr, enrichment := range enrichments {
+			info := enrichment.info
+			dbMaddr.Addr = null.StringFrom(addr)
+			dbMaddr.Asn = null.NewInt(int(info.ASN), info.ASN != 0)
+			dbMaddr.IsCloud = null.NewInt(enrichment.datacenter, enrichment.datacenter != 0)
+			dbMaddr.Country = null.NewString(info.Country, info.Country != "")
+			dbMaddr.Continent = null.NewString(info.Continent, info.Continent != "")
+			dbMaddr.City = null.NewString(info.City, info.City != "")
+			dbMaddr.ConnectionType = null.NewString(string(info.ConnectionType), info.ConnectionType != "")
+		}
+		return
+	}
+
+	if len(enrichments) == 0 {
+		return
+	}
+
+	var (
+		asns            []int
+		countries       []string
+		continents      []string
+		cities          []string
+		connectionTypes []string
+	)
+	for _, enrichment := range enrichments {
+		info := enrichment.info
+		if info.ASN != 0 {
+			asns = append(asns, int(info.ASN))
+		}
+		if info.Country != "" {
+			countries = append(countries, info.Country)
+		}
+		if info.Continent != "" {
+			continents = append(continents, info.Continent)
+		}
+		if info.City != "" {
+			cities = append(cities, info.City)
+		}
+		if info.ConnectionType != "" {
+			connectionTypes = append(connectionTypes, string(info.ConnectionType))
+		}
+	}
+
+	dbMaddr.HasManyAddrs = null.BoolFrom(true)
+	dbMaddr.Asn = null.IntFromPtr(util.Unique(asns))
+	dbMaddr.Country = null.StringFromPtr(util.Unique(countries))
+	dbMaddr.Continent = null.StringFromPtr(util.Unique(continents))
+	dbMaddr.City = null.StringFromPtr(util.Unique(cities))
+	dbMaddr.ConnectionType = null.StringFromPtr(util.Unique(connectionTypes))
+}
+
+// upsertIPAddresses saves the IP addresses of a multi address that resolved to multiple addresses.
+func upsertIPAddresses(ctx context.Context, exec boil.ContextExecutor, maddrID int64, enrichments map[string]*addrEnrichment) error {
+	for addr, enrichment := range enrichments {
+		info := enrichment.info
+		dbIPAddress := &models.IPAddress{
+			MultiAddressID: maddrID,
+			Address:        addr,
+			Country:        null.NewString(info.Country, info.Country != ""),
+			Continent:      null.NewString(info.Continent, info.Continent != ""),
+			Asn:            null.NewInt(int(info.ASN), info.ASN != 0),
+			IsCloud:        null.NewInt(enrichment.datacenter, enrichment.datacenter != 0),
+			City:           null.NewString(info.City, info.City != ""),
+			ConnectionType: null.NewString(string(info.ConnectionType), info.ConnectionType != ""),
+		}
+
+		err := dbIPAddress.Upsert(
+			ctx,
+			exec,
+			true,
+			[]string{models.IPAddressColumns.Address},
+			boil.Whitelist(
+				models.IPAddressColumns.MultiAddressID,
+				models.IPAddressColumns.Country,
+				models.IPAddressColumns.Continent,
+				models.IPAddressColumns.Asn,
+				models.IPAddressColumns.IsCloud,
+				models.IPAddressColumns.City,
+				models.IPAddressColumns.ConnectionType,
+				models.IPAddressColumns.UpdatedAt,
+			),
+			boil.Infer(),
+		)
+		if err != nil {
+			return errors.Wrapf(err, "upsert ip address %s", addr)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/dennis-tra/punchr/pkg/maxmind"
+	"github.com/dennis-tra/punchr/pkg/models"
+)
+
+func TestApplyEnrichments(t *testing.T) {
+	berlin := &addrEnrichment{info: &maxmind.AddrInfo{Country: "DE", Continent: "EU", City: "Berlin", ASN: 3320, ConnectionType: maxmind.ConnectionTypeResidential}}
+	munich := &addrEnrichment{info: &maxmind.AddrInfo{Country: "DE", Continent: "EU", City: "Munich", ASN: 3320, ConnectionType: maxmind.ConnectionTypeResidential}}
+	cloud := &addrEnrichment{info: &maxmind.AddrInfo{Country: "NL", Continent: "EU", ASN: 14061}, datacenter: 42}
+
+	tests := []struct {
+		name        string
+		enrichments map[string]*addrEnrichment
+		want        *models.MultiAddress
+	}{
+		{
+			name:        "unresolved",
+			enrichments: map[string]*addrEnrichment{},
+			want:        &models.MultiAddress{},
+		},
+		{
+			name:        "single address",
+			enrichments: map[string]*addrEnrichment{"1.2.3.4": berlin},
+			want: &models.MultiAddress{
+				Addr:           null.StringFrom("1.2.3.4"),
+				Asn:            null.IntFrom(3320),
+				Country:        null.StringFrom("DE"),
+				Continent:      null.StringFrom("EU"),
+				City:           null.StringFrom("Berlin"),
+				ConnectionType: null.StringFrom(string(maxmind.ConnectionTypeResidential)),
+			},
+		},
+		{
+			name:        "single cloud address",
+			enrichments: map[string]*addrEnrichment{"5.6.7.8": cloud},
+			want: &models.MultiAddress{
+				Addr:      null.StringFrom("5.6.7.8"),
+				Asn:       null.IntFrom(14061),
+				IsCloud:   null.IntFrom(42),
+				Country:   null.StringFrom("NL"),
+				Continent: null.StringFrom("EU"),
+			},
+		},
+		{
+			name:        "many addresses only keep common values",
+			enrichments: map[string]*addrEnrichment{"1.2.3.4": berlin, "1.2.3.5": munich},
+			want: &models.MultiAddress{
+				HasManyAddrs:   null.BoolFrom(true),
+				Asn:            null.IntFrom(3320),
+				Country:        null.StringFrom("DE"),
+				Continent:      null.StringFrom("EU"),
+				ConnectionType: null.StringFrom(string(maxmind.ConnectionTypeResidential)),
+			},
+		},
+		{
+			name:        "many addresses in different countries ignore unknown values",
+			enrichments: map[string]*addrEnrichment{"1.2.3.4": berlin, "5.6.7.8": cloud},
+			want: &models.MultiAddress{
+				HasManyAddrs:   null.BoolFrom(true),
+				Continent:      null.StringFrom("EU"),
+				City:           null.StringFrom("Berlin"),
+				ConnectionType: null.StringFrom(string(maxmind.ConnectionTypeResidential)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &models.MultiAddress{}
+			applyEnrichments(got, tt.enrichments)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEnricher_lookup(t *testing.T) {
+	e, err := NewEnricher(nil, nil, nil, EnricherConfig{CacheSize: 1})
+	require.NoError(t, err)
+
+	lookups := map[string]int{}
+	e.lookupAddr = func(addr string) *addrEnrichment {
+		lookups[addr]++
+		return &addrEnrichment{info: &maxmind.AddrInfo{Country: addr}}
+	}
+
+	assert.Equal(t, "1.2.3.4", e.lookup("1.2.3.4").info.Country)
+	assert.Equal(t, "1.2.3.4", e.lookup("1.2.3.4").info.Country)
+	assert.Equal(t, 1, lookups["1.2.3.4"])
+
+	// Evicts 1.2.3.4 from the cache
+	e.lookup("5.6.7.8")
+	e.lookup("1.2.3.4")
+	assert.Equal(t, 2, lookups["1.2.3.4"])
+}
+
+func TestEnricher_inflight(t *testing.T) {
+	e, err := NewEnricher(nil, nil, nil, EnricherConfig{})
+	require.NoError(t, err)
+
+	assert.True(t, e.start(1))
+	assert.False(t, e.start(1))
+	assert.True(t, e.start(2))
+
+	e.finish(1)
+	assert.True(t, e.start(1))
+}
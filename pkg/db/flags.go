@@ -0,0 +1,133 @@
+package db
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags returns the flags that configure the database connection (see NewClient). The
+// environment variables of the flags are prefixed with envPrefix, e.g., PUNCHR_SERVER.
+func Flags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "db-host",
+			Usage:       "On which host address can the database be reached",
+			EnvVars:     []string{envPrefix + "_DATABASE_HOST"},
+			DefaultText: "localhost",
+			Value:       "localhost",
+		},
+		&cli.StringFlag{
+			Name:        "db-port",
+			Usage:       "On which port can the database be reached",
+			EnvVars:     []string{envPrefix + "_DATABASE_PORT"},
+			DefaultText: "5432",
+			Value:       "5432",
+		},
+		&cli.StringFlag{
+			Name:        "db-name",
+			Usage:       "The name of the database to use",
+			EnvVars:     []string{envPrefix + "_DATABASE_NAME"},
+			DefaultText: "punchr",
+			Value:       "punchr",
+		},
+		&cli.StringFlag{
+			Name:        "db-password",
+			Usage:       "The password for the database to use",
+			EnvVars:     []string{envPrefix + "_DATABASE_PASSWORD"},
+			DefaultText: "password",
+			Value:       "password",
+		},
+		&cli.StringFlag{
+			Name:        "db-user",
+			Usage:       "The user with which to access the database to use",
+			EnvVars:     []string{envPrefix + "_DATABASE_USER"},
+			DefaultText: "punchr",
+			Value:       "punchr",
+		},
+		&cli.StringFlag{
+			Name:        "db-sslmode",
+			Usage:       "The sslmode to use when connecting the the database",
+			EnvVars:     []string{envPrefix + "_DATABASE_SSL_MODE"},
+			DefaultText: "disable",
+			Value:       "disable",
+		},
+		&cli.BoolFlag{
+			Name:    "db-auto-migrate",
+			Usage:   "Apply pending database migrations on startup instead of refusing to start",
+			EnvVars: []string{envPrefix + "_DATABASE_AUTO_MIGRATE"},
+		},
+		&cli.IntFlag{
+			Name:        "db-max-open-conns",
+			Usage:       "The maximum number of open connections to the database (0 means unlimited)",
+			EnvVars:     []string{envPrefix + "_DATABASE_MAX_OPEN_CONNS"},
+			DefaultText: "25",
+			Value:       25,
+		},
+		&cli.IntFlag{
+			Name:        "db-max-idle-conns",
+			Usage:       "The maximum number of idle connections to the database",
+			EnvVars:     []string{envPrefix + "_DATABASE_MAX_IDLE_CONNS"},
+			DefaultText: "10",
+			Value:       10,
+		},
+		&cli.DurationFlag{
+			Name:        "db-conn-max-lifetime",
+			Usage:       "The maximum amount of time a database connection may be reused (0 means forever)",
+			EnvVars:     []string{envPrefix + "_DATABASE_CONN_MAX_LIFETIME"},
+			DefaultText: "30m",
+			Value:       30 * time.Minute,
+		},
+		&cli.DurationFlag{
+			Name:        "db-conn-max-idle-time",
+			Usage:       "The maximum amount of time a database connection may be idle (0 means forever)",
+			EnvVars:     []string{envPrefix + "_DATABASE_CONN_MAX_IDLE_TIME"},
+			DefaultText: "5m",
+			Value:       5 * time.Minute,
+		},
+		&cli.DurationFlag{
+			Name:        "db-statement-timeout",
+			Usage:       "Abort database statements that take longer than this (0 disables the timeout)",
+			EnvVars:     []string{envPrefix + "_DATABASE_STATEMENT_TIMEOUT"},
+			DefaultText: "30s",
+			Value:       30 * time.Second,
+		},
+	}
+}
+
+// ReplicaFlag returns the flag that configures an optional read replica of the database.
+func ReplicaFlag(envPrefix string) cli.Flag {
+	return &cli.StringFlag{
+		Name:    "db-replica-dsn",
+		Usage:   "Serve read-only queries from the read replica at this connection string (postgres:// URL or key=value pairs)",
+		EnvVars: []string{envPrefix + "_DATABASE_REPLICA_DSN"},
+	}
+}
+
+// EnrichmentFlags returns the flags that configure the Enricher of the multi addresses.
+func EnrichmentFlags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{
+			Name:        "enrichment-workers",
+			Usage:       "How many multi addresses should be resolved and looked up in the geo databases in parallel",
+			EnvVars:     []string{envPrefix + "_ENRICHMENT_WORKERS"},
+			DefaultText: strconv.Itoa(DefaultEnrichmentWorkers),
+			Value:       DefaultEnrichmentWorkers,
+		},
+		&cli.IntFlag{
+			Name:        "enrichment-cache-size",
+			Usage:       "Of how many IP addresses the geo and data center information should be cached",
+			EnvVars:     []string{envPrefix + "_ENRICHMENT_CACHE_SIZE"},
+			DefaultText: strconv.Itoa(DefaultEnrichmentCacheSize),
+			Value:       DefaultEnrichmentCacheSize,
+		},
+		&cli.DurationFlag{
+			Name:        "enrichment-interval",
+			Usage:       "How often to look for multi addresses that need to be enriched",
+			EnvVars:     []string{envPrefix + "_ENRICHMENT_INTERVAL"},
+			DefaultText: DefaultEnrichmentInterval.String(),
+			Value:       DefaultEnrichmentInterval,
+		},
+	}
+}
@@ -0,0 +1,218 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// migrationsLockID is the key of the advisory lock that serializes migrations and
+// schema checks of all punchr processes, e.g., a honeypot and a server that start
+// at the same time. It's an arbitrary but fixed number.
+const migrationsLockID int64 = 7_406_192_835
+
+// Migrator applies the embedded migrations to the database. It holds the
+// migrations lock until it's closed.
+type Migrator struct {
+	conn *sql.Conn
+	src  source.Driver
+	m    *migrate.Migrate
+}
+
+// MigrationStatus describes the schema version of the database.
+type MigrationStatus struct {
+	// Version is the version of the last applied migration. It's 0 if none was applied yet.
+	Version uint
+
+	// Dirty is true if the last migration failed halfway. The schema must be fixed
+	// manually and the version set with Force afterwards.
+	Dirty bool
+
+	// Latest is the version of the newest migration that's embedded in this binary.
+	Latest uint
+
+	// Pending are the versions of all migrations that still need to be applied.
+	Pending []uint
+}
+
+// NewMigrator waits for the migrations lock and prepares the embedded migrations.
+func NewMigrator(ctx context.Context, dbh *sql.DB) (*Migrator, error) {
+	conn, err := dbh.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get connection")
+	}
+
+	// Migrations may take longer than the statement timeout of the pool
+	if _, err = conn.ExecContext(ctx, "SET statement_timeout = 0"); err != nil {
+		_ = conn.Close()
+		return nil, errors.Wrap(err, "disable statement timeout")
+	}
+
+	log.Debugln("Acquiring migrations lock...")
+	if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockID); err != nil {
+		resetStatementTimeout(conn)
+		_ = conn.Close()
+		return nil, errors.Wrap(err, "acquire migrations lock")
+	}
+
+	mg := &Migrator{conn: conn}
+
+	if mg.src, err = iofs.New(migrations, "migrations"); err != nil {
+		mg.unlock()
+		return nil, errors.Wrap(err, "open migrations")
+	}
+
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	if err != nil {
+		mg.unlock()
+		return nil, errors.Wrap(err, "create driver instance")
+	}
+
+	if mg.m, err = migrate.NewWithInstance("iofs", mg.src, "postgres", driver); err != nil {
+		mg.unlock()
+		return nil, errors.Wrap(err, "create migrate instance")
+	}
+
+	return mg, nil
+}
+
+// Up applies the next n pending migrations. It applies all of them if n isn't positive.
+func (mg *Migrator) Up(n int) error {
+	var err error
+	if n > 0 {
+		err = mg.m.Steps(n)
+	} else {
+		err = mg.m.Up()
+	}
+
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return errors.Wrap(err, "apply migrations")
+	}
+
+	return nil
+}
+
+// Down rolls back the last n applied migrations.
+func (mg *Migrator) Down(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("number of migrations to roll back must be positive")
+	}
+
+	if err := mg.m.Steps(-n); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return errors.Wrap(err, "roll back migrations")
+	}
+
+	return nil
+}
+
+// Force sets the schema version without running any migrations and clears the dirty flag.
+// Use it after fixing the schema manually following a failed migration.
+func (mg *Migrator) Force(version int) error {
+	return errors.Wrap(mg.m.Force(version), "force version")
+}
+
+// Status returns the schema version of the database and the pending migrations.
+func (mg *Migrator) Status() (*MigrationStatus, error) {
+	version, dirty, err := mg.m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, errors.Wrap(err, "get schema version")
+	}
+
+	versions, err := migrationVersions(mg.src)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{Version: version, Dirty: dirty}
+	for _, v := range versions {
+		status.Latest = v
+		if v > version {
+			status.Pending = append(status.Pending, v)
+		}
+	}
+
+	return status, nil
+}
+
+// Check returns an error if the schema of the database doesn't
+// match the migrations that are embedded in this binary.
+func (mg *Migrator) Check() error {
+	status, err := mg.Status()
+	if err != nil {
+		return err
+	}
+	return status.Check()
+}
+
+// Check returns an error if the schema version isn't the latest one.
+func (s *MigrationStatus) Check() error {
+	switch {
+	case s.Dirty:
+		return fmt.Errorf("database schema is dirty at version %d, fix the schema and run `punchrserver migrate force %d`", s.Version, s.Version)
+	case s.Version < s.Latest:
+		return fmt.Errorf("database schema is at version %d but version %d is required, run `punchrserver migrate up`", s.Version, s.Latest)
+	case s.Version > s.Latest:
+		return fmt.Errorf("database schema is at version %d which is newer than the supported version %d, upgrade this binary", s.Version, s.Latest)
+	default:
+		return nil
+	}
+}
+
+// Close releases the migrations lock.
+func (mg *Migrator) Close() error {
+	mg.unlock()
+	srcErr, dbErr := mg.m.Close()
+	if srcErr != nil {
+		return errors.Wrap(srcErr, "close migrations")
+	}
+	return errors.Wrap(dbErr, "close driver")
+}
+
+// unlock releases the migrations lock and restores the statement timeout. Session level
+// settings and advisory locks would otherwise stay with the connection after it was
+// returned to the pool.
+func (mg *Migrator) unlock() {
+	if _, err := mg.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationsLockID); err != nil {
+		log.WithError(err).Warnln("Could not release migrations lock")
+	}
+
+	resetStatementTimeout(mg.conn)
+
+	if mg.m == nil {
+		_ = mg.conn.Close()
+	}
+}
+
+// resetStatementTimeout restores the statement timeout of the pool that NewMigrator disabled.
+func resetStatementTimeout(conn *sql.Conn) {
+	if _, err := conn.ExecContext(context.Background(), "RESET statement_timeout"); err != nil {
+		log.WithError(err).Warnln("Could not reset statement timeout")
+	}
+}
+
+// migrationVersions returns the versions of all migrations in ascending order.
+func migrationVersions(src source.Driver) ([]uint, error) {
+	version, err := src.First()
+	if err != nil {
+		return nil, errors.Wrap(err, "read first migration")
+	}
+
+	versions := []uint{version}
+	for {
+		version, err = src.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return versions, nil
+		} else if err != nil {
+			return nil, errors.Wrap(err, "read next migration")
+		}
+		versions = append(versions, version)
+	}
+}
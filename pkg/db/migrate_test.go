@@ -0,0 +1,57 @@
+package db
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationVersions(t *testing.T) {
+	src, err := iofs.New(migrations, "migrations")
+	require.NoError(t, err)
+
+	versions, err := migrationVersions(src)
+	require.NoError(t, err)
+	require.NotEmpty(t, versions)
+
+	for i, v := range versions {
+		assert.Equal(t, uint(i+1), v, "migrations must be numbered consecutively")
+	}
+
+	// Every migration must be reversible
+	files, err := fs.Glob(migrations, "migrations/*.up.sql")
+	require.NoError(t, err)
+	assert.Len(t, files, len(versions))
+	for _, file := range files {
+		_, err = fs.Stat(migrations, strings.TrimSuffix(file, ".up.sql")+".down.sql")
+		assert.NoError(t, err, file)
+	}
+}
+
+func TestMigrationStatus_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  MigrationStatus
+		wantErr string
+	}{
+		{name: "up-to-date", status: MigrationStatus{Version: 20, Latest: 20}},
+		{name: "pending", status: MigrationStatus{Version: 19, Latest: 20, Pending: []uint{20}}, wantErr: "migrate up"},
+		{name: "empty database", status: MigrationStatus{Latest: 20, Pending: []uint{1, 2}}, wantErr: "migrate up"},
+		{name: "dirty", status: MigrationStatus{Version: 20, Dirty: true, Latest: 20}, wantErr: "migrate force 20"},
+		{name: "newer schema", status: MigrationStatus{Version: 21, Latest: 20}, wantErr: "upgrade"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.status.Check()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
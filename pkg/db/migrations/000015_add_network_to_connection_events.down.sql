@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_connection_events_network_opened_at;
+
+ALTER TABLE connection_events
+    DROP COLUMN network;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- The libp2p network (e.g., ipfs, filecoin) in which the honeypot registered the connection.
+ALTER TABLE connection_events
+    ADD COLUMN network TEXT NOT NULL DEFAULT 'ipfs';
+
+CREATE INDEX idx_connection_events_network_opened_at ON connection_events (network, opened_at);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_peers_implementation;
+
+ALTER TABLE peers
+    DROP COLUMN implementation,
+    DROP COLUMN implementation_version,
+    DROP COLUMN go_libp2p_version;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+-- The agent version of a peer classified into the libp2p implementation and its
+-- version. This is derived from the agent_version column (see pkg/agent).
+ALTER TABLE peers
+    ADD COLUMN implementation         TEXT,
+    ADD COLUMN implementation_version TEXT,
+    ADD COLUMN go_libp2p_version      TEXT;
+
+CREATE INDEX idx_peers_implementation ON peers (implementation);
+
+COMMIT;
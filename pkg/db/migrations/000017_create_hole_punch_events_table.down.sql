@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS hole_punch_events;
+DROP TYPE IF EXISTS hole_punch_event_type;
+
+COMMIT;
@@ -0,0 +1,39 @@
+BEGIN;
+
+CREATE TYPE hole_punch_event_type AS ENUM (
+    'UNKNOWN',
+    -- raw events of the go-libp2p hole punch tracer
+    'DIRECT_DIAL',
+    'PROTOCOL_ERROR',
+    'START_HOLE_PUNCH',
+    'END_HOLE_PUNCH',
+    'HOLE_PUNCH_ATTEMPT',
+    -- connections to the remote peer were opened or closed
+    'CONNECTION_OPENED',
+    'CONNECTION_CLOSED'
+    );
+
+-- The `hole_punch_events` table holds the full timeline of events
+-- that were observed during a single hole punch.
+CREATE TABLE hole_punch_events
+(
+    id                   INT GENERATED ALWAYS AS IDENTITY,
+    hole_punch_result_id INT                   NOT NULL,
+    type                 hole_punch_event_type NOT NULL,
+    occurred_at          TIMESTAMPTZ           NOT NULL,
+    multi_addresses      TEXT[]                NOT NULL,
+    attempt              INT,
+    success              BOOLEAN,
+    elapsed_time         INTERVAL,
+    rtt                  INTERVAL,
+    error                TEXT,
+    direction            TEXT,
+
+    CONSTRAINT fk_hole_punch_events_hole_punch_result_id FOREIGN KEY (hole_punch_result_id) REFERENCES hole_punch_results (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_hole_punch_events_hole_punch_result_id ON hole_punch_events (hole_punch_result_id, occurred_at);
+
+COMMIT;
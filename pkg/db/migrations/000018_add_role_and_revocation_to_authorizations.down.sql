@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE authorizations
+    DROP COLUMN role,
+    DROP COLUMN updated_at,
+    DROP COLUMN revoked_at;
+
+DROP TYPE IF EXISTS authorization_role;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- The role of an authorization determines which RPCs its API key may call:
+--   CONTRIBUTOR: register clients, request peers to hole punch and report results
+--   ADMIN:       all of the above plus managing API keys
+--   READ_ONLY:   only request peers to hole punch but not contribute results
+CREATE TYPE authorization_role AS ENUM (
+    'CONTRIBUTOR',
+    'ADMIN',
+    'READ_ONLY'
+    );
+
+ALTER TABLE authorizations
+    ADD COLUMN role       authorization_role NOT NULL DEFAULT 'CONTRIBUTOR',
+    ADD COLUMN updated_at TIMESTAMPTZ,
+    ADD COLUMN revoked_at TIMESTAMPTZ;
+
+UPDATE authorizations SET updated_at = created_at;
+
+ALTER TABLE authorizations
+    ALTER COLUMN updated_at SET NOT NULL;
+
+COMMIT;
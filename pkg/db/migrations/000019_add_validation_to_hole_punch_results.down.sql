@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP VIEW IF EXISTS authorization_trust_scores;
+
+ALTER TABLE hole_punch_results
+    DROP COLUMN authorization_id,
+    DROP COLUMN validation_status,
+    DROP COLUMN validation_reasons;
+
+DROP TYPE IF EXISTS validation_status;
+
+COMMIT;
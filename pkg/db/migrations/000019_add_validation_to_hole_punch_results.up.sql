@@ -0,0 +1,39 @@
+BEGIN;
+
+-- The result of the plausibility checks of a hole punch result:
+--   ACCEPTED: all checks passed
+--   FLAGGED:  the result is suspicious, e.g., the remote peer wasn't allocated to the client
+--   REJECTED: the result is inconsistent, e.g., a success without a direct connection
+CREATE TYPE validation_status AS ENUM (
+    'ACCEPTED',
+    'FLAGGED',
+    'REJECTED'
+    );
+
+ALTER TABLE hole_punch_results
+    ADD COLUMN authorization_id   INT,
+    ADD COLUMN validation_status  validation_status NOT NULL DEFAULT 'ACCEPTED',
+    ADD COLUMN validation_reasons TEXT[]            NOT NULL DEFAULT '{}',
+
+    ADD CONSTRAINT fk_hole_punch_results_authorization_id FOREIGN KEY (authorization_id) REFERENCES authorizations (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_hole_punch_results_authorization_id ON hole_punch_results (authorization_id);
+
+-- The trust score of an authorization is the share of its results that were
+-- accepted. Flagged results count half. The score starts at 0.5 for new API keys
+-- and is only meaningful for results that were reported after validation was introduced.
+CREATE VIEW authorization_trust_scores AS
+SELECT a.id                                                             AS authorization_id,
+       a.username,
+       count(hpr.id)                                                    AS results,
+       count(hpr.id) FILTER ( WHERE hpr.validation_status = 'ACCEPTED' ) AS accepted,
+       count(hpr.id) FILTER ( WHERE hpr.validation_status = 'FLAGGED' )  AS flagged,
+       count(hpr.id) FILTER ( WHERE hpr.validation_status = 'REJECTED' ) AS rejected,
+       (count(hpr.id) FILTER ( WHERE hpr.validation_status = 'ACCEPTED' ) +
+        0.5 * count(hpr.id) FILTER ( WHERE hpr.validation_status = 'FLAGGED' ) + 1) /
+       (count(hpr.id) + 2)::FLOAT                                       AS trust_score
+FROM authorizations a
+         LEFT JOIN hole_punch_results hpr ON a.id = hpr.authorization_id
+GROUP BY a.id;
+
+COMMIT;
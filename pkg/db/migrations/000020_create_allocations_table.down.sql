@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP VIEW IF EXISTS client_allocation_stats;
+
+ALTER TABLE hole_punch_results
+    DROP COLUMN allocation_id;
+
+DROP TABLE IF EXISTS allocations;
+
+COMMIT;
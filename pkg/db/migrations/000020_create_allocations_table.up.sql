@@ -0,0 +1,55 @@
+BEGIN;
+
+-- The `allocations` table holds every remote peer that the server handed
+-- out to a client for hole punching. Clients report the allocation ID
+-- together with the result, so that results can be matched with their
+-- allocation and clients that never report results can be detected.
+CREATE TABLE allocations
+(
+    id                     BIGINT GENERATED ALWAYS AS IDENTITY,
+    client_id              BIGINT      NOT NULL,
+    remote_id              BIGINT      NOT NULL,
+    authorization_id       INT,
+    multi_addresses_set_id INT,
+    network                TEXT        NOT NULL,
+    protocol_filters       INT[]       NOT NULL,
+    experiment_arm         TEXT        NOT NULL,
+    expires_at             TIMESTAMPTZ NOT NULL,
+    created_at             TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT fk_allocations_client_id FOREIGN KEY (client_id) REFERENCES peers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_allocations_remote_id FOREIGN KEY (remote_id) REFERENCES peers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_allocations_authorization_id FOREIGN KEY (authorization_id) REFERENCES authorizations (id) ON DELETE SET NULL,
+    CONSTRAINT fk_allocations_multi_addresses_set_id FOREIGN KEY (multi_addresses_set_id) REFERENCES multi_addresses_sets (id) ON DELETE SET NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_allocations_client_id_remote_id_created_at ON allocations (client_id, remote_id, created_at);
+CREATE INDEX idx_allocations_created_at ON allocations (created_at);
+
+-- Each allocation can be completed by at most one result.
+ALTER TABLE hole_punch_results
+    ADD COLUMN allocation_id BIGINT,
+
+    ADD CONSTRAINT uq_hole_punch_results_allocation_id UNIQUE (allocation_id),
+    ADD CONSTRAINT fk_hole_punch_results_allocation_id FOREIGN KEY (allocation_id) REFERENCES allocations (id) ON DELETE SET NULL;
+
+-- The `client_allocation_stats` view shows per client how many of its
+-- allocations were completed by a result. Clients with many expired
+-- allocations without a result request peers but never report back.
+CREATE VIEW client_allocation_stats AS
+SELECT a.client_id,
+       a.authorization_id,
+       count(a.id)                                                                  AS allocations,
+       count(hpr.id)                                                                AS completed,
+       count(a.id) FILTER ( WHERE hpr.id IS NULL AND a.expires_at < NOW() )         AS expired,
+       count(hpr.id)::FLOAT / NULLIF(count(a.id) FILTER ( WHERE hpr.id IS NOT NULL OR a.expires_at < NOW() ), 0)
+                                                                                    AS completion_rate,
+       max(a.created_at)                                                            AS last_allocated_at,
+       max(hpr.created_at)                                                          AS last_reported_at
+FROM allocations a
+         LEFT JOIN hole_punch_results hpr ON a.id = hpr.allocation_id
+GROUP BY a.client_id, a.authorization_id;
+
+COMMIT;
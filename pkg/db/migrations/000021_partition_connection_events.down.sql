@@ -0,0 +1,73 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_hole_punch_results_created_at;
+
+-- Move the partitioned tables out of the way. Their primary keys, indexes and
+-- sequence are renamed or dropped because the unpartitioned tables reuse the names.
+ALTER TABLE connection_events
+    RENAME TO connection_events_partitioned;
+ALTER TABLE connection_events_partitioned
+    DROP CONSTRAINT connection_events_pkey;
+ALTER SEQUENCE connection_events_id_seq RENAME TO connection_events_partitioned_id_seq;
+DROP INDEX idx_connection_events_opened_at;
+DROP INDEX idx_connection_events_network_opened_at;
+
+ALTER TABLE connection_events_x_multi_addresses
+    RENAME TO connection_events_x_multi_addresses_partitioned;
+ALTER TABLE connection_events_x_multi_addresses_partitioned
+    DROP CONSTRAINT connection_events_x_multi_addresses_pkey;
+DROP INDEX idx_connection_events_x_multi_addresses_1;
+DROP INDEX idx_connection_events_x_multi_addresses_2;
+
+CREATE TABLE connection_events
+(
+    id                    INT GENERATED ALWAYS AS IDENTITY,
+    local_id              BIGINT      NOT NULL,
+    remote_id             BIGINT      NOT NULL,
+    conn_multi_address_id BIGINT      NOT NULL,
+    opened_at             TIMESTAMPTZ NOT NULL,
+    created_at            TIMESTAMPTZ NOT NULL,
+    network               TEXT        NOT NULL DEFAULT 'ipfs',
+
+    CONSTRAINT fk_connection_events_local_id FOREIGN KEY (local_id) REFERENCES peers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_connection_events_remote_id FOREIGN KEY (remote_id) REFERENCES peers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_connection_events_multi_address_id FOREIGN KEY (conn_multi_address_id) REFERENCES multi_addresses (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (id)
+);
+
+INSERT INTO connection_events (id, local_id, remote_id, conn_multi_address_id, opened_at, created_at, network)
+    OVERRIDING SYSTEM VALUE
+SELECT id, local_id, remote_id, conn_multi_address_id, opened_at, created_at, network
+FROM connection_events_partitioned;
+
+SELECT setval(pg_get_serial_sequence('connection_events', 'id'), coalesce((SELECT max(id) FROM connection_events), 0) + 1, false);
+
+CREATE INDEX idx_connection_events_opened_at ON connection_events (opened_at);
+CREATE INDEX idx_connection_events_network_opened_at ON connection_events (network, opened_at);
+
+CREATE TABLE connection_events_x_multi_addresses
+(
+    connection_event_id INT    NOT NULL,
+    multi_address_id    BIGINT NOT NULL,
+
+    CONSTRAINT fk_connection_events_x_multi_addresses_multi_address_id FOREIGN KEY (multi_address_id) REFERENCES multi_addresses (id) ON DELETE CASCADE,
+    CONSTRAINT fk_connection_events_x_multi_addresses_connection_event_id FOREIGN KEY (connection_event_id) REFERENCES connection_events (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (multi_address_id, connection_event_id)
+);
+
+INSERT INTO connection_events_x_multi_addresses (connection_event_id, multi_address_id)
+SELECT cexma.connection_event_id, cexma.multi_address_id
+FROM connection_events_x_multi_addresses_partitioned cexma
+WHERE EXISTS(SELECT FROM connection_events ce WHERE ce.id = cexma.connection_event_id);
+
+CREATE INDEX idx_connection_events_x_multi_addresses_1 ON connection_events_x_multi_addresses (connection_event_id, multi_address_id);
+CREATE INDEX idx_connection_events_x_multi_addresses_2 ON connection_events_x_multi_addresses (multi_address_id, connection_event_id);
+
+DROP TABLE connection_events_x_multi_addresses_partitioned;
+DROP TABLE connection_events_partitioned;
+
+DROP FUNCTION IF EXISTS create_monthly_partitions;
+
+COMMIT;
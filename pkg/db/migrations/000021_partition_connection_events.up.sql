@@ -0,0 +1,130 @@
+BEGIN;
+
+-- The `create_monthly_partitions` function creates the partitions of a table
+-- that is partitioned by month for all months from from_month up to and
+-- including to_month. Existing partitions are skipped. The partitions are
+-- named <parent>_y<YYYY>m<MM> and cover the month in UTC. Returns the number
+-- of created partitions.
+CREATE FUNCTION create_monthly_partitions(parent TEXT, from_month DATE, to_month DATE) RETURNS INT AS
+$$
+DECLARE
+    cur_month DATE := date_trunc('month', from_month);
+    part_name TEXT;
+    created   INT  := 0;
+BEGIN
+    -- Serialize concurrent calls, e.g., of a honeypot and a server that start at the same time.
+    PERFORM pg_advisory_xact_lock(hashtext('create_monthly_partitions'));
+
+    WHILE cur_month <= date_trunc('month', to_month)
+        LOOP
+            part_name := format('%s_y%sm%s', parent, to_char(cur_month, 'YYYY'), to_char(cur_month, 'MM'));
+            IF to_regclass(part_name) IS NULL THEN
+                EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+                               part_name, parent,
+                               cur_month::TIMESTAMP AT TIME ZONE 'UTC',
+                               (cur_month + INTERVAL '1 month')::TIMESTAMP AT TIME ZONE 'UTC');
+                created := created + 1;
+            END IF;
+            cur_month := cur_month + INTERVAL '1 month';
+        END LOOP;
+
+    RETURN created;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Move the existing tables out of the way. Their primary keys and indexes
+-- are dropped because the new tables reuse the names.
+ALTER TABLE connection_events
+    ALTER COLUMN id DROP IDENTITY;
+ALTER TABLE connection_events
+    RENAME TO connection_events_old;
+ALTER TABLE connection_events_old
+    DROP CONSTRAINT connection_events_pkey CASCADE;
+DROP INDEX idx_connection_events_opened_at;
+DROP INDEX idx_connection_events_network_opened_at;
+
+ALTER TABLE connection_events_x_multi_addresses
+    RENAME TO connection_events_x_multi_addresses_old;
+ALTER TABLE connection_events_x_multi_addresses_old
+    DROP CONSTRAINT connection_events_x_multi_addresses_pkey;
+DROP INDEX idx_connection_events_x_multi_addresses_1;
+DROP INDEX idx_connection_events_x_multi_addresses_2;
+
+-- Partitioned tables can't have identity columns (before Postgres 17).
+CREATE SEQUENCE connection_events_id_seq AS INT;
+
+-- The `connection_events` table is partitioned by month, so that queries
+-- for recent connections only scan the latest partitions and old data can
+-- be archived and dropped a partition at a time (see pkg/retention).
+CREATE TABLE connection_events
+(
+    -- A unique ID of this connection event
+    id                    INT         NOT NULL DEFAULT nextval('connection_events_id_seq'),
+    -- The local peer ID of the honeypot
+    local_id              BIGINT      NOT NULL,
+    -- The peer ID of the remote peer
+    remote_id             BIGINT      NOT NULL,
+    -- The multi address of the connection
+    conn_multi_address_id BIGINT      NOT NULL,
+    -- When was this connection opened
+    opened_at             TIMESTAMPTZ NOT NULL,
+    -- When was this event written to the DB
+    created_at            TIMESTAMPTZ NOT NULL,
+    -- The libp2p network (e.g., ipfs, filecoin) in which the honeypot registered the connection.
+    network               TEXT        NOT NULL DEFAULT 'ipfs',
+
+    CONSTRAINT fk_connection_events_local_id FOREIGN KEY (local_id) REFERENCES peers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_connection_events_remote_id FOREIGN KEY (remote_id) REFERENCES peers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_connection_events_multi_address_id FOREIGN KEY (conn_multi_address_id) REFERENCES multi_addresses (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (id, opened_at)
+) PARTITION BY RANGE (opened_at);
+
+ALTER SEQUENCE connection_events_id_seq OWNED BY connection_events.id;
+
+CREATE INDEX idx_connection_events_opened_at ON connection_events (opened_at);
+CREATE INDEX idx_connection_events_network_opened_at ON connection_events (network, opened_at);
+
+-- The join table is partitioned like the `connection_events` table and therefore holds
+-- the opened_at timestamp of the connection event as well. It has no foreign key to the
+-- connection events because the partitions of both tables are always dropped together.
+CREATE TABLE connection_events_x_multi_addresses
+(
+    connection_event_id INT         NOT NULL,
+    multi_address_id    BIGINT      NOT NULL,
+    opened_at           TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT fk_connection_events_x_multi_addresses_multi_address_id FOREIGN KEY (multi_address_id) REFERENCES multi_addresses (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (multi_address_id, connection_event_id, opened_at)
+) PARTITION BY RANGE (opened_at);
+
+CREATE INDEX idx_connection_events_x_multi_addresses_1 ON connection_events_x_multi_addresses (connection_event_id, multi_address_id);
+CREATE INDEX idx_connection_events_x_multi_addresses_2 ON connection_events_x_multi_addresses (multi_address_id, connection_event_id);
+
+-- Create partitions for all existing connection events and the next two months.
+SELECT create_monthly_partitions(
+               table_name,
+               coalesce((SELECT min(opened_at) AT TIME ZONE 'UTC' FROM connection_events_old), NOW() AT TIME ZONE 'UTC')::DATE,
+               (NOW() AT TIME ZONE 'UTC' + INTERVAL '2 months')::DATE
+           )
+FROM unnest(ARRAY ['connection_events', 'connection_events_x_multi_addresses']) AS table_name;
+
+INSERT INTO connection_events (id, local_id, remote_id, conn_multi_address_id, opened_at, created_at, network)
+SELECT id, local_id, remote_id, conn_multi_address_id, opened_at, created_at, network
+FROM connection_events_old;
+
+INSERT INTO connection_events_x_multi_addresses (connection_event_id, multi_address_id, opened_at)
+SELECT cexma.connection_event_id, cexma.multi_address_id, ce.opened_at
+FROM connection_events_x_multi_addresses_old cexma
+         INNER JOIN connection_events_old ce ON ce.id = cexma.connection_event_id;
+
+SELECT setval('connection_events_id_seq', coalesce((SELECT max(id) FROM connection_events_old), 0) + 1, false);
+
+DROP TABLE connection_events_x_multi_addresses_old;
+DROP TABLE connection_events_old;
+
+-- Speeds up the retention of hole punch results (see pkg/retention).
+CREATE INDEX idx_hole_punch_results_created_at ON hole_punch_results (created_at);
+
+COMMIT;
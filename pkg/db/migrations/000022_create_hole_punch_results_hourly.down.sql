@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS refresh_hole_punch_results_hourly;
+DROP TABLE IF EXISTS aggregate_refreshes;
+DROP TABLE IF EXISTS hole_punch_results_hourly;
+
+COMMIT;
@@ -0,0 +1,105 @@
+BEGIN;
+
+-- The `hole_punch_results_hourly` table holds the number of hole punch results per hour
+-- and combination of dimensions. Dashboards and notebooks should compute success rates
+-- from this table instead of scanning the raw results. It is maintained by the server
+-- (see pkg/aggregate) and outlives the raw results that the retention job deletes.
+CREATE TABLE hole_punch_results_hourly
+(
+    id                   BIGINT GENERATED ALWAYS AS IDENTITY,
+    -- The start of the hour in which the results were tracked
+    hour                 TIMESTAMPTZ        NOT NULL,
+    -- The protocol filters (IPv4/IPv6, TCP/QUIC) that the client applied
+    protocol_filters     INT[]              NOT NULL,
+    -- The autonomous system of the public listen address of the client
+    client_asn           INT,
+    -- The country of the public listen address of the client
+    client_country       CHAR(2),
+    -- The agent version of the remote peer
+    remote_agent_version TEXT,
+    -- The peer ID of the relay through which the client connected to the remote peer
+    relay_id             TEXT,
+    -- The outcome of the hole punches
+    outcome              hole_punch_outcome NOT NULL,
+    -- The number of hole punch results
+    results              INT                NOT NULL,
+
+    CHECK ( hour = date_trunc('hour', hour AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' ),
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_hole_punch_results_hourly_hour ON hole_punch_results_hourly (hour);
+
+-- The `aggregate_refreshes` table records up to when an aggregate table was refreshed.
+CREATE TABLE aggregate_refreshes
+(
+    -- The name of the aggregate table
+    name            TEXT        NOT NULL,
+    -- All results that were tracked before this time are aggregated
+    refreshed_until TIMESTAMPTZ NOT NULL,
+    updated_at      TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (name)
+);
+
+-- The `refresh_hole_punch_results_hourly` function recomputes the hourly aggregates of
+-- all hours from from_hour up to but excluding to_hour from the raw hole punch results.
+-- Both bounds are truncated to the hour in UTC. Results that were rejected by the
+-- plausibility checks are ignored. If the initial multi addresses of the remote peer
+-- contain several relays, the result is attributed to the relay of the first address.
+-- Returns the number of inserted rows.
+CREATE FUNCTION refresh_hole_punch_results_hourly(from_hour TIMESTAMPTZ, to_hour TIMESTAMPTZ) RETURNS INT AS
+$$
+DECLARE
+    inserted INT;
+BEGIN
+    from_hour := date_trunc('hour', from_hour AT TIME ZONE 'UTC') AT TIME ZONE 'UTC';
+    to_hour := date_trunc('hour', to_hour AT TIME ZONE 'UTC') AT TIME ZONE 'UTC';
+
+    DELETE FROM hole_punch_results_hourly WHERE hour >= from_hour AND hour < to_hour;
+
+    INSERT INTO hole_punch_results_hourly (hour, protocol_filters, client_asn, client_country, remote_agent_version,
+                                           relay_id, outcome, results)
+    SELECT date_trunc('hour', hpr.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC',
+           hpr.protocol_filters,
+           client.asn,
+           client.country,
+           p.agent_version,
+           relay.relay_id,
+           hpr.outcome,
+           count(*)
+    FROM hole_punch_results hpr
+             INNER JOIN peers p ON p.id = hpr.remote_id
+             LEFT JOIN multi_addresses_sets mas ON mas.id = hpr.listen_multi_addresses_set_id
+             LEFT JOIN LATERAL (
+        SELECT ma.asn, ma.country
+        FROM multi_addresses ma
+        WHERE ma.id = ANY (mas.multi_addresses_ids)
+          AND ma.is_public
+          AND NOT ma.is_relay
+        ORDER BY ma.id
+        LIMIT 1
+        ) client ON TRUE
+             LEFT JOIN LATERAL (
+        SELECT substring(ma.maddr FROM '/p2p/([^/]+)/p2p-circuit') AS relay_id
+        FROM hole_punch_results_x_multi_addresses hprxma
+                 INNER JOIN multi_addresses ma ON ma.id = hprxma.multi_address_id
+        WHERE hprxma.hole_punch_result_id = hpr.id
+          AND hprxma.relationship = 'INITIAL'
+          AND ma.is_relay
+        ORDER BY ma.id
+        LIMIT 1
+        ) relay ON TRUE
+    WHERE hpr.created_at >= from_hour
+      AND hpr.created_at < to_hour
+      AND hpr.validation_status != 'REJECTED'
+    GROUP BY 1, 2, 3, 4, 5, 6, 7;
+
+    GET DIAGNOSTICS inserted = ROW_COUNT;
+
+    RETURN inserted;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE ip_addresses
+    DROP COLUMN connection_type,
+    DROP COLUMN city;
+
+ALTER TABLE multi_addresses
+    DROP COLUMN connection_type,
+    DROP COLUMN city;
+
+DROP TYPE connection_type;
+
+COMMIT;
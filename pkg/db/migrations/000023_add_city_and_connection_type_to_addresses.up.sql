@@ -0,0 +1,28 @@
+BEGIN;
+
+-- The kind of network an IP address belongs to as reported by the geo databases:
+--   MOBILE:      cellular networks
+--   RESIDENTIAL: consumer ISPs, e.g., cable or DSL
+--   BUSINESS:    corporate networks
+--   HOSTING:     data centers and cloud providers
+--   EDUCATION:   universities and research networks
+--   SATELLITE:   satellite links
+CREATE TYPE connection_type AS ENUM (
+    'MOBILE',
+    'RESIDENTIAL',
+    'BUSINESS',
+    'HOSTING',
+    'EDUCATION',
+    'SATELLITE'
+    );
+
+-- Both columns are only populated if a geo database with city or connection type data is configured.
+ALTER TABLE multi_addresses
+    ADD COLUMN city            TEXT,
+    ADD COLUMN connection_type connection_type;
+
+ALTER TABLE ip_addresses
+    ADD COLUMN city            TEXT,
+    ADD COLUMN connection_type connection_type;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX idx_multi_addresses_pending_enrichment;
+
+ALTER TABLE multi_addresses
+    DROP COLUMN enrichment_version;
+
+COMMIT;
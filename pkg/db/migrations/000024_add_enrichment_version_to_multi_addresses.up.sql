@@ -0,0 +1,12 @@
+BEGIN;
+
+-- The version of the geo databases with which the geo, ASN and data center columns of the
+-- multi address were derived. NULL means the multi address wasn't enriched yet. Multi
+-- addresses are enriched asynchronously after they were saved and again after the geo
+-- databases were updated.
+ALTER TABLE multi_addresses
+    ADD COLUMN enrichment_version TEXT;
+
+CREATE INDEX idx_multi_addresses_pending_enrichment ON multi_addresses (id) WHERE enrichment_version IS NULL;
+
+COMMIT;
@@ -0,0 +1,65 @@
+BEGIN;
+
+-- Restore the attribution of the hourly aggregates to the relay of the first initial address
+CREATE OR REPLACE FUNCTION refresh_hole_punch_results_hourly(from_hour TIMESTAMPTZ, to_hour TIMESTAMPTZ) RETURNS INT AS
+$$
+DECLARE
+    inserted INT;
+BEGIN
+    from_hour := date_trunc('hour', from_hour AT TIME ZONE 'UTC') AT TIME ZONE 'UTC';
+    to_hour := date_trunc('hour', to_hour AT TIME ZONE 'UTC') AT TIME ZONE 'UTC';
+
+    DELETE FROM hole_punch_results_hourly WHERE hour >= from_hour AND hour < to_hour;
+
+    INSERT INTO hole_punch_results_hourly (hour, protocol_filters, client_asn, client_country, remote_agent_version,
+                                           relay_id, outcome, results)
+    SELECT date_trunc('hour', hpr.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC',
+           hpr.protocol_filters,
+           client.asn,
+           client.country,
+           p.agent_version,
+           relay.relay_id,
+           hpr.outcome,
+           count(*)
+    FROM hole_punch_results hpr
+             INNER JOIN peers p ON p.id = hpr.remote_id
+             LEFT JOIN multi_addresses_sets mas ON mas.id = hpr.listen_multi_addresses_set_id
+             LEFT JOIN LATERAL (
+        SELECT ma.asn, ma.country
+        FROM multi_addresses ma
+        WHERE ma.id = ANY (mas.multi_addresses_ids)
+          AND ma.is_public
+          AND NOT ma.is_relay
+        ORDER BY ma.id
+        LIMIT 1
+        ) client ON TRUE
+             LEFT JOIN LATERAL (
+        SELECT substring(ma.maddr FROM '/p2p/([^/]+)/p2p-circuit') AS relay_id
+        FROM hole_punch_results_x_multi_addresses hprxma
+                 INNER JOIN multi_addresses ma ON ma.id = hprxma.multi_address_id
+        WHERE hprxma.hole_punch_result_id = hpr.id
+          AND hprxma.relationship = 'INITIAL'
+          AND ma.is_relay
+        ORDER BY ma.id
+        LIMIT 1
+        ) relay ON TRUE
+    WHERE hpr.created_at >= from_hour
+      AND hpr.created_at < to_hour
+      AND hpr.validation_status != 'REJECTED'
+    GROUP BY 1, 2, 3, 4, 5, 6, 7;
+
+    GET DIAGNOSTICS inserted = ROW_COUNT;
+
+    RETURN inserted;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX idx_hole_punch_results_relay_id;
+
+ALTER TABLE hole_punch_results
+    DROP CONSTRAINT fk_hole_punch_results_relay_id,
+    DROP COLUMN relay_id;
+
+DROP TABLE relays;
+
+COMMIT;
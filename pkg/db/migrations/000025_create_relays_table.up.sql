@@ -0,0 +1,102 @@
+BEGIN;
+
+-- The `relays` table holds rolling statistics of every relay through which remote peers
+-- were reachable. They are updated whenever a hole punch result is tracked, so that
+-- failures that are caused by relays can be told apart from failures that are caused by
+-- NATs. Results that were rejected by the plausibility checks are ignored. The rolling
+-- values are exponentially weighted moving averages, so that recent measurements count
+-- more than old ones. They are NULL until there was a measurement.
+CREATE TABLE relays
+(
+    -- The peer ID of the relay
+    peer_id                 BIGINT      NOT NULL,
+    -- How often clients tried to reach the relay after a hole punch
+    pings                   INT         NOT NULL DEFAULT 0,
+    -- How often clients could connect to the relay and measure the round trip time
+    pings_reachable         INT         NOT NULL DEFAULT 0,
+    -- The rolling share of pings that reached the relay
+    reachability            FLOAT,
+    -- The rolling average round trip time in seconds from clients to the relay
+    rtt                     FLOAT,
+    -- How often clients connected to remote peers through the relay
+    circuits                INT         NOT NULL DEFAULT 0,
+    -- How many hole punches succeeded or failed after the clients connected through the relay
+    hole_punches            INT         NOT NULL DEFAULT 0,
+    -- How many of these hole punches succeeded
+    hole_punches_successful INT         NOT NULL DEFAULT 0,
+    -- The rolling share of hole punches through the relay that succeeded
+    hole_punch_success_rate FLOAT,
+    updated_at              TIMESTAMPTZ NOT NULL,
+    created_at              TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT fk_relays_peer_id FOREIGN KEY (peer_id) REFERENCES peers (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (peer_id)
+);
+
+-- The relay through which the client connected to the remote peer. NULL if the client
+-- couldn't connect to the remote peer or the relay is unknown, e.g., for old results.
+ALTER TABLE hole_punch_results
+    ADD COLUMN relay_id BIGINT,
+
+    ADD CONSTRAINT fk_hole_punch_results_relay_id FOREIGN KEY (relay_id) REFERENCES peers (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_hole_punch_results_relay_id ON hole_punch_results (relay_id);
+
+-- Attribute the hourly aggregates to the relay that served the connection. Old results
+-- without a relay are still attributed to the relay of the first initial address.
+CREATE OR REPLACE FUNCTION refresh_hole_punch_results_hourly(from_hour TIMESTAMPTZ, to_hour TIMESTAMPTZ) RETURNS INT AS
+$$
+DECLARE
+    inserted INT;
+BEGIN
+    from_hour := date_trunc('hour', from_hour AT TIME ZONE 'UTC') AT TIME ZONE 'UTC';
+    to_hour := date_trunc('hour', to_hour AT TIME ZONE 'UTC') AT TIME ZONE 'UTC';
+
+    DELETE FROM hole_punch_results_hourly WHERE hour >= from_hour AND hour < to_hour;
+
+    INSERT INTO hole_punch_results_hourly (hour, protocol_filters, client_asn, client_country, remote_agent_version,
+                                           relay_id, outcome, results)
+    SELECT date_trunc('hour', hpr.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC',
+           hpr.protocol_filters,
+           client.asn,
+           client.country,
+           p.agent_version,
+           coalesce(rp.multi_hash, relay.relay_id),
+           hpr.outcome,
+           count(*)
+    FROM hole_punch_results hpr
+             INNER JOIN peers p ON p.id = hpr.remote_id
+             LEFT JOIN peers rp ON rp.id = hpr.relay_id
+             LEFT JOIN multi_addresses_sets mas ON mas.id = hpr.listen_multi_addresses_set_id
+             LEFT JOIN LATERAL (
+        SELECT ma.asn, ma.country
+        FROM multi_addresses ma
+        WHERE ma.id = ANY (mas.multi_addresses_ids)
+          AND ma.is_public
+          AND NOT ma.is_relay
+        ORDER BY ma.id
+        LIMIT 1
+        ) client ON TRUE
+             LEFT JOIN LATERAL (
+        SELECT substring(ma.maddr FROM '/p2p/([^/]+)/p2p-circuit') AS relay_id
+        FROM hole_punch_results_x_multi_addresses hprxma
+                 INNER JOIN multi_addresses ma ON ma.id = hprxma.multi_address_id
+        WHERE hprxma.hole_punch_result_id = hpr.id
+          AND hprxma.relationship = 'INITIAL'
+          AND ma.is_relay
+        ORDER BY ma.id
+        LIMIT 1
+        ) relay ON TRUE
+    WHERE hpr.created_at >= from_hour
+      AND hpr.created_at < to_hour
+      AND hpr.validation_status != 'REJECTED'
+    GROUP BY 1, 2, 3, 4, 5, 6, 7;
+
+    GET DIAGNOSTICS inserted = ROW_COUNT;
+
+    RETURN inserted;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
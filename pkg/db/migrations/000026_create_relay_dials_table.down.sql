@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS relay_dials;
+
+ALTER TABLE hole_punch_results
+    DROP COLUMN IF EXISTS relay_multi_address_id,
+    DROP COLUMN IF EXISTS relay_strategy;
+
+DROP TYPE IF EXISTS relay_strategy;
+
+COMMIT;
@@ -0,0 +1,53 @@
+BEGIN;
+
+CREATE TYPE relay_strategy AS ENUM (
+    -- the client dialed all relayed addresses at once and kept the first connection
+    'ALL',
+    -- the client tried the relays in the order of the addresses
+    'FIRST',
+    -- the client connected to all relays and chose the one with the lowest round trip time
+    'LOWEST_RTT',
+    -- the client tried the relays in random order
+    'RANDOM',
+    -- the client tried the relay first that the server chose
+    'SERVER'
+    );
+
+-- How the client chose the relay and the relayed address through which it connected
+-- to the remote peer. Both are NULL for clients that don't report them.
+ALTER TABLE hole_punch_results
+    ADD COLUMN relay_strategy         relay_strategy,
+    ADD COLUMN relay_multi_address_id BIGINT,
+
+    ADD CONSTRAINT fk_hole_punch_results_relay_multi_address_id FOREIGN KEY (relay_multi_address_id) REFERENCES multi_addresses (id) ON DELETE SET NULL;
+
+-- The `relay_dials` table holds the connection attempts of a client to the
+-- relays of the remote peer before it connected to the remote peer.
+CREATE TABLE relay_dials
+(
+    id                   INT GENERATED ALWAYS AS IDENTITY,
+    hole_punch_result_id INT         NOT NULL,
+    -- The peer ID of the relay
+    relay_id             BIGINT      NOT NULL,
+    -- The multi addresses of the relay that the client dialed
+    multi_addresses      TEXT[]      NOT NULL,
+    started_at           TIMESTAMPTZ NOT NULL,
+    ended_at             TIMESTAMPTZ NOT NULL,
+    elapsed_time         INTERVAL    NOT NULL,
+    -- The round trip time to the relay if the client measured it
+    rtt                  INTERVAL,
+    -- The error if the client couldn't connect to the relay
+    error                TEXT,
+    -- Whether the client connected to the remote peer through this relay
+    selected             BOOLEAN     NOT NULL,
+
+    CONSTRAINT fk_relay_dials_hole_punch_result_id FOREIGN KEY (hole_punch_result_id) REFERENCES hole_punch_results (id) ON DELETE CASCADE,
+    CONSTRAINT fk_relay_dials_relay_id FOREIGN KEY (relay_id) REFERENCES peers (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_relay_dials_hole_punch_result_id ON relay_dials (hole_punch_result_id);
+CREATE INDEX idx_relay_dials_relay_id ON relay_dials (relay_id);
+
+COMMIT;
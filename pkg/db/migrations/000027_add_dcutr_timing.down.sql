@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE hole_punch_results
+    DROP COLUMN IF EXISTS max_ping_count,
+    DROP COLUMN IF EXISTS ping_duration,
+    DROP COLUMN IF EXISTS retry_count,
+    DROP COLUMN IF EXISTS communication_timeout;
+
+ALTER TABLE allocations
+    DROP COLUMN IF EXISTS timing_arm;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+-- The timing experiment arm that the server assigned to the allocation. NULL if the
+-- server didn't set DCUtR timing parameters and the client used its own.
+ALTER TABLE allocations
+    ADD COLUMN timing_arm TEXT;
+
+-- The timing parameters of the DCUtR protocol that the client used for the hole
+-- punch. They are NULL for clients that don't report them.
+ALTER TABLE hole_punch_results
+    -- How long the client waited for the /libp2p/dcutr stream and the events of an attempt
+    ADD COLUMN communication_timeout INTERVAL,
+    -- How many hole punch attempts the client waited for
+    ADD COLUMN retry_count           INT,
+    -- How long the client measured round trip times
+    ADD COLUMN ping_duration         INTERVAL,
+    -- How many pings the client sent to measure a round trip time
+    ADD COLUMN max_ping_count        INT;
+
+COMMIT;
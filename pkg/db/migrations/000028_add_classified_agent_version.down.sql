@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE peers
+    DROP COLUMN IF EXISTS classified_agent_version;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+-- The agent version from which the implementation columns were derived. If it
+-- differs from the agent_version column the peer must be classified again.
+ALTER TABLE peers
+    ADD COLUMN classified_agent_version TEXT;
+
+UPDATE peers
+SET classified_agent_version = agent_version
+WHERE implementation IS NOT NULL;
+
+COMMIT;
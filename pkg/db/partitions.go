@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// PartitionedTables are the tables that are partitioned by month. The partitions of a
+// connection event and its multi addresses cover the same month and are dropped together.
+var PartitionedTables = []string{"connection_events", "connection_events_x_multi_addresses"}
+
+const (
+	// partitionsAhead is the number of months for which partitions are created in advance.
+	partitionsAhead = 2
+
+	// partitionMaintenanceInterval is the time between two checks for missing partitions.
+	partitionMaintenanceInterval = 24 * time.Hour
+)
+
+// partitionNameRegex matches the names of monthly partitions, e.g., connection_events_y2022m09.
+var partitionNameRegex = regexp.MustCompile(`^(.+)_y(\d{4})m(\d{2})$`)
+
+// Partition is the partition of a table that holds the rows of a single month.
+type Partition struct {
+	// Name is the name of the partition table.
+	Name string
+
+	// Parent is the name of the partitioned table.
+	Parent string
+
+	// Month is the start of the month in UTC.
+	Month time.Time
+}
+
+// End returns the exclusive upper bound of the partition.
+func (p Partition) End() time.Time {
+	return p.Month.AddDate(0, 1, 0)
+}
+
+// parsePartition derives the parent table and month from the name of a partition.
+func parsePartition(name string) (Partition, error) {
+	matches := partitionNameRegex.FindStringSubmatch(name)
+	if matches == nil {
+		return Partition{}, fmt.Errorf("invalid partition name %s", name)
+	}
+
+	month, err := time.Parse("200601", matches[2]+matches[3])
+	if err != nil {
+		return Partition{}, errors.Wrapf(err, "invalid month of partition %s", name)
+	}
+
+	return Partition{Name: name, Parent: matches[1], Month: month}, nil
+}
+
+// EnsurePartitions creates the partitions of all partitioned tables for the
+// current and the next months. Existing partitions are left untouched.
+func (c *Client) EnsurePartitions(ctx context.Context) error {
+	for _, table := range PartitionedTables {
+		var created int
+		err := c.QueryRowContext(ctx,
+			"SELECT create_monthly_partitions($1, (NOW() AT TIME ZONE 'UTC')::DATE, (NOW() AT TIME ZONE 'UTC' + make_interval(months => $2))::DATE)",
+			table, partitionsAhead,
+		).Scan(&created)
+		if err != nil {
+			return errors.Wrapf(err, "create partitions of %s", table)
+		}
+
+		if created > 0 {
+			log.WithFields(log.Fields{"table": table, "count": created}).Infoln("Created partitions")
+		}
+	}
+	return nil
+}
+
+// MaintainPartitions creates missing partitions now and then every day until the
+// context is cancelled. Inserts fail if the partition for their month doesn't exist.
+func (c *Client) MaintainPartitions(ctx context.Context) {
+	ticker := time.NewTicker(partitionMaintenanceInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.EnsurePartitions(ctx); err != nil {
+			log.WithError(err).Warnln("Could not create partitions")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Partitions returns the partitions of the given table sorted by month.
+func (c *Client) Partitions(ctx context.Context, table string) ([]Partition, error) {
+	query := `
+SELECT child.relname
+FROM pg_inherits
+         INNER JOIN pg_class parent ON pg_inherits.inhparent = parent.oid
+         INNER JOIN pg_class child ON pg_inherits.inhrelid = child.oid
+WHERE parent.relname = $1
+ORDER BY child.relname`
+
+	rows, err := c.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, errors.Wrapf(err, "query partitions of %s", table)
+	}
+	defer rows.Close()
+
+	var partitions []Partition
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, errors.Wrap(err, "scan partition name")
+		}
+
+		partition, err := parsePartition(name)
+		if err != nil {
+			log.WithError(err).Warnln("Skipping unknown partition")
+			continue
+		}
+		partitions = append(partitions, partition)
+	}
+
+	return partitions, errors.Wrap(rows.Err(), "iterate partitions")
+}
+
+// DropPartition deletes the given partition and all its rows.
+func (c *Client) DropPartition(ctx context.Context, partition Partition) error {
+	if _, err := c.ExecContext(ctx, "DROP TABLE IF EXISTS "+pq.QuoteIdentifier(partition.Name)); err != nil {
+		return errors.Wrapf(err, "drop partition %s", partition.Name)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePartition(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Partition
+		wantErr bool
+	}{
+		{
+			name: "connection_events_y2022m09",
+			want: Partition{Name: "connection_events_y2022m09", Parent: "connection_events", Month: time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "connection_events_x_multi_addresses_y2023m12",
+			want: Partition{Name: "connection_events_x_multi_addresses_y2023m12", Parent: "connection_events_x_multi_addresses", Month: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{name: "connection_events_default", wantErr: true},
+		{name: "connection_events_y2022m13", wantErr: true},
+		{name: "y2022m09", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePartition(tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.Month.AddDate(0, 1, 0), got.End())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"database/sql"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Names of the database handles in the go_sql_* prometheus metrics.
+const (
+	dbNamePrimary = "primary"
+	dbNameReplica = "replica"
+)
+
+// poolConfig configures the connection pool of a database handle.
+type poolConfig struct {
+	maxOpenConns     int
+	maxIdleConns     int
+	connMaxLifetime  time.Duration
+	connMaxIdleTime  time.Duration
+	statementTimeout time.Duration
+}
+
+func poolConfigFromContext(c *cli.Context) poolConfig {
+	return poolConfig{
+		maxOpenConns:     c.Int("db-max-open-conns"),
+		maxIdleConns:     c.Int("db-max-idle-conns"),
+		connMaxLifetime:  c.Duration("db-conn-max-lifetime"),
+		connMaxIdleTime:  c.Duration("db-conn-max-idle-time"),
+		statementTimeout: c.Duration("db-statement-timeout"),
+	}
+}
+
+// open opens and pings a database handle with the given pool configuration
+// and exports its connection pool statistics as prometheus metrics.
+func open(name string, dsn string, conf poolConfig) (*sql.DB, error) {
+	dsn, err := withStatementTimeout(dsn, conf.statementTimeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "set statement timeout")
+	}
+
+	dbh, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening %s database", name)
+	}
+
+	dbh.SetMaxOpenConns(conf.maxOpenConns)
+	dbh.SetMaxIdleConns(conf.maxIdleConns)
+	dbh.SetConnMaxLifetime(conf.connMaxLifetime)
+	dbh.SetConnMaxIdleTime(conf.connMaxIdleTime)
+
+	// Ping database to verify connection.
+	if err = dbh.Ping(); err != nil {
+		_ = dbh.Close()
+		return nil, errors.Wrapf(err, "pinging %s database", name)
+	}
+
+	// Only the first handle of a name is exported, e.g., if the keys command opens a second one
+	if err = prometheus.Register(collectors.NewDBStatsCollector(dbh, name)); err != nil {
+		log.WithError(err).WithField("db", name).Debugln("Could not register database stats collector")
+	}
+
+	return dbh, nil
+}
+
+// withStatementTimeout adds the statement_timeout run-time parameter to the given
+// connection string. The string may be in URL or in keyword/value format.
+// Postgres aborts statements that take longer. A zero timeout disables it.
+func withStatementTimeout(dsn string, timeout time.Duration) (string, error) {
+	if timeout <= 0 {
+		return dsn, nil
+	}
+
+	ms := strconv.FormatInt(timeout.Milliseconds(), 10)
+	if !strings.HasPrefix(dsn, "postgres://") && !strings.HasPrefix(dsn, "postgresql://") {
+		return strings.TrimSpace(dsn) + " statement_timeout=" + ms, nil
+	}
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", errors.Wrap(err, "parse connection url")
+	}
+
+	q := u.Query()
+	q.Set("statement_timeout", ms)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,142 @@
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/dennis-tra/punchr/pkg/models"
+)
+
+// relayStatsWeight is the weight of a new measurement in the rolling statistics of
+// a relay. With 0.1 the last ~20 measurements account for about 90% of the value.
+const relayStatsWeight = 0.1
+
+// RelayObservation is what a single hole punch result tells about a relay.
+type RelayObservation struct {
+	// PeerID is the database ID of the relay peer.
+	PeerID int64
+
+	// Pinged is true if the client tried to reach the relay after the hole punch.
+	Pinged bool
+
+	// Reachable is true if the client could measure the round trip time to the relay.
+	Reachable bool
+
+	// RTT is the average round trip time to the relay in seconds if it was reachable.
+	RTT null.Float64
+
+	// Circuit is true if the client connected to the remote peer through the relay.
+	Circuit bool
+
+	// HolePunched is true if the client hole punched the remote peer after it connected through the
+	// relay. HolePunchSucceeded tells if the hole punch succeeded.
+	HolePunched        bool
+	HolePunchSucceeded bool
+}
+
+// UpsertRelayPeer saves the peer of a relay. Other than UpsertPeer it doesn't
+// overwrite the agent version and protocols if the peer already exists.
+func (c *Client) UpsertRelayPeer(ctx context.Context, exec boil.ContextExecutor, pid peer.ID) (*models.Peer, error) {
+	dbPeer := &models.Peer{MultiHash: pid.String()}
+
+	return dbPeer, dbPeer.Upsert(ctx, exec, true, []string{models.PeerColumns.MultiHash}, boil.Whitelist(models.PeerColumns.UpdatedAt), boil.Infer())
+}
+
+// UpsertRelayPeers upserts the peers of the given relays and returns their database IDs.
+// The peers are upserted in the order of their multi hashes, so that concurrent
+// transactions can't deadlock.
+func (c *Client) UpsertRelayPeers(ctx context.Context, exec boil.ContextExecutor, pids []peer.ID) (map[peer.ID]int64, error) {
+	sort.Slice(pids, func(i, j int) bool {
+		return pids[i].String() < pids[j].String()
+	})
+
+	dbPeerIDs := make(map[peer.ID]int64, len(pids))
+	for _, pid := range pids {
+		if _, found := dbPeerIDs[pid]; found {
+			continue
+		}
+
+		dbPeer, err := c.UpsertRelayPeer(ctx, exec, pid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "upsert relay peer %s", pid)
+		}
+		dbPeerIDs[pid] = dbPeer.ID
+	}
+
+	return dbPeerIDs, nil
+}
+
+// UpdateRelays adds the given observations to the statistics of their relays. The
+// relays are updated in the order of their IDs, so that concurrent transactions
+// can't deadlock.
+func (c *Client) UpdateRelays(ctx context.Context, exec boil.ContextExecutor, observations []*RelayObservation) error {
+	sort.Slice(observations, func(i, j int) bool {
+		return observations[i].PeerID < observations[j].PeerID
+	})
+
+	for _, o := range observations {
+		if _, err := exec.ExecContext(ctx, upsertRelayQuery, o.values()...); err != nil {
+			return errors.Wrapf(err, "upsert relay %d", o.PeerID)
+		}
+	}
+
+	return nil
+}
+
+// upsertRelayQuery inserts or updates the statistics of a relay. The rolling values
+// are only updated if the observation contains a measurement ($4, $5 or $9 are not NULL).
+const upsertRelayQuery = `
+INSERT INTO relays (peer_id, pings, pings_reachable, reachability, rtt, circuits, hole_punches,
+                    hole_punches_successful, hole_punch_success_rate, updated_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+ON CONFLICT (peer_id) DO UPDATE SET
+    pings                   = relays.pings + EXCLUDED.pings,
+    pings_reachable         = relays.pings_reachable + EXCLUDED.pings_reachable,
+    reachability            = coalesce((1 - $10::FLOAT) * relays.reachability + $10::FLOAT * EXCLUDED.reachability,
+                                       EXCLUDED.reachability, relays.reachability),
+    rtt                     = coalesce((1 - $10::FLOAT) * relays.rtt + $10::FLOAT * EXCLUDED.rtt,
+                                       EXCLUDED.rtt, relays.rtt),
+    circuits                = relays.circuits + EXCLUDED.circuits,
+    hole_punches            = relays.hole_punches + EXCLUDED.hole_punches,
+    hole_punches_successful = relays.hole_punches_successful + EXCLUDED.hole_punches_successful,
+    hole_punch_success_rate = coalesce((1 - $10::FLOAT) * relays.hole_punch_success_rate + $10::FLOAT * EXCLUDED.hole_punch_success_rate,
+                                       EXCLUDED.hole_punch_success_rate, relays.hole_punch_success_rate),
+    updated_at              = EXCLUDED.updated_at`
+
+// values returns the arguments of upsertRelayQuery.
+func (o *RelayObservation) values() []interface{} {
+	var pings, pingsReachable, circuits, holePunches, holePunchesSuccessful int
+	var reachability, successRate null.Float64
+
+	if o.Pinged {
+		pings = 1
+		reachability = null.Float64From(0)
+		if o.Reachable {
+			pingsReachable = 1
+			reachability = null.Float64From(1)
+		}
+	}
+
+	if o.Circuit {
+		circuits = 1
+	}
+
+	if o.HolePunched {
+		holePunches = 1
+		successRate = null.Float64From(0)
+		if o.HolePunchSucceeded {
+			holePunchesSuccessful = 1
+			successRate = null.Float64From(1)
+		}
+	}
+
+	return []interface{}{
+		o.PeerID, pings, pingsReachable, reachability, o.RTT, circuits,
+		holePunches, holePunchesSuccessful, successRate, relayStatsWeight,
+	}
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestRelayObservation_values(t *testing.T) {
+	tests := []struct {
+		name        string
+		observation RelayObservation
+		want        []interface{}
+	}{
+		{
+			name:        "unreachable",
+			observation: RelayObservation{PeerID: 1, Pinged: true},
+			want:        []interface{}{int64(1), 1, 0, null.Float64From(0), null.Float64{}, 0, 0, 0, null.Float64{}, relayStatsWeight},
+		},
+		{
+			name:        "reachable",
+			observation: RelayObservation{PeerID: 1, Pinged: true, Reachable: true, RTT: null.Float64From(0.2)},
+			want:        []interface{}{int64(1), 1, 1, null.Float64From(1), null.Float64From(0.2), 0, 0, 0, null.Float64{}, relayStatsWeight},
+		},
+		{
+			name:        "failed hole punch",
+			observation: RelayObservation{PeerID: 2, Circuit: true, HolePunched: true},
+			want:        []interface{}{int64(2), 0, 0, null.Float64{}, null.Float64{}, 1, 1, 0, null.Float64From(0), relayStatsWeight},
+		},
+		{
+			name:        "successful hole punch",
+			observation: RelayObservation{PeerID: 2, Circuit: true, HolePunched: true, HolePunchSucceeded: true},
+			want:        []interface{}{int64(2), 0, 0, null.Float64{}, null.Float64{}, 1, 1, 1, null.Float64From(1), relayStatsWeight},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.observation.values())
+		})
+	}
+}
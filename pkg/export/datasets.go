@@ -0,0 +1,441 @@
+package export
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+
+	"github.com/dennis-tra/punchr/pkg/anonymize"
+)
+
+// resultRow is a row of the results dataset. The parquet tags define the column
+// names and types in both formats, the desc tags are written to the manifest.
+type resultRow struct {
+	ResultID             int64    `parquet:"name=result_id, type=INT64" desc:"The ID of the hole punch result"`
+	ClientID             string   `parquet:"name=client_id, type=BYTE_ARRAY, convertedtype=UTF8" desc:"The peer ID of the punchr client"`
+	ClientAgentVersion   *string  `parquet:"name=client_agent_version, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The agent version of the punchr client"`
+	ClientIP             *string  `parquet:"name=client_ip, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The first public IP address on which the client listened"`
+	ClientCountry        *string  `parquet:"name=client_country, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The ISO country code of the client IP address"`
+	ClientContinent      *string  `parquet:"name=client_continent, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The continent code of the client IP address"`
+	ClientASN            *int64   `parquet:"name=client_asn, type=INT64, repetitiontype=OPTIONAL" desc:"The autonomous system number of the client IP address"`
+	RemoteID             string   `parquet:"name=remote_id, type=BYTE_ARRAY, convertedtype=UTF8" desc:"The peer ID of the remote peer"`
+	RemoteAgentVersion   *string  `parquet:"name=remote_agent_version, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The agent version of the remote peer"`
+	RemoteIP             *string  `parquet:"name=remote_ip, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The IP address of the first direct connection to the remote peer after the hole punch"`
+	RemoteCountry        *string  `parquet:"name=remote_country, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The ISO country code of the remote IP address"`
+	RemoteContinent      *string  `parquet:"name=remote_continent, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The continent code of the remote IP address"`
+	RemoteASN            *int64   `parquet:"name=remote_asn, type=INT64, repetitiontype=OPTIONAL" desc:"The autonomous system number of the remote IP address"`
+	Transport            *string  `parquet:"name=transport, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The transport of the direct connection (tcp, quic)"`
+	IPVersion            *int64   `parquet:"name=ip_version, type=INT64, repetitiontype=OPTIONAL" desc:"The IP version of the direct connection (4, 6)"`
+	RelayID              *string  `parquet:"name=relay_id, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The peer ID of the relay through which the client connected to the remote peer"`
+	RelayCountry         *string  `parquet:"name=relay_country, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The ISO country code of the relay IP address"`
+	RelayASN             *int64   `parquet:"name=relay_asn, type=INT64, repetitiontype=OPTIONAL" desc:"The autonomous system number of the relay IP address"`
+	ProtocolFilters      []int32  `parquet:"name=protocol_filters, type=MAP, convertedtype=LIST, valuetype=INT32" desc:"The protocol filters that the client applied (see punchr.proto)"`
+	ConnectStartedAt     int64    `parquet:"name=connect_started_at, type=INT64, convertedtype=TIMESTAMP_MILLIS" desc:"When the client started to connect to the remote peer through the relay"`
+	ConnectEndedAt       int64    `parquet:"name=connect_ended_at, type=INT64, convertedtype=TIMESTAMP_MILLIS" desc:"When the relayed connection was established or failed"`
+	EndedAt              int64    `parquet:"name=ended_at, type=INT64, convertedtype=TIMESTAMP_MILLIS" desc:"When the hole punch ended"`
+	HasDirectConns       bool     `parquet:"name=has_direct_conns, type=BOOLEAN" desc:"Whether the client had direct connections to the remote peer at the end"`
+	Outcome              string   `parquet:"name=outcome, type=BYTE_ARRAY, convertedtype=UTF8" desc:"The outcome of the hole punch (see the README)"`
+	Error                *string  `parquet:"name=error, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The error message if the hole punch failed"`
+	Attempts             int64    `parquet:"name=attempts, type=INT64" desc:"The number of hole punch attempts"`
+	RTTThroughRelay      *float64 `parquet:"name=rtt_through_relay_s, type=DOUBLE, repetitiontype=OPTIONAL" desc:"The minimum round trip time in seconds to the remote peer through the relay"`
+	RTTAfterHolePunch    *float64 `parquet:"name=rtt_after_hole_punch_s, type=DOUBLE, repetitiontype=OPTIONAL" desc:"The minimum round trip time in seconds to the remote peer over the direct connection"`
+	HasPortMapping       bool     `parquet:"name=has_port_mapping, type=BOOLEAN" desc:"Whether the router of the client had an active port mapping"`
+	ValidationStatus     string   `parquet:"name=validation_status, type=BYTE_ARRAY, convertedtype=UTF8" desc:"The result of the plausibility checks (ACCEPTED, FLAGGED, REJECTED)"`
+	ClientConnType       *string  `parquet:"name=client_connection_type, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The kind of network of the client IP address (MOBILE, RESIDENTIAL, BUSINESS, HOSTING, EDUCATION, SATELLITE) if a connection type database is configured"`
+	RemoteConnType       *string  `parquet:"name=remote_connection_type, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The kind of network of the remote IP address if a connection type database is configured"`
+	RelayReachability    *float64 `parquet:"name=relay_reachability, type=DOUBLE, repetitiontype=OPTIONAL" desc:"The rolling share of pings from clients that reached the relay at the time of the export"`
+	RelayRTT             *float64 `parquet:"name=relay_rtt_s, type=DOUBLE, repetitiontype=OPTIONAL" desc:"The rolling average round trip time in seconds from clients to the relay at the time of the export"`
+	RelaySuccessRate     *float64 `parquet:"name=relay_hole_punch_success_rate, type=DOUBLE, repetitiontype=OPTIONAL" desc:"The rolling share of hole punches through the relay that succeeded at the time of the export"`
+	RelayStrategy        *string  `parquet:"name=relay_strategy, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"How the client chose the relay (ALL, FIRST, LOWEST_RTT, RANDOM, SERVER)"`
+	RelayDials           int64    `parquet:"name=relay_dials, type=INT64" desc:"The number of relays that the client dialed before it connected to the remote peer, zero if it dialed all at once"`
+	TimingArm            *string  `parquet:"name=timing_arm, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The timing experiment arm that the server assigned to the allocation, empty if the client used its own timing"`
+	CommunicationTimeout *float64 `parquet:"name=communication_timeout_s, type=DOUBLE, repetitiontype=OPTIONAL" desc:"How long in seconds the client waited for the /libp2p/dcutr stream and the events of an attempt"`
+	RetryCount           *int64   `parquet:"name=retry_count, type=INT64, repetitiontype=OPTIONAL" desc:"How many hole punch attempts the client waited for"`
+}
+
+// attemptRow is a row of the attempts dataset.
+type attemptRow struct {
+	AttemptID       int64    `parquet:"name=attempt_id, type=INT64" desc:"The ID of the hole punch attempt"`
+	ResultID        int64    `parquet:"name=result_id, type=INT64" desc:"The ID of the hole punch result in the results dataset"`
+	Attempt         int64    `parquet:"name=attempt, type=INT64" desc:"The number of the attempt within the hole punch, starting at 1"`
+	OpenedAt        int64    `parquet:"name=opened_at, type=INT64, convertedtype=TIMESTAMP_MILLIS" desc:"When the hole punch stream was opened"`
+	StartedAt       *int64   `parquet:"name=started_at, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL" desc:"When the hole punch started"`
+	EndedAt         int64    `parquet:"name=ended_at, type=INT64, convertedtype=TIMESTAMP_MILLIS" desc:"When the attempt ended"`
+	StartRTT        *float64 `parquet:"name=start_rtt_s, type=DOUBLE, repetitiontype=OPTIONAL" desc:"The round trip time in seconds that was measured before the hole punch"`
+	ElapsedTime     float64  `parquet:"name=elapsed_time_s, type=DOUBLE" desc:"The duration of the attempt in seconds"`
+	Outcome         string   `parquet:"name=outcome, type=BYTE_ARRAY, convertedtype=UTF8" desc:"The outcome of the attempt (see the README)"`
+	Error           *string  `parquet:"name=error, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The error message if the attempt failed"`
+	DirectDialError *string  `parquet:"name=direct_dial_error, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" desc:"The error message of the direct dial before the hole punch"`
+	Transports      []string `parquet:"name=transports, type=MAP, convertedtype=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8" desc:"The transports of the addresses that were dialed (tcp, quic)"`
+}
+
+// dataset is a file of the export.
+type dataset struct {
+	name        string
+	description string
+
+	// row is a pointer to the row type that defines the schema.
+	row interface{}
+
+	// query selects the rows of the hole punch results that were created between $1 and $2.
+	query string
+
+	// scan converts the current row of the query to a pointer to the row type.
+	scan func(rows *sql.Rows, anon *anonymize.Anonymizer) (interface{}, error)
+}
+
+var datasets = []dataset{
+	{
+		name:        "results",
+		description: "One row per hole punch result with the geo location and autonomous system of the client, remote peer and relay",
+		row:         new(resultRow),
+		query:       resultsQuery,
+		scan:        scanResult,
+	},
+	{
+		name:        "attempts",
+		description: "One row per hole punch attempt",
+		row:         new(attemptRow),
+		query:       attemptsQuery,
+		scan:        scanAttempt,
+	},
+}
+
+const resultsQuery = `
+SELECT hpr.id,
+       lp.multi_hash,
+       lp.agent_version,
+       client.addr,
+       client.country,
+       client.continent,
+       client.asn,
+       rp.multi_hash,
+       rp.agent_version,
+       remote.addr,
+       remote.country,
+       remote.continent,
+       remote.asn,
+       remote.maddr,
+       coalesce(crp.multi_hash, relay.relay_id),
+       relay.country,
+       relay.asn,
+       hpr.protocol_filters,
+       hpr.connect_started_at,
+       hpr.connect_ended_at,
+       hpr.ended_at,
+       hpr.has_direct_conns,
+       hpr.outcome,
+       hpr.error,
+       (SELECT count(*) FROM hole_punch_attempt hpa WHERE hpa.hole_punch_result_id = hpr.id),
+       (SELECT min(lm.rtt_min)
+        FROM latency_measurements lm
+        WHERE lm.hole_punch_result_id = hpr.id
+          AND lm.mtype = 'TO_REMOTE_THROUGH_RELAY'
+          AND array_length(array_remove(lm.rtt_errs, ''), 1) IS NULL),
+       (SELECT min(lm.rtt_min)
+        FROM latency_measurements lm
+        WHERE lm.hole_punch_result_id = hpr.id
+          AND lm.mtype = 'TO_REMOTE_AFTER_HOLEPUNCH'
+          AND array_length(array_remove(lm.rtt_errs, ''), 1) IS NULL),
+       EXISTS(SELECT FROM port_mappings pm WHERE pm.hole_punch_result_id = hpr.id),
+       hpr.validation_status,
+       client.connection_type,
+       remote.connection_type,
+       rs.reachability,
+       rs.rtt,
+       rs.hole_punch_success_rate,
+       hpr.relay_strategy,
+       (SELECT count(*) FROM relay_dials rd WHERE rd.hole_punch_result_id = hpr.id),
+       (SELECT a.timing_arm FROM allocations a WHERE a.id = hpr.allocation_id),
+       EXTRACT('epoch' FROM hpr.communication_timeout),
+       hpr.retry_count
+FROM hole_punch_results hpr
+         INNER JOIN peers lp ON lp.id = hpr.local_id
+         INNER JOIN peers rp ON rp.id = hpr.remote_id
+         LEFT JOIN peers crp ON crp.id = hpr.relay_id
+         LEFT JOIN multi_addresses_sets mas ON mas.id = hpr.listen_multi_addresses_set_id
+         LEFT JOIN LATERAL (
+    SELECT host(ma.addr) AS addr, ma.country, ma.continent, ma.asn, ma.connection_type
+    FROM multi_addresses ma
+    WHERE ma.id = ANY (mas.multi_addresses_ids)
+      AND ma.is_public
+      AND NOT ma.is_relay
+    ORDER BY ma.id
+    LIMIT 1
+    ) client ON TRUE
+         LEFT JOIN LATERAL (
+    SELECT ma.maddr, host(ma.addr) AS addr, ma.country, ma.continent, ma.asn, ma.connection_type
+    FROM hole_punch_results_x_multi_addresses hprxma
+             INNER JOIN multi_addresses ma ON ma.id = hprxma.multi_address_id
+    WHERE hprxma.hole_punch_result_id = hpr.id
+      AND hprxma.relationship = 'FINAL'
+      AND NOT ma.is_relay
+    ORDER BY ma.id
+    LIMIT 1
+    ) remote ON TRUE
+         LEFT JOIN LATERAL (
+    SELECT substring(ma.maddr FROM '/p2p/([^/]+)/p2p-circuit') AS relay_id, ma.country, ma.asn
+    FROM hole_punch_results_x_multi_addresses hprxma
+             INNER JOIN multi_addresses ma ON ma.id = hprxma.multi_address_id
+    WHERE hprxma.hole_punch_result_id = hpr.id
+      AND hprxma.relationship = 'INITIAL'
+      AND ma.is_relay
+    -- prefer the addresses of the relay through which the client connected
+    ORDER BY substring(ma.maddr FROM '/p2p/([^/]+)/p2p-circuit') IS NOT DISTINCT FROM crp.multi_hash DESC, ma.id
+    LIMIT 1
+    ) relay ON TRUE
+         LEFT JOIN peers relay_peer ON relay_peer.multi_hash = coalesce(crp.multi_hash, relay.relay_id)
+         LEFT JOIN relays rs ON rs.peer_id = relay_peer.id
+WHERE hpr.created_at >= $1
+  AND hpr.created_at < $2
+ORDER BY hpr.id`
+
+const attemptsQuery = `
+SELECT hpa.id,
+       hpa.hole_punch_result_id,
+       row_number() OVER (PARTITION BY hpa.hole_punch_result_id ORDER BY hpa.opened_at, hpa.id),
+       hpa.opened_at,
+       hpa.started_at,
+       hpa.ended_at,
+       EXTRACT('epoch' FROM hpa.start_rtt),
+       EXTRACT('epoch' FROM hpa.elapsed_time),
+       hpa.outcome,
+       hpa.error,
+       hpa.direct_dial_error,
+       (SELECT array_agg(ma.maddr ORDER BY ma.id)
+        FROM hole_punch_attempt_x_multi_addresses hpaxma
+                 INNER JOIN multi_addresses ma ON ma.id = hpaxma.multi_address_id
+        WHERE hpaxma.hole_punch_attempt = hpa.id)
+FROM hole_punch_attempt hpa
+         INNER JOIN hole_punch_results hpr ON hpr.id = hpa.hole_punch_result_id
+WHERE hpr.created_at >= $1
+  AND hpr.created_at < $2
+ORDER BY hpa.hole_punch_result_id, hpa.id`
+
+func scanResult(rows *sql.Rows, anon *anonymize.Anonymizer) (interface{}, error) {
+	var (
+		r                  resultRow
+		clientAgentVersion sql.NullString
+		clientIP           sql.NullString
+		clientCountry      sql.NullString
+		clientContinent    sql.NullString
+		clientASN          sql.NullInt64
+		remoteAgentVersion sql.NullString
+		remoteIP           sql.NullString
+		remoteCountry      sql.NullString
+		remoteContinent    sql.NullString
+		remoteASN          sql.NullInt64
+		remoteMaddr        sql.NullString
+		relayID            sql.NullString
+		relayCountry       sql.NullString
+		relayASN           sql.NullInt64
+		protocolFilters    pq.Int32Array
+		connectStartedAt   time.Time
+		connectEndedAt     time.Time
+		endedAt            time.Time
+		resultErr          sql.NullString
+		rttThroughRelay    sql.NullFloat64
+		rttAfterHolePunch  sql.NullFloat64
+		clientConnType     sql.NullString
+		remoteConnType     sql.NullString
+		relayReachability  sql.NullFloat64
+		relayRTT           sql.NullFloat64
+		relaySuccessRate   sql.NullFloat64
+		relayStrategy      sql.NullString
+		timingArm          sql.NullString
+		commTimeout        sql.NullFloat64
+		retryCount         sql.NullInt64
+	)
+
+	err := rows.Scan(
+		&r.ResultID,
+		&r.ClientID,
+		&clientAgentVersion,
+		&clientIP,
+		&clientCountry,
+		&clientContinent,
+		&clientASN,
+		&r.RemoteID,
+		&remoteAgentVersion,
+		&remoteIP,
+		&remoteCountry,
+		&remoteContinent,
+		&remoteASN,
+		&remoteMaddr,
+		&relayID,
+		&relayCountry,
+		&relayASN,
+		&protocolFilters,
+		&connectStartedAt,
+		&connectEndedAt,
+		&endedAt,
+		&r.HasDirectConns,
+		&r.Outcome,
+		&resultErr,
+		&r.Attempts,
+		&rttThroughRelay,
+		&rttAfterHolePunch,
+		&r.HasPortMapping,
+		&r.ValidationStatus,
+		&clientConnType,
+		&remoteConnType,
+		&relayReachability,
+		&relayRTT,
+		&relaySuccessRate,
+		&relayStrategy,
+		&r.RelayDials,
+		&timingArm,
+		&commTimeout,
+		&retryCount,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "scan result")
+	}
+
+	r.ClientID = anon.PeerID(r.ClientID)
+	r.ClientAgentVersion = stringPtr(clientAgentVersion)
+	r.ClientIP = anon.IPPtr(stringPtr(clientIP))
+	r.ClientCountry = stringPtr(clientCountry)
+	r.ClientContinent = stringPtr(clientContinent)
+	r.ClientASN = int64Ptr(clientASN)
+	r.RemoteID = anon.PeerID(r.RemoteID)
+	r.RemoteAgentVersion = stringPtr(remoteAgentVersion)
+	r.RemoteIP = anon.IPPtr(stringPtr(remoteIP))
+	r.RemoteCountry = stringPtr(remoteCountry)
+	r.RemoteContinent = stringPtr(remoteContinent)
+	r.RemoteASN = int64Ptr(remoteASN)
+	if remoteMaddr.Valid {
+		r.Transport, r.IPVersion = transportOf(remoteMaddr.String)
+	}
+	if relayID.Valid {
+		pseudonym := anon.PeerID(relayID.String)
+		r.RelayID = &pseudonym
+	}
+	r.RelayCountry = stringPtr(relayCountry)
+	r.RelayASN = int64Ptr(relayASN)
+	r.ProtocolFilters = protocolFilters
+	r.ConnectStartedAt = connectStartedAt.UnixMilli()
+	r.ConnectEndedAt = connectEndedAt.UnixMilli()
+	r.EndedAt = endedAt.UnixMilli()
+	r.Error = stringPtr(resultErr)
+	r.RTTThroughRelay = float64Ptr(rttThroughRelay)
+	r.RTTAfterHolePunch = float64Ptr(rttAfterHolePunch)
+	r.ClientConnType = stringPtr(clientConnType)
+	r.RemoteConnType = stringPtr(remoteConnType)
+	r.RelayReachability = float64Ptr(relayReachability)
+	r.RelayRTT = float64Ptr(relayRTT)
+	r.RelaySuccessRate = float64Ptr(relaySuccessRate)
+	r.RelayStrategy = stringPtr(relayStrategy)
+	r.TimingArm = stringPtr(timingArm)
+	r.CommunicationTimeout = float64Ptr(commTimeout)
+	r.RetryCount = int64Ptr(retryCount)
+
+	return &r, nil
+}
+
+func scanAttempt(rows *sql.Rows, _ *anonymize.Anonymizer) (interface{}, error) {
+	var (
+		a               attemptRow
+		openedAt        time.Time
+		startedAt       sql.NullTime
+		endedAt         time.Time
+		startRTT        sql.NullFloat64
+		attemptErr      sql.NullString
+		directDialError sql.NullString
+		maddrs          pq.StringArray
+	)
+
+	err := rows.Scan(
+		&a.AttemptID,
+		&a.ResultID,
+		&a.Attempt,
+		&openedAt,
+		&startedAt,
+		&endedAt,
+		&startRTT,
+		&a.ElapsedTime,
+		&a.Outcome,
+		&attemptErr,
+		&directDialError,
+		&maddrs,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "scan attempt")
+	}
+
+	a.OpenedAt = openedAt.UnixMilli()
+	if startedAt.Valid {
+		ms := startedAt.Time.UnixMilli()
+		a.StartedAt = &ms
+	}
+	a.EndedAt = endedAt.UnixMilli()
+	a.StartRTT = float64Ptr(startRTT)
+	a.Error = stringPtr(attemptErr)
+	a.DirectDialError = stringPtr(directDialError)
+
+	a.Transports = []string{}
+	for _, maddr := range maddrs {
+		if transport, _ := transportOf(maddr); transport != nil {
+			a.Transports = append(a.Transports, *transport)
+		}
+	}
+
+	return &a, nil
+}
+
+// transportOf returns the transport (tcp, quic) and IP version of the given multi address.
+// Either is nil if the multi address can't be parsed or doesn't contain the protocol.
+func transportOf(s string) (*string, *int64) {
+	maddr, err := multiaddr.NewMultiaddr(s)
+	if err != nil {
+		return nil, nil
+	}
+
+	var (
+		transport *string
+		ipVersion *int64
+	)
+	multiaddr.ForEach(maddr, func(c multiaddr.Component) bool {
+		switch c.Protocol().Code {
+		case multiaddr.P_IP4:
+			v := int64(4)
+			ipVersion = &v
+		case multiaddr.P_IP6:
+			v := int64(6)
+			ipVersion = &v
+		case multiaddr.P_TCP:
+			t := "tcp"
+			transport = &t
+		case multiaddr.P_QUIC:
+			t := "quic"
+			transport = &t
+		}
+		return true
+	})
+
+	return transport, ipVersion
+}
+
+func stringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+func int64Ptr(i sql.NullInt64) *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int64
+}
+
+func float64Ptr(f sql.NullFloat64) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
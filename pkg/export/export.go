@@ -0,0 +1,210 @@
+// Package export writes denormalized datasets of the hole punch measurements to files, so that
+// they can be analyzed, shared and published without access to the database. An export is a
+// directory with a file per dataset and a manifest.json that documents the time range, the
+// schema version and every column. The schema version is incremented whenever a column is
+// renamed, removed or changes its meaning. Adding columns doesn't change the version.
+package export
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dennis-tra/punchr/pkg/anonymize"
+)
+
+// SchemaVersion is the version of the dataset schemas.
+const SchemaVersion = 1
+
+// Format is the file format of the datasets.
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat returns the dataset format with the given name.
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case FormatCSV, FormatParquet:
+		return Format(name), nil
+	default:
+		return "", fmt.Errorf("unknown export format %s (csv, parquet)", name)
+	}
+}
+
+// extension returns the file extension of the datasets.
+func (f Format) extension() string {
+	if f == FormatParquet {
+		return ".parquet"
+	}
+	return ".csv.gz"
+}
+
+// Options configure an export.
+type Options struct {
+	// Dir is the directory in which the export directory is created.
+	Dir string
+
+	// Format is the file format of the datasets.
+	Format Format
+
+	// From and To limit the export to the hole punch results that
+	// were tracked at or after From and before To.
+	From time.Time
+	To   time.Time
+
+	// Anonymizer replaces peer IDs and IP addresses with pseudonyms. If it's nil, they're exported as is.
+	Anonymizer *anonymize.Anonymizer
+
+	// Version is the version of punchr that created the export.
+	Version string
+}
+
+// Manifest describes an export. It's written to manifest.json in the export directory.
+type Manifest struct {
+	SchemaVersion   int               `json:"schema_version"`
+	PunchrVersion   string            `json:"punchr_version"`
+	CreatedAt       time.Time         `json:"created_at"`
+	From            time.Time         `json:"from"`
+	To              time.Time         `json:"to"`
+	Format          Format            `json:"format"`
+	Anonymized      bool              `json:"anonymized"`
+	IPAnonymization string            `json:"ip_anonymization,omitempty"`
+	Datasets        []DatasetManifest `json:"datasets"`
+}
+
+// DatasetManifest describes a dataset of an export.
+type DatasetManifest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	File        string   `json:"file"`
+	Rows        int      `json:"rows"`
+	Columns     []Column `json:"columns"`
+}
+
+// Name returns the name of the export directory, e.g., punchr_v1_2022-09-01_2022-10-01.
+func (o Options) Name() string {
+	return fmt.Sprintf("punchr_v%d_%s_%s", SchemaVersion, o.From.UTC().Format("2006-01-02"), o.To.UTC().Format("2006-01-02"))
+}
+
+// Export writes all datasets to a new directory in the export directory and returns its path.
+// The datasets are written to a temporary directory that is only renamed to its final name
+// after all datasets were written. It fails if the export directory already exists. All
+// datasets are read in the given transaction, which should be repeatable read, so that
+// the datasets are consistent with each other.
+func Export(ctx context.Context, tx *sql.Tx, opts Options) (string, *Manifest, error) {
+	path := filepath.Join(opts.Dir, opts.Name())
+	if _, err := os.Stat(path); err == nil {
+		return "", nil, fmt.Errorf("export %s already exists", path)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return "", nil, errors.Wrap(err, "remove incomplete export")
+	}
+
+	if err := os.MkdirAll(tmpPath, 0o755); err != nil {
+		return "", nil, errors.Wrap(err, "create export directory")
+	}
+
+	manifest, err := export(ctx, tx, tmpPath, opts)
+	if err != nil {
+		_ = os.RemoveAll(tmpPath)
+		return "", nil, err
+	}
+
+	if err = os.Rename(tmpPath, path); err != nil {
+		return "", nil, errors.Wrap(err, "rename export directory")
+	}
+
+	return path, manifest, nil
+}
+
+func export(ctx context.Context, tx *sql.Tx, dir string, opts Options) (*Manifest, error) {
+	manifest := &Manifest{
+		SchemaVersion:   SchemaVersion,
+		PunchrVersion:   opts.Version,
+		CreatedAt:       time.Now().UTC(),
+		From:            opts.From.UTC(),
+		To:              opts.To.UTC(),
+		Format:          opts.Format,
+		Anonymized:      opts.Anonymizer != nil,
+		IPAnonymization: opts.Anonymizer.IPMode(),
+	}
+
+	for _, ds := range datasets {
+		dm, err := exportDataset(ctx, tx, dir, ds, opts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "export %s", ds.name)
+		}
+		log.WithFields(log.Fields{"dataset": ds.name, "rows": dm.Rows}).Infoln("Exported dataset")
+
+		manifest.Datasets = append(manifest.Datasets, *dm)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal manifest")
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, "manifest.json"), append(data, '\n'), 0o644); err != nil {
+		return nil, errors.Wrap(err, "write manifest")
+	}
+
+	return manifest, nil
+}
+
+func exportDataset(ctx context.Context, tx *sql.Tx, dir string, ds dataset, opts Options) (*DatasetManifest, error) {
+	cols := columns(ds.row)
+
+	dm := &DatasetManifest{
+		Name:        ds.name,
+		Description: ds.description,
+		File:        ds.name + opts.Format.extension(),
+		Columns:     make([]Column, len(cols)),
+	}
+	for i, col := range cols {
+		dm.Columns[i] = col.Column
+	}
+
+	rows, err := tx.QueryContext(ctx, ds.query, opts.From, opts.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "query rows")
+	}
+	defer rows.Close()
+
+	w, err := newRowWriter(filepath.Join(dir, dm.File), opts.Format, ds.row)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		row, err := ds.scan(rows, opts.Anonymizer)
+		if err != nil {
+			_ = w.Close()
+			return nil, err
+		}
+
+		if err = w.Write(row); err != nil {
+			_ = w.Close()
+			return nil, errors.Wrap(err, "write row")
+		}
+		dm.Rows++
+	}
+
+	if err = rows.Err(); err != nil {
+		_ = w.Close()
+		return nil, errors.Wrap(err, "iterate rows")
+	}
+
+	return dm, w.Close()
+}
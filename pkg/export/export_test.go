@@ -0,0 +1,137 @@
+package export
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("parquet")
+	require.NoError(t, err)
+	assert.Equal(t, FormatParquet, format)
+
+	_, err = ParseFormat("json")
+	assert.Error(t, err)
+}
+
+func TestColumns(t *testing.T) {
+	cols := columns(new(resultRow))
+
+	byName := map[string]column{}
+	for _, col := range cols {
+		assert.NotEmpty(t, col.Description, col.Name)
+		byName[col.Name] = col
+	}
+	assert.Len(t, byName, len(cols), "duplicate column names")
+
+	tests := []struct {
+		name      string
+		typ       string
+		nullable  bool
+		timestamp bool
+	}{
+		{name: "result_id", typ: "int64"},
+		{name: "client_id", typ: "string"},
+		{name: "client_ip", typ: "string", nullable: true},
+		{name: "client_asn", typ: "int64", nullable: true},
+		{name: "protocol_filters", typ: "list<int32>"},
+		{name: "connect_started_at", typ: "timestamp", timestamp: true},
+		{name: "has_direct_conns", typ: "bool"},
+		{name: "rtt_through_relay_s", typ: "float64", nullable: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			col, found := byName[tt.name]
+			require.True(t, found)
+			assert.Equal(t, tt.typ, col.Type)
+			assert.Equal(t, tt.nullable, col.Nullable)
+			assert.Equal(t, tt.timestamp, col.timestamp)
+		})
+	}
+
+	for _, col := range columns(new(attemptRow)) {
+		assert.NotEmpty(t, col.Description, col.Name)
+	}
+}
+
+func TestTransportOf(t *testing.T) {
+	tests := []struct {
+		maddr     string
+		transport string
+		ipVersion int64
+	}{
+		{maddr: "/ip4/1.2.3.4/tcp/4001", transport: "tcp", ipVersion: 4},
+		{maddr: "/ip6/::1/udp/4001/quic", transport: "quic", ipVersion: 6},
+		{maddr: "/ip4/1.2.3.4/udp/4001", ipVersion: 4},
+		{maddr: "invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.maddr, func(t *testing.T) {
+			transport, ipVersion := transportOf(tt.maddr)
+			if tt.transport == "" {
+				assert.Nil(t, transport)
+			} else if assert.NotNil(t, transport) {
+				assert.Equal(t, tt.transport, *transport)
+			}
+			if tt.ipVersion == 0 {
+				assert.Nil(t, ipVersion)
+			} else if assert.NotNil(t, ipVersion) {
+				assert.Equal(t, tt.ipVersion, *ipVersion)
+			}
+		})
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "attempts.csv.gz")
+
+	w, err := newRowWriter(path, FormatCSV, new(attemptRow))
+	require.NoError(t, err)
+
+	rtt := 0.25
+	require.NoError(t, w.Write(&attemptRow{
+		AttemptID:   1,
+		ResultID:    2,
+		Attempt:     1,
+		OpenedAt:    1664582400000,
+		EndedAt:     1664582401500,
+		StartRTT:    &rtt,
+		ElapsedTime: 1.5,
+		Outcome:     "SUCCESS",
+		Transports:  []string{"tcp", "quic"},
+	}))
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(gz)
+	require.NoError(t, err)
+
+	expected := "attempt_id,result_id,attempt,opened_at,started_at,ended_at,start_rtt_s,elapsed_time_s,outcome,error,direct_dial_error,transports\n" +
+		"1,2,1,2022-10-01T00:00:00Z,,2022-10-01T00:00:01.5Z,0.25,1.5,SUCCESS,,,\"tcp,quic\"\n"
+	assert.Equal(t, expected, string(data))
+}
+
+func TestParquetWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.parquet")
+
+	w, err := newRowWriter(path, FormatParquet, new(resultRow))
+	require.NoError(t, err)
+	require.NoError(t, w.Write(&resultRow{ResultID: 1, ClientID: "client", RemoteID: "remote", ProtocolFilters: []int32{1}, Outcome: "SUCCESS"}))
+	require.NoError(t, w.Close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Greater(t, info.Size(), int64(0))
+}
@@ -0,0 +1,242 @@
+package export
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// Column documents a column of a dataset.
+type Column struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Nullable    bool   `json:"nullable"`
+	Description string `json:"description"`
+}
+
+// column is a column of a row type.
+type column struct {
+	Column
+
+	// timestamp is true if the field holds milliseconds since the epoch.
+	timestamp bool
+}
+
+// columns derives the columns of the given row type from its struct tags.
+func columns(row interface{}) []column {
+	t := reflect.TypeOf(row)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	cols := make([]column, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tags := map[string]string{}
+		for _, tag := range strings.Split(field.Tag.Get("parquet"), ",") {
+			if key, value, found := strings.Cut(strings.TrimSpace(tag), "="); found {
+				tags[key] = value
+			}
+		}
+
+		col := column{
+			Column: Column{
+				Name:        tags["name"],
+				Nullable:    tags["repetitiontype"] == "OPTIONAL",
+				Description: field.Tag.Get("desc"),
+			},
+			timestamp: tags["convertedtype"] == "TIMESTAMP_MILLIS",
+		}
+
+		switch {
+		case col.timestamp:
+			col.Type = "timestamp"
+		case tags["convertedtype"] == "LIST":
+			col.Type = "list<" + columnType(tags["valuetype"], tags["valueconvertedtype"]) + ">"
+		default:
+			col.Type = columnType(tags["type"], tags["convertedtype"])
+		}
+
+		cols[i] = col
+	}
+
+	return cols
+}
+
+// columnType returns the documented type of a parquet type.
+func columnType(typ string, convertedType string) string {
+	switch {
+	case convertedType == "UTF8":
+		return "string"
+	case typ == "INT64":
+		return "int64"
+	case typ == "INT32":
+		return "int32"
+	case typ == "DOUBLE":
+		return "float64"
+	case typ == "BOOLEAN":
+		return "bool"
+	default:
+		return strings.ToLower(typ)
+	}
+}
+
+// rowWriter writes rows of a row type to a dataset file.
+type rowWriter interface {
+	Write(row interface{}) error
+	Close() error
+}
+
+// newRowWriter creates the file at the given path and returns a writer for rows of the given type.
+func newRowWriter(path string, format Format, row interface{}) (rowWriter, error) {
+	switch format {
+	case FormatParquet:
+		file, err := local.NewLocalFileWriter(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "new local file writer")
+		}
+
+		w, err := newParquetWriter(file, row)
+		if err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		return w, nil
+	default:
+		file, err := os.Create(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "create file")
+		}
+		return newCSVWriter(file, columns(row))
+	}
+}
+
+// parquetWriter writes snappy compressed parquet files with the schema of the row type.
+type parquetWriter struct {
+	file source.ParquetFile
+	pw   *writer.ParquetWriter
+}
+
+func newParquetWriter(file source.ParquetFile, row interface{}) (*parquetWriter, error) {
+	pw, err := writer.NewParquetWriter(file, row, 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "new parquet writer")
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	return &parquetWriter{file: file, pw: pw}, nil
+}
+
+func (w *parquetWriter) Write(row interface{}) error {
+	return w.pw.Write(row)
+}
+
+func (w *parquetWriter) Close() error {
+	if err := w.pw.WriteStop(); err != nil {
+		_ = w.file.Close()
+		return errors.Wrap(err, "write parquet footer")
+	}
+
+	return errors.Wrap(w.file.Close(), "close parquet file")
+}
+
+// csvWriter writes gzip compressed CSV files with a header row. NULL values are empty, timestamps
+// are formatted as RFC 3339 in UTC and lists are written as comma separated values.
+type csvWriter struct {
+	file    *os.File
+	gz      *gzip.Writer
+	csv     *csv.Writer
+	columns []column
+}
+
+func newCSVWriter(file *os.File, columns []column) (*csvWriter, error) {
+	gz := gzip.NewWriter(file)
+	w := &csvWriter{file: file, gz: gz, csv: csv.NewWriter(gz), columns: columns}
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.Name
+	}
+
+	if err := w.csv.Write(header); err != nil {
+		_ = file.Close()
+		return nil, errors.Wrap(err, "write header")
+	}
+
+	return w, nil
+}
+
+func (w *csvWriter) Write(row interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(row))
+
+	record := make([]string, len(w.columns))
+	for i, col := range w.columns {
+		record[i] = formatField(v.Field(i), col.timestamp)
+	}
+
+	return w.csv.Write(record)
+}
+
+// formatField returns the CSV representation of a field of a row type.
+func formatField(v reflect.Value, timestamp bool) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int32, reflect.Int64:
+		if timestamp {
+			return time.UnixMilli(v.Int()).UTC().Format(time.RFC3339Nano)
+		}
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Slice:
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = formatField(v.Index(i), false)
+		}
+		return strings.Join(elems, ",")
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+func (w *csvWriter) Close() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		_ = w.file.Close()
+		return errors.Wrap(err, "flush csv")
+	}
+
+	if err := w.gz.Close(); err != nil {
+		_ = w.file.Close()
+		return errors.Wrap(err, "close gzip writer")
+	}
+
+	if err := w.file.Sync(); err != nil {
+		_ = w.file.Close()
+		return errors.Wrap(err, "sync file")
+	}
+
+	return errors.Wrap(w.file.Close(), "close file")
+}
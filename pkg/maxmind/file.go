@@ -0,0 +1,128 @@
+package maxmind
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// FileProvider looks up IP addresses in an MMDB file on disk. It supports the
+// MaxMind GeoIP2/GeoLite2 Country, City, ASN and Connection Type databases, the
+// DB-IP databases and the IPinfo databases. The file is reloaded whenever it
+// changes, so that it can be kept up-to-date with, e.g., geoipupdate.
+type FileProvider struct {
+	path string
+
+	mu sync.RWMutex
+	db *database
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+var _ GeoProvider = (*FileProvider)(nil)
+
+// NewFileProvider loads the MMDB file at the given path and starts watching it for changes.
+// Like the certs.Reloader, it watches the directory of the file because update tools
+// replace the file instead of writing to it.
+func NewFileProvider(path string) (*FileProvider, error) {
+	p := &FileProvider{
+		path: filepath.Clean(path),
+		done: make(chan struct{}),
+	}
+
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "new file watcher")
+	}
+
+	if err = watcher.Add(filepath.Dir(p.path)); err != nil {
+		_ = watcher.Close()
+		return nil, errors.Wrapf(err, "watch %s", filepath.Dir(p.path))
+	}
+	p.watcher = watcher
+
+	go p.watch()
+
+	return p, nil
+}
+
+func (p *FileProvider) watch() {
+	for {
+		select {
+		case <-p.done:
+			return
+		case evt, ok := <-p.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if evt.Op == fsnotify.Chmod || filepath.Clean(evt.Name) != p.path {
+				continue
+			}
+
+			// A failed reload keeps the previous database, e.g., if the file was removed.
+			if err := p.Reload(); err != nil {
+				log.WithError(err).WithField("file", p.path).Warnln("Could not reload geo database")
+			} else {
+				log.WithField("file", p.path).Infoln("Reloaded geo database")
+			}
+		case err, ok := <-p.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.WithError(err).WithField("file", p.path).Warnln("Error watching geo database")
+		}
+	}
+}
+
+// Reload reads the MMDB file from disk. The file is read into memory instead of being
+// memory mapped, so that writing to it while it's in use can't crash the process.
+func (p *FileProvider) Reload() error {
+	buf, err := os.ReadFile(p.path)
+	if err != nil {
+		return errors.Wrap(err, "read geo database")
+	}
+
+	db, err := openDatabase(buf)
+	if err != nil {
+		return errors.Wrapf(err, "open geo database %s", p.path)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.db = db
+
+	return nil
+}
+
+func (p *FileProvider) Lookup(ip net.IP) (*AddrInfo, error) {
+	p.mu.RLock()
+	db := p.db
+	p.mu.RUnlock()
+
+	return db.lookup(ip)
+}
+
+func (p *FileProvider) Version() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.db.version()
+}
+
+// Close stops watching the file.
+func (p *FileProvider) Close() error {
+	close(p.done)
+	return p.watcher.Close()
+}
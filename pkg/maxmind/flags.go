@@ -0,0 +1,57 @@
+package maxmind
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Flags returns the flags that configure the geo databases (see NewClientFromContext). The
+// environment variables of the flags are prefixed with envPrefix, e.g., PUNCHR_SERVER.
+func Flags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:      "geo-db",
+			Usage:     "Look up locations, ASNs and connection types in these MMDB files (MaxMind GeoIP2/GeoLite2, DB-IP or IPinfo). Earlier files take precedence. Files are reloaded when they change",
+			TakesFile: true,
+			EnvVars:   []string{envPrefix + "_GEO_DATABASES"},
+		},
+		&cli.BoolFlag{
+			Name:        "geo-db-embedded-fallback",
+			Usage:       "Fall back to the embedded GeoLite2 databases for data that the geo-db files don't provide",
+			EnvVars:     []string{envPrefix + "_GEO_DATABASE_EMBEDDED_FALLBACK"},
+			DefaultText: "true",
+			Value:       true,
+		},
+	}
+}
+
+// NewClientFromContext initializes a client that looks up addresses in the geo-db files
+// followed by the embedded databases. Without geo-db files, only the embedded databases are used.
+func NewClientFromContext(c *cli.Context) (*Client, error) {
+	var providers Providers
+	for _, path := range c.StringSlice("geo-db") {
+		log.WithField("file", path).Infoln("Loading geo database")
+		p, err := NewFileProvider(path)
+		if err != nil {
+			_ = providers.Close()
+			return nil, errors.Wrapf(err, "new file provider for %s", path)
+		}
+		providers = append(providers, p)
+	}
+
+	if c.Bool("geo-db-embedded-fallback") || len(providers) == 0 {
+		p, err := NewEmbeddedProvider()
+		if err != nil {
+			_ = providers.Close()
+			return nil, errors.Wrap(err, "new embedded provider")
+		}
+		providers = append(providers, p)
+	}
+
+	if len(providers) == 1 {
+		return NewClientWithProvider(providers[0]), nil
+	}
+
+	return NewClientWithProvider(providers), nil
+}
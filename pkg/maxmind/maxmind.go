@@ -9,7 +9,6 @@ import (
 	"github.com/friendsofgo/errors"
 	ma "github.com/multiformats/go-multiaddr"
 	madns "github.com/multiformats/go-multiaddr-dns"
-	"github.com/oschwald/geoip2-golang"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -19,113 +18,143 @@ var geoLite2Country []byte
 //go:embed GeoLite2-ASN.mmdb
 var geoLite2ASN []byte
 
+// Client derives geo information of multi addresses from a GeoProvider.
 type Client struct {
-	countryReader *geoip2.Reader
-	asnReader     *geoip2.Reader
+	provider GeoProvider
 }
 
 // NewClient initializes a new maxmind database client from the embedded database
 func NewClient() (*Client, error) {
-	countryReader, err := geoip2.FromBytes(geoLite2Country)
+	provider, err := NewEmbeddedProvider()
 	if err != nil {
-		return nil, errors.Wrap(err, "geoip from bytes")
+		return nil, errors.Wrap(err, "new embedded provider")
 	}
 
-	asnReader, err := geoip2.FromBytes(geoLite2ASN)
-	if err != nil {
-		return nil, errors.Wrap(err, "geoip from bytes")
-	}
+	return NewClientWithProvider(provider), nil
+}
 
-	return &Client{
-		countryReader: countryReader,
-		asnReader:     asnReader,
-	}, nil
+// NewClientWithProvider initializes a new client that looks up addresses with the given provider.
+func NewClientWithProvider(provider GeoProvider) *Client {
+	return &Client{provider: provider}
 }
 
 type AddrInfo struct {
 	Continent string
 	Country   string
 	ASN       uint
+
+	// ASOrg is the organization of the autonomous system.
+	ASOrg string
+
+	// City and ConnectionType are only known if a geo
+	// database with city or connection type data is configured.
+	City           string
+	ConnectionType ConnectionType
+}
+
+// merge sets all empty fields of the info to the values of other.
+func (i *AddrInfo) merge(other *AddrInfo) {
+	if i.Continent == "" {
+		i.Continent = other.Continent
+	}
+	if i.Country == "" {
+		i.Country = other.Country
+	}
+	if i.ASN == 0 {
+		i.ASN = other.ASN
+		i.ASOrg = other.ASOrg
+	}
+	if i.City == "" {
+		i.City = other.City
+	}
+	if i.ConnectionType == "" {
+		i.ConnectionType = other.ConnectionType
+	}
 }
 
 // MaddrInfo resolve the give multi address to its corresponding
 // IP addresses (it could be multiple due to protocols like dnsaddr)
-// and returns a map of the form IP-address -> Country ISO code.
+// and returns a map of the form IP-address -> geo information.
 func (c *Client) MaddrInfo(ctx context.Context, maddr ma.Multiaddr) (map[string]*AddrInfo, error) {
-	resolved := resolveAddrs(ctx, maddr)
-	if len(resolved) == 0 {
-		return nil, fmt.Errorf("could not resolve multi address %s", maddr)
+	resolved, err := c.Resolve(ctx, maddr)
+	if err != nil {
+		return nil, err
 	}
 
 	infos := map[string]*AddrInfo{}
 	for _, addr := range resolved {
-		country, continent, err := c.AddrCountryContinent(addr)
+		info, err := c.AddrInfo(addr)
 		if err != nil {
-			log.Debugln("could not derive Country for address", addr)
+			log.Debugln("could not derive geo information for address", addr)
+			info = &AddrInfo{}
 		}
-		asn, _, err := c.AddrAS(addr)
-		if err != nil {
-			log.Debugln("could not derive Country for address", addr)
-		}
-		infos[addr] = &AddrInfo{Country: country, Continent: continent, ASN: asn}
+		infos[addr] = info
 	}
 	return infos, nil
 }
 
-// AddrCountry takes an IP address string and tries to derive the Country ISO code.
-func (c *Client) AddrCountry(addr string) (string, error) {
+// Resolve returns the IP addresses of the given multi address. Multi addresses with
+// DNS components can resolve to multiple addresses.
+func (c *Client) Resolve(ctx context.Context, maddr ma.Multiaddr) ([]string, error) {
+	resolved := resolveAddrs(ctx, maddr)
+	if len(resolved) == 0 {
+		return nil, fmt.Errorf("could not resolve multi address %s", maddr)
+	}
+	return resolved, nil
+}
+
+// AddrInfo takes an IP address string and looks up all information the provider has about it.
+func (c *Client) AddrInfo(addr string) (*AddrInfo, error) {
 	ip := net.ParseIP(addr)
 	if ip == nil {
-		return "", fmt.Errorf("invalid address %s", addr)
+		return nil, fmt.Errorf("invalid address %s", addr)
 	}
-	record, err := c.countryReader.Country(ip)
+	return c.provider.Lookup(ip)
+}
+
+// AddrCountry takes an IP address string and tries to derive the Country ISO code.
+func (c *Client) AddrCountry(addr string) (string, error) {
+	info, err := c.AddrInfo(addr)
 	if err != nil {
 		return "", err
 	}
-	return record.Country.IsoCode, nil
+	return info.Country, nil
 }
 
 // AddrContinent takes an IP address string and tries to derive the continent ISO code.
 func (c *Client) AddrContinent(addr string) (string, error) {
-	ip := net.ParseIP(addr)
-	if ip == nil {
-		return "", fmt.Errorf("invalid address %s", addr)
-	}
-	record, err := c.countryReader.Country(ip)
+	info, err := c.AddrInfo(addr)
 	if err != nil {
 		return "", err
 	}
-	return record.Continent.Code, nil
+	return info.Continent, nil
 }
 
 // AddrCountryContinent takes an IP address string and tries to derive the Country  ISO code plus Continent code.
 func (c *Client) AddrCountryContinent(addr string) (string, string, error) {
-	ip := net.ParseIP(addr)
-	if ip == nil {
-		return "", "", fmt.Errorf("invalid address %s", addr)
-	}
-	record, err := c.countryReader.Country(ip)
+	info, err := c.AddrInfo(addr)
 	if err != nil {
 		return "", "", err
 	}
-	return record.Country.IsoCode, record.Continent.Code, nil
+	return info.Country, info.Continent, nil
 }
 
 // AddrAS takes an IP address string and tries to derive the Autonomous System Number
 func (c *Client) AddrAS(addr string) (uint, string, error) {
-	ip := net.ParseIP(addr)
-	if ip == nil {
-		return 0, "", fmt.Errorf("invalid address %s", addr)
-	}
-	record, err := c.asnReader.ASN(ip)
+	info, err := c.AddrInfo(addr)
 	if err != nil {
 		return 0, "", err
 	}
-	return record.AutonomousSystemNumber, record.AutonomousSystemOrganization, nil
+	return info.ASN, info.ASOrg, nil
+}
+
+// Version identifies the geo data of the provider. It changes whenever the geo databases are updated.
+func (c *Client) Version() string {
+	return c.provider.Version()
 }
 
 func (c *Client) Close() error {
-	return c.countryReader.Close()
+	return c.provider.Close()
 }
 
 // resolveAddrs loops through the multi addresses of the given peer and recursively resolves
@@ -0,0 +1,260 @@
+package maxmind
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/pkg/errors"
+)
+
+// GeoProvider derives geolocation and network information of IP addresses.
+type GeoProvider interface {
+	// Lookup returns everything the provider knows about the IP address. Fields
+	// it has no data for are empty. Unknown addresses are not an error.
+	Lookup(ip net.IP) (*AddrInfo, error)
+
+	// Version identifies the data of the provider. It changes whenever the data is updated.
+	Version() string
+
+	// Close releases the resources of the provider.
+	Close() error
+}
+
+// ConnectionType is the kind of network an IP address belongs to. The values
+// correspond to the connection_type enum of the database.
+type ConnectionType string
+
+const (
+	ConnectionTypeMobile      ConnectionType = "MOBILE"
+	ConnectionTypeResidential ConnectionType = "RESIDENTIAL"
+	ConnectionTypeBusiness    ConnectionType = "BUSINESS"
+	ConnectionTypeHosting     ConnectionType = "HOSTING"
+	ConnectionTypeEducation   ConnectionType = "EDUCATION"
+	ConnectionTypeSatellite   ConnectionType = "SATELLITE"
+)
+
+// connectionTypes maps the connection types of the MaxMind GeoIP2 Connection Type
+// database and the types of the IPinfo ASN database to our connection types.
+var connectionTypes = map[string]ConnectionType{
+	// MaxMind
+	"cellular":  ConnectionTypeMobile,
+	"cable/dsl": ConnectionTypeResidential,
+	"dialup":    ConnectionTypeResidential,
+	"corporate": ConnectionTypeBusiness,
+	"satellite": ConnectionTypeSatellite,
+
+	// IPinfo
+	"isp":       ConnectionTypeResidential,
+	"hosting":   ConnectionTypeHosting,
+	"business":  ConnectionTypeBusiness,
+	"education": ConnectionTypeEducation,
+}
+
+// parseConnectionType returns the connection type of the given database
+// value. It's empty for unknown values.
+func parseConnectionType(s string) ConnectionType {
+	return connectionTypes[strings.ToLower(s)]
+}
+
+// schema is the record layout of an MMDB database.
+type schema int
+
+const (
+	// schemaGeoIP2 is the layout of the MaxMind GeoIP2 and GeoLite2 databases.
+	// DB-IP publishes their databases in the same layout.
+	schemaGeoIP2 schema = iota
+
+	// schemaIPinfo is the flat layout of the IPinfo databases.
+	schemaIPinfo
+)
+
+// geoIP2Record holds the fields of the GeoIP2 Country, City, ASN and Connection Type databases.
+type geoIP2Record struct {
+	Continent struct {
+		Code string `maxminddb:"code"`
+	} `maxminddb:"continent"`
+	Country struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	AutonomousSystemNumber       uint   `maxminddb:"autonomous_system_number"`
+	AutonomousSystemOrganization string `maxminddb:"autonomous_system_organization"`
+	ConnectionType               string `maxminddb:"connection_type"`
+}
+
+// ipinfoRecord holds the fields of the IPinfo location, ASN and country_asn databases.
+type ipinfoRecord struct {
+	Continent string `maxminddb:"continent"`
+	Country   string `maxminddb:"country"`
+	City      string `maxminddb:"city"`
+	ASN       string `maxminddb:"asn"`
+	ASName    string `maxminddb:"as_name"`
+	Name      string `maxminddb:"name"`
+	Type      string `maxminddb:"type"`
+}
+
+// database is an opened MMDB database.
+type database struct {
+	reader *maxminddb.Reader
+	schema schema
+}
+
+// openDatabase parses the MMDB database in buf and detects its schema from its database type.
+func openDatabase(buf []byte) (*database, error) {
+	reader, err := maxminddb.FromBytes(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "maxminddb from bytes")
+	}
+
+	db := &database{reader: reader, schema: schemaGeoIP2}
+	if strings.HasPrefix(strings.ToLower(reader.Metadata.DatabaseType), "ipinfo") {
+		db.schema = schemaIPinfo
+	}
+
+	return db, nil
+}
+
+// version returns the type and build time of the database, e.g., GeoLite2-Country@1667260800.
+func (d *database) version() string {
+	return fmt.Sprintf("%s@%d", d.reader.Metadata.DatabaseType, d.reader.Metadata.BuildEpoch)
+}
+
+// lookup returns the information of the database about the IP address.
+func (d *database) lookup(ip net.IP) (*AddrInfo, error) {
+	if d.schema == schemaIPinfo {
+		var record ipinfoRecord
+		if err := d.reader.Lookup(ip, &record); err != nil {
+			return nil, err
+		}
+
+		asn, _ := strconv.ParseUint(strings.TrimPrefix(record.ASN, "AS"), 10, 32)
+		asOrg := record.ASName
+		if asOrg == "" {
+			asOrg = record.Name
+		}
+
+		return &AddrInfo{
+			Continent:      record.Continent,
+			Country:        record.Country,
+			City:           record.City,
+			ASN:            uint(asn),
+			ASOrg:          asOrg,
+			ConnectionType: parseConnectionType(record.Type),
+		}, nil
+	}
+
+	var record geoIP2Record
+	if err := d.reader.Lookup(ip, &record); err != nil {
+		return nil, err
+	}
+
+	return &AddrInfo{
+		Continent:      record.Continent.Code,
+		Country:        record.Country.IsoCode,
+		City:           record.City.Names["en"],
+		ASN:            record.AutonomousSystemNumber,
+		ASOrg:          record.AutonomousSystemOrganization,
+		ConnectionType: parseConnectionType(record.ConnectionType),
+	}, nil
+}
+
+// EmbeddedProvider looks up IP addresses in the GeoLite2 Country and ASN databases
+// that are embedded into the binary. Their data is as old as the build.
+type EmbeddedProvider struct {
+	country *database
+	asn     *database
+}
+
+var _ GeoProvider = (*EmbeddedProvider)(nil)
+
+// NewEmbeddedProvider opens the embedded databases.
+func NewEmbeddedProvider() (*EmbeddedProvider, error) {
+	country, err := openDatabase(geoLite2Country)
+	if err != nil {
+		return nil, errors.Wrap(err, "open embedded country database")
+	}
+
+	asn, err := openDatabase(geoLite2ASN)
+	if err != nil {
+		return nil, errors.Wrap(err, "open embedded asn database")
+	}
+
+	return &EmbeddedProvider{country: country, asn: asn}, nil
+}
+
+func (p *EmbeddedProvider) Lookup(ip net.IP) (*AddrInfo, error) {
+	info, err := p.country.lookup(ip)
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup country")
+	}
+
+	asnInfo, err := p.asn.lookup(ip)
+	if err != nil {
+		return nil, errors.Wrap(err, "lookup asn")
+	}
+	info.merge(asnInfo)
+
+	return info, nil
+}
+
+func (p *EmbeddedProvider) Version() string {
+	return p.country.version() + "," + p.asn.version()
+}
+
+func (p *EmbeddedProvider) Close() error {
+	return nil
+}
+
+// Providers queries all of its providers and merges their answers. The
+// first provider that knows a field of an address determines its value.
+type Providers []GeoProvider
+
+var _ GeoProvider = (Providers)(nil)
+
+func (ps Providers) Lookup(ip net.IP) (*AddrInfo, error) {
+	info := &AddrInfo{}
+	var errs []string
+	for _, p := range ps {
+		pinfo, err := p.Lookup(ip)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		info.merge(pinfo)
+	}
+
+	// Only fail if no provider could answer
+	if len(errs) == len(ps) && len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "; "))
+	}
+
+	return info, nil
+}
+
+func (ps Providers) Version() string {
+	versions := make([]string, len(ps))
+	for i, p := range ps {
+		versions[i] = p.Version()
+	}
+	return strings.Join(versions, ",")
+}
+
+func (ps Providers) Close() error {
+	var errs []string
+	for _, p := range ps {
+		if err := p.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
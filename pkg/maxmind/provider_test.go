@@ -0,0 +1,372 @@
+package maxmind
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testNetwork is a network and its record in a test MMDB database.
+type testNetwork struct {
+	cidr   string
+	record map[string]interface{}
+}
+
+// trieNode is a node of the search tree of a test MMDB database. Leaves reference a record.
+type trieNode struct {
+	children [2]*trieNode
+	record   int
+}
+
+// buildMMDB returns an IPv6 MMDB database with 24 bit records of the given type that
+// maps the networks to their records. IPv4 networks are stored in the ::/96 subtree.
+func buildMMDB(t *testing.T, dbType string, networks ...testNetwork) []byte {
+	root := &trieNode{record: -1}
+	for i, n := range networks {
+		_, ipnet, err := net.ParseCIDR(n.cidr)
+		require.NoError(t, err)
+
+		ones, _ := ipnet.Mask.Size()
+		ip := ipnet.IP.To16()
+		if ipnet.IP.To4() != nil {
+			ip = append(make(net.IP, 12), ipnet.IP.To4()...)
+			ones += 96
+		}
+
+		node := root
+		for bit := 0; bit < ones; bit++ {
+			b := (ip[bit/8] >> (7 - bit%8)) & 1
+			if node.children[b] == nil {
+				node.children[b] = &trieNode{record: -1}
+			}
+			node = node.children[b]
+		}
+		node.record = i
+	}
+
+	// Number the inner nodes breadth first
+	var nodes []*trieNode
+	numbers := map[*trieNode]int{}
+	for queue := []*trieNode{root}; len(queue) > 0; queue = queue[1:] {
+		node := queue[0]
+		numbers[node] = len(nodes)
+		nodes = append(nodes, node)
+		for _, child := range node.children {
+			if child != nil && child.record < 0 {
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	data := &bytes.Buffer{}
+	offsets := make([]int, len(networks))
+	for i, n := range networks {
+		offsets[i] = data.Len()
+		encodeMMDB(data, n.record)
+	}
+
+	nodeCount := len(nodes)
+	tree := &bytes.Buffer{}
+	for _, node := range nodes {
+		for _, child := range node.children {
+			value := nodeCount
+			if child != nil && child.record >= 0 {
+				value = nodeCount + 16 + offsets[child.record]
+			} else if child != nil {
+				value = numbers[child]
+			}
+			tree.Write([]byte{byte(value >> 16), byte(value >> 8), byte(value)})
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	buf.Write(tree.Bytes())
+	buf.Write(make([]byte, 16))
+	buf.Write(data.Bytes())
+	buf.WriteString("\xab\xcd\xefMaxMind.com")
+	encodeMMDB(buf, map[string]interface{}{
+		"binary_format_major_version": uint16(2),
+		"binary_format_minor_version": uint16(0),
+		"build_epoch":                 uint64(time.Now().Unix()),
+		"database_type":               dbType,
+		"ip_version":                  uint16(6),
+		"node_count":                  uint32(nodeCount),
+		"record_size":                 uint16(24),
+	})
+
+	return buf.Bytes()
+}
+
+// encodeMMDB writes the value in the MaxMind DB data section format.
+func encodeMMDB(buf *bytes.Buffer, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		writeControl(buf, 2, len(v))
+		buf.WriteString(v)
+	case uint16:
+		writeControl(buf, 5, 2)
+		_ = binary.Write(buf, binary.BigEndian, v)
+	case uint32:
+		writeControl(buf, 6, 4)
+		_ = binary.Write(buf, binary.BigEndian, v)
+	case uint64:
+		writeControl(buf, 9, 8)
+		_ = binary.Write(buf, binary.BigEndian, v)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		writeControl(buf, 7, len(v))
+		for _, key := range keys {
+			encodeMMDB(buf, key)
+			encodeMMDB(buf, v[key])
+		}
+	default:
+		panic("unsupported type")
+	}
+}
+
+// writeControl writes the control byte(s) of a field of the given type and size.
+func writeControl(buf *bytes.Buffer, typ int, size int) {
+	var extra []byte
+	switch {
+	case size < 29:
+	case size < 285:
+		extra = []byte{byte(size - 29)}
+		size = 29
+	default:
+		panic("unsupported size")
+	}
+
+	if typ > 7 {
+		buf.WriteByte(byte(size))
+		buf.WriteByte(byte(typ - 7))
+	} else {
+		buf.WriteByte(byte(typ<<5 | size))
+	}
+	buf.Write(extra)
+}
+
+func writeMMDB(t *testing.T, path string, dbType string, networks ...testNetwork) {
+	tmpPath := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmpPath, buildMMDB(t, dbType, networks...), 0o644))
+	require.NoError(t, os.Rename(tmpPath, path))
+}
+
+func TestFileProvider_Lookup(t *testing.T) {
+	dir := t.TempDir()
+
+	geoIP2City := filepath.Join(dir, "GeoIP2-City.mmdb")
+	writeMMDB(t, geoIP2City, "GeoIP2-City", testNetwork{
+		cidr: "1.2.3.0/24",
+		record: map[string]interface{}{
+			"continent": map[string]interface{}{"code": "EU"},
+			"country":   map[string]interface{}{"iso_code": "DE"},
+			"city":      map[string]interface{}{"names": map[string]interface{}{"en": "Berlin", "de": "Berlin"}},
+		},
+	}, testNetwork{
+		cidr: "2001:db8::/32",
+		record: map[string]interface{}{
+			"country": map[string]interface{}{"iso_code": "FR"},
+		},
+	})
+
+	geoIP2ConnType := filepath.Join(dir, "GeoIP2-Connection-Type.mmdb")
+	writeMMDB(t, geoIP2ConnType, "GeoIP2-Connection-Type", testNetwork{
+		cidr:   "1.2.0.0/16",
+		record: map[string]interface{}{"connection_type": "Cellular"},
+	})
+
+	dbipASN := filepath.Join(dir, "dbip-asn-lite.mmdb")
+	writeMMDB(t, dbipASN, "DBIP-ASN-Lite (compat=GeoLite2-ASN)", testNetwork{
+		cidr: "1.2.3.0/24",
+		record: map[string]interface{}{
+			"autonomous_system_number":       uint32(3320),
+			"autonomous_system_organization": "Deutsche Telekom AG",
+		},
+	})
+
+	ipinfo := filepath.Join(dir, "asn.mmdb")
+	writeMMDB(t, ipinfo, "ipinfo asn.mmdb", testNetwork{
+		cidr: "5.6.7.0/24",
+		record: map[string]interface{}{
+			"asn":  "AS24940",
+			"name": "Hetzner Online GmbH",
+			"type": "hosting",
+		},
+	})
+
+	ipinfoCountryASN := filepath.Join(dir, "country_asn.mmdb")
+	writeMMDB(t, ipinfoCountryASN, "ipinfo country_asn.mmdb", testNetwork{
+		cidr: "5.6.7.0/24",
+		record: map[string]interface{}{
+			"country":   "FI",
+			"continent": "EU",
+			"asn":       "AS24940",
+			"as_name":   "Hetzner Online GmbH",
+		},
+	})
+
+	tests := []struct {
+		name string
+		path string
+		addr string
+		want *AddrInfo
+	}{
+		{name: "geoip2 city", path: geoIP2City, addr: "1.2.3.4", want: &AddrInfo{Continent: "EU", Country: "DE", City: "Berlin"}},
+		{name: "geoip2 city ipv6", path: geoIP2City, addr: "2001:db8::1", want: &AddrInfo{Country: "FR"}},
+		{name: "geoip2 city unknown", path: geoIP2City, addr: "8.8.8.8", want: &AddrInfo{}},
+		{name: "geoip2 connection type", path: geoIP2ConnType, addr: "1.2.200.1", want: &AddrInfo{ConnectionType: ConnectionTypeMobile}},
+		{name: "dbip asn", path: dbipASN, addr: "1.2.3.4", want: &AddrInfo{ASN: 3320, ASOrg: "Deutsche Telekom AG"}},
+		{name: "ipinfo asn", path: ipinfo, addr: "5.6.7.8", want: &AddrInfo{ASN: 24940, ASOrg: "Hetzner Online GmbH", ConnectionType: ConnectionTypeHosting}},
+		{name: "ipinfo country asn", path: ipinfoCountryASN, addr: "5.6.7.8", want: &AddrInfo{Continent: "EU", Country: "FI", ASN: 24940, ASOrg: "Hetzner Online GmbH"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewFileProvider(tt.path)
+			require.NoError(t, err)
+			defer p.Close()
+
+			got, err := p.Lookup(net.ParseIP(tt.addr))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFileProvider_invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewFileProvider(filepath.Join(dir, "missing.mmdb"))
+	assert.Error(t, err)
+
+	path := filepath.Join(dir, "invalid.mmdb")
+	require.NoError(t, os.WriteFile(path, []byte("not a database"), 0o644))
+	_, err = NewFileProvider(path)
+	assert.Error(t, err)
+}
+
+func TestFileProvider_reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "GeoLite2-Country.mmdb")
+	country := func(iso string) testNetwork {
+		return testNetwork{cidr: "1.2.3.0/24", record: map[string]interface{}{"country": map[string]interface{}{"iso_code": iso}}}
+	}
+
+	writeMMDB(t, path, "GeoLite2-Country", country("DE"))
+
+	p, err := NewFileProvider(path)
+	require.NoError(t, err)
+	defer p.Close()
+
+	lookup := func() string {
+		info, err := p.Lookup(net.ParseIP("1.2.3.4"))
+		require.NoError(t, err)
+		return info.Country
+	}
+	assert.Equal(t, "DE", lookup())
+
+	writeMMDB(t, path, "GeoLite2-Country", country("NL"))
+	assert.Eventually(t, func() bool { return lookup() == "NL" }, 5*time.Second, 10*time.Millisecond)
+
+	// An invalid update keeps the previous database
+	require.NoError(t, os.WriteFile(path, []byte("truncated"), 0o644))
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, "NL", lookup())
+}
+
+type staticProvider struct {
+	info *AddrInfo
+	err  error
+}
+
+func (p staticProvider) Lookup(net.IP) (*AddrInfo, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	info := *p.info
+	return &info, nil
+}
+
+func (p staticProvider) Version() string {
+	return "static"
+}
+
+func (p staticProvider) Close() error {
+	return nil
+}
+
+func TestProviders_Lookup(t *testing.T) {
+	city := staticProvider{info: &AddrInfo{Country: "DE", Continent: "EU", City: "Berlin"}}
+	connType := staticProvider{info: &AddrInfo{ConnectionType: ConnectionTypeResidential}}
+	embedded := staticProvider{info: &AddrInfo{Country: "NL", Continent: "EU", ASN: 3320, ASOrg: "Deutsche Telekom AG"}}
+	failing := staticProvider{err: assert.AnError}
+
+	tests := []struct {
+		name      string
+		providers Providers
+		want      *AddrInfo
+		wantErr   bool
+	}{
+		{
+			name:      "earlier providers take precedence",
+			providers: Providers{city, connType, embedded},
+			want:      &AddrInfo{Country: "DE", Continent: "EU", City: "Berlin", ASN: 3320, ASOrg: "Deutsche Telekom AG", ConnectionType: ConnectionTypeResidential},
+		},
+		{
+			name:      "failing provider is skipped",
+			providers: Providers{failing, embedded},
+			want:      &AddrInfo{Country: "NL", Continent: "EU", ASN: 3320, ASOrg: "Deutsche Telekom AG"},
+		},
+		{
+			name:      "all providers fail",
+			providers: Providers{failing, failing},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.providers.Lookup(net.ParseIP("1.2.3.4"))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseConnectionType(t *testing.T) {
+	tests := []struct {
+		value string
+		want  ConnectionType
+	}{
+		{value: "Cellular", want: ConnectionTypeMobile},
+		{value: "Cable/DSL", want: ConnectionTypeResidential},
+		{value: "Dialup", want: ConnectionTypeResidential},
+		{value: "Corporate", want: ConnectionTypeBusiness},
+		{value: "Satellite", want: ConnectionTypeSatellite},
+		{value: "isp", want: ConnectionTypeResidential},
+		{value: "hosting", want: ConnectionTypeHosting},
+		{value: "business", want: ConnectionTypeBusiness},
+		{value: "education", want: ConnectionTypeEducation},
+		{value: "", want: ""},
+		{value: "unknown", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseConnectionType(tt.value))
+		})
+	}
+}
@@ -0,0 +1,959 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AggregateRefresh is an object representing the database table.
+type AggregateRefresh struct {
+	Name           string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	RefreshedUntil time.Time `boil:"refreshed_until" json:"refreshed_until" toml:"refreshed_until" yaml:"refreshed_until"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *aggregateRefreshR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L aggregateRefreshL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AggregateRefreshColumns = struct {
+	Name           string
+	RefreshedUntil string
+	UpdatedAt      string
+}{
+	Name:           "name",
+	RefreshedUntil: "refreshed_until",
+	UpdatedAt:      "updated_at",
+}
+
+var AggregateRefreshTableColumns = struct {
+	Name           string
+	RefreshedUntil string
+	UpdatedAt      string
+}{
+	Name:           "aggregate_refreshes.name",
+	RefreshedUntil: "aggregate_refreshes.refreshed_until",
+	UpdatedAt:      "aggregate_refreshes.updated_at",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AggregateRefreshWhere = struct {
+	Name           whereHelperstring
+	RefreshedUntil whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	Name:           whereHelperstring{field: "\"aggregate_refreshes\".\"name\""},
+	RefreshedUntil: whereHelpertime_Time{field: "\"aggregate_refreshes\".\"refreshed_until\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"aggregate_refreshes\".\"updated_at\""},
+}
+
+// AggregateRefreshRels is where relationship names are stored.
+var AggregateRefreshRels = struct {
+}{}
+
+// aggregateRefreshR is where relationships are stored.
+type aggregateRefreshR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*aggregateRefreshR) NewStruct() *aggregateRefreshR {
+	return &aggregateRefreshR{}
+}
+
+// aggregateRefreshL is where Load methods for each relationship are stored.
+type aggregateRefreshL struct{}
+
+var (
+	aggregateRefreshAllColumns            = []string{"name", "refreshed_until", "updated_at"}
+	aggregateRefreshColumnsWithoutDefault = []string{"name", "refreshed_until", "updated_at"}
+	aggregateRefreshColumnsWithDefault    = []string{}
+	aggregateRefreshPrimaryKeyColumns     = []string{"name"}
+	aggregateRefreshGeneratedColumns      = []string{}
+)
+
+type (
+	// AggregateRefreshSlice is an alias for a slice of pointers to AggregateRefresh.
+	// This should almost always be used instead of []AggregateRefresh.
+	AggregateRefreshSlice []*AggregateRefresh
+	// AggregateRefreshHook is the signature for custom AggregateRefresh hook methods
+	AggregateRefreshHook func(context.Context, boil.ContextExecutor, *AggregateRefresh) error
+
+	aggregateRefreshQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	aggregateRefreshType                 = reflect.TypeOf(&AggregateRefresh{})
+	aggregateRefreshMapping              = queries.MakeStructMapping(aggregateRefreshType)
+	aggregateRefreshPrimaryKeyMapping, _ = queries.BindMapping(aggregateRefreshType, aggregateRefreshMapping, aggregateRefreshPrimaryKeyColumns)
+	aggregateRefreshInsertCacheMut       sync.RWMutex
+	aggregateRefreshInsertCache          = make(map[string]insertCache)
+	aggregateRefreshUpdateCacheMut       sync.RWMutex
+	aggregateRefreshUpdateCache          = make(map[string]updateCache)
+	aggregateRefreshUpsertCacheMut       sync.RWMutex
+	aggregateRefreshUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var aggregateRefreshAfterSelectHooks []AggregateRefreshHook
+
+var aggregateRefreshBeforeInsertHooks []AggregateRefreshHook
+var aggregateRefreshAfterInsertHooks []AggregateRefreshHook
+
+var aggregateRefreshBeforeUpdateHooks []AggregateRefreshHook
+var aggregateRefreshAfterUpdateHooks []AggregateRefreshHook
+
+var aggregateRefreshBeforeDeleteHooks []AggregateRefreshHook
+var aggregateRefreshAfterDeleteHooks []AggregateRefreshHook
+
+var aggregateRefreshBeforeUpsertHooks []AggregateRefreshHook
+var aggregateRefreshAfterUpsertHooks []AggregateRefreshHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AggregateRefresh) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AggregateRefresh) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AggregateRefresh) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AggregateRefresh) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AggregateRefresh) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AggregateRefresh) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AggregateRefresh) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AggregateRefresh) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AggregateRefresh) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aggregateRefreshAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAggregateRefreshHook registers your hook function for all future operations.
+func AddAggregateRefreshHook(hookPoint boil.HookPoint, aggregateRefreshHook AggregateRefreshHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		aggregateRefreshAfterSelectHooks = append(aggregateRefreshAfterSelectHooks, aggregateRefreshHook)
+	case boil.BeforeInsertHook:
+		aggregateRefreshBeforeInsertHooks = append(aggregateRefreshBeforeInsertHooks, aggregateRefreshHook)
+	case boil.AfterInsertHook:
+		aggregateRefreshAfterInsertHooks = append(aggregateRefreshAfterInsertHooks, aggregateRefreshHook)
+	case boil.BeforeUpdateHook:
+		aggregateRefreshBeforeUpdateHooks = append(aggregateRefreshBeforeUpdateHooks, aggregateRefreshHook)
+	case boil.AfterUpdateHook:
+		aggregateRefreshAfterUpdateHooks = append(aggregateRefreshAfterUpdateHooks, aggregateRefreshHook)
+	case boil.BeforeDeleteHook:
+		aggregateRefreshBeforeDeleteHooks = append(aggregateRefreshBeforeDeleteHooks, aggregateRefreshHook)
+	case boil.AfterDeleteHook:
+		aggregateRefreshAfterDeleteHooks = append(aggregateRefreshAfterDeleteHooks, aggregateRefreshHook)
+	case boil.BeforeUpsertHook:
+		aggregateRefreshBeforeUpsertHooks = append(aggregateRefreshBeforeUpsertHooks, aggregateRefreshHook)
+	case boil.AfterUpsertHook:
+		aggregateRefreshAfterUpsertHooks = append(aggregateRefreshAfterUpsertHooks, aggregateRefreshHook)
+	}
+}
+
+// One returns a single aggregateRefresh record from the query.
+func (q aggregateRefreshQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AggregateRefresh, error) {
+	o := &AggregateRefresh{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for aggregate_refreshes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AggregateRefresh records from the query.
+func (q aggregateRefreshQuery) All(ctx context.Context, exec boil.ContextExecutor) (AggregateRefreshSlice, error) {
+	var o []*AggregateRefresh
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AggregateRefresh slice")
+	}
+
+	if len(aggregateRefreshAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AggregateRefresh records in the query.
+func (q aggregateRefreshQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count aggregate_refreshes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q aggregateRefreshQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if aggregate_refreshes exists")
+	}
+
+	return count > 0, nil
+}
+
+// AggregateRefreshes retrieves all the records using an executor.
+func AggregateRefreshes(mods ...qm.QueryMod) aggregateRefreshQuery {
+	mods = append(mods, qm.From("\"aggregate_refreshes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"aggregate_refreshes\".*"})
+	}
+
+	return aggregateRefreshQuery{q}
+}
+
+// FindAggregateRefresh retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAggregateRefresh(ctx context.Context, exec boil.ContextExecutor, name string, selectCols ...string) (*AggregateRefresh, error) {
+	aggregateRefreshObj := &AggregateRefresh{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"aggregate_refreshes\" where \"name\"=$1", sel,
+	)
+
+	q := queries.Raw(query, name)
+
+	err := q.Bind(ctx, exec, aggregateRefreshObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from aggregate_refreshes")
+	}
+
+	if err = aggregateRefreshObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return aggregateRefreshObj, err
+	}
+
+	return aggregateRefreshObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AggregateRefresh) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no aggregate_refreshes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(aggregateRefreshColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	aggregateRefreshInsertCacheMut.RLock()
+	cache, cached := aggregateRefreshInsertCache[key]
+	aggregateRefreshInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			aggregateRefreshAllColumns,
+			aggregateRefreshColumnsWithDefault,
+			aggregateRefreshColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(aggregateRefreshType, aggregateRefreshMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(aggregateRefreshType, aggregateRefreshMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"aggregate_refreshes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"aggregate_refreshes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into aggregate_refreshes")
+	}
+
+	if !cached {
+		aggregateRefreshInsertCacheMut.Lock()
+		aggregateRefreshInsertCache[key] = cache
+		aggregateRefreshInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AggregateRefresh.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AggregateRefresh) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	aggregateRefreshUpdateCacheMut.RLock()
+	cache, cached := aggregateRefreshUpdateCache[key]
+	aggregateRefreshUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			aggregateRefreshAllColumns,
+			aggregateRefreshPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update aggregate_refreshes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"aggregate_refreshes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, aggregateRefreshPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(aggregateRefreshType, aggregateRefreshMapping, append(wl, aggregateRefreshPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update aggregate_refreshes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for aggregate_refreshes")
+	}
+
+	if !cached {
+		aggregateRefreshUpdateCacheMut.Lock()
+		aggregateRefreshUpdateCache[key] = cache
+		aggregateRefreshUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q aggregateRefreshQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for aggregate_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for aggregate_refreshes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AggregateRefreshSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), aggregateRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"aggregate_refreshes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, aggregateRefreshPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in aggregateRefresh slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all aggregateRefresh")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AggregateRefresh) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no aggregate_refreshes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(aggregateRefreshColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	aggregateRefreshUpsertCacheMut.RLock()
+	cache, cached := aggregateRefreshUpsertCache[key]
+	aggregateRefreshUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			aggregateRefreshAllColumns,
+			aggregateRefreshColumnsWithDefault,
+			aggregateRefreshColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			aggregateRefreshAllColumns,
+			aggregateRefreshPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert aggregate_refreshes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(aggregateRefreshPrimaryKeyColumns))
+			copy(conflict, aggregateRefreshPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"aggregate_refreshes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(aggregateRefreshType, aggregateRefreshMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(aggregateRefreshType, aggregateRefreshMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert aggregate_refreshes")
+	}
+
+	if !cached {
+		aggregateRefreshUpsertCacheMut.Lock()
+		aggregateRefreshUpsertCache[key] = cache
+		aggregateRefreshUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AggregateRefresh record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AggregateRefresh) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AggregateRefresh provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), aggregateRefreshPrimaryKeyMapping)
+	sql := "DELETE FROM \"aggregate_refreshes\" WHERE \"name\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from aggregate_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for aggregate_refreshes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q aggregateRefreshQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no aggregateRefreshQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from aggregate_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for aggregate_refreshes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AggregateRefreshSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(aggregateRefreshBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), aggregateRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"aggregate_refreshes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, aggregateRefreshPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from aggregateRefresh slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for aggregate_refreshes")
+	}
+
+	if len(aggregateRefreshAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AggregateRefresh) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAggregateRefresh(ctx, exec, o.Name)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AggregateRefreshSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AggregateRefreshSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), aggregateRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"aggregate_refreshes\".* FROM \"aggregate_refreshes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, aggregateRefreshPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AggregateRefreshSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AggregateRefreshExists checks if the AggregateRefresh row exists.
+func AggregateRefreshExists(ctx context.Context, exec boil.ContextExecutor, name string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"aggregate_refreshes\" where \"name\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, name)
+	}
+	row := exec.QueryRowContext(ctx, sql, name)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if aggregate_refreshes exists")
+	}
+
+	return exists, nil
+}
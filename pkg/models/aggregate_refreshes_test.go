@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAggregateRefreshes(t *testing.T) {
+	t.Parallel()
+
+	query := AggregateRefreshes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAggregateRefreshesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAggregateRefreshesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AggregateRefreshes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAggregateRefreshesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AggregateRefreshSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAggregateRefreshesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AggregateRefreshExists(ctx, tx, o.Name)
+	if err != nil {
+		t.Errorf("Unable to check if AggregateRefresh exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AggregateRefreshExists to return true, but got false.")
+	}
+}
+
+func testAggregateRefreshesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	aggregateRefreshFound, err := FindAggregateRefresh(ctx, tx, o.Name)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if aggregateRefreshFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAggregateRefreshesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AggregateRefreshes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAggregateRefreshesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AggregateRefreshes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAggregateRefreshesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	aggregateRefreshOne := &AggregateRefresh{}
+	aggregateRefreshTwo := &AggregateRefresh{}
+	if err = randomize.Struct(seed, aggregateRefreshOne, aggregateRefreshDBTypes, false, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+	if err = randomize.Struct(seed, aggregateRefreshTwo, aggregateRefreshDBTypes, false, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = aggregateRefreshOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = aggregateRefreshTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AggregateRefreshes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAggregateRefreshesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	aggregateRefreshOne := &AggregateRefresh{}
+	aggregateRefreshTwo := &AggregateRefresh{}
+	if err = randomize.Struct(seed, aggregateRefreshOne, aggregateRefreshDBTypes, false, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+	if err = randomize.Struct(seed, aggregateRefreshTwo, aggregateRefreshDBTypes, false, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = aggregateRefreshOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = aggregateRefreshTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func aggregateRefreshBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func aggregateRefreshAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func aggregateRefreshAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func aggregateRefreshBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func aggregateRefreshAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func aggregateRefreshBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func aggregateRefreshAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func aggregateRefreshBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func aggregateRefreshAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AggregateRefresh) error {
+	*o = AggregateRefresh{}
+	return nil
+}
+
+func testAggregateRefreshesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AggregateRefresh{}
+	o := &AggregateRefresh{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh object: %s", err)
+	}
+
+	AddAggregateRefreshHook(boil.BeforeInsertHook, aggregateRefreshBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshBeforeInsertHooks = []AggregateRefreshHook{}
+
+	AddAggregateRefreshHook(boil.AfterInsertHook, aggregateRefreshAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshAfterInsertHooks = []AggregateRefreshHook{}
+
+	AddAggregateRefreshHook(boil.AfterSelectHook, aggregateRefreshAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshAfterSelectHooks = []AggregateRefreshHook{}
+
+	AddAggregateRefreshHook(boil.BeforeUpdateHook, aggregateRefreshBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshBeforeUpdateHooks = []AggregateRefreshHook{}
+
+	AddAggregateRefreshHook(boil.AfterUpdateHook, aggregateRefreshAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshAfterUpdateHooks = []AggregateRefreshHook{}
+
+	AddAggregateRefreshHook(boil.BeforeDeleteHook, aggregateRefreshBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshBeforeDeleteHooks = []AggregateRefreshHook{}
+
+	AddAggregateRefreshHook(boil.AfterDeleteHook, aggregateRefreshAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshAfterDeleteHooks = []AggregateRefreshHook{}
+
+	AddAggregateRefreshHook(boil.BeforeUpsertHook, aggregateRefreshBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshBeforeUpsertHooks = []AggregateRefreshHook{}
+
+	AddAggregateRefreshHook(boil.AfterUpsertHook, aggregateRefreshAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	aggregateRefreshAfterUpsertHooks = []AggregateRefreshHook{}
+}
+
+func testAggregateRefreshesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAggregateRefreshesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(aggregateRefreshColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAggregateRefreshesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAggregateRefreshesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AggregateRefreshSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAggregateRefreshesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AggregateRefreshes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	aggregateRefreshDBTypes = map[string]string{`Name`: `text`, `RefreshedUntil`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                       = bytes.MinRead
+)
+
+func testAggregateRefreshesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(aggregateRefreshPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(aggregateRefreshAllColumns) == len(aggregateRefreshPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAggregateRefreshesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(aggregateRefreshAllColumns) == len(aggregateRefreshPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AggregateRefresh{}
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, aggregateRefreshDBTypes, true, aggregateRefreshPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(aggregateRefreshAllColumns, aggregateRefreshPrimaryKeyColumns) {
+		fields = aggregateRefreshAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			aggregateRefreshAllColumns,
+			aggregateRefreshPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AggregateRefreshSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAggregateRefreshesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(aggregateRefreshAllColumns) == len(aggregateRefreshPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AggregateRefresh{}
+	if err = randomize.Struct(seed, &o, aggregateRefreshDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AggregateRefresh: %s", err)
+	}
+
+	count, err := AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, aggregateRefreshDBTypes, false, aggregateRefreshPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AggregateRefresh struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AggregateRefresh: %s", err)
+	}
+
+	count, err = AggregateRefreshes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1434 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAllocations(t *testing.T) {
+	t.Parallel()
+
+	query := Allocations()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAllocationsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAllocationsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Allocations().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAllocationsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AllocationSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAllocationsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AllocationExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Allocation exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AllocationExists to return true, but got false.")
+	}
+}
+
+func testAllocationsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	allocationFound, err := FindAllocation(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if allocationFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAllocationsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Allocations().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAllocationsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Allocations().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAllocationsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	allocationOne := &Allocation{}
+	allocationTwo := &Allocation{}
+	if err = randomize.Struct(seed, allocationOne, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+	if err = randomize.Struct(seed, allocationTwo, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = allocationOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = allocationTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Allocations().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAllocationsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	allocationOne := &Allocation{}
+	allocationTwo := &Allocation{}
+	if err = randomize.Struct(seed, allocationOne, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+	if err = randomize.Struct(seed, allocationTwo, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = allocationOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = allocationTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func allocationBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func allocationAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func allocationAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func allocationBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func allocationAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func allocationBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func allocationAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func allocationBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func allocationAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Allocation) error {
+	*o = Allocation{}
+	return nil
+}
+
+func testAllocationsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Allocation{}
+	o := &Allocation{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, allocationDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Allocation object: %s", err)
+	}
+
+	AddAllocationHook(boil.BeforeInsertHook, allocationBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	allocationBeforeInsertHooks = []AllocationHook{}
+
+	AddAllocationHook(boil.AfterInsertHook, allocationAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	allocationAfterInsertHooks = []AllocationHook{}
+
+	AddAllocationHook(boil.AfterSelectHook, allocationAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	allocationAfterSelectHooks = []AllocationHook{}
+
+	AddAllocationHook(boil.BeforeUpdateHook, allocationBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	allocationBeforeUpdateHooks = []AllocationHook{}
+
+	AddAllocationHook(boil.AfterUpdateHook, allocationAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	allocationAfterUpdateHooks = []AllocationHook{}
+
+	AddAllocationHook(boil.BeforeDeleteHook, allocationBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	allocationBeforeDeleteHooks = []AllocationHook{}
+
+	AddAllocationHook(boil.AfterDeleteHook, allocationAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	allocationAfterDeleteHooks = []AllocationHook{}
+
+	AddAllocationHook(boil.BeforeUpsertHook, allocationBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	allocationBeforeUpsertHooks = []AllocationHook{}
+
+	AddAllocationHook(boil.AfterUpsertHook, allocationAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	allocationAfterUpsertHooks = []AllocationHook{}
+}
+
+func testAllocationsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAllocationsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(allocationColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAllocationOneToOneHolePunchResultUsingHolePunchResult(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign HolePunchResult
+	var local Allocation
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, holePunchResultDBTypes, true, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&foreign.AllocationID, local.ID)
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.HolePunchResult().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.AllocationID, foreign.AllocationID) {
+		t.Errorf("want: %v, got %v", foreign.AllocationID, check.AllocationID)
+	}
+
+	slice := AllocationSlice{&local}
+	if err = local.L.LoadHolePunchResult(ctx, tx, false, (*[]*Allocation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.HolePunchResult == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.HolePunchResult = nil
+	if err = local.L.LoadHolePunchResult(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.HolePunchResult == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAllocationOneToOneSetOpHolePunchResultUsingHolePunchResult(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Allocation
+	var b, c HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*HolePunchResult{&b, &c} {
+		err = a.SetHolePunchResult(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.HolePunchResult != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Allocation != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if !queries.Equal(a.ID, x.AllocationID) {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(x.AllocationID))
+		reflect.Indirect(reflect.ValueOf(&x.AllocationID)).Set(zero)
+
+		if err = x.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ID, x.AllocationID) {
+			t.Error("foreign key was wrong value", a.ID, x.AllocationID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
+func testAllocationOneToOneRemoveOpHolePunchResultUsingHolePunchResult(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Allocation
+	var b HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetHolePunchResult(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveHolePunchResult(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.HolePunchResult().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.HolePunchResult != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(b.AllocationID) {
+		t.Error("foreign key column should be nil")
+	}
+
+	if b.R.Allocation != nil {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAllocationToOneAuthorizationUsingAuthorization(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Allocation
+	var foreign Authorization
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, authorizationDBTypes, false, authorizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Authorization struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AuthorizationID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Authorization().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AllocationSlice{&local}
+	if err = local.L.LoadAuthorization(ctx, tx, false, (*[]*Allocation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Authorization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Authorization = nil
+	if err = local.L.LoadAuthorization(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Authorization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAllocationToOnePeerUsingClient(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Allocation
+	var foreign Peer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Peer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ClientID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Client().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AllocationSlice{&local}
+	if err = local.L.LoadClient(ctx, tx, false, (*[]*Allocation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Client == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Client = nil
+	if err = local.L.LoadClient(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Client == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAllocationToOneMultiAddressesSetUsingMultiAddressesSet(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Allocation
+	var foreign MultiAddressesSet
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, multiAddressesSetDBTypes, false, multiAddressesSetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MultiAddressesSet struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.MultiAddressesSetID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.MultiAddressesSet().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AllocationSlice{&local}
+	if err = local.L.LoadMultiAddressesSet(ctx, tx, false, (*[]*Allocation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MultiAddressesSet == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.MultiAddressesSet = nil
+	if err = local.L.LoadMultiAddressesSet(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MultiAddressesSet == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAllocationToOnePeerUsingRemote(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Allocation
+	var foreign Peer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Peer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RemoteID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Remote().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := AllocationSlice{&local}
+	if err = local.L.LoadRemote(ctx, tx, false, (*[]*Allocation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Remote == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Remote = nil
+	if err = local.L.LoadRemote(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Remote == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testAllocationToOneSetOpAuthorizationUsingAuthorization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Allocation
+	var b, c Authorization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Authorization{&b, &c} {
+		err = a.SetAuthorization(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Authorization != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Allocations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AuthorizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.AuthorizationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AuthorizationID))
+		reflect.Indirect(reflect.ValueOf(&a.AuthorizationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AuthorizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.AuthorizationID, x.ID)
+		}
+	}
+}
+
+func testAllocationToOneRemoveOpAuthorizationUsingAuthorization(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Allocation
+	var b Authorization
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetAuthorization(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveAuthorization(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Authorization().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Authorization != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.AuthorizationID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Allocations) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAllocationToOneSetOpPeerUsingClient(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Allocation
+	var b, c Peer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Peer{&b, &c} {
+		err = a.SetClient(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Client != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ClientAllocations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ClientID != x.ID {
+			t.Error("foreign key was wrong value", a.ClientID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ClientID))
+		reflect.Indirect(reflect.ValueOf(&a.ClientID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ClientID != x.ID {
+			t.Error("foreign key was wrong value", a.ClientID, x.ID)
+		}
+	}
+}
+func testAllocationToOneSetOpMultiAddressesSetUsingMultiAddressesSet(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Allocation
+	var b, c MultiAddressesSet
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, multiAddressesSetDBTypes, false, strmangle.SetComplement(multiAddressesSetPrimaryKeyColumns, multiAddressesSetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, multiAddressesSetDBTypes, false, strmangle.SetComplement(multiAddressesSetPrimaryKeyColumns, multiAddressesSetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*MultiAddressesSet{&b, &c} {
+		err = a.SetMultiAddressesSet(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.MultiAddressesSet != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Allocations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.MultiAddressesSetID, x.ID) {
+			t.Error("foreign key was wrong value", a.MultiAddressesSetID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.MultiAddressesSetID))
+		reflect.Indirect(reflect.ValueOf(&a.MultiAddressesSetID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.MultiAddressesSetID, x.ID) {
+			t.Error("foreign key was wrong value", a.MultiAddressesSetID, x.ID)
+		}
+	}
+}
+
+func testAllocationToOneRemoveOpMultiAddressesSetUsingMultiAddressesSet(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Allocation
+	var b MultiAddressesSet
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, multiAddressesSetDBTypes, false, strmangle.SetComplement(multiAddressesSetPrimaryKeyColumns, multiAddressesSetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetMultiAddressesSet(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveMultiAddressesSet(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.MultiAddressesSet().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.MultiAddressesSet != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.MultiAddressesSetID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Allocations) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testAllocationToOneSetOpPeerUsingRemote(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Allocation
+	var b, c Peer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Peer{&b, &c} {
+		err = a.SetRemote(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Remote != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RemoteAllocations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RemoteID != x.ID {
+			t.Error("foreign key was wrong value", a.RemoteID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RemoteID))
+		reflect.Indirect(reflect.ValueOf(&a.RemoteID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.RemoteID != x.ID {
+			t.Error("foreign key was wrong value", a.RemoteID, x.ID)
+		}
+	}
+}
+
+func testAllocationsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAllocationsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AllocationSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAllocationsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Allocations().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	allocationDBTypes = map[string]string{`ID`: `bigint`, `ClientID`: `bigint`, `RemoteID`: `bigint`, `AuthorizationID`: `integer`, `MultiAddressesSetID`: `integer`, `Network`: `text`, `ProtocolFilters`: `ARRAYinteger`, `ExperimentArm`: `text`, `ExpiresAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `TimingArm`: `text`}
+	_                 = bytes.MinRead
+)
+
+func testAllocationsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(allocationPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(allocationAllColumns) == len(allocationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAllocationsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(allocationAllColumns) == len(allocationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Allocation{}
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, allocationDBTypes, true, allocationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(allocationAllColumns, allocationPrimaryKeyColumns) {
+		fields = allocationAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			allocationAllColumns,
+			allocationPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, allocationGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AllocationSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAllocationsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(allocationAllColumns) == len(allocationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Allocation{}
+	if err = randomize.Struct(seed, &o, allocationDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Allocation: %s", err)
+	}
+
+	count, err := Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, allocationDBTypes, false, allocationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Allocation: %s", err)
+	}
+
+	count, err = Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
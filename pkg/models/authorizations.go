@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -27,6 +28,9 @@ type Authorization struct {
 	APIKey    string    `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
 	Username  string    `boil:"username" json:"username" toml:"username" yaml:"username"`
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Role      string    `boil:"role" json:"role" toml:"role" yaml:"role"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	RevokedAt null.Time `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
 
 	R *authorizationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L authorizationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -37,11 +41,17 @@ var AuthorizationColumns = struct {
 	APIKey    string
 	Username  string
 	CreatedAt string
+	Role      string
+	UpdatedAt string
+	RevokedAt string
 }{
 	ID:        "id",
 	APIKey:    "api_key",
 	Username:  "username",
 	CreatedAt: "created_at",
+	Role:      "role",
+	UpdatedAt: "updated_at",
+	RevokedAt: "revoked_at",
 }
 
 var AuthorizationTableColumns = struct {
@@ -49,11 +59,17 @@ var AuthorizationTableColumns = struct {
 	APIKey    string
 	Username  string
 	CreatedAt string
+	Role      string
+	UpdatedAt string
+	RevokedAt string
 }{
 	ID:        "authorizations.id",
 	APIKey:    "authorizations.api_key",
 	Username:  "authorizations.username",
 	CreatedAt: "authorizations.created_at",
+	Role:      "authorizations.role",
+	UpdatedAt: "authorizations.updated_at",
+	RevokedAt: "authorizations.revoked_at",
 }
 
 // Generated where
@@ -81,72 +97,64 @@ func (w whereHelperint) NIN(slice []int) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelperstring struct{ field string }
+type whereHelpernull_Time struct{ field string }
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var AuthorizationWhere = struct {
 	ID        whereHelperint
 	APIKey    whereHelperstring
 	Username  whereHelperstring
 	CreatedAt whereHelpertime_Time
+	Role      whereHelperstring
+	UpdatedAt whereHelpertime_Time
+	RevokedAt whereHelpernull_Time
 }{
 	ID:        whereHelperint{field: "\"authorizations\".\"id\""},
 	APIKey:    whereHelperstring{field: "\"authorizations\".\"api_key\""},
 	Username:  whereHelperstring{field: "\"authorizations\".\"username\""},
 	CreatedAt: whereHelpertime_Time{field: "\"authorizations\".\"created_at\""},
+	Role:      whereHelperstring{field: "\"authorizations\".\"role\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"authorizations\".\"updated_at\""},
+	RevokedAt: whereHelpernull_Time{field: "\"authorizations\".\"revoked_at\""},
 }
 
 // AuthorizationRels is where relationship names are stored.
 var AuthorizationRels = struct {
-	Clients string
+	Allocations      string
+	Clients          string
+	HolePunchResults string
 }{
-	Clients: "Clients",
+	Allocations:      "Allocations",
+	Clients:          "Clients",
+	HolePunchResults: "HolePunchResults",
 }
 
 // authorizationR is where relationships are stored.
 type authorizationR struct {
-	Clients ClientSlice `boil:"Clients" json:"Clients" toml:"Clients" yaml:"Clients"`
+	Allocations      AllocationSlice      `boil:"Allocations" json:"Allocations" toml:"Allocations" yaml:"Allocations"`
+	Clients          ClientSlice          `boil:"Clients" json:"Clients" toml:"Clients" yaml:"Clients"`
+	HolePunchResults HolePunchResultSlice `boil:"HolePunchResults" json:"HolePunchResults" toml:"HolePunchResults" yaml:"HolePunchResults"`
 }
 
 // NewStruct creates a new relationship struct
@@ -154,6 +162,13 @@ func (*authorizationR) NewStruct() *authorizationR {
 	return &authorizationR{}
 }
 
+func (r *authorizationR) GetAllocations() AllocationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Allocations
+}
+
 func (r *authorizationR) GetClients() ClientSlice {
 	if r == nil {
 		return nil
@@ -161,13 +176,20 @@ func (r *authorizationR) GetClients() ClientSlice {
 	return r.Clients
 }
 
+func (r *authorizationR) GetHolePunchResults() HolePunchResultSlice {
+	if r == nil {
+		return nil
+	}
+	return r.HolePunchResults
+}
+
 // authorizationL is where Load methods for each relationship are stored.
 type authorizationL struct{}
 
 var (
-	authorizationAllColumns            = []string{"id", "api_key", "username", "created_at"}
-	authorizationColumnsWithoutDefault = []string{"api_key", "username", "created_at"}
-	authorizationColumnsWithDefault    = []string{"id"}
+	authorizationAllColumns            = []string{"id", "api_key", "username", "created_at", "role", "updated_at", "revoked_at"}
+	authorizationColumnsWithoutDefault = []string{"api_key", "username", "created_at", "updated_at"}
+	authorizationColumnsWithDefault    = []string{"id", "role", "revoked_at"}
 	authorizationPrimaryKeyColumns     = []string{"id"}
 	authorizationGeneratedColumns      = []string{"id"}
 )
@@ -450,6 +472,20 @@ func (q authorizationQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// Allocations retrieves all the allocation's Allocations with an executor.
+func (o *Authorization) Allocations(mods ...qm.QueryMod) allocationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"allocations\".\"authorization_id\"=?", o.ID),
+	)
+
+	return Allocations(queryMods...)
+}
+
 // Clients retrieves all the client's Clients with an executor.
 func (o *Authorization) Clients(mods ...qm.QueryMod) clientQuery {
 	var queryMods []qm.QueryMod
@@ -464,6 +500,134 @@ func (o *Authorization) Clients(mods ...qm.QueryMod) clientQuery {
 	return Clients(queryMods...)
 }
 
+// HolePunchResults retrieves all the hole_punch_result's HolePunchResults with an executor.
+func (o *Authorization) HolePunchResults(mods ...qm.QueryMod) holePunchResultQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"hole_punch_results\".\"authorization_id\"=?", o.ID),
+	)
+
+	return HolePunchResults(queryMods...)
+}
+
+// LoadAllocations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (authorizationL) LoadAllocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuthorization interface{}, mods queries.Applicator) error {
+	var slice []*Authorization
+	var object *Authorization
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuthorization.(*Authorization)
+		if !ok {
+			object = new(Authorization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuthorization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuthorization))
+			}
+		}
+	} else {
+		s, ok := maybeAuthorization.(*[]*Authorization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuthorization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuthorization))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &authorizationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &authorizationR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`allocations`),
+		qm.WhereIn(`allocations.authorization_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load allocations")
+	}
+
+	var resultSlice []*Allocation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice allocations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on allocations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for allocations")
+	}
+
+	if len(allocationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Allocations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &allocationR{}
+			}
+			foreign.R.Authorization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AuthorizationID) {
+				local.R.Allocations = append(local.R.Allocations, foreign)
+				if foreign.R == nil {
+					foreign.R = &allocationR{}
+				}
+				foreign.R.Authorization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadClients allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (authorizationL) LoadClients(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuthorization interface{}, mods queries.Applicator) error {
@@ -578,6 +742,247 @@ func (authorizationL) LoadClients(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
+// LoadHolePunchResults allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (authorizationL) LoadHolePunchResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuthorization interface{}, mods queries.Applicator) error {
+	var slice []*Authorization
+	var object *Authorization
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuthorization.(*Authorization)
+		if !ok {
+			object = new(Authorization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuthorization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuthorization))
+			}
+		}
+	} else {
+		s, ok := maybeAuthorization.(*[]*Authorization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuthorization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuthorization))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &authorizationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &authorizationR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_results`),
+		qm.WhereIn(`hole_punch_results.authorization_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load hole_punch_results")
+	}
+
+	var resultSlice []*HolePunchResult
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
+	}
+
+	if len(holePunchResultAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.HolePunchResults = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holePunchResultR{}
+			}
+			foreign.R.Authorization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AuthorizationID) {
+				local.R.HolePunchResults = append(local.R.HolePunchResults, foreign)
+				if foreign.R == nil {
+					foreign.R = &holePunchResultR{}
+				}
+				foreign.R.Authorization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAllocations adds the given related objects to the existing relationships
+// of the authorization, optionally inserting them as new records.
+// Appends related to o.R.Allocations.
+// Sets related.R.Authorization appropriately.
+func (o *Authorization) AddAllocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Allocation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AuthorizationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"allocations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"authorization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, allocationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AuthorizationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &authorizationR{
+			Allocations: related,
+		}
+	} else {
+		o.R.Allocations = append(o.R.Allocations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &allocationR{
+				Authorization: o,
+			}
+		} else {
+			rel.R.Authorization = o
+		}
+	}
+	return nil
+}
+
+// SetAllocations removes all previously related items of the
+// authorization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Authorization's Allocations accordingly.
+// Replaces o.R.Allocations with related.
+// Sets related.R.Authorization's Allocations accordingly.
+func (o *Authorization) SetAllocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Allocation) error {
+	query := "update \"allocations\" set \"authorization_id\" = null where \"authorization_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Allocations {
+			queries.SetScanner(&rel.AuthorizationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Authorization = nil
+		}
+		o.R.Allocations = nil
+	}
+
+	return o.AddAllocations(ctx, exec, insert, related...)
+}
+
+// RemoveAllocations relationships from objects passed in.
+// Removes related items from R.Allocations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Authorization.
+func (o *Authorization) RemoveAllocations(ctx context.Context, exec boil.ContextExecutor, related ...*Allocation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AuthorizationID, nil)
+		if rel.R != nil {
+			rel.R.Authorization = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("authorization_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Allocations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Allocations)
+			if ln > 1 && i < ln-1 {
+				o.R.Allocations[i] = o.R.Allocations[ln-1]
+			}
+			o.R.Allocations = o.R.Allocations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddClients adds the given related objects to the existing relationships
 // of the authorization, optionally inserting them as new records.
 // Appends related to o.R.Clients.
@@ -631,6 +1036,133 @@ func (o *Authorization) AddClients(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
+// AddHolePunchResults adds the given related objects to the existing relationships
+// of the authorization, optionally inserting them as new records.
+// Appends related to o.R.HolePunchResults.
+// Sets related.R.Authorization appropriately.
+func (o *Authorization) AddHolePunchResults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchResult) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AuthorizationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"hole_punch_results\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"authorization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, holePunchResultPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AuthorizationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &authorizationR{
+			HolePunchResults: related,
+		}
+	} else {
+		o.R.HolePunchResults = append(o.R.HolePunchResults, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &holePunchResultR{
+				Authorization: o,
+			}
+		} else {
+			rel.R.Authorization = o
+		}
+	}
+	return nil
+}
+
+// SetHolePunchResults removes all previously related items of the
+// authorization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Authorization's HolePunchResults accordingly.
+// Replaces o.R.HolePunchResults with related.
+// Sets related.R.Authorization's HolePunchResults accordingly.
+func (o *Authorization) SetHolePunchResults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchResult) error {
+	query := "update \"hole_punch_results\" set \"authorization_id\" = null where \"authorization_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.HolePunchResults {
+			queries.SetScanner(&rel.AuthorizationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Authorization = nil
+		}
+		o.R.HolePunchResults = nil
+	}
+
+	return o.AddHolePunchResults(ctx, exec, insert, related...)
+}
+
+// RemoveHolePunchResults relationships from objects passed in.
+// Removes related items from R.HolePunchResults (uses pointer comparison, removal does not keep order)
+// Sets related.R.Authorization.
+func (o *Authorization) RemoveHolePunchResults(ctx context.Context, exec boil.ContextExecutor, related ...*HolePunchResult) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AuthorizationID, nil)
+		if rel.R != nil {
+			rel.R.Authorization = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("authorization_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.HolePunchResults {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.HolePunchResults)
+			if ln > 1 && i < ln-1 {
+				o.R.HolePunchResults[i] = o.R.HolePunchResults[ln-1]
+			}
+			o.R.HolePunchResults = o.R.HolePunchResults[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Authorizations retrieves all the records using an executor.
 func Authorizations(mods ...qm.QueryMod) authorizationQuery {
 	mods = append(mods, qm.From("\"authorizations\""))
@@ -686,6 +1218,9 @@ func (o *Authorization) Insert(ctx context.Context, exec boil.ContextExecutor, c
 		if o.CreatedAt.IsZero() {
 			o.CreatedAt = currTime
 		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
 	}
 
 	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
@@ -763,6 +1298,12 @@ func (o *Authorization) Insert(ctx context.Context, exec boil.ContextExecutor, c
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
 func (o *Authorization) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
 	var err error
 	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
 		return 0, err
@@ -900,6 +1441,7 @@ func (o *Authorization) Upsert(ctx context.Context, exec boil.ContextExecutor, u
 		if o.CreatedAt.IsZero() {
 			o.CreatedAt = currTime
 		}
+		o.UpdatedAt = currTime
 	}
 
 	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
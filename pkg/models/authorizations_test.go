@@ -494,6 +494,83 @@ func testAuthorizationsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testAuthorizationToManyAllocations(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Authorization
+	var b, c Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, authorizationDBTypes, true, authorizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Authorization struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.AuthorizationID, a.ID)
+	queries.Assign(&c.AuthorizationID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Allocations().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.AuthorizationID, b.AuthorizationID) {
+			bFound = true
+		}
+		if queries.Equal(v.AuthorizationID, c.AuthorizationID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := AuthorizationSlice{&a}
+	if err = a.L.LoadAllocations(ctx, tx, false, (*[]*Authorization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Allocations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Allocations = nil
+	if err = a.L.LoadAllocations(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Allocations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testAuthorizationToManyClients(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -572,6 +649,334 @@ func testAuthorizationToManyClients(t *testing.T) {
 	}
 }
 
+func testAuthorizationToManyHolePunchResults(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Authorization
+	var b, c HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, authorizationDBTypes, true, authorizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Authorization struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.AuthorizationID, a.ID)
+	queries.Assign(&c.AuthorizationID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.HolePunchResults().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.AuthorizationID, b.AuthorizationID) {
+			bFound = true
+		}
+		if queries.Equal(v.AuthorizationID, c.AuthorizationID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := AuthorizationSlice{&a}
+	if err = a.L.LoadHolePunchResults(ctx, tx, false, (*[]*Authorization)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.HolePunchResults); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.HolePunchResults = nil
+	if err = a.L.LoadHolePunchResults(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.HolePunchResults); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testAuthorizationToManyAddOpAllocations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Authorization
+	var b, c, d, e Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Allocation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Allocation{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAllocations(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.AuthorizationID) {
+			t.Error("foreign key was wrong value", a.ID, first.AuthorizationID)
+		}
+		if !queries.Equal(a.ID, second.AuthorizationID) {
+			t.Error("foreign key was wrong value", a.ID, second.AuthorizationID)
+		}
+
+		if first.R.Authorization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Authorization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Allocations[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Allocations[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Allocations().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testAuthorizationToManySetOpAllocations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Authorization
+	var b, c, d, e Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Allocation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAllocations(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAllocations(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.AuthorizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.AuthorizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.AuthorizationID) {
+		t.Error("foreign key was wrong value", a.ID, d.AuthorizationID)
+	}
+	if !queries.Equal(a.ID, e.AuthorizationID) {
+		t.Error("foreign key was wrong value", a.ID, e.AuthorizationID)
+	}
+
+	if b.R.Authorization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Authorization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Authorization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Authorization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Allocations[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Allocations[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testAuthorizationToManyRemoveOpAllocations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Authorization
+	var b, c, d, e Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Allocation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAllocations(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAllocations(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.AuthorizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.AuthorizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Authorization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Authorization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Authorization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Authorization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Allocations) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Allocations[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Allocations[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testAuthorizationToManyAddOpClients(t *testing.T) {
 	var err error
 
@@ -647,6 +1052,256 @@ func testAuthorizationToManyAddOpClients(t *testing.T) {
 		}
 	}
 }
+func testAuthorizationToManyAddOpHolePunchResults(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Authorization
+	var b, c, d, e HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*HolePunchResult{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*HolePunchResult{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddHolePunchResults(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.AuthorizationID) {
+			t.Error("foreign key was wrong value", a.ID, first.AuthorizationID)
+		}
+		if !queries.Equal(a.ID, second.AuthorizationID) {
+			t.Error("foreign key was wrong value", a.ID, second.AuthorizationID)
+		}
+
+		if first.R.Authorization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Authorization != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.HolePunchResults[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.HolePunchResults[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.HolePunchResults().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testAuthorizationToManySetOpHolePunchResults(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Authorization
+	var b, c, d, e HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*HolePunchResult{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetHolePunchResults(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.HolePunchResults().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetHolePunchResults(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.HolePunchResults().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.AuthorizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.AuthorizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.AuthorizationID) {
+		t.Error("foreign key was wrong value", a.ID, d.AuthorizationID)
+	}
+	if !queries.Equal(a.ID, e.AuthorizationID) {
+		t.Error("foreign key was wrong value", a.ID, e.AuthorizationID)
+	}
+
+	if b.R.Authorization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Authorization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Authorization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Authorization != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.HolePunchResults[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.HolePunchResults[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testAuthorizationToManyRemoveOpHolePunchResults(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Authorization
+	var b, c, d, e HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*HolePunchResult{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddHolePunchResults(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.HolePunchResults().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveHolePunchResults(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.HolePunchResults().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.AuthorizationID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.AuthorizationID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Authorization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Authorization != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Authorization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Authorization != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.HolePunchResults) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.HolePunchResults[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.HolePunchResults[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
 
 func testAuthorizationsReload(t *testing.T) {
 	t.Parallel()
@@ -722,7 +1377,7 @@ func testAuthorizationsSelect(t *testing.T) {
 }
 
 var (
-	authorizationDBTypes = map[string]string{`ID`: `integer`, `APIKey`: `character varying`, `Username`: `text`, `CreatedAt`: `timestamp with time zone`}
+	authorizationDBTypes = map[string]string{`ID`: `integer`, `APIKey`: `character varying`, `Username`: `text`, `CreatedAt`: `timestamp with time zone`, `Role`: `enum.authorization_role('CONTRIBUTOR','ADMIN','READ_ONLY')`, `UpdatedAt`: `timestamp with time zone`, `RevokedAt`: `timestamp with time zone`}
 	_                    = bytes.MinRead
 )
 
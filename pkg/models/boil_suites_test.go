@@ -12,11 +12,16 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshes)
+	t.Run("Allocations", testAllocations)
 	t.Run("Authorizations", testAuthorizations)
 	t.Run("Clients", testClients)
 	t.Run("ConnectionEvents", testConnectionEvents)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddresses)
 	t.Run("HolePunchAttempts", testHolePunchAttempts)
+	t.Run("HolePunchEvents", testHolePunchEvents)
 	t.Run("HolePunchResults", testHolePunchResults)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourlies)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddresses)
 	t.Run("IPAddresses", testIPAddresses)
 	t.Run("LatencyMeasurements", testLatencyMeasurements)
@@ -26,14 +31,21 @@ func TestParent(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogs)
 	t.Run("Peers", testPeers)
 	t.Run("PortMappings", testPortMappings)
+	t.Run("RelayDials", testRelayDials)
+	t.Run("Relays", testRelays)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesDelete)
+	t.Run("Allocations", testAllocationsDelete)
 	t.Run("Authorizations", testAuthorizationsDelete)
 	t.Run("Clients", testClientsDelete)
 	t.Run("ConnectionEvents", testConnectionEventsDelete)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesDelete)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsDelete)
+	t.Run("HolePunchEvents", testHolePunchEventsDelete)
 	t.Run("HolePunchResults", testHolePunchResultsDelete)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesDelete)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesDelete)
 	t.Run("IPAddresses", testIPAddressesDelete)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsDelete)
@@ -43,14 +55,21 @@ func TestDelete(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsDelete)
 	t.Run("Peers", testPeersDelete)
 	t.Run("PortMappings", testPortMappingsDelete)
+	t.Run("RelayDials", testRelayDialsDelete)
+	t.Run("Relays", testRelaysDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesQueryDeleteAll)
+	t.Run("Allocations", testAllocationsQueryDeleteAll)
 	t.Run("Authorizations", testAuthorizationsQueryDeleteAll)
 	t.Run("Clients", testClientsQueryDeleteAll)
 	t.Run("ConnectionEvents", testConnectionEventsQueryDeleteAll)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesQueryDeleteAll)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsQueryDeleteAll)
+	t.Run("HolePunchEvents", testHolePunchEventsQueryDeleteAll)
 	t.Run("HolePunchResults", testHolePunchResultsQueryDeleteAll)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesQueryDeleteAll)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesQueryDeleteAll)
 	t.Run("IPAddresses", testIPAddressesQueryDeleteAll)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsQueryDeleteAll)
@@ -60,14 +79,21 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsQueryDeleteAll)
 	t.Run("Peers", testPeersQueryDeleteAll)
 	t.Run("PortMappings", testPortMappingsQueryDeleteAll)
+	t.Run("RelayDials", testRelayDialsQueryDeleteAll)
+	t.Run("Relays", testRelaysQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesSliceDeleteAll)
+	t.Run("Allocations", testAllocationsSliceDeleteAll)
 	t.Run("Authorizations", testAuthorizationsSliceDeleteAll)
 	t.Run("Clients", testClientsSliceDeleteAll)
 	t.Run("ConnectionEvents", testConnectionEventsSliceDeleteAll)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesSliceDeleteAll)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsSliceDeleteAll)
+	t.Run("HolePunchEvents", testHolePunchEventsSliceDeleteAll)
 	t.Run("HolePunchResults", testHolePunchResultsSliceDeleteAll)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesSliceDeleteAll)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesSliceDeleteAll)
 	t.Run("IPAddresses", testIPAddressesSliceDeleteAll)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsSliceDeleteAll)
@@ -77,14 +103,21 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsSliceDeleteAll)
 	t.Run("Peers", testPeersSliceDeleteAll)
 	t.Run("PortMappings", testPortMappingsSliceDeleteAll)
+	t.Run("RelayDials", testRelayDialsSliceDeleteAll)
+	t.Run("Relays", testRelaysSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesExists)
+	t.Run("Allocations", testAllocationsExists)
 	t.Run("Authorizations", testAuthorizationsExists)
 	t.Run("Clients", testClientsExists)
 	t.Run("ConnectionEvents", testConnectionEventsExists)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesExists)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsExists)
+	t.Run("HolePunchEvents", testHolePunchEventsExists)
 	t.Run("HolePunchResults", testHolePunchResultsExists)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesExists)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesExists)
 	t.Run("IPAddresses", testIPAddressesExists)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsExists)
@@ -94,14 +127,21 @@ func TestExists(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsExists)
 	t.Run("Peers", testPeersExists)
 	t.Run("PortMappings", testPortMappingsExists)
+	t.Run("RelayDials", testRelayDialsExists)
+	t.Run("Relays", testRelaysExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesFind)
+	t.Run("Allocations", testAllocationsFind)
 	t.Run("Authorizations", testAuthorizationsFind)
 	t.Run("Clients", testClientsFind)
 	t.Run("ConnectionEvents", testConnectionEventsFind)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesFind)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsFind)
+	t.Run("HolePunchEvents", testHolePunchEventsFind)
 	t.Run("HolePunchResults", testHolePunchResultsFind)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesFind)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesFind)
 	t.Run("IPAddresses", testIPAddressesFind)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsFind)
@@ -111,14 +151,21 @@ func TestFind(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsFind)
 	t.Run("Peers", testPeersFind)
 	t.Run("PortMappings", testPortMappingsFind)
+	t.Run("RelayDials", testRelayDialsFind)
+	t.Run("Relays", testRelaysFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesBind)
+	t.Run("Allocations", testAllocationsBind)
 	t.Run("Authorizations", testAuthorizationsBind)
 	t.Run("Clients", testClientsBind)
 	t.Run("ConnectionEvents", testConnectionEventsBind)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesBind)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsBind)
+	t.Run("HolePunchEvents", testHolePunchEventsBind)
 	t.Run("HolePunchResults", testHolePunchResultsBind)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesBind)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesBind)
 	t.Run("IPAddresses", testIPAddressesBind)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsBind)
@@ -128,14 +175,21 @@ func TestBind(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsBind)
 	t.Run("Peers", testPeersBind)
 	t.Run("PortMappings", testPortMappingsBind)
+	t.Run("RelayDials", testRelayDialsBind)
+	t.Run("Relays", testRelaysBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesOne)
+	t.Run("Allocations", testAllocationsOne)
 	t.Run("Authorizations", testAuthorizationsOne)
 	t.Run("Clients", testClientsOne)
 	t.Run("ConnectionEvents", testConnectionEventsOne)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesOne)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsOne)
+	t.Run("HolePunchEvents", testHolePunchEventsOne)
 	t.Run("HolePunchResults", testHolePunchResultsOne)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesOne)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesOne)
 	t.Run("IPAddresses", testIPAddressesOne)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsOne)
@@ -145,14 +199,21 @@ func TestOne(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsOne)
 	t.Run("Peers", testPeersOne)
 	t.Run("PortMappings", testPortMappingsOne)
+	t.Run("RelayDials", testRelayDialsOne)
+	t.Run("Relays", testRelaysOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesAll)
+	t.Run("Allocations", testAllocationsAll)
 	t.Run("Authorizations", testAuthorizationsAll)
 	t.Run("Clients", testClientsAll)
 	t.Run("ConnectionEvents", testConnectionEventsAll)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesAll)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsAll)
+	t.Run("HolePunchEvents", testHolePunchEventsAll)
 	t.Run("HolePunchResults", testHolePunchResultsAll)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesAll)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesAll)
 	t.Run("IPAddresses", testIPAddressesAll)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsAll)
@@ -162,14 +223,21 @@ func TestAll(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsAll)
 	t.Run("Peers", testPeersAll)
 	t.Run("PortMappings", testPortMappingsAll)
+	t.Run("RelayDials", testRelayDialsAll)
+	t.Run("Relays", testRelaysAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesCount)
+	t.Run("Allocations", testAllocationsCount)
 	t.Run("Authorizations", testAuthorizationsCount)
 	t.Run("Clients", testClientsCount)
 	t.Run("ConnectionEvents", testConnectionEventsCount)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesCount)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsCount)
+	t.Run("HolePunchEvents", testHolePunchEventsCount)
 	t.Run("HolePunchResults", testHolePunchResultsCount)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesCount)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesCount)
 	t.Run("IPAddresses", testIPAddressesCount)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsCount)
@@ -179,14 +247,21 @@ func TestCount(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsCount)
 	t.Run("Peers", testPeersCount)
 	t.Run("PortMappings", testPortMappingsCount)
+	t.Run("RelayDials", testRelayDialsCount)
+	t.Run("Relays", testRelaysCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesHooks)
+	t.Run("Allocations", testAllocationsHooks)
 	t.Run("Authorizations", testAuthorizationsHooks)
 	t.Run("Clients", testClientsHooks)
 	t.Run("ConnectionEvents", testConnectionEventsHooks)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesHooks)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsHooks)
+	t.Run("HolePunchEvents", testHolePunchEventsHooks)
 	t.Run("HolePunchResults", testHolePunchResultsHooks)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesHooks)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesHooks)
 	t.Run("IPAddresses", testIPAddressesHooks)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsHooks)
@@ -196,19 +271,31 @@ func TestHooks(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsHooks)
 	t.Run("Peers", testPeersHooks)
 	t.Run("PortMappings", testPortMappingsHooks)
+	t.Run("RelayDials", testRelayDialsHooks)
+	t.Run("Relays", testRelaysHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesInsert)
+	t.Run("AggregateRefreshes", testAggregateRefreshesInsertWhitelist)
+	t.Run("Allocations", testAllocationsInsert)
+	t.Run("Allocations", testAllocationsInsertWhitelist)
 	t.Run("Authorizations", testAuthorizationsInsert)
 	t.Run("Authorizations", testAuthorizationsInsertWhitelist)
 	t.Run("Clients", testClientsInsert)
 	t.Run("Clients", testClientsInsertWhitelist)
 	t.Run("ConnectionEvents", testConnectionEventsInsert)
 	t.Run("ConnectionEvents", testConnectionEventsInsertWhitelist)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesInsert)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesInsertWhitelist)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsInsert)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsInsertWhitelist)
+	t.Run("HolePunchEvents", testHolePunchEventsInsert)
+	t.Run("HolePunchEvents", testHolePunchEventsInsertWhitelist)
 	t.Run("HolePunchResults", testHolePunchResultsInsert)
 	t.Run("HolePunchResults", testHolePunchResultsInsertWhitelist)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesInsert)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesInsertWhitelist)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesInsert)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesInsertWhitelist)
 	t.Run("IPAddresses", testIPAddressesInsert)
@@ -227,19 +314,33 @@ func TestInsert(t *testing.T) {
 	t.Run("Peers", testPeersInsertWhitelist)
 	t.Run("PortMappings", testPortMappingsInsert)
 	t.Run("PortMappings", testPortMappingsInsertWhitelist)
+	t.Run("RelayDials", testRelayDialsInsert)
+	t.Run("RelayDials", testRelayDialsInsertWhitelist)
+	t.Run("Relays", testRelaysInsert)
+	t.Run("Relays", testRelaysInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("AllocationToAuthorizationUsingAuthorization", testAllocationToOneAuthorizationUsingAuthorization)
+	t.Run("AllocationToPeerUsingClient", testAllocationToOnePeerUsingClient)
+	t.Run("AllocationToMultiAddressesSetUsingMultiAddressesSet", testAllocationToOneMultiAddressesSetUsingMultiAddressesSet)
+	t.Run("AllocationToPeerUsingRemote", testAllocationToOnePeerUsingRemote)
 	t.Run("ClientToAuthorizationUsingAuthorization", testClientToOneAuthorizationUsingAuthorization)
 	t.Run("ClientToPeerUsingPeer", testClientToOnePeerUsingPeer)
 	t.Run("ConnectionEventToPeerUsingLocal", testConnectionEventToOnePeerUsingLocal)
 	t.Run("ConnectionEventToMultiAddressUsingConnMultiAddress", testConnectionEventToOneMultiAddressUsingConnMultiAddress)
 	t.Run("ConnectionEventToPeerUsingRemote", testConnectionEventToOnePeerUsingRemote)
+	t.Run("ConnectionEventsXMultiAddressToMultiAddressUsingMultiAddress", testConnectionEventsXMultiAddressToOneMultiAddressUsingMultiAddress)
 	t.Run("HolePunchAttemptToHolePunchResultUsingHolePunchResult", testHolePunchAttemptToOneHolePunchResultUsingHolePunchResult)
+	t.Run("HolePunchEventToHolePunchResultUsingHolePunchResult", testHolePunchEventToOneHolePunchResultUsingHolePunchResult)
+	t.Run("HolePunchResultToAllocationUsingAllocation", testHolePunchResultToOneAllocationUsingAllocation)
+	t.Run("HolePunchResultToAuthorizationUsingAuthorization", testHolePunchResultToOneAuthorizationUsingAuthorization)
 	t.Run("HolePunchResultToMultiAddressesSetUsingListenMultiAddressesSet", testHolePunchResultToOneMultiAddressesSetUsingListenMultiAddressesSet)
 	t.Run("HolePunchResultToPeerUsingLocal", testHolePunchResultToOnePeerUsingLocal)
+	t.Run("HolePunchResultToPeerUsingRelay", testHolePunchResultToOnePeerUsingRelay)
+	t.Run("HolePunchResultToMultiAddressUsingRelayMultiAddress", testHolePunchResultToOneMultiAddressUsingRelayMultiAddress)
 	t.Run("HolePunchResultToPeerUsingRemote", testHolePunchResultToOnePeerUsingRemote)
 	t.Run("HolePunchResultsXMultiAddressToHolePunchResultUsingHolePunchResult", testHolePunchResultsXMultiAddressToOneHolePunchResultUsingHolePunchResult)
 	t.Run("HolePunchResultsXMultiAddressToMultiAddressUsingMultiAddress", testHolePunchResultsXMultiAddressToOneMultiAddressUsingMultiAddress)
@@ -250,50 +351,75 @@ func TestToOne(t *testing.T) {
 	t.Run("NetworkInformationToPeerUsingPeer", testNetworkInformationToOnePeerUsingPeer)
 	t.Run("PeerLogToPeerUsingPeer", testPeerLogToOnePeerUsingPeer)
 	t.Run("PortMappingToHolePunchResultUsingHolePunchResult", testPortMappingToOneHolePunchResultUsingHolePunchResult)
+	t.Run("RelayDialToHolePunchResultUsingHolePunchResult", testRelayDialToOneHolePunchResultUsingHolePunchResult)
+	t.Run("RelayDialToPeerUsingRelay", testRelayDialToOnePeerUsingRelay)
+	t.Run("RelayToPeerUsingPeer", testRelayToOnePeerUsingPeer)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOne(t *testing.T) {}
+func TestOneToOne(t *testing.T) {
+	t.Run("AllocationToHolePunchResultUsingHolePunchResult", testAllocationOneToOneHolePunchResultUsingHolePunchResult)
+	t.Run("PeerToRelayUsingRelay", testPeerOneToOneRelayUsingRelay)
+}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("AuthorizationToAllocations", testAuthorizationToManyAllocations)
 	t.Run("AuthorizationToClients", testAuthorizationToManyClients)
-	t.Run("ConnectionEventToMultiAddresses", testConnectionEventToManyMultiAddresses)
+	t.Run("AuthorizationToHolePunchResults", testAuthorizationToManyHolePunchResults)
 	t.Run("HolePunchAttemptToMultiAddresses", testHolePunchAttemptToManyMultiAddresses)
 	t.Run("HolePunchResultToHolePunchAttempts", testHolePunchResultToManyHolePunchAttempts)
+	t.Run("HolePunchResultToHolePunchEvents", testHolePunchResultToManyHolePunchEvents)
 	t.Run("HolePunchResultToHolePunchResultsXMultiAddresses", testHolePunchResultToManyHolePunchResultsXMultiAddresses)
 	t.Run("HolePunchResultToLatencyMeasurements", testHolePunchResultToManyLatencyMeasurements)
 	t.Run("HolePunchResultToPortMappings", testHolePunchResultToManyPortMappings)
+	t.Run("HolePunchResultToRelayDials", testHolePunchResultToManyRelayDials)
 	t.Run("MultiAddressToConnMultiAddressConnectionEvents", testMultiAddressToManyConnMultiAddressConnectionEvents)
-	t.Run("MultiAddressToConnectionEvents", testMultiAddressToManyConnectionEvents)
+	t.Run("MultiAddressToConnectionEventsXMultiAddresses", testMultiAddressToManyConnectionEventsXMultiAddresses)
 	t.Run("MultiAddressToHolePunchAttempts", testMultiAddressToManyHolePunchAttempts)
+	t.Run("MultiAddressToRelayMultiAddressHolePunchResults", testMultiAddressToManyRelayMultiAddressHolePunchResults)
 	t.Run("MultiAddressToHolePunchResultsXMultiAddresses", testMultiAddressToManyHolePunchResultsXMultiAddresses)
 	t.Run("MultiAddressToIPAddresses", testMultiAddressToManyIPAddresses)
 	t.Run("MultiAddressToLatencyMeasurements", testMultiAddressToManyLatencyMeasurements)
+	t.Run("MultiAddressesSetToAllocations", testMultiAddressesSetToManyAllocations)
 	t.Run("MultiAddressesSetToListenMultiAddressesSetHolePunchResults", testMultiAddressesSetToManyListenMultiAddressesSetHolePunchResults)
+	t.Run("PeerToClientAllocations", testPeerToManyClientAllocations)
+	t.Run("PeerToRemoteAllocations", testPeerToManyRemoteAllocations)
 	t.Run("PeerToClients", testPeerToManyClients)
 	t.Run("PeerToLocalConnectionEvents", testPeerToManyLocalConnectionEvents)
 	t.Run("PeerToRemoteConnectionEvents", testPeerToManyRemoteConnectionEvents)
 	t.Run("PeerToLocalHolePunchResults", testPeerToManyLocalHolePunchResults)
+	t.Run("PeerToRelayHolePunchResults", testPeerToManyRelayHolePunchResults)
 	t.Run("PeerToRemoteHolePunchResults", testPeerToManyRemoteHolePunchResults)
 	t.Run("PeerToRemoteLatencyMeasurements", testPeerToManyRemoteLatencyMeasurements)
 	t.Run("PeerToNetworkInformations", testPeerToManyNetworkInformations)
 	t.Run("PeerToPeerLogs", testPeerToManyPeerLogs)
+	t.Run("PeerToRelayRelayDials", testPeerToManyRelayRelayDials)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("AllocationToAuthorizationUsingAllocations", testAllocationToOneSetOpAuthorizationUsingAuthorization)
+	t.Run("AllocationToPeerUsingClientAllocations", testAllocationToOneSetOpPeerUsingClient)
+	t.Run("AllocationToMultiAddressesSetUsingAllocations", testAllocationToOneSetOpMultiAddressesSetUsingMultiAddressesSet)
+	t.Run("AllocationToPeerUsingRemoteAllocations", testAllocationToOneSetOpPeerUsingRemote)
 	t.Run("ClientToAuthorizationUsingClients", testClientToOneSetOpAuthorizationUsingAuthorization)
 	t.Run("ClientToPeerUsingClients", testClientToOneSetOpPeerUsingPeer)
 	t.Run("ConnectionEventToPeerUsingLocalConnectionEvents", testConnectionEventToOneSetOpPeerUsingLocal)
 	t.Run("ConnectionEventToMultiAddressUsingConnMultiAddressConnectionEvents", testConnectionEventToOneSetOpMultiAddressUsingConnMultiAddress)
 	t.Run("ConnectionEventToPeerUsingRemoteConnectionEvents", testConnectionEventToOneSetOpPeerUsingRemote)
+	t.Run("ConnectionEventsXMultiAddressToMultiAddressUsingConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressToOneSetOpMultiAddressUsingMultiAddress)
 	t.Run("HolePunchAttemptToHolePunchResultUsingHolePunchAttempts", testHolePunchAttemptToOneSetOpHolePunchResultUsingHolePunchResult)
+	t.Run("HolePunchEventToHolePunchResultUsingHolePunchEvents", testHolePunchEventToOneSetOpHolePunchResultUsingHolePunchResult)
+	t.Run("HolePunchResultToAllocationUsingHolePunchResult", testHolePunchResultToOneSetOpAllocationUsingAllocation)
+	t.Run("HolePunchResultToAuthorizationUsingHolePunchResults", testHolePunchResultToOneSetOpAuthorizationUsingAuthorization)
 	t.Run("HolePunchResultToMultiAddressesSetUsingListenMultiAddressesSetHolePunchResults", testHolePunchResultToOneSetOpMultiAddressesSetUsingListenMultiAddressesSet)
 	t.Run("HolePunchResultToPeerUsingLocalHolePunchResults", testHolePunchResultToOneSetOpPeerUsingLocal)
+	t.Run("HolePunchResultToPeerUsingRelayHolePunchResults", testHolePunchResultToOneSetOpPeerUsingRelay)
+	t.Run("HolePunchResultToMultiAddressUsingRelayMultiAddressHolePunchResults", testHolePunchResultToOneSetOpMultiAddressUsingRelayMultiAddress)
 	t.Run("HolePunchResultToPeerUsingRemoteHolePunchResults", testHolePunchResultToOneSetOpPeerUsingRemote)
 	t.Run("HolePunchResultsXMultiAddressToHolePunchResultUsingHolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressToOneSetOpHolePunchResultUsingHolePunchResult)
 	t.Run("HolePunchResultsXMultiAddressToMultiAddressUsingHolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressToOneSetOpMultiAddressUsingMultiAddress)
@@ -304,71 +430,106 @@ func TestToOneSet(t *testing.T) {
 	t.Run("NetworkInformationToPeerUsingNetworkInformations", testNetworkInformationToOneSetOpPeerUsingPeer)
 	t.Run("PeerLogToPeerUsingPeerLogs", testPeerLogToOneSetOpPeerUsingPeer)
 	t.Run("PortMappingToHolePunchResultUsingPortMappings", testPortMappingToOneSetOpHolePunchResultUsingHolePunchResult)
+	t.Run("RelayDialToHolePunchResultUsingRelayDials", testRelayDialToOneSetOpHolePunchResultUsingHolePunchResult)
+	t.Run("RelayDialToPeerUsingRelayRelayDials", testRelayDialToOneSetOpPeerUsingRelay)
+	t.Run("RelayToPeerUsingRelay", testRelayToOneSetOpPeerUsingPeer)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("AllocationToAuthorizationUsingAllocations", testAllocationToOneRemoveOpAuthorizationUsingAuthorization)
+	t.Run("AllocationToMultiAddressesSetUsingAllocations", testAllocationToOneRemoveOpMultiAddressesSetUsingMultiAddressesSet)
+	t.Run("HolePunchResultToAllocationUsingHolePunchResult", testHolePunchResultToOneRemoveOpAllocationUsingAllocation)
+	t.Run("HolePunchResultToAuthorizationUsingHolePunchResults", testHolePunchResultToOneRemoveOpAuthorizationUsingAuthorization)
+	t.Run("HolePunchResultToPeerUsingRelayHolePunchResults", testHolePunchResultToOneRemoveOpPeerUsingRelay)
+	t.Run("HolePunchResultToMultiAddressUsingRelayMultiAddressHolePunchResults", testHolePunchResultToOneRemoveOpMultiAddressUsingRelayMultiAddress)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneSet(t *testing.T) {}
+func TestOneToOneSet(t *testing.T) {
+	t.Run("AllocationToHolePunchResultUsingHolePunchResult", testAllocationOneToOneSetOpHolePunchResultUsingHolePunchResult)
+	t.Run("PeerToRelayUsingRelay", testPeerOneToOneSetOpRelayUsingRelay)
+}
 
 // TestOneToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneRemove(t *testing.T) {}
+func TestOneToOneRemove(t *testing.T) {
+	t.Run("AllocationToHolePunchResultUsingHolePunchResult", testAllocationOneToOneRemoveOpHolePunchResultUsingHolePunchResult)
+}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("AuthorizationToAllocations", testAuthorizationToManyAddOpAllocations)
 	t.Run("AuthorizationToClients", testAuthorizationToManyAddOpClients)
-	t.Run("ConnectionEventToMultiAddresses", testConnectionEventToManyAddOpMultiAddresses)
+	t.Run("AuthorizationToHolePunchResults", testAuthorizationToManyAddOpHolePunchResults)
 	t.Run("HolePunchAttemptToMultiAddresses", testHolePunchAttemptToManyAddOpMultiAddresses)
 	t.Run("HolePunchResultToHolePunchAttempts", testHolePunchResultToManyAddOpHolePunchAttempts)
+	t.Run("HolePunchResultToHolePunchEvents", testHolePunchResultToManyAddOpHolePunchEvents)
 	t.Run("HolePunchResultToHolePunchResultsXMultiAddresses", testHolePunchResultToManyAddOpHolePunchResultsXMultiAddresses)
 	t.Run("HolePunchResultToLatencyMeasurements", testHolePunchResultToManyAddOpLatencyMeasurements)
 	t.Run("HolePunchResultToPortMappings", testHolePunchResultToManyAddOpPortMappings)
+	t.Run("HolePunchResultToRelayDials", testHolePunchResultToManyAddOpRelayDials)
 	t.Run("MultiAddressToConnMultiAddressConnectionEvents", testMultiAddressToManyAddOpConnMultiAddressConnectionEvents)
-	t.Run("MultiAddressToConnectionEvents", testMultiAddressToManyAddOpConnectionEvents)
+	t.Run("MultiAddressToConnectionEventsXMultiAddresses", testMultiAddressToManyAddOpConnectionEventsXMultiAddresses)
 	t.Run("MultiAddressToHolePunchAttempts", testMultiAddressToManyAddOpHolePunchAttempts)
+	t.Run("MultiAddressToRelayMultiAddressHolePunchResults", testMultiAddressToManyAddOpRelayMultiAddressHolePunchResults)
 	t.Run("MultiAddressToHolePunchResultsXMultiAddresses", testMultiAddressToManyAddOpHolePunchResultsXMultiAddresses)
 	t.Run("MultiAddressToIPAddresses", testMultiAddressToManyAddOpIPAddresses)
 	t.Run("MultiAddressToLatencyMeasurements", testMultiAddressToManyAddOpLatencyMeasurements)
+	t.Run("MultiAddressesSetToAllocations", testMultiAddressesSetToManyAddOpAllocations)
 	t.Run("MultiAddressesSetToListenMultiAddressesSetHolePunchResults", testMultiAddressesSetToManyAddOpListenMultiAddressesSetHolePunchResults)
+	t.Run("PeerToClientAllocations", testPeerToManyAddOpClientAllocations)
+	t.Run("PeerToRemoteAllocations", testPeerToManyAddOpRemoteAllocations)
 	t.Run("PeerToClients", testPeerToManyAddOpClients)
 	t.Run("PeerToLocalConnectionEvents", testPeerToManyAddOpLocalConnectionEvents)
 	t.Run("PeerToRemoteConnectionEvents", testPeerToManyAddOpRemoteConnectionEvents)
 	t.Run("PeerToLocalHolePunchResults", testPeerToManyAddOpLocalHolePunchResults)
+	t.Run("PeerToRelayHolePunchResults", testPeerToManyAddOpRelayHolePunchResults)
 	t.Run("PeerToRemoteHolePunchResults", testPeerToManyAddOpRemoteHolePunchResults)
 	t.Run("PeerToRemoteLatencyMeasurements", testPeerToManyAddOpRemoteLatencyMeasurements)
 	t.Run("PeerToNetworkInformations", testPeerToManyAddOpNetworkInformations)
 	t.Run("PeerToPeerLogs", testPeerToManyAddOpPeerLogs)
+	t.Run("PeerToRelayRelayDials", testPeerToManyAddOpRelayRelayDials)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
-	t.Run("ConnectionEventToMultiAddresses", testConnectionEventToManySetOpMultiAddresses)
+	t.Run("AuthorizationToAllocations", testAuthorizationToManySetOpAllocations)
+	t.Run("AuthorizationToHolePunchResults", testAuthorizationToManySetOpHolePunchResults)
 	t.Run("HolePunchAttemptToMultiAddresses", testHolePunchAttemptToManySetOpMultiAddresses)
-	t.Run("MultiAddressToConnectionEvents", testMultiAddressToManySetOpConnectionEvents)
 	t.Run("MultiAddressToHolePunchAttempts", testMultiAddressToManySetOpHolePunchAttempts)
+	t.Run("MultiAddressToRelayMultiAddressHolePunchResults", testMultiAddressToManySetOpRelayMultiAddressHolePunchResults)
+	t.Run("MultiAddressesSetToAllocations", testMultiAddressesSetToManySetOpAllocations)
+	t.Run("PeerToRelayHolePunchResults", testPeerToManySetOpRelayHolePunchResults)
 }
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
-	t.Run("ConnectionEventToMultiAddresses", testConnectionEventToManyRemoveOpMultiAddresses)
+	t.Run("AuthorizationToAllocations", testAuthorizationToManyRemoveOpAllocations)
+	t.Run("AuthorizationToHolePunchResults", testAuthorizationToManyRemoveOpHolePunchResults)
 	t.Run("HolePunchAttemptToMultiAddresses", testHolePunchAttemptToManyRemoveOpMultiAddresses)
-	t.Run("MultiAddressToConnectionEvents", testMultiAddressToManyRemoveOpConnectionEvents)
 	t.Run("MultiAddressToHolePunchAttempts", testMultiAddressToManyRemoveOpHolePunchAttempts)
+	t.Run("MultiAddressToRelayMultiAddressHolePunchResults", testMultiAddressToManyRemoveOpRelayMultiAddressHolePunchResults)
+	t.Run("MultiAddressesSetToAllocations", testMultiAddressesSetToManyRemoveOpAllocations)
+	t.Run("PeerToRelayHolePunchResults", testPeerToManyRemoveOpRelayHolePunchResults)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesReload)
+	t.Run("Allocations", testAllocationsReload)
 	t.Run("Authorizations", testAuthorizationsReload)
 	t.Run("Clients", testClientsReload)
 	t.Run("ConnectionEvents", testConnectionEventsReload)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesReload)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsReload)
+	t.Run("HolePunchEvents", testHolePunchEventsReload)
 	t.Run("HolePunchResults", testHolePunchResultsReload)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesReload)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesReload)
 	t.Run("IPAddresses", testIPAddressesReload)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsReload)
@@ -378,14 +539,21 @@ func TestReload(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsReload)
 	t.Run("Peers", testPeersReload)
 	t.Run("PortMappings", testPortMappingsReload)
+	t.Run("RelayDials", testRelayDialsReload)
+	t.Run("Relays", testRelaysReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesReloadAll)
+	t.Run("Allocations", testAllocationsReloadAll)
 	t.Run("Authorizations", testAuthorizationsReloadAll)
 	t.Run("Clients", testClientsReloadAll)
 	t.Run("ConnectionEvents", testConnectionEventsReloadAll)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesReloadAll)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsReloadAll)
+	t.Run("HolePunchEvents", testHolePunchEventsReloadAll)
 	t.Run("HolePunchResults", testHolePunchResultsReloadAll)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesReloadAll)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesReloadAll)
 	t.Run("IPAddresses", testIPAddressesReloadAll)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsReloadAll)
@@ -395,14 +563,21 @@ func TestReloadAll(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsReloadAll)
 	t.Run("Peers", testPeersReloadAll)
 	t.Run("PortMappings", testPortMappingsReloadAll)
+	t.Run("RelayDials", testRelayDialsReloadAll)
+	t.Run("Relays", testRelaysReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesSelect)
+	t.Run("Allocations", testAllocationsSelect)
 	t.Run("Authorizations", testAuthorizationsSelect)
 	t.Run("Clients", testClientsSelect)
 	t.Run("ConnectionEvents", testConnectionEventsSelect)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesSelect)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsSelect)
+	t.Run("HolePunchEvents", testHolePunchEventsSelect)
 	t.Run("HolePunchResults", testHolePunchResultsSelect)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesSelect)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesSelect)
 	t.Run("IPAddresses", testIPAddressesSelect)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsSelect)
@@ -412,14 +587,21 @@ func TestSelect(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsSelect)
 	t.Run("Peers", testPeersSelect)
 	t.Run("PortMappings", testPortMappingsSelect)
+	t.Run("RelayDials", testRelayDialsSelect)
+	t.Run("Relays", testRelaysSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesUpdate)
+	t.Run("Allocations", testAllocationsUpdate)
 	t.Run("Authorizations", testAuthorizationsUpdate)
 	t.Run("Clients", testClientsUpdate)
 	t.Run("ConnectionEvents", testConnectionEventsUpdate)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesUpdate)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsUpdate)
+	t.Run("HolePunchEvents", testHolePunchEventsUpdate)
 	t.Run("HolePunchResults", testHolePunchResultsUpdate)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesUpdate)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesUpdate)
 	t.Run("IPAddresses", testIPAddressesUpdate)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsUpdate)
@@ -429,14 +611,21 @@ func TestUpdate(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsUpdate)
 	t.Run("Peers", testPeersUpdate)
 	t.Run("PortMappings", testPortMappingsUpdate)
+	t.Run("RelayDials", testRelayDialsUpdate)
+	t.Run("Relays", testRelaysUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesSliceUpdateAll)
+	t.Run("Allocations", testAllocationsSliceUpdateAll)
 	t.Run("Authorizations", testAuthorizationsSliceUpdateAll)
 	t.Run("Clients", testClientsSliceUpdateAll)
 	t.Run("ConnectionEvents", testConnectionEventsSliceUpdateAll)
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesSliceUpdateAll)
 	t.Run("HolePunchAttempts", testHolePunchAttemptsSliceUpdateAll)
+	t.Run("HolePunchEvents", testHolePunchEventsSliceUpdateAll)
 	t.Run("HolePunchResults", testHolePunchResultsSliceUpdateAll)
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesSliceUpdateAll)
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesSliceUpdateAll)
 	t.Run("IPAddresses", testIPAddressesSliceUpdateAll)
 	t.Run("LatencyMeasurements", testLatencyMeasurementsSliceUpdateAll)
@@ -446,4 +635,6 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("PeerLogs", testPeerLogsSliceUpdateAll)
 	t.Run("Peers", testPeersSliceUpdateAll)
 	t.Run("PortMappings", testPortMappingsSliceUpdateAll)
+	t.Run("RelayDials", testRelayDialsSliceUpdateAll)
+	t.Run("Relays", testRelaysSliceUpdateAll)
 }
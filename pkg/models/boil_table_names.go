@@ -4,13 +4,17 @@
 package models
 
 var TableNames = struct {
+	AggregateRefreshes              string
+	Allocations                     string
 	Authorizations                  string
 	Clients                         string
 	ConnectionEvents                string
 	ConnectionEventsXMultiAddresses string
 	HolePunchAttempt                string
 	HolePunchAttemptXMultiAddresses string
+	HolePunchEvents                 string
 	HolePunchResults                string
+	HolePunchResultsHourly          string
 	HolePunchResultsXMultiAddresses string
 	IPAddresses                     string
 	LatencyMeasurements             string
@@ -20,14 +24,20 @@ var TableNames = struct {
 	PeerLogs                        string
 	Peers                           string
 	PortMappings                    string
+	RelayDials                      string
+	Relays                          string
 }{
+	AggregateRefreshes:              "aggregate_refreshes",
+	Allocations:                     "allocations",
 	Authorizations:                  "authorizations",
 	Clients:                         "clients",
 	ConnectionEvents:                "connection_events",
 	ConnectionEventsXMultiAddresses: "connection_events_x_multi_addresses",
 	HolePunchAttempt:                "hole_punch_attempt",
 	HolePunchAttemptXMultiAddresses: "hole_punch_attempt_x_multi_addresses",
+	HolePunchEvents:                 "hole_punch_events",
 	HolePunchResults:                "hole_punch_results",
+	HolePunchResultsHourly:          "hole_punch_results_hourly",
 	HolePunchResultsXMultiAddresses: "hole_punch_results_x_multi_addresses",
 	IPAddresses:                     "ip_addresses",
 	LatencyMeasurements:             "latency_measurements",
@@ -37,4 +47,6 @@ var TableNames = struct {
 	PeerLogs:                        "peer_logs",
 	Peers:                           "peers",
 	PortMappings:                    "port_mappings",
+	RelayDials:                      "relay_dials",
+	Relays:                          "relays",
 }
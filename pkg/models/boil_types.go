@@ -51,6 +51,21 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+// Enum values for AuthorizationRole
+const (
+	AuthorizationRoleCONTRIBUTOR string = "CONTRIBUTOR"
+	AuthorizationRoleADMIN       string = "ADMIN"
+	AuthorizationRoleREAD_ONLY   string = "READ_ONLY"
+)
+
+func AllAuthorizationRole() []string {
+	return []string{
+		AuthorizationRoleCONTRIBUTOR,
+		AuthorizationRoleADMIN,
+		AuthorizationRoleREAD_ONLY,
+	}
+}
+
 // Enum values for HolePunchAttemptOutcome
 const (
 	HolePunchAttemptOutcomeUNKNOWN        string = "UNKNOWN"
@@ -74,6 +89,31 @@ func AllHolePunchAttemptOutcome() []string {
 	}
 }
 
+// Enum values for HolePunchEventType
+const (
+	HolePunchEventTypeUNKNOWN            string = "UNKNOWN"
+	HolePunchEventTypeDIRECT_DIAL        string = "DIRECT_DIAL"
+	HolePunchEventTypePROTOCOL_ERROR     string = "PROTOCOL_ERROR"
+	HolePunchEventTypeSTART_HOLE_PUNCH   string = "START_HOLE_PUNCH"
+	HolePunchEventTypeEND_HOLE_PUNCH     string = "END_HOLE_PUNCH"
+	HolePunchEventTypeHOLE_PUNCH_ATTEMPT string = "HOLE_PUNCH_ATTEMPT"
+	HolePunchEventTypeCONNECTION_OPENED  string = "CONNECTION_OPENED"
+	HolePunchEventTypeCONNECTION_CLOSED  string = "CONNECTION_CLOSED"
+)
+
+func AllHolePunchEventType() []string {
+	return []string{
+		HolePunchEventTypeUNKNOWN,
+		HolePunchEventTypeDIRECT_DIAL,
+		HolePunchEventTypePROTOCOL_ERROR,
+		HolePunchEventTypeSTART_HOLE_PUNCH,
+		HolePunchEventTypeEND_HOLE_PUNCH,
+		HolePunchEventTypeHOLE_PUNCH_ATTEMPT,
+		HolePunchEventTypeCONNECTION_OPENED,
+		HolePunchEventTypeCONNECTION_CLOSED,
+	}
+}
+
 // Enum values for HolePunchOutcome
 const (
 	HolePunchOutcomeUNKNOWN             string = "UNKNOWN"
@@ -97,6 +137,40 @@ func AllHolePunchOutcome() []string {
 	}
 }
 
+// Enum values for ValidationStatus
+const (
+	ValidationStatusACCEPTED string = "ACCEPTED"
+	ValidationStatusFLAGGED  string = "FLAGGED"
+	ValidationStatusREJECTED string = "REJECTED"
+)
+
+func AllValidationStatus() []string {
+	return []string{
+		ValidationStatusACCEPTED,
+		ValidationStatusFLAGGED,
+		ValidationStatusREJECTED,
+	}
+}
+
+// Enum values for RelayStrategy
+const (
+	RelayStrategyALL        string = "ALL"
+	RelayStrategyFIRST      string = "FIRST"
+	RelayStrategyLOWEST_RTT string = "LOWEST_RTT"
+	RelayStrategyRANDOM     string = "RANDOM"
+	RelayStrategySERVER     string = "SERVER"
+)
+
+func AllRelayStrategy() []string {
+	return []string{
+		RelayStrategyALL,
+		RelayStrategyFIRST,
+		RelayStrategyLOWEST_RTT,
+		RelayStrategyRANDOM,
+		RelayStrategySERVER,
+	}
+}
+
 // Enum values for HolePunchMultiAddressRelationship
 const (
 	HolePunchMultiAddressRelationshipINITIAL string = "INITIAL"
@@ -110,6 +184,27 @@ func AllHolePunchMultiAddressRelationship() []string {
 	}
 }
 
+// Enum values for ConnectionType
+const (
+	ConnectionTypeMOBILE      string = "MOBILE"
+	ConnectionTypeRESIDENTIAL string = "RESIDENTIAL"
+	ConnectionTypeBUSINESS    string = "BUSINESS"
+	ConnectionTypeHOSTING     string = "HOSTING"
+	ConnectionTypeEDUCATION   string = "EDUCATION"
+	ConnectionTypeSATELLITE   string = "SATELLITE"
+)
+
+func AllConnectionType() []string {
+	return []string{
+		ConnectionTypeMOBILE,
+		ConnectionTypeRESIDENTIAL,
+		ConnectionTypeBUSINESS,
+		ConnectionTypeHOSTING,
+		ConnectionTypeEDUCATION,
+		ConnectionTypeSATELLITE,
+	}
+}
+
 // Enum values for LatencyMeasurementType
 const (
 	LatencyMeasurementTypeTO_RELAY                  string = "TO_RELAY"
@@ -29,6 +29,7 @@ type ConnectionEvent struct {
 	ConnMultiAddressID int64     `boil:"conn_multi_address_id" json:"conn_multi_address_id" toml:"conn_multi_address_id" yaml:"conn_multi_address_id"`
 	OpenedAt           time.Time `boil:"opened_at" json:"opened_at" toml:"opened_at" yaml:"opened_at"`
 	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Network            string    `boil:"network" json:"network" toml:"network" yaml:"network"`
 
 	R *connectionEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L connectionEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -41,6 +42,7 @@ var ConnectionEventColumns = struct {
 	ConnMultiAddressID string
 	OpenedAt           string
 	CreatedAt          string
+	Network            string
 }{
 	ID:                 "id",
 	LocalID:            "local_id",
@@ -48,6 +50,7 @@ var ConnectionEventColumns = struct {
 	ConnMultiAddressID: "conn_multi_address_id",
 	OpenedAt:           "opened_at",
 	CreatedAt:          "created_at",
+	Network:            "network",
 }
 
 var ConnectionEventTableColumns = struct {
@@ -57,6 +60,7 @@ var ConnectionEventTableColumns = struct {
 	ConnMultiAddressID string
 	OpenedAt           string
 	CreatedAt          string
+	Network            string
 }{
 	ID:                 "connection_events.id",
 	LocalID:            "connection_events.local_id",
@@ -64,6 +68,7 @@ var ConnectionEventTableColumns = struct {
 	ConnMultiAddressID: "connection_events.conn_multi_address_id",
 	OpenedAt:           "connection_events.opened_at",
 	CreatedAt:          "connection_events.created_at",
+	Network:            "connection_events.network",
 }
 
 // Generated where
@@ -75,6 +80,7 @@ var ConnectionEventWhere = struct {
 	ConnMultiAddressID whereHelperint64
 	OpenedAt           whereHelpertime_Time
 	CreatedAt          whereHelpertime_Time
+	Network            whereHelperstring
 }{
 	ID:                 whereHelperint{field: "\"connection_events\".\"id\""},
 	LocalID:            whereHelperint64{field: "\"connection_events\".\"local_id\""},
@@ -82,6 +88,7 @@ var ConnectionEventWhere = struct {
 	ConnMultiAddressID: whereHelperint64{field: "\"connection_events\".\"conn_multi_address_id\""},
 	OpenedAt:           whereHelpertime_Time{field: "\"connection_events\".\"opened_at\""},
 	CreatedAt:          whereHelpertime_Time{field: "\"connection_events\".\"created_at\""},
+	Network:            whereHelperstring{field: "\"connection_events\".\"network\""},
 }
 
 // ConnectionEventRels is where relationship names are stored.
@@ -89,20 +96,17 @@ var ConnectionEventRels = struct {
 	Local            string
 	ConnMultiAddress string
 	Remote           string
-	MultiAddresses   string
 }{
 	Local:            "Local",
 	ConnMultiAddress: "ConnMultiAddress",
 	Remote:           "Remote",
-	MultiAddresses:   "MultiAddresses",
 }
 
 // connectionEventR is where relationships are stored.
 type connectionEventR struct {
-	Local            *Peer             `boil:"Local" json:"Local" toml:"Local" yaml:"Local"`
-	ConnMultiAddress *MultiAddress     `boil:"ConnMultiAddress" json:"ConnMultiAddress" toml:"ConnMultiAddress" yaml:"ConnMultiAddress"`
-	Remote           *Peer             `boil:"Remote" json:"Remote" toml:"Remote" yaml:"Remote"`
-	MultiAddresses   MultiAddressSlice `boil:"MultiAddresses" json:"MultiAddresses" toml:"MultiAddresses" yaml:"MultiAddresses"`
+	Local            *Peer         `boil:"Local" json:"Local" toml:"Local" yaml:"Local"`
+	ConnMultiAddress *MultiAddress `boil:"ConnMultiAddress" json:"ConnMultiAddress" toml:"ConnMultiAddress" yaml:"ConnMultiAddress"`
+	Remote           *Peer         `boil:"Remote" json:"Remote" toml:"Remote" yaml:"Remote"`
 }
 
 // NewStruct creates a new relationship struct
@@ -131,22 +135,15 @@ func (r *connectionEventR) GetRemote() *Peer {
 	return r.Remote
 }
 
-func (r *connectionEventR) GetMultiAddresses() MultiAddressSlice {
-	if r == nil {
-		return nil
-	}
-	return r.MultiAddresses
-}
-
 // connectionEventL is where Load methods for each relationship are stored.
 type connectionEventL struct{}
 
 var (
-	connectionEventAllColumns            = []string{"id", "local_id", "remote_id", "conn_multi_address_id", "opened_at", "created_at"}
+	connectionEventAllColumns            = []string{"id", "local_id", "remote_id", "conn_multi_address_id", "opened_at", "created_at", "network"}
 	connectionEventColumnsWithoutDefault = []string{"local_id", "remote_id", "conn_multi_address_id", "opened_at", "created_at"}
-	connectionEventColumnsWithDefault    = []string{"id"}
-	connectionEventPrimaryKeyColumns     = []string{"id"}
-	connectionEventGeneratedColumns      = []string{"id"}
+	connectionEventColumnsWithDefault    = []string{"id", "network"}
+	connectionEventPrimaryKeyColumns     = []string{"id", "opened_at"}
+	connectionEventGeneratedColumns      = []string{}
 )
 
 type (
@@ -460,21 +457,6 @@ func (o *ConnectionEvent) Remote(mods ...qm.QueryMod) peerQuery {
 	return Peers(queryMods...)
 }
 
-// MultiAddresses retrieves all the multi_address's MultiAddresses with an executor.
-func (o *ConnectionEvent) MultiAddresses(mods ...qm.QueryMod) multiAddressQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
-	}
-
-	queryMods = append(queryMods,
-		qm.InnerJoin("\"connection_events_x_multi_addresses\" on \"multi_addresses\".\"id\" = \"connection_events_x_multi_addresses\".\"multi_address_id\""),
-		qm.Where("\"connection_events_x_multi_addresses\".\"connection_event_id\"=?", o.ID),
-	)
-
-	return MultiAddresses(queryMods...)
-}
-
 // LoadLocal allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (connectionEventL) LoadLocal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConnectionEvent interface{}, mods queries.Applicator) error {
@@ -835,137 +817,6 @@ func (connectionEventL) LoadRemote(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadMultiAddresses allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (connectionEventL) LoadMultiAddresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConnectionEvent interface{}, mods queries.Applicator) error {
-	var slice []*ConnectionEvent
-	var object *ConnectionEvent
-
-	if singular {
-		var ok bool
-		object, ok = maybeConnectionEvent.(*ConnectionEvent)
-		if !ok {
-			object = new(ConnectionEvent)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeConnectionEvent)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConnectionEvent))
-			}
-		}
-	} else {
-		s, ok := maybeConnectionEvent.(*[]*ConnectionEvent)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeConnectionEvent)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConnectionEvent))
-			}
-		}
-	}
-
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &connectionEventR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &connectionEventR{}
-			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
-		}
-	}
-
-	if len(args) == 0 {
-		return nil
-	}
-
-	query := NewQuery(
-		qm.Select("\"multi_addresses\".\"id\", \"multi_addresses\".\"asn\", \"multi_addresses\".\"is_cloud\", \"multi_addresses\".\"is_relay\", \"multi_addresses\".\"is_public\", \"multi_addresses\".\"addr\", \"multi_addresses\".\"has_many_addrs\", \"multi_addresses\".\"country\", \"multi_addresses\".\"continent\", \"multi_addresses\".\"maddr\", \"multi_addresses\".\"updated_at\", \"multi_addresses\".\"created_at\", \"a\".\"connection_event_id\""),
-		qm.From("\"multi_addresses\""),
-		qm.InnerJoin("\"connection_events_x_multi_addresses\" as \"a\" on \"multi_addresses\".\"id\" = \"a\".\"multi_address_id\""),
-		qm.WhereIn("\"a\".\"connection_event_id\" in ?", args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
-
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load multi_addresses")
-	}
-
-	var resultSlice []*MultiAddress
-
-	var localJoinCols []int
-	for results.Next() {
-		one := new(MultiAddress)
-		var localJoinCol int
-
-		err = results.Scan(&one.ID, &one.Asn, &one.IsCloud, &one.IsRelay, &one.IsPublic, &one.Addr, &one.HasManyAddrs, &one.Country, &one.Continent, &one.Maddr, &one.UpdatedAt, &one.CreatedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for multi_addresses")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice multi_addresses")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
-	}
-
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on multi_addresses")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for multi_addresses")
-	}
-
-	if len(multiAddressAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
-		}
-	}
-	if singular {
-		object.R.MultiAddresses = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &multiAddressR{}
-			}
-			foreign.R.ConnectionEvents = append(foreign.R.ConnectionEvents, object)
-		}
-		return nil
-	}
-
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
-		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.MultiAddresses = append(local.R.MultiAddresses, foreign)
-				if foreign.R == nil {
-					foreign.R = &multiAddressR{}
-				}
-				foreign.R.ConnectionEvents = append(foreign.R.ConnectionEvents, local)
-				break
-			}
-		}
-	}
-
-	return nil
-}
-
 // SetLocal of the connectionEvent to the related item.
 // Sets o.R.Local to related.
 // Adds o to related.R.LocalConnectionEvents.
@@ -982,7 +833,7 @@ func (o *ConnectionEvent) SetLocal(ctx context.Context, exec boil.ContextExecuto
 		strmangle.SetParamNames("\"", "\"", 1, []string{"local_id"}),
 		strmangle.WhereClause("\"", "\"", 2, connectionEventPrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.ID}
+	values := []interface{}{related.ID, o.ID, o.OpenedAt}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1029,7 +880,7 @@ func (o *ConnectionEvent) SetConnMultiAddress(ctx context.Context, exec boil.Con
 		strmangle.SetParamNames("\"", "\"", 1, []string{"conn_multi_address_id"}),
 		strmangle.WhereClause("\"", "\"", 2, connectionEventPrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.ID}
+	values := []interface{}{related.ID, o.ID, o.OpenedAt}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1076,7 +927,7 @@ func (o *ConnectionEvent) SetRemote(ctx context.Context, exec boil.ContextExecut
 		strmangle.SetParamNames("\"", "\"", 1, []string{"remote_id"}),
 		strmangle.WhereClause("\"", "\"", 2, connectionEventPrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.ID}
+	values := []interface{}{related.ID, o.ID, o.OpenedAt}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1107,151 +958,6 @@ func (o *ConnectionEvent) SetRemote(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// AddMultiAddresses adds the given related objects to the existing relationships
-// of the connection_event, optionally inserting them as new records.
-// Appends related to o.R.MultiAddresses.
-// Sets related.R.ConnectionEvents appropriately.
-func (o *ConnectionEvent) AddMultiAddresses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MultiAddress) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		}
-	}
-
-	for _, rel := range related {
-		query := "insert into \"connection_events_x_multi_addresses\" (\"connection_event_id\", \"multi_address_id\") values ($1, $2)"
-		values := []interface{}{o.ID, rel.ID}
-
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
-		}
-	}
-	if o.R == nil {
-		o.R = &connectionEventR{
-			MultiAddresses: related,
-		}
-	} else {
-		o.R.MultiAddresses = append(o.R.MultiAddresses, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &multiAddressR{
-				ConnectionEvents: ConnectionEventSlice{o},
-			}
-		} else {
-			rel.R.ConnectionEvents = append(rel.R.ConnectionEvents, o)
-		}
-	}
-	return nil
-}
-
-// SetMultiAddresses removes all previously related items of the
-// connection_event replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.ConnectionEvents's MultiAddresses accordingly.
-// Replaces o.R.MultiAddresses with related.
-// Sets related.R.ConnectionEvents's MultiAddresses accordingly.
-func (o *ConnectionEvent) SetMultiAddresses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MultiAddress) error {
-	query := "delete from \"connection_events_x_multi_addresses\" where \"connection_event_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	removeMultiAddressesFromConnectionEventsSlice(o, related)
-	if o.R != nil {
-		o.R.MultiAddresses = nil
-	}
-
-	return o.AddMultiAddresses(ctx, exec, insert, related...)
-}
-
-// RemoveMultiAddresses relationships from objects passed in.
-// Removes related items from R.MultiAddresses (uses pointer comparison, removal does not keep order)
-// Sets related.R.ConnectionEvents.
-func (o *ConnectionEvent) RemoveMultiAddresses(ctx context.Context, exec boil.ContextExecutor, related ...*MultiAddress) error {
-	if len(related) == 0 {
-		return nil
-	}
-
-	var err error
-	query := fmt.Sprintf(
-		"delete from \"connection_events_x_multi_addresses\" where \"connection_event_id\" = $1 and \"multi_address_id\" in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ID}
-	for _, rel := range related {
-		values = append(values, rel.ID)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-	removeMultiAddressesFromConnectionEventsSlice(o, related)
-	if o.R == nil {
-		return nil
-	}
-
-	for _, rel := range related {
-		for i, ri := range o.R.MultiAddresses {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.MultiAddresses)
-			if ln > 1 && i < ln-1 {
-				o.R.MultiAddresses[i] = o.R.MultiAddresses[ln-1]
-			}
-			o.R.MultiAddresses = o.R.MultiAddresses[:ln-1]
-			break
-		}
-	}
-
-	return nil
-}
-
-func removeMultiAddressesFromConnectionEventsSlice(o *ConnectionEvent, related []*MultiAddress) {
-	for _, rel := range related {
-		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.ConnectionEvents {
-			if o.ID != ri.ID {
-				continue
-			}
-
-			ln := len(rel.R.ConnectionEvents)
-			if ln > 1 && i < ln-1 {
-				rel.R.ConnectionEvents[i] = rel.R.ConnectionEvents[ln-1]
-			}
-			rel.R.ConnectionEvents = rel.R.ConnectionEvents[:ln-1]
-			break
-		}
-	}
-}
-
 // ConnectionEvents retrieves all the records using an executor.
 func ConnectionEvents(mods ...qm.QueryMod) connectionEventQuery {
 	mods = append(mods, qm.From("\"connection_events\""))
@@ -1265,7 +971,7 @@ func ConnectionEvents(mods ...qm.QueryMod) connectionEventQuery {
 
 // FindConnectionEvent retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindConnectionEvent(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ConnectionEvent, error) {
+func FindConnectionEvent(ctx context.Context, exec boil.ContextExecutor, iD int, openedAt time.Time, selectCols ...string) (*ConnectionEvent, error) {
 	connectionEventObj := &ConnectionEvent{}
 
 	sel := "*"
@@ -1273,10 +979,10 @@ func FindConnectionEvent(ctx context.Context, exec boil.ContextExecutor, iD int,
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"connection_events\" where \"id\"=$1", sel,
+		"select %s from \"connection_events\" where \"id\"=$1 AND \"opened_at\"=$2", sel,
 	)
 
-	q := queries.Raw(query, iD)
+	q := queries.Raw(query, iD, openedAt)
 
 	err := q.Bind(ctx, exec, connectionEventObj)
 	if err != nil {
@@ -1327,7 +1033,6 @@ func (o *ConnectionEvent) Insert(ctx context.Context, exec boil.ContextExecutor,
 			connectionEventColumnsWithoutDefault,
 			nzDefaults,
 		)
-		wl = strmangle.SetComplement(wl, connectionEventGeneratedColumns)
 
 		cache.valueMapping, err = queries.BindMapping(connectionEventType, connectionEventMapping, wl)
 		if err != nil {
@@ -1398,7 +1103,6 @@ func (o *ConnectionEvent) Update(ctx context.Context, exec boil.ContextExecutor,
 			connectionEventAllColumns,
 			connectionEventPrimaryKeyColumns,
 		)
-		wl = strmangle.SetComplement(wl, connectionEventGeneratedColumns)
 
 		if !columns.IsWhitelist() {
 			wl = strmangle.SetComplement(wl, []string{"created_at"})
@@ -1576,9 +1280,6 @@ func (o *ConnectionEvent) Upsert(ctx context.Context, exec boil.ContextExecutor,
 			connectionEventPrimaryKeyColumns,
 		)
 
-		insert = strmangle.SetComplement(insert, connectionEventGeneratedColumns)
-		update = strmangle.SetComplement(update, connectionEventGeneratedColumns)
-
 		if updateOnConflict && len(update) == 0 {
 			return errors.New("models: unable to upsert connection_events, could not build update column list")
 		}
@@ -1647,7 +1348,7 @@ func (o *ConnectionEvent) Delete(ctx context.Context, exec boil.ContextExecutor)
 	}
 
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), connectionEventPrimaryKeyMapping)
-	sql := "DELETE FROM \"connection_events\" WHERE \"id\"=$1"
+	sql := "DELETE FROM \"connection_events\" WHERE \"id\"=$1 AND \"opened_at\"=$2"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1744,7 +1445,7 @@ func (o ConnectionEventSlice) DeleteAll(ctx context.Context, exec boil.ContextEx
 // Reload refetches the object from the database
 // using the primary keys with an executor.
 func (o *ConnectionEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
-	ret, err := FindConnectionEvent(ctx, exec, o.ID)
+	ret, err := FindConnectionEvent(ctx, exec, o.ID, o.OpenedAt)
 	if err != nil {
 		return err
 	}
@@ -1783,16 +1484,16 @@ func (o *ConnectionEventSlice) ReloadAll(ctx context.Context, exec boil.ContextE
 }
 
 // ConnectionEventExists checks if the ConnectionEvent row exists.
-func ConnectionEventExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+func ConnectionEventExists(ctx context.Context, exec boil.ContextExecutor, iD int, openedAt time.Time) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"connection_events\" where \"id\"=$1 limit 1)"
+	sql := "select exists(select 1 from \"connection_events\" where \"id\"=$1 AND \"opened_at\"=$2 limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, iD)
+		fmt.Fprintln(writer, iD, openedAt)
 	}
-	row := exec.QueryRowContext(ctx, sql, iD)
+	row := exec.QueryRowContext(ctx, sql, iD, openedAt)
 
 	err := row.Scan(&exists)
 	if err != nil {
@@ -0,0 +1,1086 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ConnectionEventsXMultiAddress is an object representing the database table.
+type ConnectionEventsXMultiAddress struct {
+	ConnectionEventID int       `boil:"connection_event_id" json:"connection_event_id" toml:"connection_event_id" yaml:"connection_event_id"`
+	MultiAddressID    int64     `boil:"multi_address_id" json:"multi_address_id" toml:"multi_address_id" yaml:"multi_address_id"`
+	OpenedAt          time.Time `boil:"opened_at" json:"opened_at" toml:"opened_at" yaml:"opened_at"`
+
+	R *connectionEventsXMultiAddressR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L connectionEventsXMultiAddressL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ConnectionEventsXMultiAddressColumns = struct {
+	ConnectionEventID string
+	MultiAddressID    string
+	OpenedAt          string
+}{
+	ConnectionEventID: "connection_event_id",
+	MultiAddressID:    "multi_address_id",
+	OpenedAt:          "opened_at",
+}
+
+var ConnectionEventsXMultiAddressTableColumns = struct {
+	ConnectionEventID string
+	MultiAddressID    string
+	OpenedAt          string
+}{
+	ConnectionEventID: "connection_events_x_multi_addresses.connection_event_id",
+	MultiAddressID:    "connection_events_x_multi_addresses.multi_address_id",
+	OpenedAt:          "connection_events_x_multi_addresses.opened_at",
+}
+
+// Generated where
+
+var ConnectionEventsXMultiAddressWhere = struct {
+	ConnectionEventID whereHelperint
+	MultiAddressID    whereHelperint64
+	OpenedAt          whereHelpertime_Time
+}{
+	ConnectionEventID: whereHelperint{field: "\"connection_events_x_multi_addresses\".\"connection_event_id\""},
+	MultiAddressID:    whereHelperint64{field: "\"connection_events_x_multi_addresses\".\"multi_address_id\""},
+	OpenedAt:          whereHelpertime_Time{field: "\"connection_events_x_multi_addresses\".\"opened_at\""},
+}
+
+// ConnectionEventsXMultiAddressRels is where relationship names are stored.
+var ConnectionEventsXMultiAddressRels = struct {
+	MultiAddress string
+}{
+	MultiAddress: "MultiAddress",
+}
+
+// connectionEventsXMultiAddressR is where relationships are stored.
+type connectionEventsXMultiAddressR struct {
+	MultiAddress *MultiAddress `boil:"MultiAddress" json:"MultiAddress" toml:"MultiAddress" yaml:"MultiAddress"`
+}
+
+// NewStruct creates a new relationship struct
+func (*connectionEventsXMultiAddressR) NewStruct() *connectionEventsXMultiAddressR {
+	return &connectionEventsXMultiAddressR{}
+}
+
+func (r *connectionEventsXMultiAddressR) GetMultiAddress() *MultiAddress {
+	if r == nil {
+		return nil
+	}
+	return r.MultiAddress
+}
+
+// connectionEventsXMultiAddressL is where Load methods for each relationship are stored.
+type connectionEventsXMultiAddressL struct{}
+
+var (
+	connectionEventsXMultiAddressAllColumns            = []string{"connection_event_id", "multi_address_id", "opened_at"}
+	connectionEventsXMultiAddressColumnsWithoutDefault = []string{"connection_event_id", "multi_address_id", "opened_at"}
+	connectionEventsXMultiAddressColumnsWithDefault    = []string{}
+	connectionEventsXMultiAddressPrimaryKeyColumns     = []string{"multi_address_id", "connection_event_id", "opened_at"}
+	connectionEventsXMultiAddressGeneratedColumns      = []string{}
+)
+
+type (
+	// ConnectionEventsXMultiAddressSlice is an alias for a slice of pointers to ConnectionEventsXMultiAddress.
+	// This should almost always be used instead of []ConnectionEventsXMultiAddress.
+	ConnectionEventsXMultiAddressSlice []*ConnectionEventsXMultiAddress
+	// ConnectionEventsXMultiAddressHook is the signature for custom ConnectionEventsXMultiAddress hook methods
+	ConnectionEventsXMultiAddressHook func(context.Context, boil.ContextExecutor, *ConnectionEventsXMultiAddress) error
+
+	connectionEventsXMultiAddressQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	connectionEventsXMultiAddressType                 = reflect.TypeOf(&ConnectionEventsXMultiAddress{})
+	connectionEventsXMultiAddressMapping              = queries.MakeStructMapping(connectionEventsXMultiAddressType)
+	connectionEventsXMultiAddressPrimaryKeyMapping, _ = queries.BindMapping(connectionEventsXMultiAddressType, connectionEventsXMultiAddressMapping, connectionEventsXMultiAddressPrimaryKeyColumns)
+	connectionEventsXMultiAddressInsertCacheMut       sync.RWMutex
+	connectionEventsXMultiAddressInsertCache          = make(map[string]insertCache)
+	connectionEventsXMultiAddressUpdateCacheMut       sync.RWMutex
+	connectionEventsXMultiAddressUpdateCache          = make(map[string]updateCache)
+	connectionEventsXMultiAddressUpsertCacheMut       sync.RWMutex
+	connectionEventsXMultiAddressUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var connectionEventsXMultiAddressAfterSelectHooks []ConnectionEventsXMultiAddressHook
+
+var connectionEventsXMultiAddressBeforeInsertHooks []ConnectionEventsXMultiAddressHook
+var connectionEventsXMultiAddressAfterInsertHooks []ConnectionEventsXMultiAddressHook
+
+var connectionEventsXMultiAddressBeforeUpdateHooks []ConnectionEventsXMultiAddressHook
+var connectionEventsXMultiAddressAfterUpdateHooks []ConnectionEventsXMultiAddressHook
+
+var connectionEventsXMultiAddressBeforeDeleteHooks []ConnectionEventsXMultiAddressHook
+var connectionEventsXMultiAddressAfterDeleteHooks []ConnectionEventsXMultiAddressHook
+
+var connectionEventsXMultiAddressBeforeUpsertHooks []ConnectionEventsXMultiAddressHook
+var connectionEventsXMultiAddressAfterUpsertHooks []ConnectionEventsXMultiAddressHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ConnectionEventsXMultiAddress) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ConnectionEventsXMultiAddress) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ConnectionEventsXMultiAddress) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ConnectionEventsXMultiAddress) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ConnectionEventsXMultiAddress) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ConnectionEventsXMultiAddress) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ConnectionEventsXMultiAddress) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ConnectionEventsXMultiAddress) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ConnectionEventsXMultiAddress) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range connectionEventsXMultiAddressAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddConnectionEventsXMultiAddressHook registers your hook function for all future operations.
+func AddConnectionEventsXMultiAddressHook(hookPoint boil.HookPoint, connectionEventsXMultiAddressHook ConnectionEventsXMultiAddressHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		connectionEventsXMultiAddressAfterSelectHooks = append(connectionEventsXMultiAddressAfterSelectHooks, connectionEventsXMultiAddressHook)
+	case boil.BeforeInsertHook:
+		connectionEventsXMultiAddressBeforeInsertHooks = append(connectionEventsXMultiAddressBeforeInsertHooks, connectionEventsXMultiAddressHook)
+	case boil.AfterInsertHook:
+		connectionEventsXMultiAddressAfterInsertHooks = append(connectionEventsXMultiAddressAfterInsertHooks, connectionEventsXMultiAddressHook)
+	case boil.BeforeUpdateHook:
+		connectionEventsXMultiAddressBeforeUpdateHooks = append(connectionEventsXMultiAddressBeforeUpdateHooks, connectionEventsXMultiAddressHook)
+	case boil.AfterUpdateHook:
+		connectionEventsXMultiAddressAfterUpdateHooks = append(connectionEventsXMultiAddressAfterUpdateHooks, connectionEventsXMultiAddressHook)
+	case boil.BeforeDeleteHook:
+		connectionEventsXMultiAddressBeforeDeleteHooks = append(connectionEventsXMultiAddressBeforeDeleteHooks, connectionEventsXMultiAddressHook)
+	case boil.AfterDeleteHook:
+		connectionEventsXMultiAddressAfterDeleteHooks = append(connectionEventsXMultiAddressAfterDeleteHooks, connectionEventsXMultiAddressHook)
+	case boil.BeforeUpsertHook:
+		connectionEventsXMultiAddressBeforeUpsertHooks = append(connectionEventsXMultiAddressBeforeUpsertHooks, connectionEventsXMultiAddressHook)
+	case boil.AfterUpsertHook:
+		connectionEventsXMultiAddressAfterUpsertHooks = append(connectionEventsXMultiAddressAfterUpsertHooks, connectionEventsXMultiAddressHook)
+	}
+}
+
+// One returns a single connectionEventsXMultiAddress record from the query.
+func (q connectionEventsXMultiAddressQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ConnectionEventsXMultiAddress, error) {
+	o := &ConnectionEventsXMultiAddress{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for connection_events_x_multi_addresses")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ConnectionEventsXMultiAddress records from the query.
+func (q connectionEventsXMultiAddressQuery) All(ctx context.Context, exec boil.ContextExecutor) (ConnectionEventsXMultiAddressSlice, error) {
+	var o []*ConnectionEventsXMultiAddress
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ConnectionEventsXMultiAddress slice")
+	}
+
+	if len(connectionEventsXMultiAddressAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ConnectionEventsXMultiAddress records in the query.
+func (q connectionEventsXMultiAddressQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count connection_events_x_multi_addresses rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q connectionEventsXMultiAddressQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if connection_events_x_multi_addresses exists")
+	}
+
+	return count > 0, nil
+}
+
+// MultiAddress pointed to by the foreign key.
+func (o *ConnectionEventsXMultiAddress) MultiAddress(mods ...qm.QueryMod) multiAddressQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MultiAddressID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return MultiAddresses(queryMods...)
+}
+
+// LoadMultiAddress allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (connectionEventsXMultiAddressL) LoadMultiAddress(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConnectionEventsXMultiAddress interface{}, mods queries.Applicator) error {
+	var slice []*ConnectionEventsXMultiAddress
+	var object *ConnectionEventsXMultiAddress
+
+	if singular {
+		var ok bool
+		object, ok = maybeConnectionEventsXMultiAddress.(*ConnectionEventsXMultiAddress)
+		if !ok {
+			object = new(ConnectionEventsXMultiAddress)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConnectionEventsXMultiAddress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConnectionEventsXMultiAddress))
+			}
+		}
+	} else {
+		s, ok := maybeConnectionEventsXMultiAddress.(*[]*ConnectionEventsXMultiAddress)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConnectionEventsXMultiAddress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConnectionEventsXMultiAddress))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &connectionEventsXMultiAddressR{}
+		}
+		args = append(args, object.MultiAddressID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &connectionEventsXMultiAddressR{}
+			}
+
+			for _, a := range args {
+				if a == obj.MultiAddressID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.MultiAddressID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`multi_addresses`),
+		qm.WhereIn(`multi_addresses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load MultiAddress")
+	}
+
+	var resultSlice []*MultiAddress
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice MultiAddress")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for multi_addresses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for multi_addresses")
+	}
+
+	if len(connectionEventsXMultiAddressAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.MultiAddress = foreign
+		if foreign.R == nil {
+			foreign.R = &multiAddressR{}
+		}
+		foreign.R.ConnectionEventsXMultiAddresses = append(foreign.R.ConnectionEventsXMultiAddresses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MultiAddressID == foreign.ID {
+				local.R.MultiAddress = foreign
+				if foreign.R == nil {
+					foreign.R = &multiAddressR{}
+				}
+				foreign.R.ConnectionEventsXMultiAddresses = append(foreign.R.ConnectionEventsXMultiAddresses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMultiAddress of the connectionEventsXMultiAddress to the related item.
+// Sets o.R.MultiAddress to related.
+// Adds o to related.R.ConnectionEventsXMultiAddresses.
+func (o *ConnectionEventsXMultiAddress) SetMultiAddress(ctx context.Context, exec boil.ContextExecutor, insert bool, related *MultiAddress) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"connection_events_x_multi_addresses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"multi_address_id"}),
+		strmangle.WhereClause("\"", "\"", 2, connectionEventsXMultiAddressPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.MultiAddressID, o.ConnectionEventID, o.OpenedAt}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MultiAddressID = related.ID
+	if o.R == nil {
+		o.R = &connectionEventsXMultiAddressR{
+			MultiAddress: related,
+		}
+	} else {
+		o.R.MultiAddress = related
+	}
+
+	if related.R == nil {
+		related.R = &multiAddressR{
+			ConnectionEventsXMultiAddresses: ConnectionEventsXMultiAddressSlice{o},
+		}
+	} else {
+		related.R.ConnectionEventsXMultiAddresses = append(related.R.ConnectionEventsXMultiAddresses, o)
+	}
+
+	return nil
+}
+
+// ConnectionEventsXMultiAddresses retrieves all the records using an executor.
+func ConnectionEventsXMultiAddresses(mods ...qm.QueryMod) connectionEventsXMultiAddressQuery {
+	mods = append(mods, qm.From("\"connection_events_x_multi_addresses\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"connection_events_x_multi_addresses\".*"})
+	}
+
+	return connectionEventsXMultiAddressQuery{q}
+}
+
+// FindConnectionEventsXMultiAddress retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindConnectionEventsXMultiAddress(ctx context.Context, exec boil.ContextExecutor, multiAddressID int64, connectionEventID int, openedAt time.Time, selectCols ...string) (*ConnectionEventsXMultiAddress, error) {
+	connectionEventsXMultiAddressObj := &ConnectionEventsXMultiAddress{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"connection_events_x_multi_addresses\" where \"multi_address_id\"=$1 AND \"connection_event_id\"=$2 AND \"opened_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, multiAddressID, connectionEventID, openedAt)
+
+	err := q.Bind(ctx, exec, connectionEventsXMultiAddressObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from connection_events_x_multi_addresses")
+	}
+
+	if err = connectionEventsXMultiAddressObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return connectionEventsXMultiAddressObj, err
+	}
+
+	return connectionEventsXMultiAddressObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ConnectionEventsXMultiAddress) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no connection_events_x_multi_addresses provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(connectionEventsXMultiAddressColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	connectionEventsXMultiAddressInsertCacheMut.RLock()
+	cache, cached := connectionEventsXMultiAddressInsertCache[key]
+	connectionEventsXMultiAddressInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			connectionEventsXMultiAddressAllColumns,
+			connectionEventsXMultiAddressColumnsWithDefault,
+			connectionEventsXMultiAddressColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(connectionEventsXMultiAddressType, connectionEventsXMultiAddressMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(connectionEventsXMultiAddressType, connectionEventsXMultiAddressMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"connection_events_x_multi_addresses\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"connection_events_x_multi_addresses\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into connection_events_x_multi_addresses")
+	}
+
+	if !cached {
+		connectionEventsXMultiAddressInsertCacheMut.Lock()
+		connectionEventsXMultiAddressInsertCache[key] = cache
+		connectionEventsXMultiAddressInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ConnectionEventsXMultiAddress.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ConnectionEventsXMultiAddress) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	connectionEventsXMultiAddressUpdateCacheMut.RLock()
+	cache, cached := connectionEventsXMultiAddressUpdateCache[key]
+	connectionEventsXMultiAddressUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			connectionEventsXMultiAddressAllColumns,
+			connectionEventsXMultiAddressPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update connection_events_x_multi_addresses, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"connection_events_x_multi_addresses\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, connectionEventsXMultiAddressPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(connectionEventsXMultiAddressType, connectionEventsXMultiAddressMapping, append(wl, connectionEventsXMultiAddressPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update connection_events_x_multi_addresses row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for connection_events_x_multi_addresses")
+	}
+
+	if !cached {
+		connectionEventsXMultiAddressUpdateCacheMut.Lock()
+		connectionEventsXMultiAddressUpdateCache[key] = cache
+		connectionEventsXMultiAddressUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q connectionEventsXMultiAddressQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for connection_events_x_multi_addresses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for connection_events_x_multi_addresses")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ConnectionEventsXMultiAddressSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), connectionEventsXMultiAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"connection_events_x_multi_addresses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, connectionEventsXMultiAddressPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in connectionEventsXMultiAddress slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all connectionEventsXMultiAddress")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ConnectionEventsXMultiAddress) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no connection_events_x_multi_addresses provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(connectionEventsXMultiAddressColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	connectionEventsXMultiAddressUpsertCacheMut.RLock()
+	cache, cached := connectionEventsXMultiAddressUpsertCache[key]
+	connectionEventsXMultiAddressUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			connectionEventsXMultiAddressAllColumns,
+			connectionEventsXMultiAddressColumnsWithDefault,
+			connectionEventsXMultiAddressColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			connectionEventsXMultiAddressAllColumns,
+			connectionEventsXMultiAddressPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert connection_events_x_multi_addresses, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(connectionEventsXMultiAddressPrimaryKeyColumns))
+			copy(conflict, connectionEventsXMultiAddressPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"connection_events_x_multi_addresses\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(connectionEventsXMultiAddressType, connectionEventsXMultiAddressMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(connectionEventsXMultiAddressType, connectionEventsXMultiAddressMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert connection_events_x_multi_addresses")
+	}
+
+	if !cached {
+		connectionEventsXMultiAddressUpsertCacheMut.Lock()
+		connectionEventsXMultiAddressUpsertCache[key] = cache
+		connectionEventsXMultiAddressUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ConnectionEventsXMultiAddress record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ConnectionEventsXMultiAddress) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ConnectionEventsXMultiAddress provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), connectionEventsXMultiAddressPrimaryKeyMapping)
+	sql := "DELETE FROM \"connection_events_x_multi_addresses\" WHERE \"multi_address_id\"=$1 AND \"connection_event_id\"=$2 AND \"opened_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from connection_events_x_multi_addresses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for connection_events_x_multi_addresses")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q connectionEventsXMultiAddressQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no connectionEventsXMultiAddressQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from connection_events_x_multi_addresses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for connection_events_x_multi_addresses")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ConnectionEventsXMultiAddressSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(connectionEventsXMultiAddressBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), connectionEventsXMultiAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"connection_events_x_multi_addresses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, connectionEventsXMultiAddressPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from connectionEventsXMultiAddress slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for connection_events_x_multi_addresses")
+	}
+
+	if len(connectionEventsXMultiAddressAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ConnectionEventsXMultiAddress) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindConnectionEventsXMultiAddress(ctx, exec, o.MultiAddressID, o.ConnectionEventID, o.OpenedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ConnectionEventsXMultiAddressSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ConnectionEventsXMultiAddressSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), connectionEventsXMultiAddressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"connection_events_x_multi_addresses\".* FROM \"connection_events_x_multi_addresses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, connectionEventsXMultiAddressPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ConnectionEventsXMultiAddressSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ConnectionEventsXMultiAddressExists checks if the ConnectionEventsXMultiAddress row exists.
+func ConnectionEventsXMultiAddressExists(ctx context.Context, exec boil.ContextExecutor, multiAddressID int64, connectionEventID int, openedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"connection_events_x_multi_addresses\" where \"multi_address_id\"=$1 AND \"connection_event_id\"=$2 AND \"opened_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, multiAddressID, connectionEventID, openedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, multiAddressID, connectionEventID, openedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if connection_events_x_multi_addresses exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testConnectionEventsXMultiAddresses(t *testing.T) {
+	t.Parallel()
+
+	query := ConnectionEventsXMultiAddresses()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testConnectionEventsXMultiAddressesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testConnectionEventsXMultiAddressesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ConnectionEventsXMultiAddresses().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testConnectionEventsXMultiAddressesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ConnectionEventsXMultiAddressSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testConnectionEventsXMultiAddressesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ConnectionEventsXMultiAddressExists(ctx, tx, o.MultiAddressID, o.ConnectionEventID, o.OpenedAt)
+	if err != nil {
+		t.Errorf("Unable to check if ConnectionEventsXMultiAddress exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ConnectionEventsXMultiAddressExists to return true, but got false.")
+	}
+}
+
+func testConnectionEventsXMultiAddressesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	connectionEventsXMultiAddressFound, err := FindConnectionEventsXMultiAddress(ctx, tx, o.MultiAddressID, o.ConnectionEventID, o.OpenedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if connectionEventsXMultiAddressFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testConnectionEventsXMultiAddressesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ConnectionEventsXMultiAddresses().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testConnectionEventsXMultiAddressesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ConnectionEventsXMultiAddresses().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testConnectionEventsXMultiAddressesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	connectionEventsXMultiAddressOne := &ConnectionEventsXMultiAddress{}
+	connectionEventsXMultiAddressTwo := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, connectionEventsXMultiAddressOne, connectionEventsXMultiAddressDBTypes, false, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+	if err = randomize.Struct(seed, connectionEventsXMultiAddressTwo, connectionEventsXMultiAddressDBTypes, false, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = connectionEventsXMultiAddressOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = connectionEventsXMultiAddressTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ConnectionEventsXMultiAddresses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testConnectionEventsXMultiAddressesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	connectionEventsXMultiAddressOne := &ConnectionEventsXMultiAddress{}
+	connectionEventsXMultiAddressTwo := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, connectionEventsXMultiAddressOne, connectionEventsXMultiAddressDBTypes, false, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+	if err = randomize.Struct(seed, connectionEventsXMultiAddressTwo, connectionEventsXMultiAddressDBTypes, false, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = connectionEventsXMultiAddressOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = connectionEventsXMultiAddressTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func connectionEventsXMultiAddressBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func connectionEventsXMultiAddressAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func connectionEventsXMultiAddressAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func connectionEventsXMultiAddressBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func connectionEventsXMultiAddressAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func connectionEventsXMultiAddressBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func connectionEventsXMultiAddressAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func connectionEventsXMultiAddressBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func connectionEventsXMultiAddressAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ConnectionEventsXMultiAddress) error {
+	*o = ConnectionEventsXMultiAddress{}
+	return nil
+}
+
+func testConnectionEventsXMultiAddressesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ConnectionEventsXMultiAddress{}
+	o := &ConnectionEventsXMultiAddress{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress object: %s", err)
+	}
+
+	AddConnectionEventsXMultiAddressHook(boil.BeforeInsertHook, connectionEventsXMultiAddressBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressBeforeInsertHooks = []ConnectionEventsXMultiAddressHook{}
+
+	AddConnectionEventsXMultiAddressHook(boil.AfterInsertHook, connectionEventsXMultiAddressAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressAfterInsertHooks = []ConnectionEventsXMultiAddressHook{}
+
+	AddConnectionEventsXMultiAddressHook(boil.AfterSelectHook, connectionEventsXMultiAddressAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressAfterSelectHooks = []ConnectionEventsXMultiAddressHook{}
+
+	AddConnectionEventsXMultiAddressHook(boil.BeforeUpdateHook, connectionEventsXMultiAddressBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressBeforeUpdateHooks = []ConnectionEventsXMultiAddressHook{}
+
+	AddConnectionEventsXMultiAddressHook(boil.AfterUpdateHook, connectionEventsXMultiAddressAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressAfterUpdateHooks = []ConnectionEventsXMultiAddressHook{}
+
+	AddConnectionEventsXMultiAddressHook(boil.BeforeDeleteHook, connectionEventsXMultiAddressBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressBeforeDeleteHooks = []ConnectionEventsXMultiAddressHook{}
+
+	AddConnectionEventsXMultiAddressHook(boil.AfterDeleteHook, connectionEventsXMultiAddressAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressAfterDeleteHooks = []ConnectionEventsXMultiAddressHook{}
+
+	AddConnectionEventsXMultiAddressHook(boil.BeforeUpsertHook, connectionEventsXMultiAddressBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressBeforeUpsertHooks = []ConnectionEventsXMultiAddressHook{}
+
+	AddConnectionEventsXMultiAddressHook(boil.AfterUpsertHook, connectionEventsXMultiAddressAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	connectionEventsXMultiAddressAfterUpsertHooks = []ConnectionEventsXMultiAddressHook{}
+}
+
+func testConnectionEventsXMultiAddressesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testConnectionEventsXMultiAddressesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(connectionEventsXMultiAddressColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testConnectionEventsXMultiAddressToOneMultiAddressUsingMultiAddress(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ConnectionEventsXMultiAddress
+	var foreign MultiAddress
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, connectionEventsXMultiAddressDBTypes, false, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, multiAddressDBTypes, false, multiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MultiAddress struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.MultiAddressID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.MultiAddress().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ConnectionEventsXMultiAddressSlice{&local}
+	if err = local.L.LoadMultiAddress(ctx, tx, false, (*[]*ConnectionEventsXMultiAddress)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MultiAddress == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.MultiAddress = nil
+	if err = local.L.LoadMultiAddress(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MultiAddress == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testConnectionEventsXMultiAddressToOneSetOpMultiAddressUsingMultiAddress(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ConnectionEventsXMultiAddress
+	var b, c MultiAddress
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, connectionEventsXMultiAddressDBTypes, false, strmangle.SetComplement(connectionEventsXMultiAddressPrimaryKeyColumns, connectionEventsXMultiAddressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*MultiAddress{&b, &c} {
+		err = a.SetMultiAddress(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.MultiAddress != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ConnectionEventsXMultiAddresses[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.MultiAddressID != x.ID {
+			t.Error("foreign key was wrong value", a.MultiAddressID)
+		}
+
+		if exists, err := ConnectionEventsXMultiAddressExists(ctx, tx, a.MultiAddressID, a.ConnectionEventID, a.OpenedAt); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testConnectionEventsXMultiAddressesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testConnectionEventsXMultiAddressesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ConnectionEventsXMultiAddressSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testConnectionEventsXMultiAddressesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ConnectionEventsXMultiAddresses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	connectionEventsXMultiAddressDBTypes = map[string]string{`ConnectionEventID`: `integer`, `MultiAddressID`: `bigint`, `OpenedAt`: `timestamp with time zone`}
+	_                                    = bytes.MinRead
+)
+
+func testConnectionEventsXMultiAddressesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(connectionEventsXMultiAddressPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(connectionEventsXMultiAddressAllColumns) == len(connectionEventsXMultiAddressPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testConnectionEventsXMultiAddressesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(connectionEventsXMultiAddressAllColumns) == len(connectionEventsXMultiAddressPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, connectionEventsXMultiAddressDBTypes, true, connectionEventsXMultiAddressPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(connectionEventsXMultiAddressAllColumns, connectionEventsXMultiAddressPrimaryKeyColumns) {
+		fields = connectionEventsXMultiAddressAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			connectionEventsXMultiAddressAllColumns,
+			connectionEventsXMultiAddressPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ConnectionEventsXMultiAddressSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testConnectionEventsXMultiAddressesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(connectionEventsXMultiAddressAllColumns) == len(connectionEventsXMultiAddressPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ConnectionEventsXMultiAddress{}
+	if err = randomize.Struct(seed, &o, connectionEventsXMultiAddressDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ConnectionEventsXMultiAddress: %s", err)
+	}
+
+	count, err := ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, connectionEventsXMultiAddressDBTypes, false, connectionEventsXMultiAddressPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ConnectionEventsXMultiAddress struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ConnectionEventsXMultiAddress: %s", err)
+	}
+
+	count, err = ConnectionEventsXMultiAddresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
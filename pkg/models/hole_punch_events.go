@@ -0,0 +1,1194 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// HolePunchEvent is an object representing the database table.
+type HolePunchEvent struct {
+	ID                int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	HolePunchResultID int               `boil:"hole_punch_result_id" json:"hole_punch_result_id" toml:"hole_punch_result_id" yaml:"hole_punch_result_id"`
+	Type              string            `boil:"type" json:"type" toml:"type" yaml:"type"`
+	OccurredAt        time.Time         `boil:"occurred_at" json:"occurred_at" toml:"occurred_at" yaml:"occurred_at"`
+	MultiAddresses    types.StringArray `boil:"multi_addresses" json:"multi_addresses" toml:"multi_addresses" yaml:"multi_addresses"`
+	Attempt           null.Int          `boil:"attempt" json:"attempt,omitempty" toml:"attempt" yaml:"attempt,omitempty"`
+	Success           null.Bool         `boil:"success" json:"success,omitempty" toml:"success" yaml:"success,omitempty"`
+	ElapsedTime       null.String       `boil:"elapsed_time" json:"elapsed_time,omitempty" toml:"elapsed_time" yaml:"elapsed_time,omitempty"`
+	RTT               null.String       `boil:"rtt" json:"rtt,omitempty" toml:"rtt" yaml:"rtt,omitempty"`
+	Error             null.String       `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	Direction         null.String       `boil:"direction" json:"direction,omitempty" toml:"direction" yaml:"direction,omitempty"`
+
+	R *holePunchEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L holePunchEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var HolePunchEventColumns = struct {
+	ID                string
+	HolePunchResultID string
+	Type              string
+	OccurredAt        string
+	MultiAddresses    string
+	Attempt           string
+	Success           string
+	ElapsedTime       string
+	RTT               string
+	Error             string
+	Direction         string
+}{
+	ID:                "id",
+	HolePunchResultID: "hole_punch_result_id",
+	Type:              "type",
+	OccurredAt:        "occurred_at",
+	MultiAddresses:    "multi_addresses",
+	Attempt:           "attempt",
+	Success:           "success",
+	ElapsedTime:       "elapsed_time",
+	RTT:               "rtt",
+	Error:             "error",
+	Direction:         "direction",
+}
+
+var HolePunchEventTableColumns = struct {
+	ID                string
+	HolePunchResultID string
+	Type              string
+	OccurredAt        string
+	MultiAddresses    string
+	Attempt           string
+	Success           string
+	ElapsedTime       string
+	RTT               string
+	Error             string
+	Direction         string
+}{
+	ID:                "hole_punch_events.id",
+	HolePunchResultID: "hole_punch_events.hole_punch_result_id",
+	Type:              "hole_punch_events.type",
+	OccurredAt:        "hole_punch_events.occurred_at",
+	MultiAddresses:    "hole_punch_events.multi_addresses",
+	Attempt:           "hole_punch_events.attempt",
+	Success:           "hole_punch_events.success",
+	ElapsedTime:       "hole_punch_events.elapsed_time",
+	RTT:               "hole_punch_events.rtt",
+	Error:             "hole_punch_events.error",
+	Direction:         "hole_punch_events.direction",
+}
+
+// Generated where
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Bool struct{ field string }
+
+func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var HolePunchEventWhere = struct {
+	ID                whereHelperint
+	HolePunchResultID whereHelperint
+	Type              whereHelperstring
+	OccurredAt        whereHelpertime_Time
+	MultiAddresses    whereHelpertypes_StringArray
+	Attempt           whereHelpernull_Int
+	Success           whereHelpernull_Bool
+	ElapsedTime       whereHelpernull_String
+	RTT               whereHelpernull_String
+	Error             whereHelpernull_String
+	Direction         whereHelpernull_String
+}{
+	ID:                whereHelperint{field: "\"hole_punch_events\".\"id\""},
+	HolePunchResultID: whereHelperint{field: "\"hole_punch_events\".\"hole_punch_result_id\""},
+	Type:              whereHelperstring{field: "\"hole_punch_events\".\"type\""},
+	OccurredAt:        whereHelpertime_Time{field: "\"hole_punch_events\".\"occurred_at\""},
+	MultiAddresses:    whereHelpertypes_StringArray{field: "\"hole_punch_events\".\"multi_addresses\""},
+	Attempt:           whereHelpernull_Int{field: "\"hole_punch_events\".\"attempt\""},
+	Success:           whereHelpernull_Bool{field: "\"hole_punch_events\".\"success\""},
+	ElapsedTime:       whereHelpernull_String{field: "\"hole_punch_events\".\"elapsed_time\""},
+	RTT:               whereHelpernull_String{field: "\"hole_punch_events\".\"rtt\""},
+	Error:             whereHelpernull_String{field: "\"hole_punch_events\".\"error\""},
+	Direction:         whereHelpernull_String{field: "\"hole_punch_events\".\"direction\""},
+}
+
+// HolePunchEventRels is where relationship names are stored.
+var HolePunchEventRels = struct {
+	HolePunchResult string
+}{
+	HolePunchResult: "HolePunchResult",
+}
+
+// holePunchEventR is where relationships are stored.
+type holePunchEventR struct {
+	HolePunchResult *HolePunchResult `boil:"HolePunchResult" json:"HolePunchResult" toml:"HolePunchResult" yaml:"HolePunchResult"`
+}
+
+// NewStruct creates a new relationship struct
+func (*holePunchEventR) NewStruct() *holePunchEventR {
+	return &holePunchEventR{}
+}
+
+func (r *holePunchEventR) GetHolePunchResult() *HolePunchResult {
+	if r == nil {
+		return nil
+	}
+	return r.HolePunchResult
+}
+
+// holePunchEventL is where Load methods for each relationship are stored.
+type holePunchEventL struct{}
+
+var (
+	holePunchEventAllColumns            = []string{"id", "hole_punch_result_id", "type", "occurred_at", "multi_addresses", "attempt", "success", "elapsed_time", "rtt", "error", "direction"}
+	holePunchEventColumnsWithoutDefault = []string{"hole_punch_result_id", "type", "occurred_at", "multi_addresses"}
+	holePunchEventColumnsWithDefault    = []string{"id", "attempt", "success", "elapsed_time", "rtt", "error", "direction"}
+	holePunchEventPrimaryKeyColumns     = []string{"id"}
+	holePunchEventGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// HolePunchEventSlice is an alias for a slice of pointers to HolePunchEvent.
+	// This should almost always be used instead of []HolePunchEvent.
+	HolePunchEventSlice []*HolePunchEvent
+	// HolePunchEventHook is the signature for custom HolePunchEvent hook methods
+	HolePunchEventHook func(context.Context, boil.ContextExecutor, *HolePunchEvent) error
+
+	holePunchEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	holePunchEventType                 = reflect.TypeOf(&HolePunchEvent{})
+	holePunchEventMapping              = queries.MakeStructMapping(holePunchEventType)
+	holePunchEventPrimaryKeyMapping, _ = queries.BindMapping(holePunchEventType, holePunchEventMapping, holePunchEventPrimaryKeyColumns)
+	holePunchEventInsertCacheMut       sync.RWMutex
+	holePunchEventInsertCache          = make(map[string]insertCache)
+	holePunchEventUpdateCacheMut       sync.RWMutex
+	holePunchEventUpdateCache          = make(map[string]updateCache)
+	holePunchEventUpsertCacheMut       sync.RWMutex
+	holePunchEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var holePunchEventAfterSelectHooks []HolePunchEventHook
+
+var holePunchEventBeforeInsertHooks []HolePunchEventHook
+var holePunchEventAfterInsertHooks []HolePunchEventHook
+
+var holePunchEventBeforeUpdateHooks []HolePunchEventHook
+var holePunchEventAfterUpdateHooks []HolePunchEventHook
+
+var holePunchEventBeforeDeleteHooks []HolePunchEventHook
+var holePunchEventAfterDeleteHooks []HolePunchEventHook
+
+var holePunchEventBeforeUpsertHooks []HolePunchEventHook
+var holePunchEventAfterUpsertHooks []HolePunchEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *HolePunchEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *HolePunchEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *HolePunchEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *HolePunchEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *HolePunchEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *HolePunchEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *HolePunchEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *HolePunchEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *HolePunchEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddHolePunchEventHook registers your hook function for all future operations.
+func AddHolePunchEventHook(hookPoint boil.HookPoint, holePunchEventHook HolePunchEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		holePunchEventAfterSelectHooks = append(holePunchEventAfterSelectHooks, holePunchEventHook)
+	case boil.BeforeInsertHook:
+		holePunchEventBeforeInsertHooks = append(holePunchEventBeforeInsertHooks, holePunchEventHook)
+	case boil.AfterInsertHook:
+		holePunchEventAfterInsertHooks = append(holePunchEventAfterInsertHooks, holePunchEventHook)
+	case boil.BeforeUpdateHook:
+		holePunchEventBeforeUpdateHooks = append(holePunchEventBeforeUpdateHooks, holePunchEventHook)
+	case boil.AfterUpdateHook:
+		holePunchEventAfterUpdateHooks = append(holePunchEventAfterUpdateHooks, holePunchEventHook)
+	case boil.BeforeDeleteHook:
+		holePunchEventBeforeDeleteHooks = append(holePunchEventBeforeDeleteHooks, holePunchEventHook)
+	case boil.AfterDeleteHook:
+		holePunchEventAfterDeleteHooks = append(holePunchEventAfterDeleteHooks, holePunchEventHook)
+	case boil.BeforeUpsertHook:
+		holePunchEventBeforeUpsertHooks = append(holePunchEventBeforeUpsertHooks, holePunchEventHook)
+	case boil.AfterUpsertHook:
+		holePunchEventAfterUpsertHooks = append(holePunchEventAfterUpsertHooks, holePunchEventHook)
+	}
+}
+
+// One returns a single holePunchEvent record from the query.
+func (q holePunchEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*HolePunchEvent, error) {
+	o := &HolePunchEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for hole_punch_events")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all HolePunchEvent records from the query.
+func (q holePunchEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (HolePunchEventSlice, error) {
+	var o []*HolePunchEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to HolePunchEvent slice")
+	}
+
+	if len(holePunchEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all HolePunchEvent records in the query.
+func (q holePunchEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count hole_punch_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q holePunchEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if hole_punch_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// HolePunchResult pointed to by the foreign key.
+func (o *HolePunchEvent) HolePunchResult(mods ...qm.QueryMod) holePunchResultQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.HolePunchResultID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return HolePunchResults(queryMods...)
+}
+
+// LoadHolePunchResult allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holePunchEventL) LoadHolePunchResult(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchEvent interface{}, mods queries.Applicator) error {
+	var slice []*HolePunchEvent
+	var object *HolePunchEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeHolePunchEvent.(*HolePunchEvent)
+		if !ok {
+			object = new(HolePunchEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeHolePunchEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeHolePunchEvent))
+			}
+		}
+	} else {
+		s, ok := maybeHolePunchEvent.(*[]*HolePunchEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeHolePunchEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeHolePunchEvent))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holePunchEventR{}
+		}
+		args = append(args, object.HolePunchResultID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holePunchEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.HolePunchResultID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.HolePunchResultID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_results`),
+		qm.WhereIn(`hole_punch_results.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load HolePunchResult")
+	}
+
+	var resultSlice []*HolePunchResult
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice HolePunchResult")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for hole_punch_results")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
+	}
+
+	if len(holePunchEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.HolePunchResult = foreign
+		if foreign.R == nil {
+			foreign.R = &holePunchResultR{}
+		}
+		foreign.R.HolePunchEvents = append(foreign.R.HolePunchEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.HolePunchResultID == foreign.ID {
+				local.R.HolePunchResult = foreign
+				if foreign.R == nil {
+					foreign.R = &holePunchResultR{}
+				}
+				foreign.R.HolePunchEvents = append(foreign.R.HolePunchEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetHolePunchResult of the holePunchEvent to the related item.
+// Sets o.R.HolePunchResult to related.
+// Adds o to related.R.HolePunchEvents.
+func (o *HolePunchEvent) SetHolePunchResult(ctx context.Context, exec boil.ContextExecutor, insert bool, related *HolePunchResult) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"hole_punch_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"hole_punch_result_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holePunchEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.HolePunchResultID = related.ID
+	if o.R == nil {
+		o.R = &holePunchEventR{
+			HolePunchResult: related,
+		}
+	} else {
+		o.R.HolePunchResult = related
+	}
+
+	if related.R == nil {
+		related.R = &holePunchResultR{
+			HolePunchEvents: HolePunchEventSlice{o},
+		}
+	} else {
+		related.R.HolePunchEvents = append(related.R.HolePunchEvents, o)
+	}
+
+	return nil
+}
+
+// HolePunchEvents retrieves all the records using an executor.
+func HolePunchEvents(mods ...qm.QueryMod) holePunchEventQuery {
+	mods = append(mods, qm.From("\"hole_punch_events\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"hole_punch_events\".*"})
+	}
+
+	return holePunchEventQuery{q}
+}
+
+// FindHolePunchEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindHolePunchEvent(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*HolePunchEvent, error) {
+	holePunchEventObj := &HolePunchEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"hole_punch_events\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, holePunchEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from hole_punch_events")
+	}
+
+	if err = holePunchEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return holePunchEventObj, err
+	}
+
+	return holePunchEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *HolePunchEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no hole_punch_events provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(holePunchEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	holePunchEventInsertCacheMut.RLock()
+	cache, cached := holePunchEventInsertCache[key]
+	holePunchEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			holePunchEventAllColumns,
+			holePunchEventColumnsWithDefault,
+			holePunchEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, holePunchEventGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(holePunchEventType, holePunchEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(holePunchEventType, holePunchEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"hole_punch_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"hole_punch_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into hole_punch_events")
+	}
+
+	if !cached {
+		holePunchEventInsertCacheMut.Lock()
+		holePunchEventInsertCache[key] = cache
+		holePunchEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the HolePunchEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *HolePunchEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	holePunchEventUpdateCacheMut.RLock()
+	cache, cached := holePunchEventUpdateCache[key]
+	holePunchEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			holePunchEventAllColumns,
+			holePunchEventPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, holePunchEventGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update hole_punch_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"hole_punch_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, holePunchEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(holePunchEventType, holePunchEventMapping, append(wl, holePunchEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update hole_punch_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for hole_punch_events")
+	}
+
+	if !cached {
+		holePunchEventUpdateCacheMut.Lock()
+		holePunchEventUpdateCache[key] = cache
+		holePunchEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q holePunchEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for hole_punch_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for hole_punch_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o HolePunchEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holePunchEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"hole_punch_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, holePunchEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in holePunchEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all holePunchEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *HolePunchEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no hole_punch_events provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(holePunchEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	holePunchEventUpsertCacheMut.RLock()
+	cache, cached := holePunchEventUpsertCache[key]
+	holePunchEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			holePunchEventAllColumns,
+			holePunchEventColumnsWithDefault,
+			holePunchEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			holePunchEventAllColumns,
+			holePunchEventPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, holePunchEventGeneratedColumns)
+		update = strmangle.SetComplement(update, holePunchEventGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert hole_punch_events, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(holePunchEventPrimaryKeyColumns))
+			copy(conflict, holePunchEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"hole_punch_events\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(holePunchEventType, holePunchEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(holePunchEventType, holePunchEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert hole_punch_events")
+	}
+
+	if !cached {
+		holePunchEventUpsertCacheMut.Lock()
+		holePunchEventUpsertCache[key] = cache
+		holePunchEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single HolePunchEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *HolePunchEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no HolePunchEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), holePunchEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"hole_punch_events\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from hole_punch_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for hole_punch_events")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q holePunchEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no holePunchEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from hole_punch_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for hole_punch_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o HolePunchEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(holePunchEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holePunchEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"hole_punch_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, holePunchEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from holePunchEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for hole_punch_events")
+	}
+
+	if len(holePunchEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *HolePunchEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindHolePunchEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *HolePunchEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := HolePunchEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holePunchEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"hole_punch_events\".* FROM \"hole_punch_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, holePunchEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in HolePunchEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// HolePunchEventExists checks if the HolePunchEvent row exists.
+func HolePunchEventExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"hole_punch_events\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if hole_punch_events exists")
+	}
+
+	return exists, nil
+}
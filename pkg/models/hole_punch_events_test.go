@@ -0,0 +1,842 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testHolePunchEvents(t *testing.T) {
+	t.Parallel()
+
+	query := HolePunchEvents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testHolePunchEventsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testHolePunchEventsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := HolePunchEvents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testHolePunchEventsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := HolePunchEventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testHolePunchEventsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := HolePunchEventExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if HolePunchEvent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected HolePunchEventExists to return true, but got false.")
+	}
+}
+
+func testHolePunchEventsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	holePunchEventFound, err := FindHolePunchEvent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if holePunchEventFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testHolePunchEventsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = HolePunchEvents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testHolePunchEventsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := HolePunchEvents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testHolePunchEventsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	holePunchEventOne := &HolePunchEvent{}
+	holePunchEventTwo := &HolePunchEvent{}
+	if err = randomize.Struct(seed, holePunchEventOne, holePunchEventDBTypes, false, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, holePunchEventTwo, holePunchEventDBTypes, false, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = holePunchEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = holePunchEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := HolePunchEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testHolePunchEventsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	holePunchEventOne := &HolePunchEvent{}
+	holePunchEventTwo := &HolePunchEvent{}
+	if err = randomize.Struct(seed, holePunchEventOne, holePunchEventDBTypes, false, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, holePunchEventTwo, holePunchEventDBTypes, false, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = holePunchEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = holePunchEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func holePunchEventBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func holePunchEventAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func holePunchEventAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func holePunchEventBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func holePunchEventAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func holePunchEventBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func holePunchEventAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func holePunchEventBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func holePunchEventAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchEvent) error {
+	*o = HolePunchEvent{}
+	return nil
+}
+
+func testHolePunchEventsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &HolePunchEvent{}
+	o := &HolePunchEvent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent object: %s", err)
+	}
+
+	AddHolePunchEventHook(boil.BeforeInsertHook, holePunchEventBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventBeforeInsertHooks = []HolePunchEventHook{}
+
+	AddHolePunchEventHook(boil.AfterInsertHook, holePunchEventAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventAfterInsertHooks = []HolePunchEventHook{}
+
+	AddHolePunchEventHook(boil.AfterSelectHook, holePunchEventAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventAfterSelectHooks = []HolePunchEventHook{}
+
+	AddHolePunchEventHook(boil.BeforeUpdateHook, holePunchEventBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventBeforeUpdateHooks = []HolePunchEventHook{}
+
+	AddHolePunchEventHook(boil.AfterUpdateHook, holePunchEventAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventAfterUpdateHooks = []HolePunchEventHook{}
+
+	AddHolePunchEventHook(boil.BeforeDeleteHook, holePunchEventBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventBeforeDeleteHooks = []HolePunchEventHook{}
+
+	AddHolePunchEventHook(boil.AfterDeleteHook, holePunchEventAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventAfterDeleteHooks = []HolePunchEventHook{}
+
+	AddHolePunchEventHook(boil.BeforeUpsertHook, holePunchEventBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventBeforeUpsertHooks = []HolePunchEventHook{}
+
+	AddHolePunchEventHook(boil.AfterUpsertHook, holePunchEventAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	holePunchEventAfterUpsertHooks = []HolePunchEventHook{}
+}
+
+func testHolePunchEventsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testHolePunchEventsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(holePunchEventColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testHolePunchEventToOneHolePunchResultUsingHolePunchResult(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local HolePunchEvent
+	var foreign HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, holePunchEventDBTypes, false, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.HolePunchResultID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.HolePunchResult().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := HolePunchEventSlice{&local}
+	if err = local.L.LoadHolePunchResult(ctx, tx, false, (*[]*HolePunchEvent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.HolePunchResult == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.HolePunchResult = nil
+	if err = local.L.LoadHolePunchResult(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.HolePunchResult == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testHolePunchEventToOneSetOpHolePunchResultUsingHolePunchResult(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchEvent
+	var b, c HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchEventDBTypes, false, strmangle.SetComplement(holePunchEventPrimaryKeyColumns, holePunchEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*HolePunchResult{&b, &c} {
+		err = a.SetHolePunchResult(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.HolePunchResult != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.HolePunchEvents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.HolePunchResultID != x.ID {
+			t.Error("foreign key was wrong value", a.HolePunchResultID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.HolePunchResultID))
+		reflect.Indirect(reflect.ValueOf(&a.HolePunchResultID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.HolePunchResultID != x.ID {
+			t.Error("foreign key was wrong value", a.HolePunchResultID, x.ID)
+		}
+	}
+}
+
+func testHolePunchEventsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testHolePunchEventsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := HolePunchEventSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testHolePunchEventsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := HolePunchEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	holePunchEventDBTypes = map[string]string{`ID`: `integer`, `HolePunchResultID`: `integer`, `Type`: `enum.hole_punch_event_type('UNKNOWN','DIRECT_DIAL','PROTOCOL_ERROR','START_HOLE_PUNCH','END_HOLE_PUNCH','HOLE_PUNCH_ATTEMPT','CONNECTION_OPENED','CONNECTION_CLOSED')`, `OccurredAt`: `timestamp with time zone`, `MultiAddresses`: `ARRAYtext`, `Attempt`: `integer`, `Success`: `boolean`, `ElapsedTime`: `interval`, `RTT`: `interval`, `Error`: `text`, `Direction`: `text`}
+	_                     = bytes.MinRead
+)
+
+func testHolePunchEventsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(holePunchEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(holePunchEventAllColumns) == len(holePunchEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testHolePunchEventsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(holePunchEventAllColumns) == len(holePunchEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchEvent{}
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, holePunchEventDBTypes, true, holePunchEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(holePunchEventAllColumns, holePunchEventPrimaryKeyColumns) {
+		fields = holePunchEventAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			holePunchEventAllColumns,
+			holePunchEventPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, holePunchEventGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := HolePunchEventSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testHolePunchEventsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(holePunchEventAllColumns) == len(holePunchEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := HolePunchEvent{}
+	if err = randomize.Struct(seed, &o, holePunchEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert HolePunchEvent: %s", err)
+	}
+
+	count, err := HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, holePunchEventDBTypes, false, holePunchEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize HolePunchEvent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert HolePunchEvent: %s", err)
+	}
+
+	count, err = HolePunchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
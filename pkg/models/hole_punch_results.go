@@ -25,19 +25,30 @@ import (
 
 // HolePunchResult is an object representing the database table.
 type HolePunchResult struct {
-	ID                        int              `boil:"id" json:"id" toml:"id" yaml:"id"`
-	LocalID                   int64            `boil:"local_id" json:"local_id" toml:"local_id" yaml:"local_id"`
-	RemoteID                  int64            `boil:"remote_id" json:"remote_id" toml:"remote_id" yaml:"remote_id"`
-	ConnectStartedAt          time.Time        `boil:"connect_started_at" json:"connect_started_at" toml:"connect_started_at" yaml:"connect_started_at"`
-	ConnectEndedAt            time.Time        `boil:"connect_ended_at" json:"connect_ended_at" toml:"connect_ended_at" yaml:"connect_ended_at"`
-	HasDirectConns            bool             `boil:"has_direct_conns" json:"has_direct_conns" toml:"has_direct_conns" yaml:"has_direct_conns"`
-	Error                     null.String      `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
-	Outcome                   string           `boil:"outcome" json:"outcome" toml:"outcome" yaml:"outcome"`
-	EndedAt                   time.Time        `boil:"ended_at" json:"ended_at" toml:"ended_at" yaml:"ended_at"`
-	ProtocolFilters           types.Int64Array `boil:"protocol_filters" json:"protocol_filters" toml:"protocol_filters" yaml:"protocol_filters"`
-	UpdatedAt                 time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	CreatedAt                 time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	ListenMultiAddressesSetID int              `boil:"listen_multi_addresses_set_id" json:"listen_multi_addresses_set_id" toml:"listen_multi_addresses_set_id" yaml:"listen_multi_addresses_set_id"`
+	ID                        int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	LocalID                   int64             `boil:"local_id" json:"local_id" toml:"local_id" yaml:"local_id"`
+	RemoteID                  int64             `boil:"remote_id" json:"remote_id" toml:"remote_id" yaml:"remote_id"`
+	ConnectStartedAt          time.Time         `boil:"connect_started_at" json:"connect_started_at" toml:"connect_started_at" yaml:"connect_started_at"`
+	ConnectEndedAt            time.Time         `boil:"connect_ended_at" json:"connect_ended_at" toml:"connect_ended_at" yaml:"connect_ended_at"`
+	HasDirectConns            bool              `boil:"has_direct_conns" json:"has_direct_conns" toml:"has_direct_conns" yaml:"has_direct_conns"`
+	Error                     null.String       `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	Outcome                   string            `boil:"outcome" json:"outcome" toml:"outcome" yaml:"outcome"`
+	EndedAt                   time.Time         `boil:"ended_at" json:"ended_at" toml:"ended_at" yaml:"ended_at"`
+	ProtocolFilters           types.Int64Array  `boil:"protocol_filters" json:"protocol_filters" toml:"protocol_filters" yaml:"protocol_filters"`
+	UpdatedAt                 time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt                 time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ListenMultiAddressesSetID int               `boil:"listen_multi_addresses_set_id" json:"listen_multi_addresses_set_id" toml:"listen_multi_addresses_set_id" yaml:"listen_multi_addresses_set_id"`
+	AuthorizationID           null.Int          `boil:"authorization_id" json:"authorization_id,omitempty" toml:"authorization_id" yaml:"authorization_id,omitempty"`
+	ValidationStatus          string            `boil:"validation_status" json:"validation_status" toml:"validation_status" yaml:"validation_status"`
+	ValidationReasons         types.StringArray `boil:"validation_reasons" json:"validation_reasons" toml:"validation_reasons" yaml:"validation_reasons"`
+	AllocationID              null.Int64        `boil:"allocation_id" json:"allocation_id,omitempty" toml:"allocation_id" yaml:"allocation_id,omitempty"`
+	RelayID                   null.Int64        `boil:"relay_id" json:"relay_id,omitempty" toml:"relay_id" yaml:"relay_id,omitempty"`
+	RelayStrategy             null.String       `boil:"relay_strategy" json:"relay_strategy,omitempty" toml:"relay_strategy" yaml:"relay_strategy,omitempty"`
+	RelayMultiAddressID       null.Int64        `boil:"relay_multi_address_id" json:"relay_multi_address_id,omitempty" toml:"relay_multi_address_id" yaml:"relay_multi_address_id,omitempty"`
+	CommunicationTimeout      null.String       `boil:"communication_timeout" json:"communication_timeout,omitempty" toml:"communication_timeout" yaml:"communication_timeout,omitempty"`
+	RetryCount                null.Int          `boil:"retry_count" json:"retry_count,omitempty" toml:"retry_count" yaml:"retry_count,omitempty"`
+	PingDuration              null.String       `boil:"ping_duration" json:"ping_duration,omitempty" toml:"ping_duration" yaml:"ping_duration,omitempty"`
+	MaxPingCount              null.Int          `boil:"max_ping_count" json:"max_ping_count,omitempty" toml:"max_ping_count" yaml:"max_ping_count,omitempty"`
 
 	R *holePunchResultR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L holePunchResultL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -57,6 +68,17 @@ var HolePunchResultColumns = struct {
 	UpdatedAt                 string
 	CreatedAt                 string
 	ListenMultiAddressesSetID string
+	AuthorizationID           string
+	ValidationStatus          string
+	ValidationReasons         string
+	AllocationID              string
+	RelayID                   string
+	RelayStrategy             string
+	RelayMultiAddressID       string
+	CommunicationTimeout      string
+	RetryCount                string
+	PingDuration              string
+	MaxPingCount              string
 }{
 	ID:                        "id",
 	LocalID:                   "local_id",
@@ -71,6 +93,17 @@ var HolePunchResultColumns = struct {
 	UpdatedAt:                 "updated_at",
 	CreatedAt:                 "created_at",
 	ListenMultiAddressesSetID: "listen_multi_addresses_set_id",
+	AuthorizationID:           "authorization_id",
+	ValidationStatus:          "validation_status",
+	ValidationReasons:         "validation_reasons",
+	AllocationID:              "allocation_id",
+	RelayID:                   "relay_id",
+	RelayStrategy:             "relay_strategy",
+	RelayMultiAddressID:       "relay_multi_address_id",
+	CommunicationTimeout:      "communication_timeout",
+	RetryCount:                "retry_count",
+	PingDuration:              "ping_duration",
+	MaxPingCount:              "max_ping_count",
 }
 
 var HolePunchResultTableColumns = struct {
@@ -87,6 +120,17 @@ var HolePunchResultTableColumns = struct {
 	UpdatedAt                 string
 	CreatedAt                 string
 	ListenMultiAddressesSetID string
+	AuthorizationID           string
+	ValidationStatus          string
+	ValidationReasons         string
+	AllocationID              string
+	RelayID                   string
+	RelayStrategy             string
+	RelayMultiAddressID       string
+	CommunicationTimeout      string
+	RetryCount                string
+	PingDuration              string
+	MaxPingCount              string
 }{
 	ID:                        "hole_punch_results.id",
 	LocalID:                   "hole_punch_results.local_id",
@@ -101,6 +145,17 @@ var HolePunchResultTableColumns = struct {
 	UpdatedAt:                 "hole_punch_results.updated_at",
 	CreatedAt:                 "hole_punch_results.created_at",
 	ListenMultiAddressesSetID: "hole_punch_results.listen_multi_addresses_set_id",
+	AuthorizationID:           "hole_punch_results.authorization_id",
+	ValidationStatus:          "hole_punch_results.validation_status",
+	ValidationReasons:         "hole_punch_results.validation_reasons",
+	AllocationID:              "hole_punch_results.allocation_id",
+	RelayID:                   "hole_punch_results.relay_id",
+	RelayStrategy:             "hole_punch_results.relay_strategy",
+	RelayMultiAddressID:       "hole_punch_results.relay_multi_address_id",
+	CommunicationTimeout:      "hole_punch_results.communication_timeout",
+	RetryCount:                "hole_punch_results.retry_count",
+	PingDuration:              "hole_punch_results.ping_duration",
+	MaxPingCount:              "hole_punch_results.max_ping_count",
 }
 
 // Generated where
@@ -114,26 +169,43 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
-type whereHelpertypes_Int64Array struct{ field string }
+type whereHelpernull_Int64 struct{ field string }
 
-func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var HolePunchResultWhere = struct {
 	ID                        whereHelperint
@@ -149,6 +221,17 @@ var HolePunchResultWhere = struct {
 	UpdatedAt                 whereHelpertime_Time
 	CreatedAt                 whereHelpertime_Time
 	ListenMultiAddressesSetID whereHelperint
+	AuthorizationID           whereHelpernull_Int
+	ValidationStatus          whereHelperstring
+	ValidationReasons         whereHelpertypes_StringArray
+	AllocationID              whereHelpernull_Int64
+	RelayID                   whereHelpernull_Int64
+	RelayStrategy             whereHelpernull_String
+	RelayMultiAddressID       whereHelpernull_Int64
+	CommunicationTimeout      whereHelpernull_String
+	RetryCount                whereHelpernull_Int
+	PingDuration              whereHelpernull_String
+	MaxPingCount              whereHelpernull_Int
 }{
 	ID:                        whereHelperint{field: "\"hole_punch_results\".\"id\""},
 	LocalID:                   whereHelperint64{field: "\"hole_punch_results\".\"local_id\""},
@@ -163,36 +246,65 @@ var HolePunchResultWhere = struct {
 	UpdatedAt:                 whereHelpertime_Time{field: "\"hole_punch_results\".\"updated_at\""},
 	CreatedAt:                 whereHelpertime_Time{field: "\"hole_punch_results\".\"created_at\""},
 	ListenMultiAddressesSetID: whereHelperint{field: "\"hole_punch_results\".\"listen_multi_addresses_set_id\""},
+	AuthorizationID:           whereHelpernull_Int{field: "\"hole_punch_results\".\"authorization_id\""},
+	ValidationStatus:          whereHelperstring{field: "\"hole_punch_results\".\"validation_status\""},
+	ValidationReasons:         whereHelpertypes_StringArray{field: "\"hole_punch_results\".\"validation_reasons\""},
+	AllocationID:              whereHelpernull_Int64{field: "\"hole_punch_results\".\"allocation_id\""},
+	RelayID:                   whereHelpernull_Int64{field: "\"hole_punch_results\".\"relay_id\""},
+	RelayStrategy:             whereHelpernull_String{field: "\"hole_punch_results\".\"relay_strategy\""},
+	RelayMultiAddressID:       whereHelpernull_Int64{field: "\"hole_punch_results\".\"relay_multi_address_id\""},
+	CommunicationTimeout:      whereHelpernull_String{field: "\"hole_punch_results\".\"communication_timeout\""},
+	RetryCount:                whereHelpernull_Int{field: "\"hole_punch_results\".\"retry_count\""},
+	PingDuration:              whereHelpernull_String{field: "\"hole_punch_results\".\"ping_duration\""},
+	MaxPingCount:              whereHelpernull_Int{field: "\"hole_punch_results\".\"max_ping_count\""},
 }
 
 // HolePunchResultRels is where relationship names are stored.
 var HolePunchResultRels = struct {
+	Allocation                      string
+	Authorization                   string
 	ListenMultiAddressesSet         string
 	Local                           string
+	Relay                           string
+	RelayMultiAddress               string
 	Remote                          string
 	HolePunchAttempts               string
+	HolePunchEvents                 string
 	HolePunchResultsXMultiAddresses string
 	LatencyMeasurements             string
 	PortMappings                    string
+	RelayDials                      string
 }{
+	Allocation:                      "Allocation",
+	Authorization:                   "Authorization",
 	ListenMultiAddressesSet:         "ListenMultiAddressesSet",
 	Local:                           "Local",
+	Relay:                           "Relay",
+	RelayMultiAddress:               "RelayMultiAddress",
 	Remote:                          "Remote",
 	HolePunchAttempts:               "HolePunchAttempts",
+	HolePunchEvents:                 "HolePunchEvents",
 	HolePunchResultsXMultiAddresses: "HolePunchResultsXMultiAddresses",
 	LatencyMeasurements:             "LatencyMeasurements",
 	PortMappings:                    "PortMappings",
+	RelayDials:                      "RelayDials",
 }
 
 // holePunchResultR is where relationships are stored.
 type holePunchResultR struct {
+	Allocation                      *Allocation                        `boil:"Allocation" json:"Allocation" toml:"Allocation" yaml:"Allocation"`
+	Authorization                   *Authorization                     `boil:"Authorization" json:"Authorization" toml:"Authorization" yaml:"Authorization"`
 	ListenMultiAddressesSet         *MultiAddressesSet                 `boil:"ListenMultiAddressesSet" json:"ListenMultiAddressesSet" toml:"ListenMultiAddressesSet" yaml:"ListenMultiAddressesSet"`
 	Local                           *Peer                              `boil:"Local" json:"Local" toml:"Local" yaml:"Local"`
+	Relay                           *Peer                              `boil:"Relay" json:"Relay" toml:"Relay" yaml:"Relay"`
+	RelayMultiAddress               *MultiAddress                      `boil:"RelayMultiAddress" json:"RelayMultiAddress" toml:"RelayMultiAddress" yaml:"RelayMultiAddress"`
 	Remote                          *Peer                              `boil:"Remote" json:"Remote" toml:"Remote" yaml:"Remote"`
 	HolePunchAttempts               HolePunchAttemptSlice              `boil:"HolePunchAttempts" json:"HolePunchAttempts" toml:"HolePunchAttempts" yaml:"HolePunchAttempts"`
+	HolePunchEvents                 HolePunchEventSlice                `boil:"HolePunchEvents" json:"HolePunchEvents" toml:"HolePunchEvents" yaml:"HolePunchEvents"`
 	HolePunchResultsXMultiAddresses HolePunchResultsXMultiAddressSlice `boil:"HolePunchResultsXMultiAddresses" json:"HolePunchResultsXMultiAddresses" toml:"HolePunchResultsXMultiAddresses" yaml:"HolePunchResultsXMultiAddresses"`
 	LatencyMeasurements             LatencyMeasurementSlice            `boil:"LatencyMeasurements" json:"LatencyMeasurements" toml:"LatencyMeasurements" yaml:"LatencyMeasurements"`
 	PortMappings                    PortMappingSlice                   `boil:"PortMappings" json:"PortMappings" toml:"PortMappings" yaml:"PortMappings"`
+	RelayDials                      RelayDialSlice                     `boil:"RelayDials" json:"RelayDials" toml:"RelayDials" yaml:"RelayDials"`
 }
 
 // NewStruct creates a new relationship struct
@@ -200,6 +312,20 @@ func (*holePunchResultR) NewStruct() *holePunchResultR {
 	return &holePunchResultR{}
 }
 
+func (r *holePunchResultR) GetAllocation() *Allocation {
+	if r == nil {
+		return nil
+	}
+	return r.Allocation
+}
+
+func (r *holePunchResultR) GetAuthorization() *Authorization {
+	if r == nil {
+		return nil
+	}
+	return r.Authorization
+}
+
 func (r *holePunchResultR) GetListenMultiAddressesSet() *MultiAddressesSet {
 	if r == nil {
 		return nil
@@ -214,6 +340,20 @@ func (r *holePunchResultR) GetLocal() *Peer {
 	return r.Local
 }
 
+func (r *holePunchResultR) GetRelay() *Peer {
+	if r == nil {
+		return nil
+	}
+	return r.Relay
+}
+
+func (r *holePunchResultR) GetRelayMultiAddress() *MultiAddress {
+	if r == nil {
+		return nil
+	}
+	return r.RelayMultiAddress
+}
+
 func (r *holePunchResultR) GetRemote() *Peer {
 	if r == nil {
 		return nil
@@ -228,6 +368,13 @@ func (r *holePunchResultR) GetHolePunchAttempts() HolePunchAttemptSlice {
 	return r.HolePunchAttempts
 }
 
+func (r *holePunchResultR) GetHolePunchEvents() HolePunchEventSlice {
+	if r == nil {
+		return nil
+	}
+	return r.HolePunchEvents
+}
+
 func (r *holePunchResultR) GetHolePunchResultsXMultiAddresses() HolePunchResultsXMultiAddressSlice {
 	if r == nil {
 		return nil
@@ -249,13 +396,20 @@ func (r *holePunchResultR) GetPortMappings() PortMappingSlice {
 	return r.PortMappings
 }
 
+func (r *holePunchResultR) GetRelayDials() RelayDialSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RelayDials
+}
+
 // holePunchResultL is where Load methods for each relationship are stored.
 type holePunchResultL struct{}
 
 var (
-	holePunchResultAllColumns            = []string{"id", "local_id", "remote_id", "connect_started_at", "connect_ended_at", "has_direct_conns", "error", "outcome", "ended_at", "protocol_filters", "updated_at", "created_at", "listen_multi_addresses_set_id"}
+	holePunchResultAllColumns            = []string{"id", "local_id", "remote_id", "connect_started_at", "connect_ended_at", "has_direct_conns", "error", "outcome", "ended_at", "protocol_filters", "updated_at", "created_at", "listen_multi_addresses_set_id", "authorization_id", "validation_status", "validation_reasons", "allocation_id", "relay_id", "relay_strategy", "relay_multi_address_id", "communication_timeout", "retry_count", "ping_duration", "max_ping_count"}
 	holePunchResultColumnsWithoutDefault = []string{"local_id", "remote_id", "connect_started_at", "connect_ended_at", "has_direct_conns", "outcome", "ended_at", "protocol_filters", "updated_at", "created_at"}
-	holePunchResultColumnsWithDefault    = []string{"id", "error", "listen_multi_addresses_set_id"}
+	holePunchResultColumnsWithDefault    = []string{"id", "error", "listen_multi_addresses_set_id", "authorization_id", "validation_status", "validation_reasons", "allocation_id", "relay_id", "relay_strategy", "relay_multi_address_id", "communication_timeout", "retry_count", "ping_duration", "max_ping_count"}
 	holePunchResultPrimaryKeyColumns     = []string{"id"}
 	holePunchResultGeneratedColumns      = []string{"id"}
 )
@@ -538,6 +692,28 @@ func (q holePunchResultQuery) Exists(ctx context.Context, exec boil.ContextExecu
 	return count > 0, nil
 }
 
+// Allocation pointed to by the foreign key.
+func (o *HolePunchResult) Allocation(mods ...qm.QueryMod) allocationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AllocationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Allocations(queryMods...)
+}
+
+// Authorization pointed to by the foreign key.
+func (o *HolePunchResult) Authorization(mods ...qm.QueryMod) authorizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AuthorizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Authorizations(queryMods...)
+}
+
 // ListenMultiAddressesSet pointed to by the foreign key.
 func (o *HolePunchResult) ListenMultiAddressesSet(mods ...qm.QueryMod) multiAddressesSetQuery {
 	queryMods := []qm.QueryMod{
@@ -560,6 +736,28 @@ func (o *HolePunchResult) Local(mods ...qm.QueryMod) peerQuery {
 	return Peers(queryMods...)
 }
 
+// Relay pointed to by the foreign key.
+func (o *HolePunchResult) Relay(mods ...qm.QueryMod) peerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RelayID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Peers(queryMods...)
+}
+
+// RelayMultiAddress pointed to by the foreign key.
+func (o *HolePunchResult) RelayMultiAddress(mods ...qm.QueryMod) multiAddressQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RelayMultiAddressID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return MultiAddresses(queryMods...)
+}
+
 // Remote pointed to by the foreign key.
 func (o *HolePunchResult) Remote(mods ...qm.QueryMod) peerQuery {
 	queryMods := []qm.QueryMod{
@@ -585,6 +783,20 @@ func (o *HolePunchResult) HolePunchAttempts(mods ...qm.QueryMod) holePunchAttemp
 	return HolePunchAttempts(queryMods...)
 }
 
+// HolePunchEvents retrieves all the hole_punch_event's HolePunchEvents with an executor.
+func (o *HolePunchResult) HolePunchEvents(mods ...qm.QueryMod) holePunchEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"hole_punch_events\".\"hole_punch_result_id\"=?", o.ID),
+	)
+
+	return HolePunchEvents(queryMods...)
+}
+
 // HolePunchResultsXMultiAddresses retrieves all the hole_punch_results_x_multi_address's HolePunchResultsXMultiAddresses with an executor.
 func (o *HolePunchResult) HolePunchResultsXMultiAddresses(mods ...qm.QueryMod) holePunchResultsXMultiAddressQuery {
 	var queryMods []qm.QueryMod
@@ -627,9 +839,23 @@ func (o *HolePunchResult) PortMappings(mods ...qm.QueryMod) portMappingQuery {
 	return PortMappings(queryMods...)
 }
 
-// LoadListenMultiAddressesSet allows an eager lookup of values, cached into the
+// RelayDials retrieves all the relay_dial's RelayDials with an executor.
+func (o *HolePunchResult) RelayDials(mods ...qm.QueryMod) relayDialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"relay_dials\".\"hole_punch_result_id\"=?", o.ID),
+	)
+
+	return RelayDials(queryMods...)
+}
+
+// LoadAllocation allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (holePunchResultL) LoadListenMultiAddressesSet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+func (holePunchResultL) LoadAllocation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
 	var slice []*HolePunchResult
 	var object *HolePunchResult
 
@@ -660,7 +886,9 @@ func (holePunchResultL) LoadListenMultiAddressesSet(ctx context.Context, e boil.
 		if object.R == nil {
 			object.R = &holePunchResultR{}
 		}
-		args = append(args, object.ListenMultiAddressesSetID)
+		if !queries.IsNil(object.AllocationID) {
+			args = append(args, object.AllocationID)
+		}
 
 	} else {
 	Outer:
@@ -670,12 +898,14 @@ func (holePunchResultL) LoadListenMultiAddressesSet(ctx context.Context, e boil.
 			}
 
 			for _, a := range args {
-				if a == obj.ListenMultiAddressesSetID {
+				if queries.Equal(a, obj.AllocationID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ListenMultiAddressesSetID)
+			if !queries.IsNil(obj.AllocationID) {
+				args = append(args, obj.AllocationID)
+			}
 
 		}
 	}
@@ -685,8 +915,8 @@ func (holePunchResultL) LoadListenMultiAddressesSet(ctx context.Context, e boil.
 	}
 
 	query := NewQuery(
-		qm.From(`multi_addresses_sets`),
-		qm.WhereIn(`multi_addresses_sets.id in ?`, args...),
+		qm.From(`allocations`),
+		qm.WhereIn(`allocations.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -694,19 +924,19 @@ func (holePunchResultL) LoadListenMultiAddressesSet(ctx context.Context, e boil.
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load MultiAddressesSet")
+		return errors.Wrap(err, "failed to eager load Allocation")
 	}
 
-	var resultSlice []*MultiAddressesSet
+	var resultSlice []*Allocation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice MultiAddressesSet")
+		return errors.Wrap(err, "failed to bind eager loaded slice Allocation")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for multi_addresses_sets")
+		return errors.Wrap(err, "failed to close results of eager load for allocations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for multi_addresses_sets")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for allocations")
 	}
 
 	if len(holePunchResultAfterSelectHooks) != 0 {
@@ -723,22 +953,22 @@ func (holePunchResultL) LoadListenMultiAddressesSet(ctx context.Context, e boil.
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.ListenMultiAddressesSet = foreign
+		object.R.Allocation = foreign
 		if foreign.R == nil {
-			foreign.R = &multiAddressesSetR{}
+			foreign.R = &allocationR{}
 		}
-		foreign.R.ListenMultiAddressesSetHolePunchResults = append(foreign.R.ListenMultiAddressesSetHolePunchResults, object)
+		foreign.R.HolePunchResult = object
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.ListenMultiAddressesSetID == foreign.ID {
-				local.R.ListenMultiAddressesSet = foreign
+			if queries.Equal(local.AllocationID, foreign.ID) {
+				local.R.Allocation = foreign
 				if foreign.R == nil {
-					foreign.R = &multiAddressesSetR{}
+					foreign.R = &allocationR{}
 				}
-				foreign.R.ListenMultiAddressesSetHolePunchResults = append(foreign.R.ListenMultiAddressesSetHolePunchResults, local)
+				foreign.R.HolePunchResult = local
 				break
 			}
 		}
@@ -747,9 +977,9 @@ func (holePunchResultL) LoadListenMultiAddressesSet(ctx context.Context, e boil.
 	return nil
 }
 
-// LoadLocal allows an eager lookup of values, cached into the
+// LoadAuthorization allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (holePunchResultL) LoadLocal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+func (holePunchResultL) LoadAuthorization(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
 	var slice []*HolePunchResult
 	var object *HolePunchResult
 
@@ -780,7 +1010,9 @@ func (holePunchResultL) LoadLocal(ctx context.Context, e boil.ContextExecutor, s
 		if object.R == nil {
 			object.R = &holePunchResultR{}
 		}
-		args = append(args, object.LocalID)
+		if !queries.IsNil(object.AuthorizationID) {
+			args = append(args, object.AuthorizationID)
+		}
 
 	} else {
 	Outer:
@@ -790,12 +1022,14 @@ func (holePunchResultL) LoadLocal(ctx context.Context, e boil.ContextExecutor, s
 			}
 
 			for _, a := range args {
-				if a == obj.LocalID {
+				if queries.Equal(a, obj.AuthorizationID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.LocalID)
+			if !queries.IsNil(obj.AuthorizationID) {
+				args = append(args, obj.AuthorizationID)
+			}
 
 		}
 	}
@@ -805,8 +1039,8 @@ func (holePunchResultL) LoadLocal(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`peers`),
-		qm.WhereIn(`peers.id in ?`, args...),
+		qm.From(`authorizations`),
+		qm.WhereIn(`authorizations.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -814,19 +1048,19 @@ func (holePunchResultL) LoadLocal(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Peer")
+		return errors.Wrap(err, "failed to eager load Authorization")
 	}
 
-	var resultSlice []*Peer
+	var resultSlice []*Authorization
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Peer")
+		return errors.Wrap(err, "failed to bind eager loaded slice Authorization")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for peers")
+		return errors.Wrap(err, "failed to close results of eager load for authorizations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for peers")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for authorizations")
 	}
 
 	if len(holePunchResultAfterSelectHooks) != 0 {
@@ -843,22 +1077,22 @@ func (holePunchResultL) LoadLocal(ctx context.Context, e boil.ContextExecutor, s
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.Local = foreign
+		object.R.Authorization = foreign
 		if foreign.R == nil {
-			foreign.R = &peerR{}
+			foreign.R = &authorizationR{}
 		}
-		foreign.R.LocalHolePunchResults = append(foreign.R.LocalHolePunchResults, object)
+		foreign.R.HolePunchResults = append(foreign.R.HolePunchResults, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.LocalID == foreign.ID {
-				local.R.Local = foreign
+			if queries.Equal(local.AuthorizationID, foreign.ID) {
+				local.R.Authorization = foreign
 				if foreign.R == nil {
-					foreign.R = &peerR{}
+					foreign.R = &authorizationR{}
 				}
-				foreign.R.LocalHolePunchResults = append(foreign.R.LocalHolePunchResults, local)
+				foreign.R.HolePunchResults = append(foreign.R.HolePunchResults, local)
 				break
 			}
 		}
@@ -867,9 +1101,9 @@ func (holePunchResultL) LoadLocal(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadRemote allows an eager lookup of values, cached into the
+// LoadListenMultiAddressesSet allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (holePunchResultL) LoadRemote(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+func (holePunchResultL) LoadListenMultiAddressesSet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
 	var slice []*HolePunchResult
 	var object *HolePunchResult
 
@@ -900,7 +1134,7 @@ func (holePunchResultL) LoadRemote(ctx context.Context, e boil.ContextExecutor,
 		if object.R == nil {
 			object.R = &holePunchResultR{}
 		}
-		args = append(args, object.RemoteID)
+		args = append(args, object.ListenMultiAddressesSetID)
 
 	} else {
 	Outer:
@@ -910,12 +1144,12 @@ func (holePunchResultL) LoadRemote(ctx context.Context, e boil.ContextExecutor,
 			}
 
 			for _, a := range args {
-				if a == obj.RemoteID {
+				if a == obj.ListenMultiAddressesSetID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.RemoteID)
+			args = append(args, obj.ListenMultiAddressesSetID)
 
 		}
 	}
@@ -925,8 +1159,8 @@ func (holePunchResultL) LoadRemote(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`peers`),
-		qm.WhereIn(`peers.id in ?`, args...),
+		qm.From(`multi_addresses_sets`),
+		qm.WhereIn(`multi_addresses_sets.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -934,19 +1168,19 @@ func (holePunchResultL) LoadRemote(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Peer")
+		return errors.Wrap(err, "failed to eager load MultiAddressesSet")
 	}
 
-	var resultSlice []*Peer
+	var resultSlice []*MultiAddressesSet
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Peer")
+		return errors.Wrap(err, "failed to bind eager loaded slice MultiAddressesSet")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for peers")
+		return errors.Wrap(err, "failed to close results of eager load for multi_addresses_sets")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for peers")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for multi_addresses_sets")
 	}
 
 	if len(holePunchResultAfterSelectHooks) != 0 {
@@ -963,22 +1197,22 @@ func (holePunchResultL) LoadRemote(ctx context.Context, e boil.ContextExecutor,
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.Remote = foreign
+		object.R.ListenMultiAddressesSet = foreign
 		if foreign.R == nil {
-			foreign.R = &peerR{}
+			foreign.R = &multiAddressesSetR{}
 		}
-		foreign.R.RemoteHolePunchResults = append(foreign.R.RemoteHolePunchResults, object)
+		foreign.R.ListenMultiAddressesSetHolePunchResults = append(foreign.R.ListenMultiAddressesSetHolePunchResults, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.RemoteID == foreign.ID {
-				local.R.Remote = foreign
+			if local.ListenMultiAddressesSetID == foreign.ID {
+				local.R.ListenMultiAddressesSet = foreign
 				if foreign.R == nil {
-					foreign.R = &peerR{}
+					foreign.R = &multiAddressesSetR{}
 				}
-				foreign.R.RemoteHolePunchResults = append(foreign.R.RemoteHolePunchResults, local)
+				foreign.R.ListenMultiAddressesSetHolePunchResults = append(foreign.R.ListenMultiAddressesSetHolePunchResults, local)
 				break
 			}
 		}
@@ -987,9 +1221,9 @@ func (holePunchResultL) LoadRemote(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadHolePunchAttempts allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (holePunchResultL) LoadHolePunchAttempts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+// LoadLocal allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holePunchResultL) LoadLocal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
 	var slice []*HolePunchResult
 	var object *HolePunchResult
 
@@ -1020,7 +1254,8 @@ func (holePunchResultL) LoadHolePunchAttempts(ctx context.Context, e boil.Contex
 		if object.R == nil {
 			object.R = &holePunchResultR{}
 		}
-		args = append(args, object.ID)
+		args = append(args, object.LocalID)
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -1029,12 +1264,13 @@ func (holePunchResultL) LoadHolePunchAttempts(ctx context.Context, e boil.Contex
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if a == obj.LocalID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			args = append(args, obj.LocalID)
+
 		}
 	}
 
@@ -1043,8 +1279,8 @@ func (holePunchResultL) LoadHolePunchAttempts(ctx context.Context, e boil.Contex
 	}
 
 	query := NewQuery(
-		qm.From(`hole_punch_attempt`),
-		qm.WhereIn(`hole_punch_attempt.hole_punch_result_id in ?`, args...),
+		qm.From(`peers`),
+		qm.WhereIn(`peers.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1052,47 +1288,51 @@ func (holePunchResultL) LoadHolePunchAttempts(ctx context.Context, e boil.Contex
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load hole_punch_attempt")
+		return errors.Wrap(err, "failed to eager load Peer")
 	}
 
-	var resultSlice []*HolePunchAttempt
+	var resultSlice []*Peer
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_attempt")
+		return errors.Wrap(err, "failed to bind eager loaded slice Peer")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on hole_punch_attempt")
+		return errors.Wrap(err, "failed to close results of eager load for peers")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_attempt")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for peers")
 	}
 
-	if len(holePunchAttemptAfterSelectHooks) != 0 {
+	if len(holePunchResultAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.HolePunchAttempts = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &holePunchAttemptR{}
-			}
-			foreign.R.HolePunchResult = object
+		foreign := resultSlice[0]
+		object.R.Local = foreign
+		if foreign.R == nil {
+			foreign.R = &peerR{}
 		}
+		foreign.R.LocalHolePunchResults = append(foreign.R.LocalHolePunchResults, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.HolePunchResultID {
-				local.R.HolePunchAttempts = append(local.R.HolePunchAttempts, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.LocalID == foreign.ID {
+				local.R.Local = foreign
 				if foreign.R == nil {
-					foreign.R = &holePunchAttemptR{}
+					foreign.R = &peerR{}
 				}
-				foreign.R.HolePunchResult = local
+				foreign.R.LocalHolePunchResults = append(foreign.R.LocalHolePunchResults, local)
 				break
 			}
 		}
@@ -1101,9 +1341,9 @@ func (holePunchResultL) LoadHolePunchAttempts(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// LoadHolePunchResultsXMultiAddresses allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (holePunchResultL) LoadHolePunchResultsXMultiAddresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+// LoadRelay allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holePunchResultL) LoadRelay(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
 	var slice []*HolePunchResult
 	var object *HolePunchResult
 
@@ -1134,7 +1374,10 @@ func (holePunchResultL) LoadHolePunchResultsXMultiAddresses(ctx context.Context,
 		if object.R == nil {
 			object.R = &holePunchResultR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.RelayID) {
+			args = append(args, object.RelayID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -1143,12 +1386,15 @@ func (holePunchResultL) LoadHolePunchResultsXMultiAddresses(ctx context.Context,
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.RelayID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.RelayID) {
+				args = append(args, obj.RelayID)
+			}
+
 		}
 	}
 
@@ -1157,8 +1403,8 @@ func (holePunchResultL) LoadHolePunchResultsXMultiAddresses(ctx context.Context,
 	}
 
 	query := NewQuery(
-		qm.From(`hole_punch_results_x_multi_addresses`),
-		qm.WhereIn(`hole_punch_results_x_multi_addresses.hole_punch_result_id in ?`, args...),
+		qm.From(`peers`),
+		qm.WhereIn(`peers.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1166,30 +1412,620 @@ func (holePunchResultL) LoadHolePunchResultsXMultiAddresses(ctx context.Context,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load hole_punch_results_x_multi_addresses")
+		return errors.Wrap(err, "failed to eager load Peer")
 	}
 
-	var resultSlice []*HolePunchResultsXMultiAddress
+	var resultSlice []*Peer
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results_x_multi_addresses")
+		return errors.Wrap(err, "failed to bind eager loaded slice Peer")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results_x_multi_addresses")
+		return errors.Wrap(err, "failed to close results of eager load for peers")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results_x_multi_addresses")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for peers")
 	}
 
-	if len(holePunchResultsXMultiAddressAfterSelectHooks) != 0 {
+	if len(holePunchResultAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.HolePunchResultsXMultiAddresses = resultSlice
+		foreign := resultSlice[0]
+		object.R.Relay = foreign
+		if foreign.R == nil {
+			foreign.R = &peerR{}
+		}
+		foreign.R.RelayHolePunchResults = append(foreign.R.RelayHolePunchResults, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RelayID, foreign.ID) {
+				local.R.Relay = foreign
+				if foreign.R == nil {
+					foreign.R = &peerR{}
+				}
+				foreign.R.RelayHolePunchResults = append(foreign.R.RelayHolePunchResults, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRelayMultiAddress allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holePunchResultL) LoadRelayMultiAddress(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+	var slice []*HolePunchResult
+	var object *HolePunchResult
+
+	if singular {
+		var ok bool
+		object, ok = maybeHolePunchResult.(*HolePunchResult)
+		if !ok {
+			object = new(HolePunchResult)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeHolePunchResult))
+			}
+		}
+	} else {
+		s, ok := maybeHolePunchResult.(*[]*HolePunchResult)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeHolePunchResult))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holePunchResultR{}
+		}
+		if !queries.IsNil(object.RelayMultiAddressID) {
+			args = append(args, object.RelayMultiAddressID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holePunchResultR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.RelayMultiAddressID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.RelayMultiAddressID) {
+				args = append(args, obj.RelayMultiAddressID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`multi_addresses`),
+		qm.WhereIn(`multi_addresses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load MultiAddress")
+	}
+
+	var resultSlice []*MultiAddress
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice MultiAddress")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for multi_addresses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for multi_addresses")
+	}
+
+	if len(holePunchResultAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RelayMultiAddress = foreign
+		if foreign.R == nil {
+			foreign.R = &multiAddressR{}
+		}
+		foreign.R.RelayMultiAddressHolePunchResults = append(foreign.R.RelayMultiAddressHolePunchResults, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RelayMultiAddressID, foreign.ID) {
+				local.R.RelayMultiAddress = foreign
+				if foreign.R == nil {
+					foreign.R = &multiAddressR{}
+				}
+				foreign.R.RelayMultiAddressHolePunchResults = append(foreign.R.RelayMultiAddressHolePunchResults, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRemote allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holePunchResultL) LoadRemote(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+	var slice []*HolePunchResult
+	var object *HolePunchResult
+
+	if singular {
+		var ok bool
+		object, ok = maybeHolePunchResult.(*HolePunchResult)
+		if !ok {
+			object = new(HolePunchResult)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeHolePunchResult))
+			}
+		}
+	} else {
+		s, ok := maybeHolePunchResult.(*[]*HolePunchResult)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeHolePunchResult))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holePunchResultR{}
+		}
+		args = append(args, object.RemoteID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holePunchResultR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RemoteID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RemoteID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`peers`),
+		qm.WhereIn(`peers.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Peer")
+	}
+
+	var resultSlice []*Peer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Peer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for peers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for peers")
+	}
+
+	if len(holePunchResultAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Remote = foreign
+		if foreign.R == nil {
+			foreign.R = &peerR{}
+		}
+		foreign.R.RemoteHolePunchResults = append(foreign.R.RemoteHolePunchResults, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RemoteID == foreign.ID {
+				local.R.Remote = foreign
+				if foreign.R == nil {
+					foreign.R = &peerR{}
+				}
+				foreign.R.RemoteHolePunchResults = append(foreign.R.RemoteHolePunchResults, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadHolePunchAttempts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (holePunchResultL) LoadHolePunchAttempts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+	var slice []*HolePunchResult
+	var object *HolePunchResult
+
+	if singular {
+		var ok bool
+		object, ok = maybeHolePunchResult.(*HolePunchResult)
+		if !ok {
+			object = new(HolePunchResult)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeHolePunchResult))
+			}
+		}
+	} else {
+		s, ok := maybeHolePunchResult.(*[]*HolePunchResult)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeHolePunchResult))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holePunchResultR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holePunchResultR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_attempt`),
+		qm.WhereIn(`hole_punch_attempt.hole_punch_result_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load hole_punch_attempt")
+	}
+
+	var resultSlice []*HolePunchAttempt
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_attempt")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on hole_punch_attempt")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_attempt")
+	}
+
+	if len(holePunchAttemptAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.HolePunchAttempts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holePunchAttemptR{}
+			}
+			foreign.R.HolePunchResult = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.HolePunchResultID {
+				local.R.HolePunchAttempts = append(local.R.HolePunchAttempts, foreign)
+				if foreign.R == nil {
+					foreign.R = &holePunchAttemptR{}
+				}
+				foreign.R.HolePunchResult = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadHolePunchEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (holePunchResultL) LoadHolePunchEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+	var slice []*HolePunchResult
+	var object *HolePunchResult
+
+	if singular {
+		var ok bool
+		object, ok = maybeHolePunchResult.(*HolePunchResult)
+		if !ok {
+			object = new(HolePunchResult)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeHolePunchResult))
+			}
+		}
+	} else {
+		s, ok := maybeHolePunchResult.(*[]*HolePunchResult)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeHolePunchResult))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holePunchResultR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holePunchResultR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_events`),
+		qm.WhereIn(`hole_punch_events.hole_punch_result_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load hole_punch_events")
+	}
+
+	var resultSlice []*HolePunchEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on hole_punch_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_events")
+	}
+
+	if len(holePunchEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.HolePunchEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holePunchEventR{}
+			}
+			foreign.R.HolePunchResult = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.HolePunchResultID {
+				local.R.HolePunchEvents = append(local.R.HolePunchEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &holePunchEventR{}
+				}
+				foreign.R.HolePunchResult = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadHolePunchResultsXMultiAddresses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (holePunchResultL) LoadHolePunchResultsXMultiAddresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+	var slice []*HolePunchResult
+	var object *HolePunchResult
+
+	if singular {
+		var ok bool
+		object, ok = maybeHolePunchResult.(*HolePunchResult)
+		if !ok {
+			object = new(HolePunchResult)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeHolePunchResult))
+			}
+		}
+	} else {
+		s, ok := maybeHolePunchResult.(*[]*HolePunchResult)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeHolePunchResult))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holePunchResultR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holePunchResultR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_results_x_multi_addresses`),
+		qm.WhereIn(`hole_punch_results_x_multi_addresses.hole_punch_result_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load hole_punch_results_x_multi_addresses")
+	}
+
+	var resultSlice []*HolePunchResultsXMultiAddress
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results_x_multi_addresses")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results_x_multi_addresses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results_x_multi_addresses")
+	}
+
+	if len(holePunchResultsXMultiAddressAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.HolePunchResultsXMultiAddresses = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
 				foreign.R = &holePunchResultsXMultiAddressR{}
@@ -1376,70 +2212,333 @@ func (holePunchResultL) LoadPortMappings(ctx context.Context, e boil.ContextExec
 				}
 			}
 
-			args = append(args, obj.ID)
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`port_mappings`),
+		qm.WhereIn(`port_mappings.hole_punch_result_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load port_mappings")
+	}
+
+	var resultSlice []*PortMapping
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice port_mappings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on port_mappings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for port_mappings")
+	}
+
+	if len(portMappingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PortMappings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &portMappingR{}
+			}
+			foreign.R.HolePunchResult = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.HolePunchResultID {
+				local.R.PortMappings = append(local.R.PortMappings, foreign)
+				if foreign.R == nil {
+					foreign.R = &portMappingR{}
+				}
+				foreign.R.HolePunchResult = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRelayDials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (holePunchResultL) LoadRelayDials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHolePunchResult interface{}, mods queries.Applicator) error {
+	var slice []*HolePunchResult
+	var object *HolePunchResult
+
+	if singular {
+		var ok bool
+		object, ok = maybeHolePunchResult.(*HolePunchResult)
+		if !ok {
+			object = new(HolePunchResult)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeHolePunchResult))
+			}
+		}
+	} else {
+		s, ok := maybeHolePunchResult.(*[]*HolePunchResult)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeHolePunchResult)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeHolePunchResult))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holePunchResultR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holePunchResultR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`relay_dials`),
+		qm.WhereIn(`relay_dials.hole_punch_result_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load relay_dials")
+	}
+
+	var resultSlice []*RelayDial
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice relay_dials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on relay_dials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for relay_dials")
+	}
+
+	if len(relayDialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RelayDials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &relayDialR{}
+			}
+			foreign.R.HolePunchResult = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.HolePunchResultID {
+				local.R.RelayDials = append(local.R.RelayDials, foreign)
+				if foreign.R == nil {
+					foreign.R = &relayDialR{}
+				}
+				foreign.R.HolePunchResult = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAllocation of the holePunchResult to the related item.
+// Sets o.R.Allocation to related.
+// Adds o to related.R.HolePunchResult.
+func (o *HolePunchResult) SetAllocation(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Allocation) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"hole_punch_results\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"allocation_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holePunchResultPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AllocationID, related.ID)
+	if o.R == nil {
+		o.R = &holePunchResultR{
+			Allocation: related,
+		}
+	} else {
+		o.R.Allocation = related
+	}
+
+	if related.R == nil {
+		related.R = &allocationR{
+			HolePunchResult: o,
+		}
+	} else {
+		related.R.HolePunchResult = o
+	}
+
+	return nil
+}
+
+// RemoveAllocation relationship.
+// Sets o.R.Allocation to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *HolePunchResult) RemoveAllocation(ctx context.Context, exec boil.ContextExecutor, related *Allocation) error {
+	var err error
+
+	queries.SetScanner(&o.AllocationID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("allocation_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Allocation = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.HolePunchResult = nil
+	return nil
+}
+
+// SetAuthorization of the holePunchResult to the related item.
+// Sets o.R.Authorization to related.
+// Adds o to related.R.HolePunchResults.
+func (o *HolePunchResult) SetAuthorization(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Authorization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"hole_punch_results\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"authorization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holePunchResultPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AuthorizationID, related.ID)
+	if o.R == nil {
+		o.R = &holePunchResultR{
+			Authorization: related,
 		}
+	} else {
+		o.R.Authorization = related
 	}
 
-	if len(args) == 0 {
-		return nil
+	if related.R == nil {
+		related.R = &authorizationR{
+			HolePunchResults: HolePunchResultSlice{o},
+		}
+	} else {
+		related.R.HolePunchResults = append(related.R.HolePunchResults, o)
 	}
 
-	query := NewQuery(
-		qm.From(`port_mappings`),
-		qm.WhereIn(`port_mappings.hole_punch_result_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load port_mappings")
-	}
+// RemoveAuthorization relationship.
+// Sets o.R.Authorization to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *HolePunchResult) RemoveAuthorization(ctx context.Context, exec boil.ContextExecutor, related *Authorization) error {
+	var err error
 
-	var resultSlice []*PortMapping
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice port_mappings")
+	queries.SetScanner(&o.AuthorizationID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("authorization_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on port_mappings")
+	if o.R != nil {
+		o.R.Authorization = nil
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for port_mappings")
+	if related == nil || related.R == nil {
+		return nil
 	}
 
-	if len(portMappingAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
-		}
-	}
-	if singular {
-		object.R.PortMappings = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &portMappingR{}
-			}
-			foreign.R.HolePunchResult = object
+	for i, ri := range related.R.HolePunchResults {
+		if queries.Equal(o.AuthorizationID, ri.AuthorizationID) {
+			continue
 		}
-		return nil
-	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.HolePunchResultID {
-				local.R.PortMappings = append(local.R.PortMappings, foreign)
-				if foreign.R == nil {
-					foreign.R = &portMappingR{}
-				}
-				foreign.R.HolePunchResult = local
-				break
-			}
+		ln := len(related.R.HolePunchResults)
+		if ln > 1 && i < ln-1 {
+			related.R.HolePunchResults[i] = related.R.HolePunchResults[ln-1]
 		}
+		related.R.HolePunchResults = related.R.HolePunchResults[:ln-1]
+		break
 	}
-
 	return nil
 }
 
@@ -1537,6 +2636,166 @@ func (o *HolePunchResult) SetLocal(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
+// SetRelay of the holePunchResult to the related item.
+// Sets o.R.Relay to related.
+// Adds o to related.R.RelayHolePunchResults.
+func (o *HolePunchResult) SetRelay(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Peer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"hole_punch_results\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"relay_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holePunchResultPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RelayID, related.ID)
+	if o.R == nil {
+		o.R = &holePunchResultR{
+			Relay: related,
+		}
+	} else {
+		o.R.Relay = related
+	}
+
+	if related.R == nil {
+		related.R = &peerR{
+			RelayHolePunchResults: HolePunchResultSlice{o},
+		}
+	} else {
+		related.R.RelayHolePunchResults = append(related.R.RelayHolePunchResults, o)
+	}
+
+	return nil
+}
+
+// RemoveRelay relationship.
+// Sets o.R.Relay to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *HolePunchResult) RemoveRelay(ctx context.Context, exec boil.ContextExecutor, related *Peer) error {
+	var err error
+
+	queries.SetScanner(&o.RelayID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("relay_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Relay = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RelayHolePunchResults {
+		if queries.Equal(o.RelayID, ri.RelayID) {
+			continue
+		}
+
+		ln := len(related.R.RelayHolePunchResults)
+		if ln > 1 && i < ln-1 {
+			related.R.RelayHolePunchResults[i] = related.R.RelayHolePunchResults[ln-1]
+		}
+		related.R.RelayHolePunchResults = related.R.RelayHolePunchResults[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRelayMultiAddress of the holePunchResult to the related item.
+// Sets o.R.RelayMultiAddress to related.
+// Adds o to related.R.RelayMultiAddressHolePunchResults.
+func (o *HolePunchResult) SetRelayMultiAddress(ctx context.Context, exec boil.ContextExecutor, insert bool, related *MultiAddress) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"hole_punch_results\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"relay_multi_address_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holePunchResultPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RelayMultiAddressID, related.ID)
+	if o.R == nil {
+		o.R = &holePunchResultR{
+			RelayMultiAddress: related,
+		}
+	} else {
+		o.R.RelayMultiAddress = related
+	}
+
+	if related.R == nil {
+		related.R = &multiAddressR{
+			RelayMultiAddressHolePunchResults: HolePunchResultSlice{o},
+		}
+	} else {
+		related.R.RelayMultiAddressHolePunchResults = append(related.R.RelayMultiAddressHolePunchResults, o)
+	}
+
+	return nil
+}
+
+// RemoveRelayMultiAddress relationship.
+// Sets o.R.RelayMultiAddress to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *HolePunchResult) RemoveRelayMultiAddress(ctx context.Context, exec boil.ContextExecutor, related *MultiAddress) error {
+	var err error
+
+	queries.SetScanner(&o.RelayMultiAddressID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("relay_multi_address_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.RelayMultiAddress = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RelayMultiAddressHolePunchResults {
+		if queries.Equal(o.RelayMultiAddressID, ri.RelayMultiAddressID) {
+			continue
+		}
+
+		ln := len(related.R.RelayMultiAddressHolePunchResults)
+		if ln > 1 && i < ln-1 {
+			related.R.RelayMultiAddressHolePunchResults[i] = related.R.RelayMultiAddressHolePunchResults[ln-1]
+		}
+		related.R.RelayMultiAddressHolePunchResults = related.R.RelayMultiAddressHolePunchResults[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetRemote of the holePunchResult to the related item.
 // Sets o.R.Remote to related.
 // Adds o to related.R.RemoteHolePunchResults.
@@ -1637,6 +2896,59 @@ func (o *HolePunchResult) AddHolePunchAttempts(ctx context.Context, exec boil.Co
 	return nil
 }
 
+// AddHolePunchEvents adds the given related objects to the existing relationships
+// of the hole_punch_result, optionally inserting them as new records.
+// Appends related to o.R.HolePunchEvents.
+// Sets related.R.HolePunchResult appropriately.
+func (o *HolePunchResult) AddHolePunchEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.HolePunchResultID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"hole_punch_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"hole_punch_result_id"}),
+				strmangle.WhereClause("\"", "\"", 2, holePunchEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.HolePunchResultID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &holePunchResultR{
+			HolePunchEvents: related,
+		}
+	} else {
+		o.R.HolePunchEvents = append(o.R.HolePunchEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &holePunchEventR{
+				HolePunchResult: o,
+			}
+		} else {
+			rel.R.HolePunchResult = o
+		}
+	}
+	return nil
+}
+
 // AddHolePunchResultsXMultiAddresses adds the given related objects to the existing relationships
 // of the hole_punch_result, optionally inserting them as new records.
 // Appends related to o.R.HolePunchResultsXMultiAddresses.
@@ -1796,6 +3108,59 @@ func (o *HolePunchResult) AddPortMappings(ctx context.Context, exec boil.Context
 	return nil
 }
 
+// AddRelayDials adds the given related objects to the existing relationships
+// of the hole_punch_result, optionally inserting them as new records.
+// Appends related to o.R.RelayDials.
+// Sets related.R.HolePunchResult appropriately.
+func (o *HolePunchResult) AddRelayDials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RelayDial) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.HolePunchResultID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"relay_dials\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"hole_punch_result_id"}),
+				strmangle.WhereClause("\"", "\"", 2, relayDialPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.HolePunchResultID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &holePunchResultR{
+			RelayDials: related,
+		}
+	} else {
+		o.R.RelayDials = append(o.R.RelayDials, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &relayDialR{
+				HolePunchResult: o,
+			}
+		} else {
+			rel.R.HolePunchResult = o
+		}
+	}
+	return nil
+}
+
 // HolePunchResults retrieves all the records using an executor.
 func HolePunchResults(mods ...qm.QueryMod) holePunchResultQuery {
 	mods = append(mods, qm.From("\"hole_punch_results\""))
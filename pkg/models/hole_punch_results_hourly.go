@@ -0,0 +1,946 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// HolePunchResultsHourly is an object representing the database table.
+type HolePunchResultsHourly struct {
+	ID                 int64            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Hour               time.Time        `boil:"hour" json:"hour" toml:"hour" yaml:"hour"`
+	ProtocolFilters    types.Int64Array `boil:"protocol_filters" json:"protocol_filters" toml:"protocol_filters" yaml:"protocol_filters"`
+	ClientAsn          null.Int         `boil:"client_asn" json:"client_asn,omitempty" toml:"client_asn" yaml:"client_asn,omitempty"`
+	ClientCountry      null.String      `boil:"client_country" json:"client_country,omitempty" toml:"client_country" yaml:"client_country,omitempty"`
+	RemoteAgentVersion null.String      `boil:"remote_agent_version" json:"remote_agent_version,omitempty" toml:"remote_agent_version" yaml:"remote_agent_version,omitempty"`
+	RelayID            null.String      `boil:"relay_id" json:"relay_id,omitempty" toml:"relay_id" yaml:"relay_id,omitempty"`
+	Outcome            string           `boil:"outcome" json:"outcome" toml:"outcome" yaml:"outcome"`
+	Results            int              `boil:"results" json:"results" toml:"results" yaml:"results"`
+
+	R *holePunchResultsHourlyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L holePunchResultsHourlyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var HolePunchResultsHourlyColumns = struct {
+	ID                 string
+	Hour               string
+	ProtocolFilters    string
+	ClientAsn          string
+	ClientCountry      string
+	RemoteAgentVersion string
+	RelayID            string
+	Outcome            string
+	Results            string
+}{
+	ID:                 "id",
+	Hour:               "hour",
+	ProtocolFilters:    "protocol_filters",
+	ClientAsn:          "client_asn",
+	ClientCountry:      "client_country",
+	RemoteAgentVersion: "remote_agent_version",
+	RelayID:            "relay_id",
+	Outcome:            "outcome",
+	Results:            "results",
+}
+
+var HolePunchResultsHourlyTableColumns = struct {
+	ID                 string
+	Hour               string
+	ProtocolFilters    string
+	ClientAsn          string
+	ClientCountry      string
+	RemoteAgentVersion string
+	RelayID            string
+	Outcome            string
+	Results            string
+}{
+	ID:                 "hole_punch_results_hourly.id",
+	Hour:               "hole_punch_results_hourly.hour",
+	ProtocolFilters:    "hole_punch_results_hourly.protocol_filters",
+	ClientAsn:          "hole_punch_results_hourly.client_asn",
+	ClientCountry:      "hole_punch_results_hourly.client_country",
+	RemoteAgentVersion: "hole_punch_results_hourly.remote_agent_version",
+	RelayID:            "hole_punch_results_hourly.relay_id",
+	Outcome:            "hole_punch_results_hourly.outcome",
+	Results:            "hole_punch_results_hourly.results",
+}
+
+// Generated where
+
+var HolePunchResultsHourlyWhere = struct {
+	ID                 whereHelperint64
+	Hour               whereHelpertime_Time
+	ProtocolFilters    whereHelpertypes_Int64Array
+	ClientAsn          whereHelpernull_Int
+	ClientCountry      whereHelpernull_String
+	RemoteAgentVersion whereHelpernull_String
+	RelayID            whereHelpernull_String
+	Outcome            whereHelperstring
+	Results            whereHelperint
+}{
+	ID:                 whereHelperint64{field: "\"hole_punch_results_hourly\".\"id\""},
+	Hour:               whereHelpertime_Time{field: "\"hole_punch_results_hourly\".\"hour\""},
+	ProtocolFilters:    whereHelpertypes_Int64Array{field: "\"hole_punch_results_hourly\".\"protocol_filters\""},
+	ClientAsn:          whereHelpernull_Int{field: "\"hole_punch_results_hourly\".\"client_asn\""},
+	ClientCountry:      whereHelpernull_String{field: "\"hole_punch_results_hourly\".\"client_country\""},
+	RemoteAgentVersion: whereHelpernull_String{field: "\"hole_punch_results_hourly\".\"remote_agent_version\""},
+	RelayID:            whereHelpernull_String{field: "\"hole_punch_results_hourly\".\"relay_id\""},
+	Outcome:            whereHelperstring{field: "\"hole_punch_results_hourly\".\"outcome\""},
+	Results:            whereHelperint{field: "\"hole_punch_results_hourly\".\"results\""},
+}
+
+// HolePunchResultsHourlyRels is where relationship names are stored.
+var HolePunchResultsHourlyRels = struct {
+}{}
+
+// holePunchResultsHourlyR is where relationships are stored.
+type holePunchResultsHourlyR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*holePunchResultsHourlyR) NewStruct() *holePunchResultsHourlyR {
+	return &holePunchResultsHourlyR{}
+}
+
+// holePunchResultsHourlyL is where Load methods for each relationship are stored.
+type holePunchResultsHourlyL struct{}
+
+var (
+	holePunchResultsHourlyAllColumns            = []string{"id", "hour", "protocol_filters", "client_asn", "client_country", "remote_agent_version", "relay_id", "outcome", "results"}
+	holePunchResultsHourlyColumnsWithoutDefault = []string{"hour", "protocol_filters", "outcome", "results"}
+	holePunchResultsHourlyColumnsWithDefault    = []string{"id", "client_asn", "client_country", "remote_agent_version", "relay_id"}
+	holePunchResultsHourlyPrimaryKeyColumns     = []string{"id"}
+	holePunchResultsHourlyGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// HolePunchResultsHourlySlice is an alias for a slice of pointers to HolePunchResultsHourly.
+	// This should almost always be used instead of []HolePunchResultsHourly.
+	HolePunchResultsHourlySlice []*HolePunchResultsHourly
+	// HolePunchResultsHourlyHook is the signature for custom HolePunchResultsHourly hook methods
+	HolePunchResultsHourlyHook func(context.Context, boil.ContextExecutor, *HolePunchResultsHourly) error
+
+	holePunchResultsHourlyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	holePunchResultsHourlyType                 = reflect.TypeOf(&HolePunchResultsHourly{})
+	holePunchResultsHourlyMapping              = queries.MakeStructMapping(holePunchResultsHourlyType)
+	holePunchResultsHourlyPrimaryKeyMapping, _ = queries.BindMapping(holePunchResultsHourlyType, holePunchResultsHourlyMapping, holePunchResultsHourlyPrimaryKeyColumns)
+	holePunchResultsHourlyInsertCacheMut       sync.RWMutex
+	holePunchResultsHourlyInsertCache          = make(map[string]insertCache)
+	holePunchResultsHourlyUpdateCacheMut       sync.RWMutex
+	holePunchResultsHourlyUpdateCache          = make(map[string]updateCache)
+	holePunchResultsHourlyUpsertCacheMut       sync.RWMutex
+	holePunchResultsHourlyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var holePunchResultsHourlyAfterSelectHooks []HolePunchResultsHourlyHook
+
+var holePunchResultsHourlyBeforeInsertHooks []HolePunchResultsHourlyHook
+var holePunchResultsHourlyAfterInsertHooks []HolePunchResultsHourlyHook
+
+var holePunchResultsHourlyBeforeUpdateHooks []HolePunchResultsHourlyHook
+var holePunchResultsHourlyAfterUpdateHooks []HolePunchResultsHourlyHook
+
+var holePunchResultsHourlyBeforeDeleteHooks []HolePunchResultsHourlyHook
+var holePunchResultsHourlyAfterDeleteHooks []HolePunchResultsHourlyHook
+
+var holePunchResultsHourlyBeforeUpsertHooks []HolePunchResultsHourlyHook
+var holePunchResultsHourlyAfterUpsertHooks []HolePunchResultsHourlyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *HolePunchResultsHourly) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *HolePunchResultsHourly) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *HolePunchResultsHourly) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *HolePunchResultsHourly) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *HolePunchResultsHourly) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *HolePunchResultsHourly) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *HolePunchResultsHourly) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *HolePunchResultsHourly) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *HolePunchResultsHourly) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range holePunchResultsHourlyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddHolePunchResultsHourlyHook registers your hook function for all future operations.
+func AddHolePunchResultsHourlyHook(hookPoint boil.HookPoint, holePunchResultsHourlyHook HolePunchResultsHourlyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		holePunchResultsHourlyAfterSelectHooks = append(holePunchResultsHourlyAfterSelectHooks, holePunchResultsHourlyHook)
+	case boil.BeforeInsertHook:
+		holePunchResultsHourlyBeforeInsertHooks = append(holePunchResultsHourlyBeforeInsertHooks, holePunchResultsHourlyHook)
+	case boil.AfterInsertHook:
+		holePunchResultsHourlyAfterInsertHooks = append(holePunchResultsHourlyAfterInsertHooks, holePunchResultsHourlyHook)
+	case boil.BeforeUpdateHook:
+		holePunchResultsHourlyBeforeUpdateHooks = append(holePunchResultsHourlyBeforeUpdateHooks, holePunchResultsHourlyHook)
+	case boil.AfterUpdateHook:
+		holePunchResultsHourlyAfterUpdateHooks = append(holePunchResultsHourlyAfterUpdateHooks, holePunchResultsHourlyHook)
+	case boil.BeforeDeleteHook:
+		holePunchResultsHourlyBeforeDeleteHooks = append(holePunchResultsHourlyBeforeDeleteHooks, holePunchResultsHourlyHook)
+	case boil.AfterDeleteHook:
+		holePunchResultsHourlyAfterDeleteHooks = append(holePunchResultsHourlyAfterDeleteHooks, holePunchResultsHourlyHook)
+	case boil.BeforeUpsertHook:
+		holePunchResultsHourlyBeforeUpsertHooks = append(holePunchResultsHourlyBeforeUpsertHooks, holePunchResultsHourlyHook)
+	case boil.AfterUpsertHook:
+		holePunchResultsHourlyAfterUpsertHooks = append(holePunchResultsHourlyAfterUpsertHooks, holePunchResultsHourlyHook)
+	}
+}
+
+// One returns a single holePunchResultsHourly record from the query.
+func (q holePunchResultsHourlyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*HolePunchResultsHourly, error) {
+	o := &HolePunchResultsHourly{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for hole_punch_results_hourly")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all HolePunchResultsHourly records from the query.
+func (q holePunchResultsHourlyQuery) All(ctx context.Context, exec boil.ContextExecutor) (HolePunchResultsHourlySlice, error) {
+	var o []*HolePunchResultsHourly
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to HolePunchResultsHourly slice")
+	}
+
+	if len(holePunchResultsHourlyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all HolePunchResultsHourly records in the query.
+func (q holePunchResultsHourlyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count hole_punch_results_hourly rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q holePunchResultsHourlyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if hole_punch_results_hourly exists")
+	}
+
+	return count > 0, nil
+}
+
+// HolePunchResultsHourlies retrieves all the records using an executor.
+func HolePunchResultsHourlies(mods ...qm.QueryMod) holePunchResultsHourlyQuery {
+	mods = append(mods, qm.From("\"hole_punch_results_hourly\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"hole_punch_results_hourly\".*"})
+	}
+
+	return holePunchResultsHourlyQuery{q}
+}
+
+// FindHolePunchResultsHourly retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindHolePunchResultsHourly(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*HolePunchResultsHourly, error) {
+	holePunchResultsHourlyObj := &HolePunchResultsHourly{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"hole_punch_results_hourly\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, holePunchResultsHourlyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from hole_punch_results_hourly")
+	}
+
+	if err = holePunchResultsHourlyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return holePunchResultsHourlyObj, err
+	}
+
+	return holePunchResultsHourlyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *HolePunchResultsHourly) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no hole_punch_results_hourly provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(holePunchResultsHourlyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	holePunchResultsHourlyInsertCacheMut.RLock()
+	cache, cached := holePunchResultsHourlyInsertCache[key]
+	holePunchResultsHourlyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			holePunchResultsHourlyAllColumns,
+			holePunchResultsHourlyColumnsWithDefault,
+			holePunchResultsHourlyColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, holePunchResultsHourlyGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(holePunchResultsHourlyType, holePunchResultsHourlyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(holePunchResultsHourlyType, holePunchResultsHourlyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"hole_punch_results_hourly\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"hole_punch_results_hourly\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into hole_punch_results_hourly")
+	}
+
+	if !cached {
+		holePunchResultsHourlyInsertCacheMut.Lock()
+		holePunchResultsHourlyInsertCache[key] = cache
+		holePunchResultsHourlyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the HolePunchResultsHourly.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *HolePunchResultsHourly) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	holePunchResultsHourlyUpdateCacheMut.RLock()
+	cache, cached := holePunchResultsHourlyUpdateCache[key]
+	holePunchResultsHourlyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			holePunchResultsHourlyAllColumns,
+			holePunchResultsHourlyPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, holePunchResultsHourlyGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update hole_punch_results_hourly, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"hole_punch_results_hourly\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, holePunchResultsHourlyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(holePunchResultsHourlyType, holePunchResultsHourlyMapping, append(wl, holePunchResultsHourlyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update hole_punch_results_hourly row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for hole_punch_results_hourly")
+	}
+
+	if !cached {
+		holePunchResultsHourlyUpdateCacheMut.Lock()
+		holePunchResultsHourlyUpdateCache[key] = cache
+		holePunchResultsHourlyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q holePunchResultsHourlyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for hole_punch_results_hourly")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for hole_punch_results_hourly")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o HolePunchResultsHourlySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holePunchResultsHourlyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"hole_punch_results_hourly\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, holePunchResultsHourlyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in holePunchResultsHourly slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all holePunchResultsHourly")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *HolePunchResultsHourly) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no hole_punch_results_hourly provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(holePunchResultsHourlyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	holePunchResultsHourlyUpsertCacheMut.RLock()
+	cache, cached := holePunchResultsHourlyUpsertCache[key]
+	holePunchResultsHourlyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			holePunchResultsHourlyAllColumns,
+			holePunchResultsHourlyColumnsWithDefault,
+			holePunchResultsHourlyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			holePunchResultsHourlyAllColumns,
+			holePunchResultsHourlyPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, holePunchResultsHourlyGeneratedColumns)
+		update = strmangle.SetComplement(update, holePunchResultsHourlyGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert hole_punch_results_hourly, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(holePunchResultsHourlyPrimaryKeyColumns))
+			copy(conflict, holePunchResultsHourlyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"hole_punch_results_hourly\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(holePunchResultsHourlyType, holePunchResultsHourlyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(holePunchResultsHourlyType, holePunchResultsHourlyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert hole_punch_results_hourly")
+	}
+
+	if !cached {
+		holePunchResultsHourlyUpsertCacheMut.Lock()
+		holePunchResultsHourlyUpsertCache[key] = cache
+		holePunchResultsHourlyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single HolePunchResultsHourly record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *HolePunchResultsHourly) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no HolePunchResultsHourly provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), holePunchResultsHourlyPrimaryKeyMapping)
+	sql := "DELETE FROM \"hole_punch_results_hourly\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from hole_punch_results_hourly")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for hole_punch_results_hourly")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q holePunchResultsHourlyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no holePunchResultsHourlyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from hole_punch_results_hourly")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for hole_punch_results_hourly")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o HolePunchResultsHourlySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(holePunchResultsHourlyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holePunchResultsHourlyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"hole_punch_results_hourly\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, holePunchResultsHourlyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from holePunchResultsHourly slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for hole_punch_results_hourly")
+	}
+
+	if len(holePunchResultsHourlyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *HolePunchResultsHourly) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindHolePunchResultsHourly(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *HolePunchResultsHourlySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := HolePunchResultsHourlySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holePunchResultsHourlyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"hole_punch_results_hourly\".* FROM \"hole_punch_results_hourly\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, holePunchResultsHourlyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in HolePunchResultsHourlySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// HolePunchResultsHourlyExists checks if the HolePunchResultsHourly row exists.
+func HolePunchResultsHourlyExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"hole_punch_results_hourly\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if hole_punch_results_hourly exists")
+	}
+
+	return exists, nil
+}
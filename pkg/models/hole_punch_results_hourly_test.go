@@ -0,0 +1,733 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testHolePunchResultsHourlies(t *testing.T) {
+	t.Parallel()
+
+	query := HolePunchResultsHourlies()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testHolePunchResultsHourliesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testHolePunchResultsHourliesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := HolePunchResultsHourlies().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testHolePunchResultsHourliesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := HolePunchResultsHourlySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testHolePunchResultsHourliesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := HolePunchResultsHourlyExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if HolePunchResultsHourly exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected HolePunchResultsHourlyExists to return true, but got false.")
+	}
+}
+
+func testHolePunchResultsHourliesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	holePunchResultsHourlyFound, err := FindHolePunchResultsHourly(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if holePunchResultsHourlyFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testHolePunchResultsHourliesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = HolePunchResultsHourlies().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testHolePunchResultsHourliesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := HolePunchResultsHourlies().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testHolePunchResultsHourliesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	holePunchResultsHourlyOne := &HolePunchResultsHourly{}
+	holePunchResultsHourlyTwo := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, holePunchResultsHourlyOne, holePunchResultsHourlyDBTypes, false, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+	if err = randomize.Struct(seed, holePunchResultsHourlyTwo, holePunchResultsHourlyDBTypes, false, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = holePunchResultsHourlyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = holePunchResultsHourlyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := HolePunchResultsHourlies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testHolePunchResultsHourliesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	holePunchResultsHourlyOne := &HolePunchResultsHourly{}
+	holePunchResultsHourlyTwo := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, holePunchResultsHourlyOne, holePunchResultsHourlyDBTypes, false, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+	if err = randomize.Struct(seed, holePunchResultsHourlyTwo, holePunchResultsHourlyDBTypes, false, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = holePunchResultsHourlyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = holePunchResultsHourlyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func holePunchResultsHourlyBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func holePunchResultsHourlyAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func holePunchResultsHourlyAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func holePunchResultsHourlyBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func holePunchResultsHourlyAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func holePunchResultsHourlyBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func holePunchResultsHourlyAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func holePunchResultsHourlyBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func holePunchResultsHourlyAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *HolePunchResultsHourly) error {
+	*o = HolePunchResultsHourly{}
+	return nil
+}
+
+func testHolePunchResultsHourliesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &HolePunchResultsHourly{}
+	o := &HolePunchResultsHourly{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly object: %s", err)
+	}
+
+	AddHolePunchResultsHourlyHook(boil.BeforeInsertHook, holePunchResultsHourlyBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyBeforeInsertHooks = []HolePunchResultsHourlyHook{}
+
+	AddHolePunchResultsHourlyHook(boil.AfterInsertHook, holePunchResultsHourlyAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyAfterInsertHooks = []HolePunchResultsHourlyHook{}
+
+	AddHolePunchResultsHourlyHook(boil.AfterSelectHook, holePunchResultsHourlyAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyAfterSelectHooks = []HolePunchResultsHourlyHook{}
+
+	AddHolePunchResultsHourlyHook(boil.BeforeUpdateHook, holePunchResultsHourlyBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyBeforeUpdateHooks = []HolePunchResultsHourlyHook{}
+
+	AddHolePunchResultsHourlyHook(boil.AfterUpdateHook, holePunchResultsHourlyAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyAfterUpdateHooks = []HolePunchResultsHourlyHook{}
+
+	AddHolePunchResultsHourlyHook(boil.BeforeDeleteHook, holePunchResultsHourlyBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyBeforeDeleteHooks = []HolePunchResultsHourlyHook{}
+
+	AddHolePunchResultsHourlyHook(boil.AfterDeleteHook, holePunchResultsHourlyAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyAfterDeleteHooks = []HolePunchResultsHourlyHook{}
+
+	AddHolePunchResultsHourlyHook(boil.BeforeUpsertHook, holePunchResultsHourlyBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyBeforeUpsertHooks = []HolePunchResultsHourlyHook{}
+
+	AddHolePunchResultsHourlyHook(boil.AfterUpsertHook, holePunchResultsHourlyAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	holePunchResultsHourlyAfterUpsertHooks = []HolePunchResultsHourlyHook{}
+}
+
+func testHolePunchResultsHourliesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testHolePunchResultsHourliesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(holePunchResultsHourlyColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testHolePunchResultsHourliesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testHolePunchResultsHourliesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := HolePunchResultsHourlySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testHolePunchResultsHourliesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := HolePunchResultsHourlies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	holePunchResultsHourlyDBTypes = map[string]string{`ID`: `bigint`, `Hour`: `timestamp with time zone`, `ProtocolFilters`: `ARRAYinteger`, `ClientAsn`: `integer`, `ClientCountry`: `character`, `RemoteAgentVersion`: `text`, `RelayID`: `text`, `Outcome`: `enum.hole_punch_outcome('UNKNOWN','NO_CONNECTION','NO_STREAM','CONNECTION_REVERSED','CANCELLED','FAILED','SUCCESS')`, `Results`: `integer`}
+	_                             = bytes.MinRead
+)
+
+func testHolePunchResultsHourliesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(holePunchResultsHourlyPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(holePunchResultsHourlyAllColumns) == len(holePunchResultsHourlyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testHolePunchResultsHourliesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(holePunchResultsHourlyAllColumns) == len(holePunchResultsHourlyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, holePunchResultsHourlyDBTypes, true, holePunchResultsHourlyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(holePunchResultsHourlyAllColumns, holePunchResultsHourlyPrimaryKeyColumns) {
+		fields = holePunchResultsHourlyAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			holePunchResultsHourlyAllColumns,
+			holePunchResultsHourlyPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, holePunchResultsHourlyGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := HolePunchResultsHourlySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testHolePunchResultsHourliesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(holePunchResultsHourlyAllColumns) == len(holePunchResultsHourlyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := HolePunchResultsHourly{}
+	if err = randomize.Struct(seed, &o, holePunchResultsHourlyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert HolePunchResultsHourly: %s", err)
+	}
+
+	count, err := HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, holePunchResultsHourlyDBTypes, false, holePunchResultsHourlyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResultsHourly struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert HolePunchResultsHourly: %s", err)
+	}
+
+	count, err = HolePunchResultsHourlies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -572,6 +572,84 @@ func testHolePunchResultToManyHolePunchAttempts(t *testing.T) {
 	}
 }
 
+func testHolePunchResultToManyHolePunchEvents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b, c HolePunchEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, true, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, holePunchEventDBTypes, false, holePunchEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, holePunchEventDBTypes, false, holePunchEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.HolePunchResultID = a.ID
+	c.HolePunchResultID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.HolePunchEvents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.HolePunchResultID == b.HolePunchResultID {
+			bFound = true
+		}
+		if v.HolePunchResultID == c.HolePunchResultID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := HolePunchResultSlice{&a}
+	if err = a.L.LoadHolePunchEvents(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.HolePunchEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.HolePunchEvents = nil
+	if err = a.L.LoadHolePunchEvents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.HolePunchEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testHolePunchResultToManyHolePunchResultsXMultiAddresses(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -806,6 +884,84 @@ func testHolePunchResultToManyPortMappings(t *testing.T) {
 	}
 }
 
+func testHolePunchResultToManyRelayDials(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b, c RelayDial
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, true, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, relayDialDBTypes, false, relayDialColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, relayDialDBTypes, false, relayDialColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.HolePunchResultID = a.ID
+	c.HolePunchResultID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RelayDials().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.HolePunchResultID == b.HolePunchResultID {
+			bFound = true
+		}
+		if v.HolePunchResultID == c.HolePunchResultID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := HolePunchResultSlice{&a}
+	if err = a.L.LoadRelayDials(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RelayDials); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RelayDials = nil
+	if err = a.L.LoadRelayDials(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RelayDials); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testHolePunchResultToManyAddOpHolePunchAttempts(t *testing.T) {
 	var err error
 
@@ -881,6 +1037,81 @@ func testHolePunchResultToManyAddOpHolePunchAttempts(t *testing.T) {
 		}
 	}
 }
+func testHolePunchResultToManyAddOpHolePunchEvents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b, c, d, e HolePunchEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*HolePunchEvent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, holePunchEventDBTypes, false, strmangle.SetComplement(holePunchEventPrimaryKeyColumns, holePunchEventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*HolePunchEvent{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddHolePunchEvents(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.HolePunchResultID {
+			t.Error("foreign key was wrong value", a.ID, first.HolePunchResultID)
+		}
+		if a.ID != second.HolePunchResultID {
+			t.Error("foreign key was wrong value", a.ID, second.HolePunchResultID)
+		}
+
+		if first.R.HolePunchResult != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.HolePunchResult != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.HolePunchEvents[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.HolePunchEvents[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.HolePunchEvents().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testHolePunchResultToManyAddOpHolePunchResultsXMultiAddresses(t *testing.T) {
 	var err error
 
@@ -1106,156 +1337,654 @@ func testHolePunchResultToManyAddOpPortMappings(t *testing.T) {
 		}
 	}
 }
-func testHolePunchResultToOneMultiAddressesSetUsingListenMultiAddressesSet(t *testing.T) {
+func testHolePunchResultToManyAddOpRelayDials(t *testing.T) {
+	var err error
+
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
-	var local HolePunchResult
-	var foreign MultiAddressesSet
+	var a HolePunchResult
+	var b, c, d, e RelayDial
 
 	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
-	if err := randomize.Struct(seed, &foreign, multiAddressesSetDBTypes, false, multiAddressesSetColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize MultiAddressesSet struct: %s", err)
+	foreigners := []*RelayDial{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, relayDialDBTypes, false, strmangle.SetComplement(relayDialPrimaryKeyColumns, relayDialColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
-	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	local.ListenMultiAddressesSetID = foreign.ID
-	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	check, err := local.ListenMultiAddressesSet().One(ctx, tx)
-	if err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	if check.ID != foreign.ID {
-		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	foreignersSplitByInsertion := [][]*RelayDial{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	slice := HolePunchResultSlice{&local}
-	if err = local.L.LoadListenMultiAddressesSet(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.ListenMultiAddressesSet == nil {
-		t.Error("struct should have been eager loaded")
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRelayDials(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	local.R.ListenMultiAddressesSet = nil
-	if err = local.L.LoadListenMultiAddressesSet(ctx, tx, true, &local, nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.ListenMultiAddressesSet == nil {
-		t.Error("struct should have been eager loaded")
-	}
-}
+		first := x[0]
+		second := x[1]
 
-func testHolePunchResultToOnePeerUsingLocal(t *testing.T) {
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
+		if a.ID != first.HolePunchResultID {
+			t.Error("foreign key was wrong value", a.ID, first.HolePunchResultID)
+		}
+		if a.ID != second.HolePunchResultID {
+			t.Error("foreign key was wrong value", a.ID, second.HolePunchResultID)
+		}
+
+		if first.R.HolePunchResult != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.HolePunchResult != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RelayDials[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RelayDials[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RelayDials().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testHolePunchResultToOneAllocationUsingAllocation(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local HolePunchResult
+	var foreign Allocation
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, true, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Allocation struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AllocationID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Allocation().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := HolePunchResultSlice{&local}
+	if err = local.L.LoadAllocation(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Allocation == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Allocation = nil
+	if err = local.L.LoadAllocation(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Allocation == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testHolePunchResultToOneAuthorizationUsingAuthorization(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local HolePunchResult
+	var foreign Authorization
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, true, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, authorizationDBTypes, false, authorizationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Authorization struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AuthorizationID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Authorization().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := HolePunchResultSlice{&local}
+	if err = local.L.LoadAuthorization(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Authorization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Authorization = nil
+	if err = local.L.LoadAuthorization(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Authorization == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testHolePunchResultToOneMultiAddressesSetUsingListenMultiAddressesSet(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local HolePunchResult
+	var foreign MultiAddressesSet
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, multiAddressesSetDBTypes, false, multiAddressesSetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MultiAddressesSet struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ListenMultiAddressesSetID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ListenMultiAddressesSet().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := HolePunchResultSlice{&local}
+	if err = local.L.LoadListenMultiAddressesSet(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ListenMultiAddressesSet == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ListenMultiAddressesSet = nil
+	if err = local.L.LoadListenMultiAddressesSet(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ListenMultiAddressesSet == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testHolePunchResultToOnePeerUsingLocal(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local HolePunchResult
+	var foreign Peer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Peer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.LocalID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Local().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := HolePunchResultSlice{&local}
+	if err = local.L.LoadLocal(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Local == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Local = nil
+	if err = local.L.LoadLocal(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Local == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testHolePunchResultToOnePeerUsingRelay(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local HolePunchResult
+	var foreign Peer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, true, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Peer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.RelayID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Relay().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := HolePunchResultSlice{&local}
+	if err = local.L.LoadRelay(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Relay == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Relay = nil
+	if err = local.L.LoadRelay(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Relay == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testHolePunchResultToOneMultiAddressUsingRelayMultiAddress(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local HolePunchResult
+	var foreign MultiAddress
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, true, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, multiAddressDBTypes, false, multiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MultiAddress struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.RelayMultiAddressID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.RelayMultiAddress().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := HolePunchResultSlice{&local}
+	if err = local.L.LoadRelayMultiAddress(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RelayMultiAddress == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.RelayMultiAddress = nil
+	if err = local.L.LoadRelayMultiAddress(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RelayMultiAddress == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testHolePunchResultToOnePeerUsingRemote(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local HolePunchResult
+	var foreign Peer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Peer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RemoteID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Remote().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := HolePunchResultSlice{&local}
+	if err = local.L.LoadRemote(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Remote == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Remote = nil
+	if err = local.L.LoadRemote(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Remote == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testHolePunchResultToOneSetOpAllocationUsingAllocation(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b, c Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Allocation{&b, &c} {
+		err = a.SetAllocation(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Allocation != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.HolePunchResult != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AllocationID, x.ID) {
+			t.Error("foreign key was wrong value", a.AllocationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AllocationID))
+		reflect.Indirect(reflect.ValueOf(&a.AllocationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AllocationID, x.ID) {
+			t.Error("foreign key was wrong value", a.AllocationID, x.ID)
+		}
+	}
+}
+
+func testHolePunchResultToOneRemoveOpAllocationUsingAllocation(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetAllocation(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveAllocation(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Allocation().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Allocation != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.AllocationID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if b.R.HolePunchResult != nil {
+		t.Error("failed to remove a from b's relationships")
+	}
+
+}
+
+func testHolePunchResultToOneSetOpAuthorizationUsingAuthorization(t *testing.T) {
+	var err error
 
-	var local HolePunchResult
-	var foreign Peer
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b, c Authorization
 
 	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
-	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Peer struct: %s", err)
+	if err = randomize.Struct(seed, &b, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
-
-	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &c, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
 
-	local.LocalID = foreign.ID
-	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	check, err := local.Local().One(ctx, tx)
-	if err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	if check.ID != foreign.ID {
-		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
-	}
+	for i, x := range []*Authorization{&b, &c} {
+		err = a.SetAuthorization(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	slice := HolePunchResultSlice{&local}
-	if err = local.L.LoadLocal(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.Local == nil {
-		t.Error("struct should have been eager loaded")
-	}
+		if a.R.Authorization != x {
+			t.Error("relationship struct not set to correct value")
+		}
 
-	local.R.Local = nil
-	if err = local.L.LoadLocal(ctx, tx, true, &local, nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.Local == nil {
-		t.Error("struct should have been eager loaded")
+		if x.R.HolePunchResults[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AuthorizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.AuthorizationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AuthorizationID))
+		reflect.Indirect(reflect.ValueOf(&a.AuthorizationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AuthorizationID, x.ID) {
+			t.Error("foreign key was wrong value", a.AuthorizationID, x.ID)
+		}
 	}
 }
 
-func testHolePunchResultToOnePeerUsingRemote(t *testing.T) {
+func testHolePunchResultToOneRemoveOpAuthorizationUsingAuthorization(t *testing.T) {
+	var err error
+
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
-	var local HolePunchResult
-	var foreign Peer
+	var a HolePunchResult
+	var b Authorization
 
 	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &local, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
-	}
-	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Peer struct: %s", err)
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
-
-	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &b, authorizationDBTypes, false, strmangle.SetComplement(authorizationPrimaryKeyColumns, authorizationColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
 
-	local.RemoteID = foreign.ID
-	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	check, err := local.Remote().One(ctx, tx)
-	if err != nil {
+	if err = a.SetAuthorization(ctx, tx, true, &b); err != nil {
 		t.Fatal(err)
 	}
 
-	if check.ID != foreign.ID {
-		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	if err = a.RemoveAuthorization(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
 	}
 
-	slice := HolePunchResultSlice{&local}
-	if err = local.L.LoadRemote(ctx, tx, false, (*[]*HolePunchResult)(&slice), nil); err != nil {
-		t.Fatal(err)
+	count, err := a.Authorization().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
 	}
-	if local.R.Remote == nil {
-		t.Error("struct should have been eager loaded")
+	if count != 0 {
+		t.Error("want no relationships remaining")
 	}
 
-	local.R.Remote = nil
-	if err = local.L.LoadRemote(ctx, tx, true, &local, nil); err != nil {
-		t.Fatal(err)
+	if a.R.Authorization != nil {
+		t.Error("R struct entry should be nil")
 	}
-	if local.R.Remote == nil {
-		t.Error("struct should have been eager loaded")
+
+	if !queries.IsValuerNil(a.AuthorizationID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.HolePunchResults) != 0 {
+		t.Error("failed to remove a from b's relationships")
 	}
 }
 
@@ -1373,6 +2102,224 @@ func testHolePunchResultToOneSetOpPeerUsingLocal(t *testing.T) {
 		}
 	}
 }
+func testHolePunchResultToOneSetOpPeerUsingRelay(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b, c Peer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Peer{&b, &c} {
+		err = a.SetRelay(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Relay != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RelayHolePunchResults[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.RelayID, x.ID) {
+			t.Error("foreign key was wrong value", a.RelayID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RelayID))
+		reflect.Indirect(reflect.ValueOf(&a.RelayID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.RelayID, x.ID) {
+			t.Error("foreign key was wrong value", a.RelayID, x.ID)
+		}
+	}
+}
+
+func testHolePunchResultToOneRemoveOpPeerUsingRelay(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b Peer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetRelay(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveRelay(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Relay().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Relay != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.RelayID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.RelayHolePunchResults) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testHolePunchResultToOneSetOpMultiAddressUsingRelayMultiAddress(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b, c MultiAddress
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*MultiAddress{&b, &c} {
+		err = a.SetRelayMultiAddress(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.RelayMultiAddress != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RelayMultiAddressHolePunchResults[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.RelayMultiAddressID, x.ID) {
+			t.Error("foreign key was wrong value", a.RelayMultiAddressID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RelayMultiAddressID))
+		reflect.Indirect(reflect.ValueOf(&a.RelayMultiAddressID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.RelayMultiAddressID, x.ID) {
+			t.Error("foreign key was wrong value", a.RelayMultiAddressID, x.ID)
+		}
+	}
+}
+
+func testHolePunchResultToOneRemoveOpMultiAddressUsingRelayMultiAddress(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a HolePunchResult
+	var b MultiAddress
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetRelayMultiAddress(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveRelayMultiAddress(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.RelayMultiAddress().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.RelayMultiAddress != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.RelayMultiAddressID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.RelayMultiAddressHolePunchResults) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testHolePunchResultToOneSetOpPeerUsingRemote(t *testing.T) {
 	var err error
 
@@ -1505,7 +2452,7 @@ func testHolePunchResultsSelect(t *testing.T) {
 }
 
 var (
-	holePunchResultDBTypes = map[string]string{`ID`: `integer`, `LocalID`: `bigint`, `RemoteID`: `bigint`, `ConnectStartedAt`: `timestamp with time zone`, `ConnectEndedAt`: `timestamp with time zone`, `HasDirectConns`: `boolean`, `Error`: `text`, `Outcome`: `enum.hole_punch_outcome('UNKNOWN','NO_CONNECTION','NO_STREAM','CONNECTION_REVERSED','CANCELLED','FAILED','SUCCESS')`, `EndedAt`: `timestamp with time zone`, `ProtocolFilters`: `ARRAYinteger`, `UpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `ListenMultiAddressesSetID`: `integer`}
+	holePunchResultDBTypes = map[string]string{`ID`: `integer`, `LocalID`: `bigint`, `RemoteID`: `bigint`, `ConnectStartedAt`: `timestamp with time zone`, `ConnectEndedAt`: `timestamp with time zone`, `HasDirectConns`: `boolean`, `Error`: `text`, `Outcome`: `enum.hole_punch_outcome('UNKNOWN','NO_CONNECTION','NO_STREAM','CONNECTION_REVERSED','CANCELLED','FAILED','SUCCESS')`, `EndedAt`: `timestamp with time zone`, `ProtocolFilters`: `ARRAYinteger`, `UpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `ListenMultiAddressesSetID`: `integer`, `AuthorizationID`: `integer`, `ValidationStatus`: `enum.validation_status('ACCEPTED','FLAGGED','REJECTED')`, `ValidationReasons`: `ARRAYtext`, `AllocationID`: `bigint`, `RelayID`: `bigint`, `RelayStrategy`: `enum.relay_strategy('ALL','FIRST','LOWEST_RTT','RANDOM','SERVER')`, `RelayMultiAddressID`: `bigint`, `CommunicationTimeout`: `interval`, `RetryCount`: `integer`, `PingDuration`: `interval`, `MaxPingCount`: `integer`}
 	_                      = bytes.MinRead
 )
 
@@ -33,6 +33,8 @@ type IPAddress struct {
 	Country        null.String `boil:"country" json:"country,omitempty" toml:"country" yaml:"country,omitempty"`
 	Continent      null.String `boil:"continent" json:"continent,omitempty" toml:"continent" yaml:"continent,omitempty"`
 	Address        string      `boil:"address" json:"address" toml:"address" yaml:"address"`
+	City           null.String `boil:"city" json:"city,omitempty" toml:"city" yaml:"city,omitempty"`
+	ConnectionType null.String `boil:"connection_type" json:"connection_type,omitempty" toml:"connection_type" yaml:"connection_type,omitempty"`
 
 	R *ipAddressR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L ipAddressL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +50,8 @@ var IPAddressColumns = struct {
 	Country        string
 	Continent      string
 	Address        string
+	City           string
+	ConnectionType string
 }{
 	ID:             "id",
 	MultiAddressID: "multi_address_id",
@@ -58,6 +62,8 @@ var IPAddressColumns = struct {
 	Country:        "country",
 	Continent:      "continent",
 	Address:        "address",
+	City:           "city",
+	ConnectionType: "connection_type",
 }
 
 var IPAddressTableColumns = struct {
@@ -70,6 +76,8 @@ var IPAddressTableColumns = struct {
 	Country        string
 	Continent      string
 	Address        string
+	City           string
+	ConnectionType string
 }{
 	ID:             "ip_addresses.id",
 	MultiAddressID: "ip_addresses.multi_address_id",
@@ -80,48 +88,12 @@ var IPAddressTableColumns = struct {
 	Country:        "ip_addresses.country",
 	Continent:      "ip_addresses.continent",
 	Address:        "ip_addresses.address",
+	City:           "ip_addresses.city",
+	ConnectionType: "ip_addresses.connection_type",
 }
 
 // Generated where
 
-type whereHelpernull_Int struct{ field string }
-
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var IPAddressWhere = struct {
 	ID             whereHelperint
 	MultiAddressID whereHelperint64
@@ -132,6 +104,8 @@ var IPAddressWhere = struct {
 	Country        whereHelpernull_String
 	Continent      whereHelpernull_String
 	Address        whereHelperstring
+	City           whereHelpernull_String
+	ConnectionType whereHelpernull_String
 }{
 	ID:             whereHelperint{field: "\"ip_addresses\".\"id\""},
 	MultiAddressID: whereHelperint64{field: "\"ip_addresses\".\"multi_address_id\""},
@@ -142,6 +116,8 @@ var IPAddressWhere = struct {
 	Country:        whereHelpernull_String{field: "\"ip_addresses\".\"country\""},
 	Continent:      whereHelpernull_String{field: "\"ip_addresses\".\"continent\""},
 	Address:        whereHelperstring{field: "\"ip_addresses\".\"address\""},
+	City:           whereHelpernull_String{field: "\"ip_addresses\".\"city\""},
+	ConnectionType: whereHelpernull_String{field: "\"ip_addresses\".\"connection_type\""},
 }
 
 // IPAddressRels is where relationship names are stored.
@@ -172,9 +148,9 @@ func (r *ipAddressR) GetMultiAddress() *MultiAddress {
 type ipAddressL struct{}
 
 var (
-	ipAddressAllColumns            = []string{"id", "multi_address_id", "asn", "is_cloud", "updated_at", "created_at", "country", "continent", "address"}
+	ipAddressAllColumns            = []string{"id", "multi_address_id", "asn", "is_cloud", "updated_at", "created_at", "country", "continent", "address", "city", "connection_type"}
 	ipAddressColumnsWithoutDefault = []string{"multi_address_id", "updated_at", "created_at", "address"}
-	ipAddressColumnsWithDefault    = []string{"id", "asn", "is_cloud", "country", "continent"}
+	ipAddressColumnsWithDefault    = []string{"id", "asn", "is_cloud", "country", "continent", "city", "connection_type"}
 	ipAddressPrimaryKeyColumns     = []string{"id"}
 	ipAddressGeneratedColumns      = []string{"id"}
 )
@@ -677,7 +677,7 @@ func testIPAddressesSelect(t *testing.T) {
 }
 
 var (
-	ipAddressDBTypes = map[string]string{`ID`: `integer`, `MultiAddressID`: `bigint`, `Asn`: `integer`, `IsCloud`: `integer`, `UpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `Country`: `character`, `Continent`: `character`, `Address`: `inet`}
+	ipAddressDBTypes = map[string]string{`ID`: `integer`, `MultiAddressID`: `bigint`, `Asn`: `integer`, `IsCloud`: `integer`, `UpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `Country`: `character`, `Continent`: `character`, `Address`: `inet`, `City`: `text`, `ConnectionType`: `enum.connection_type('MOBILE','RESIDENTIAL','BUSINESS','HOSTING','EDUCATION','SATELLITE')`}
 	_                = bytes.MinRead
 )
 
@@ -24,158 +24,158 @@ import (
 
 // MultiAddress is an object representing the database table.
 type MultiAddress struct {
-	ID           int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Asn          null.Int    `boil:"asn" json:"asn,omitempty" toml:"asn" yaml:"asn,omitempty"`
-	IsCloud      null.Int    `boil:"is_cloud" json:"is_cloud,omitempty" toml:"is_cloud" yaml:"is_cloud,omitempty"`
-	IsRelay      null.Bool   `boil:"is_relay" json:"is_relay,omitempty" toml:"is_relay" yaml:"is_relay,omitempty"`
-	IsPublic     null.Bool   `boil:"is_public" json:"is_public,omitempty" toml:"is_public" yaml:"is_public,omitempty"`
-	Addr         null.String `boil:"addr" json:"addr,omitempty" toml:"addr" yaml:"addr,omitempty"`
-	HasManyAddrs null.Bool   `boil:"has_many_addrs" json:"has_many_addrs,omitempty" toml:"has_many_addrs" yaml:"has_many_addrs,omitempty"`
-	Country      null.String `boil:"country" json:"country,omitempty" toml:"country" yaml:"country,omitempty"`
-	Continent    null.String `boil:"continent" json:"continent,omitempty" toml:"continent" yaml:"continent,omitempty"`
-	Maddr        string      `boil:"maddr" json:"maddr" toml:"maddr" yaml:"maddr"`
-	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID                int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Asn               null.Int    `boil:"asn" json:"asn,omitempty" toml:"asn" yaml:"asn,omitempty"`
+	IsCloud           null.Int    `boil:"is_cloud" json:"is_cloud,omitempty" toml:"is_cloud" yaml:"is_cloud,omitempty"`
+	IsRelay           null.Bool   `boil:"is_relay" json:"is_relay,omitempty" toml:"is_relay" yaml:"is_relay,omitempty"`
+	IsPublic          null.Bool   `boil:"is_public" json:"is_public,omitempty" toml:"is_public" yaml:"is_public,omitempty"`
+	Addr              null.String `boil:"addr" json:"addr,omitempty" toml:"addr" yaml:"addr,omitempty"`
+	HasManyAddrs      null.Bool   `boil:"has_many_addrs" json:"has_many_addrs,omitempty" toml:"has_many_addrs" yaml:"has_many_addrs,omitempty"`
+	Country           null.String `boil:"country" json:"country,omitempty" toml:"country" yaml:"country,omitempty"`
+	Continent         null.String `boil:"continent" json:"continent,omitempty" toml:"continent" yaml:"continent,omitempty"`
+	Maddr             string      `boil:"maddr" json:"maddr" toml:"maddr" yaml:"maddr"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	City              null.String `boil:"city" json:"city,omitempty" toml:"city" yaml:"city,omitempty"`
+	ConnectionType    null.String `boil:"connection_type" json:"connection_type,omitempty" toml:"connection_type" yaml:"connection_type,omitempty"`
+	EnrichmentVersion null.String `boil:"enrichment_version" json:"enrichment_version,omitempty" toml:"enrichment_version" yaml:"enrichment_version,omitempty"`
 
 	R *multiAddressR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L multiAddressL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var MultiAddressColumns = struct {
-	ID           string
-	Asn          string
-	IsCloud      string
-	IsRelay      string
-	IsPublic     string
-	Addr         string
-	HasManyAddrs string
-	Country      string
-	Continent    string
-	Maddr        string
-	UpdatedAt    string
-	CreatedAt    string
+	ID                string
+	Asn               string
+	IsCloud           string
+	IsRelay           string
+	IsPublic          string
+	Addr              string
+	HasManyAddrs      string
+	Country           string
+	Continent         string
+	Maddr             string
+	UpdatedAt         string
+	CreatedAt         string
+	City              string
+	ConnectionType    string
+	EnrichmentVersion string
 }{
-	ID:           "id",
-	Asn:          "asn",
-	IsCloud:      "is_cloud",
-	IsRelay:      "is_relay",
-	IsPublic:     "is_public",
-	Addr:         "addr",
-	HasManyAddrs: "has_many_addrs",
-	Country:      "country",
-	Continent:    "continent",
-	Maddr:        "maddr",
-	UpdatedAt:    "updated_at",
-	CreatedAt:    "created_at",
+	ID:                "id",
+	Asn:               "asn",
+	IsCloud:           "is_cloud",
+	IsRelay:           "is_relay",
+	IsPublic:          "is_public",
+	Addr:              "addr",
+	HasManyAddrs:      "has_many_addrs",
+	Country:           "country",
+	Continent:         "continent",
+	Maddr:             "maddr",
+	UpdatedAt:         "updated_at",
+	CreatedAt:         "created_at",
+	City:              "city",
+	ConnectionType:    "connection_type",
+	EnrichmentVersion: "enrichment_version",
 }
 
 var MultiAddressTableColumns = struct {
-	ID           string
-	Asn          string
-	IsCloud      string
-	IsRelay      string
-	IsPublic     string
-	Addr         string
-	HasManyAddrs string
-	Country      string
-	Continent    string
-	Maddr        string
-	UpdatedAt    string
-	CreatedAt    string
+	ID                string
+	Asn               string
+	IsCloud           string
+	IsRelay           string
+	IsPublic          string
+	Addr              string
+	HasManyAddrs      string
+	Country           string
+	Continent         string
+	Maddr             string
+	UpdatedAt         string
+	CreatedAt         string
+	City              string
+	ConnectionType    string
+	EnrichmentVersion string
 }{
-	ID:           "multi_addresses.id",
-	Asn:          "multi_addresses.asn",
-	IsCloud:      "multi_addresses.is_cloud",
-	IsRelay:      "multi_addresses.is_relay",
-	IsPublic:     "multi_addresses.is_public",
-	Addr:         "multi_addresses.addr",
-	HasManyAddrs: "multi_addresses.has_many_addrs",
-	Country:      "multi_addresses.country",
-	Continent:    "multi_addresses.continent",
-	Maddr:        "multi_addresses.maddr",
-	UpdatedAt:    "multi_addresses.updated_at",
-	CreatedAt:    "multi_addresses.created_at",
+	ID:                "multi_addresses.id",
+	Asn:               "multi_addresses.asn",
+	IsCloud:           "multi_addresses.is_cloud",
+	IsRelay:           "multi_addresses.is_relay",
+	IsPublic:          "multi_addresses.is_public",
+	Addr:              "multi_addresses.addr",
+	HasManyAddrs:      "multi_addresses.has_many_addrs",
+	Country:           "multi_addresses.country",
+	Continent:         "multi_addresses.continent",
+	Maddr:             "multi_addresses.maddr",
+	UpdatedAt:         "multi_addresses.updated_at",
+	CreatedAt:         "multi_addresses.created_at",
+	City:              "multi_addresses.city",
+	ConnectionType:    "multi_addresses.connection_type",
+	EnrichmentVersion: "multi_addresses.enrichment_version",
 }
 
 // Generated where
 
-type whereHelpernull_Bool struct{ field string }
-
-func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var MultiAddressWhere = struct {
-	ID           whereHelperint64
-	Asn          whereHelpernull_Int
-	IsCloud      whereHelpernull_Int
-	IsRelay      whereHelpernull_Bool
-	IsPublic     whereHelpernull_Bool
-	Addr         whereHelpernull_String
-	HasManyAddrs whereHelpernull_Bool
-	Country      whereHelpernull_String
-	Continent    whereHelpernull_String
-	Maddr        whereHelperstring
-	UpdatedAt    whereHelpertime_Time
-	CreatedAt    whereHelpertime_Time
+	ID                whereHelperint64
+	Asn               whereHelpernull_Int
+	IsCloud           whereHelpernull_Int
+	IsRelay           whereHelpernull_Bool
+	IsPublic          whereHelpernull_Bool
+	Addr              whereHelpernull_String
+	HasManyAddrs      whereHelpernull_Bool
+	Country           whereHelpernull_String
+	Continent         whereHelpernull_String
+	Maddr             whereHelperstring
+	UpdatedAt         whereHelpertime_Time
+	CreatedAt         whereHelpertime_Time
+	City              whereHelpernull_String
+	ConnectionType    whereHelpernull_String
+	EnrichmentVersion whereHelpernull_String
 }{
-	ID:           whereHelperint64{field: "\"multi_addresses\".\"id\""},
-	Asn:          whereHelpernull_Int{field: "\"multi_addresses\".\"asn\""},
-	IsCloud:      whereHelpernull_Int{field: "\"multi_addresses\".\"is_cloud\""},
-	IsRelay:      whereHelpernull_Bool{field: "\"multi_addresses\".\"is_relay\""},
-	IsPublic:     whereHelpernull_Bool{field: "\"multi_addresses\".\"is_public\""},
-	Addr:         whereHelpernull_String{field: "\"multi_addresses\".\"addr\""},
-	HasManyAddrs: whereHelpernull_Bool{field: "\"multi_addresses\".\"has_many_addrs\""},
-	Country:      whereHelpernull_String{field: "\"multi_addresses\".\"country\""},
-	Continent:    whereHelpernull_String{field: "\"multi_addresses\".\"continent\""},
-	Maddr:        whereHelperstring{field: "\"multi_addresses\".\"maddr\""},
-	UpdatedAt:    whereHelpertime_Time{field: "\"multi_addresses\".\"updated_at\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"multi_addresses\".\"created_at\""},
+	ID:                whereHelperint64{field: "\"multi_addresses\".\"id\""},
+	Asn:               whereHelpernull_Int{field: "\"multi_addresses\".\"asn\""},
+	IsCloud:           whereHelpernull_Int{field: "\"multi_addresses\".\"is_cloud\""},
+	IsRelay:           whereHelpernull_Bool{field: "\"multi_addresses\".\"is_relay\""},
+	IsPublic:          whereHelpernull_Bool{field: "\"multi_addresses\".\"is_public\""},
+	Addr:              whereHelpernull_String{field: "\"multi_addresses\".\"addr\""},
+	HasManyAddrs:      whereHelpernull_Bool{field: "\"multi_addresses\".\"has_many_addrs\""},
+	Country:           whereHelpernull_String{field: "\"multi_addresses\".\"country\""},
+	Continent:         whereHelpernull_String{field: "\"multi_addresses\".\"continent\""},
+	Maddr:             whereHelperstring{field: "\"multi_addresses\".\"maddr\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"multi_addresses\".\"updated_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"multi_addresses\".\"created_at\""},
+	City:              whereHelpernull_String{field: "\"multi_addresses\".\"city\""},
+	ConnectionType:    whereHelpernull_String{field: "\"multi_addresses\".\"connection_type\""},
+	EnrichmentVersion: whereHelpernull_String{field: "\"multi_addresses\".\"enrichment_version\""},
 }
 
 // MultiAddressRels is where relationship names are stored.
 var MultiAddressRels = struct {
-	ConnMultiAddressConnectionEvents string
-	ConnectionEvents                 string
-	HolePunchAttempts                string
-	HolePunchResultsXMultiAddresses  string
-	IPAddresses                      string
-	LatencyMeasurements              string
+	ConnMultiAddressConnectionEvents  string
+	ConnectionEventsXMultiAddresses   string
+	HolePunchAttempts                 string
+	RelayMultiAddressHolePunchResults string
+	HolePunchResultsXMultiAddresses   string
+	IPAddresses                       string
+	LatencyMeasurements               string
 }{
-	ConnMultiAddressConnectionEvents: "ConnMultiAddressConnectionEvents",
-	ConnectionEvents:                 "ConnectionEvents",
-	HolePunchAttempts:                "HolePunchAttempts",
-	HolePunchResultsXMultiAddresses:  "HolePunchResultsXMultiAddresses",
-	IPAddresses:                      "IPAddresses",
-	LatencyMeasurements:              "LatencyMeasurements",
+	ConnMultiAddressConnectionEvents:  "ConnMultiAddressConnectionEvents",
+	ConnectionEventsXMultiAddresses:   "ConnectionEventsXMultiAddresses",
+	HolePunchAttempts:                 "HolePunchAttempts",
+	RelayMultiAddressHolePunchResults: "RelayMultiAddressHolePunchResults",
+	HolePunchResultsXMultiAddresses:   "HolePunchResultsXMultiAddresses",
+	IPAddresses:                       "IPAddresses",
+	LatencyMeasurements:               "LatencyMeasurements",
 }
 
 // multiAddressR is where relationships are stored.
 type multiAddressR struct {
-	ConnMultiAddressConnectionEvents ConnectionEventSlice               `boil:"ConnMultiAddressConnectionEvents" json:"ConnMultiAddressConnectionEvents" toml:"ConnMultiAddressConnectionEvents" yaml:"ConnMultiAddressConnectionEvents"`
-	ConnectionEvents                 ConnectionEventSlice               `boil:"ConnectionEvents" json:"ConnectionEvents" toml:"ConnectionEvents" yaml:"ConnectionEvents"`
-	HolePunchAttempts                HolePunchAttemptSlice              `boil:"HolePunchAttempts" json:"HolePunchAttempts" toml:"HolePunchAttempts" yaml:"HolePunchAttempts"`
-	HolePunchResultsXMultiAddresses  HolePunchResultsXMultiAddressSlice `boil:"HolePunchResultsXMultiAddresses" json:"HolePunchResultsXMultiAddresses" toml:"HolePunchResultsXMultiAddresses" yaml:"HolePunchResultsXMultiAddresses"`
-	IPAddresses                      IPAddressSlice                     `boil:"IPAddresses" json:"IPAddresses" toml:"IPAddresses" yaml:"IPAddresses"`
-	LatencyMeasurements              LatencyMeasurementSlice            `boil:"LatencyMeasurements" json:"LatencyMeasurements" toml:"LatencyMeasurements" yaml:"LatencyMeasurements"`
+	ConnMultiAddressConnectionEvents  ConnectionEventSlice               `boil:"ConnMultiAddressConnectionEvents" json:"ConnMultiAddressConnectionEvents" toml:"ConnMultiAddressConnectionEvents" yaml:"ConnMultiAddressConnectionEvents"`
+	ConnectionEventsXMultiAddresses   ConnectionEventsXMultiAddressSlice `boil:"ConnectionEventsXMultiAddresses" json:"ConnectionEventsXMultiAddresses" toml:"ConnectionEventsXMultiAddresses" yaml:"ConnectionEventsXMultiAddresses"`
+	HolePunchAttempts                 HolePunchAttemptSlice              `boil:"HolePunchAttempts" json:"HolePunchAttempts" toml:"HolePunchAttempts" yaml:"HolePunchAttempts"`
+	RelayMultiAddressHolePunchResults HolePunchResultSlice               `boil:"RelayMultiAddressHolePunchResults" json:"RelayMultiAddressHolePunchResults" toml:"RelayMultiAddressHolePunchResults" yaml:"RelayMultiAddressHolePunchResults"`
+	HolePunchResultsXMultiAddresses   HolePunchResultsXMultiAddressSlice `boil:"HolePunchResultsXMultiAddresses" json:"HolePunchResultsXMultiAddresses" toml:"HolePunchResultsXMultiAddresses" yaml:"HolePunchResultsXMultiAddresses"`
+	IPAddresses                       IPAddressSlice                     `boil:"IPAddresses" json:"IPAddresses" toml:"IPAddresses" yaml:"IPAddresses"`
+	LatencyMeasurements               LatencyMeasurementSlice            `boil:"LatencyMeasurements" json:"LatencyMeasurements" toml:"LatencyMeasurements" yaml:"LatencyMeasurements"`
 }
 
 // NewStruct creates a new relationship struct
@@ -190,11 +190,11 @@ func (r *multiAddressR) GetConnMultiAddressConnectionEvents() ConnectionEventSli
 	return r.ConnMultiAddressConnectionEvents
 }
 
-func (r *multiAddressR) GetConnectionEvents() ConnectionEventSlice {
+func (r *multiAddressR) GetConnectionEventsXMultiAddresses() ConnectionEventsXMultiAddressSlice {
 	if r == nil {
 		return nil
 	}
-	return r.ConnectionEvents
+	return r.ConnectionEventsXMultiAddresses
 }
 
 func (r *multiAddressR) GetHolePunchAttempts() HolePunchAttemptSlice {
@@ -204,6 +204,13 @@ func (r *multiAddressR) GetHolePunchAttempts() HolePunchAttemptSlice {
 	return r.HolePunchAttempts
 }
 
+func (r *multiAddressR) GetRelayMultiAddressHolePunchResults() HolePunchResultSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RelayMultiAddressHolePunchResults
+}
+
 func (r *multiAddressR) GetHolePunchResultsXMultiAddresses() HolePunchResultsXMultiAddressSlice {
 	if r == nil {
 		return nil
@@ -229,9 +236,9 @@ func (r *multiAddressR) GetLatencyMeasurements() LatencyMeasurementSlice {
 type multiAddressL struct{}
 
 var (
-	multiAddressAllColumns            = []string{"id", "asn", "is_cloud", "is_relay", "is_public", "addr", "has_many_addrs", "country", "continent", "maddr", "updated_at", "created_at"}
+	multiAddressAllColumns            = []string{"id", "asn", "is_cloud", "is_relay", "is_public", "addr", "has_many_addrs", "country", "continent", "maddr", "updated_at", "created_at", "city", "connection_type", "enrichment_version"}
 	multiAddressColumnsWithoutDefault = []string{"maddr", "updated_at", "created_at"}
-	multiAddressColumnsWithDefault    = []string{"id", "asn", "is_cloud", "is_relay", "is_public", "addr", "has_many_addrs", "country", "continent"}
+	multiAddressColumnsWithDefault    = []string{"id", "asn", "is_cloud", "is_relay", "is_public", "addr", "has_many_addrs", "country", "continent", "city", "connection_type", "enrichment_version"}
 	multiAddressPrimaryKeyColumns     = []string{"id"}
 	multiAddressGeneratedColumns      = []string{"id"}
 )
@@ -528,19 +535,18 @@ func (o *MultiAddress) ConnMultiAddressConnectionEvents(mods ...qm.QueryMod) con
 	return ConnectionEvents(queryMods...)
 }
 
-// ConnectionEvents retrieves all the connection_event's ConnectionEvents with an executor.
-func (o *MultiAddress) ConnectionEvents(mods ...qm.QueryMod) connectionEventQuery {
+// ConnectionEventsXMultiAddresses retrieves all the connection_events_x_multi_address's ConnectionEventsXMultiAddresses with an executor.
+func (o *MultiAddress) ConnectionEventsXMultiAddresses(mods ...qm.QueryMod) connectionEventsXMultiAddressQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.InnerJoin("\"connection_events_x_multi_addresses\" on \"connection_events\".\"id\" = \"connection_events_x_multi_addresses\".\"connection_event_id\""),
 		qm.Where("\"connection_events_x_multi_addresses\".\"multi_address_id\"=?", o.ID),
 	)
 
-	return ConnectionEvents(queryMods...)
+	return ConnectionEventsXMultiAddresses(queryMods...)
 }
 
 // HolePunchAttempts retrieves all the hole_punch_attempt's HolePunchAttempts with an executor.
@@ -558,6 +564,20 @@ func (o *MultiAddress) HolePunchAttempts(mods ...qm.QueryMod) holePunchAttemptQu
 	return HolePunchAttempts(queryMods...)
 }
 
+// RelayMultiAddressHolePunchResults retrieves all the hole_punch_result's HolePunchResults with an executor via relay_multi_address_id column.
+func (o *MultiAddress) RelayMultiAddressHolePunchResults(mods ...qm.QueryMod) holePunchResultQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"hole_punch_results\".\"relay_multi_address_id\"=?", o.ID),
+	)
+
+	return HolePunchResults(queryMods...)
+}
+
 // HolePunchResultsXMultiAddresses retrieves all the hole_punch_results_x_multi_address's HolePunchResultsXMultiAddresses with an executor.
 func (o *MultiAddress) HolePunchResultsXMultiAddresses(mods ...qm.QueryMod) holePunchResultsXMultiAddressQuery {
 	var queryMods []qm.QueryMod
@@ -714,9 +734,9 @@ func (multiAddressL) LoadConnMultiAddressConnectionEvents(ctx context.Context, e
 	return nil
 }
 
-// LoadConnectionEvents allows an eager lookup of values, cached into the
+// LoadConnectionEventsXMultiAddresses allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (multiAddressL) LoadConnectionEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMultiAddress interface{}, mods queries.Applicator) error {
+func (multiAddressL) LoadConnectionEventsXMultiAddresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMultiAddress interface{}, mods queries.Applicator) error {
 	var slice []*MultiAddress
 	var object *MultiAddress
 
@@ -770,10 +790,8 @@ func (multiAddressL) LoadConnectionEvents(ctx context.Context, e boil.ContextExe
 	}
 
 	query := NewQuery(
-		qm.Select("\"connection_events\".\"id\", \"connection_events\".\"local_id\", \"connection_events\".\"remote_id\", \"connection_events\".\"conn_multi_address_id\", \"connection_events\".\"opened_at\", \"connection_events\".\"created_at\", \"a\".\"multi_address_id\""),
-		qm.From("\"connection_events\""),
-		qm.InnerJoin("\"connection_events_x_multi_addresses\" as \"a\" on \"connection_events\".\"id\" = \"a\".\"connection_event_id\""),
-		qm.WhereIn("\"a\".\"multi_address_id\" in ?", args...),
+		qm.From(`connection_events_x_multi_addresses`),
+		qm.WhereIn(`connection_events_x_multi_addresses.multi_address_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -781,36 +799,22 @@ func (multiAddressL) LoadConnectionEvents(ctx context.Context, e boil.ContextExe
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load connection_events")
+		return errors.Wrap(err, "failed to eager load connection_events_x_multi_addresses")
 	}
 
-	var resultSlice []*ConnectionEvent
-
-	var localJoinCols []int64
-	for results.Next() {
-		one := new(ConnectionEvent)
-		var localJoinCol int64
-
-		err = results.Scan(&one.ID, &one.LocalID, &one.RemoteID, &one.ConnMultiAddressID, &one.OpenedAt, &one.CreatedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for connection_events")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice connection_events")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*ConnectionEventsXMultiAddress
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice connection_events_x_multi_addresses")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on connection_events")
+		return errors.Wrap(err, "failed to close results in eager load on connection_events_x_multi_addresses")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for connection_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for connection_events_x_multi_addresses")
 	}
 
-	if len(connectionEventAfterSelectHooks) != 0 {
+	if len(connectionEventsXMultiAddressAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -818,25 +822,24 @@ func (multiAddressL) LoadConnectionEvents(ctx context.Context, e boil.ContextExe
 		}
 	}
 	if singular {
-		object.R.ConnectionEvents = resultSlice
+		object.R.ConnectionEventsXMultiAddresses = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &connectionEventR{}
+				foreign.R = &connectionEventsXMultiAddressR{}
 			}
-			foreign.R.MultiAddresses = append(foreign.R.MultiAddresses, object)
+			foreign.R.MultiAddress = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.ConnectionEvents = append(local.R.ConnectionEvents, foreign)
+			if local.ID == foreign.MultiAddressID {
+				local.R.ConnectionEventsXMultiAddresses = append(local.R.ConnectionEventsXMultiAddresses, foreign)
 				if foreign.R == nil {
-					foreign.R = &connectionEventR{}
+					foreign.R = &connectionEventsXMultiAddressR{}
 				}
-				foreign.R.MultiAddresses = append(foreign.R.MultiAddresses, local)
+				foreign.R.MultiAddress = local
 				break
 			}
 		}
@@ -976,6 +979,120 @@ func (multiAddressL) LoadHolePunchAttempts(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
+// LoadRelayMultiAddressHolePunchResults allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (multiAddressL) LoadRelayMultiAddressHolePunchResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMultiAddress interface{}, mods queries.Applicator) error {
+	var slice []*MultiAddress
+	var object *MultiAddress
+
+	if singular {
+		var ok bool
+		object, ok = maybeMultiAddress.(*MultiAddress)
+		if !ok {
+			object = new(MultiAddress)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMultiAddress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMultiAddress))
+			}
+		}
+	} else {
+		s, ok := maybeMultiAddress.(*[]*MultiAddress)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMultiAddress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMultiAddress))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &multiAddressR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &multiAddressR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_results`),
+		qm.WhereIn(`hole_punch_results.relay_multi_address_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load hole_punch_results")
+	}
+
+	var resultSlice []*HolePunchResult
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
+	}
+
+	if len(holePunchResultAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RelayMultiAddressHolePunchResults = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holePunchResultR{}
+			}
+			foreign.R.RelayMultiAddress = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RelayMultiAddressID) {
+				local.R.RelayMultiAddressHolePunchResults = append(local.R.RelayMultiAddressHolePunchResults, foreign)
+				if foreign.R == nil {
+					foreign.R = &holePunchResultR{}
+				}
+				foreign.R.RelayMultiAddress = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadHolePunchResultsXMultiAddresses allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (multiAddressL) LoadHolePunchResultsXMultiAddresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMultiAddress interface{}, mods queries.Applicator) error {
@@ -1336,7 +1453,7 @@ func (o *MultiAddress) AddConnMultiAddressConnectionEvents(ctx context.Context,
 				strmangle.SetParamNames("\"", "\"", 1, []string{"conn_multi_address_id"}),
 				strmangle.WhereClause("\"", "\"", 2, connectionEventPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.ID, rel.OpenedAt}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1371,11 +1488,64 @@ func (o *MultiAddress) AddConnMultiAddressConnectionEvents(ctx context.Context,
 	return nil
 }
 
-// AddConnectionEvents adds the given related objects to the existing relationships
+// AddConnectionEventsXMultiAddresses adds the given related objects to the existing relationships
 // of the multi_address, optionally inserting them as new records.
-// Appends related to o.R.ConnectionEvents.
+// Appends related to o.R.ConnectionEventsXMultiAddresses.
+// Sets related.R.MultiAddress appropriately.
+func (o *MultiAddress) AddConnectionEventsXMultiAddresses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ConnectionEventsXMultiAddress) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MultiAddressID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"connection_events_x_multi_addresses\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"multi_address_id"}),
+				strmangle.WhereClause("\"", "\"", 2, connectionEventsXMultiAddressPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.MultiAddressID, rel.ConnectionEventID, rel.OpenedAt}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MultiAddressID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &multiAddressR{
+			ConnectionEventsXMultiAddresses: related,
+		}
+	} else {
+		o.R.ConnectionEventsXMultiAddresses = append(o.R.ConnectionEventsXMultiAddresses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &connectionEventsXMultiAddressR{
+				MultiAddress: o,
+			}
+		} else {
+			rel.R.MultiAddress = o
+		}
+	}
+	return nil
+}
+
+// AddHolePunchAttempts adds the given related objects to the existing relationships
+// of the multi_address, optionally inserting them as new records.
+// Appends related to o.R.HolePunchAttempts.
 // Sets related.R.MultiAddresses appropriately.
-func (o *MultiAddress) AddConnectionEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ConnectionEvent) error {
+func (o *MultiAddress) AddHolePunchAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchAttempt) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1386,7 +1556,7 @@ func (o *MultiAddress) AddConnectionEvents(ctx context.Context, exec boil.Contex
 	}
 
 	for _, rel := range related {
-		query := "insert into \"connection_events_x_multi_addresses\" (\"multi_address_id\", \"connection_event_id\") values ($1, $2)"
+		query := "insert into \"hole_punch_attempt_x_multi_addresses\" (\"multi_address_id\", \"hole_punch_attempt\") values ($1, $2)"
 		values := []interface{}{o.ID, rel.ID}
 
 		if boil.IsDebug(ctx) {
@@ -1401,15 +1571,15 @@ func (o *MultiAddress) AddConnectionEvents(ctx context.Context, exec boil.Contex
 	}
 	if o.R == nil {
 		o.R = &multiAddressR{
-			ConnectionEvents: related,
+			HolePunchAttempts: related,
 		}
 	} else {
-		o.R.ConnectionEvents = append(o.R.ConnectionEvents, related...)
+		o.R.HolePunchAttempts = append(o.R.HolePunchAttempts, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &connectionEventR{
+			rel.R = &holePunchAttemptR{
 				MultiAddresses: MultiAddressSlice{o},
 			}
 		} else {
@@ -1419,14 +1589,14 @@ func (o *MultiAddress) AddConnectionEvents(ctx context.Context, exec boil.Contex
 	return nil
 }
 
-// SetConnectionEvents removes all previously related items of the
+// SetHolePunchAttempts removes all previously related items of the
 // multi_address replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.MultiAddresses's ConnectionEvents accordingly.
-// Replaces o.R.ConnectionEvents with related.
-// Sets related.R.MultiAddresses's ConnectionEvents accordingly.
-func (o *MultiAddress) SetConnectionEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ConnectionEvent) error {
-	query := "delete from \"connection_events_x_multi_addresses\" where \"multi_address_id\" = $1"
+// Sets o.R.MultiAddresses's HolePunchAttempts accordingly.
+// Replaces o.R.HolePunchAttempts with related.
+// Sets related.R.MultiAddresses's HolePunchAttempts accordingly.
+func (o *MultiAddress) SetHolePunchAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchAttempt) error {
+	query := "delete from \"hole_punch_attempt_x_multi_addresses\" where \"multi_address_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1438,25 +1608,25 @@ func (o *MultiAddress) SetConnectionEvents(ctx context.Context, exec boil.Contex
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	removeConnectionEventsFromMultiAddressesSlice(o, related)
+	removeHolePunchAttemptsFromMultiAddressesSlice(o, related)
 	if o.R != nil {
-		o.R.ConnectionEvents = nil
+		o.R.HolePunchAttempts = nil
 	}
 
-	return o.AddConnectionEvents(ctx, exec, insert, related...)
+	return o.AddHolePunchAttempts(ctx, exec, insert, related...)
 }
 
-// RemoveConnectionEvents relationships from objects passed in.
-// Removes related items from R.ConnectionEvents (uses pointer comparison, removal does not keep order)
+// RemoveHolePunchAttempts relationships from objects passed in.
+// Removes related items from R.HolePunchAttempts (uses pointer comparison, removal does not keep order)
 // Sets related.R.MultiAddresses.
-func (o *MultiAddress) RemoveConnectionEvents(ctx context.Context, exec boil.ContextExecutor, related ...*ConnectionEvent) error {
+func (o *MultiAddress) RemoveHolePunchAttempts(ctx context.Context, exec boil.ContextExecutor, related ...*HolePunchAttempt) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	query := fmt.Sprintf(
-		"delete from \"connection_events_x_multi_addresses\" where \"multi_address_id\" = $1 and \"connection_event_id\" in (%s)",
+		"delete from \"hole_punch_attempt_x_multi_addresses\" where \"multi_address_id\" = $1 and \"hole_punch_attempt\" in (%s)",
 		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
 	)
 	values := []interface{}{o.ID}
@@ -1473,22 +1643,22 @@ func (o *MultiAddress) RemoveConnectionEvents(ctx context.Context, exec boil.Con
 	if err != nil {
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
-	removeConnectionEventsFromMultiAddressesSlice(o, related)
+	removeHolePunchAttemptsFromMultiAddressesSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.ConnectionEvents {
+		for i, ri := range o.R.HolePunchAttempts {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.ConnectionEvents)
+			ln := len(o.R.HolePunchAttempts)
 			if ln > 1 && i < ln-1 {
-				o.R.ConnectionEvents[i] = o.R.ConnectionEvents[ln-1]
+				o.R.HolePunchAttempts[i] = o.R.HolePunchAttempts[ln-1]
 			}
-			o.R.ConnectionEvents = o.R.ConnectionEvents[:ln-1]
+			o.R.HolePunchAttempts = o.R.HolePunchAttempts[:ln-1]
 			break
 		}
 	}
@@ -1496,7 +1666,7 @@ func (o *MultiAddress) RemoveConnectionEvents(ctx context.Context, exec boil.Con
 	return nil
 }
 
-func removeConnectionEventsFromMultiAddressesSlice(o *MultiAddress, related []*ConnectionEvent) {
+func removeHolePunchAttemptsFromMultiAddressesSlice(o *MultiAddress, related []*HolePunchAttempt) {
 	for _, rel := range related {
 		if rel.R == nil {
 			continue
@@ -1516,62 +1686,67 @@ func removeConnectionEventsFromMultiAddressesSlice(o *MultiAddress, related []*C
 	}
 }
 
-// AddHolePunchAttempts adds the given related objects to the existing relationships
+// AddRelayMultiAddressHolePunchResults adds the given related objects to the existing relationships
 // of the multi_address, optionally inserting them as new records.
-// Appends related to o.R.HolePunchAttempts.
-// Sets related.R.MultiAddresses appropriately.
-func (o *MultiAddress) AddHolePunchAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchAttempt) error {
+// Appends related to o.R.RelayMultiAddressHolePunchResults.
+// Sets related.R.RelayMultiAddress appropriately.
+func (o *MultiAddress) AddRelayMultiAddressHolePunchResults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchResult) error {
 	var err error
 	for _, rel := range related {
 		if insert {
+			queries.Assign(&rel.RelayMultiAddressID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"hole_punch_results\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"relay_multi_address_id"}),
+				strmangle.WhereClause("\"", "\"", 2, holePunchResultPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	for _, rel := range related {
-		query := "insert into \"hole_punch_attempt_x_multi_addresses\" (\"multi_address_id\", \"hole_punch_attempt\") values ($1, $2)"
-		values := []interface{}{o.ID, rel.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
+			queries.Assign(&rel.RelayMultiAddressID, o.ID)
 		}
 	}
+
 	if o.R == nil {
 		o.R = &multiAddressR{
-			HolePunchAttempts: related,
+			RelayMultiAddressHolePunchResults: related,
 		}
 	} else {
-		o.R.HolePunchAttempts = append(o.R.HolePunchAttempts, related...)
+		o.R.RelayMultiAddressHolePunchResults = append(o.R.RelayMultiAddressHolePunchResults, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &holePunchAttemptR{
-				MultiAddresses: MultiAddressSlice{o},
+			rel.R = &holePunchResultR{
+				RelayMultiAddress: o,
 			}
 		} else {
-			rel.R.MultiAddresses = append(rel.R.MultiAddresses, o)
+			rel.R.RelayMultiAddress = o
 		}
 	}
 	return nil
 }
 
-// SetHolePunchAttempts removes all previously related items of the
+// SetRelayMultiAddressHolePunchResults removes all previously related items of the
 // multi_address replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.MultiAddresses's HolePunchAttempts accordingly.
-// Replaces o.R.HolePunchAttempts with related.
-// Sets related.R.MultiAddresses's HolePunchAttempts accordingly.
-func (o *MultiAddress) SetHolePunchAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchAttempt) error {
-	query := "delete from \"hole_punch_attempt_x_multi_addresses\" where \"multi_address_id\" = $1"
+// Sets o.R.RelayMultiAddress's RelayMultiAddressHolePunchResults accordingly.
+// Replaces o.R.RelayMultiAddressHolePunchResults with related.
+// Sets related.R.RelayMultiAddress's RelayMultiAddressHolePunchResults accordingly.
+func (o *MultiAddress) SetRelayMultiAddressHolePunchResults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchResult) error {
+	query := "update \"hole_punch_results\" set \"relay_multi_address_id\" = null where \"relay_multi_address_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1583,57 +1758,54 @@ func (o *MultiAddress) SetHolePunchAttempts(ctx context.Context, exec boil.Conte
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	removeHolePunchAttemptsFromMultiAddressesSlice(o, related)
 	if o.R != nil {
-		o.R.HolePunchAttempts = nil
+		for _, rel := range o.R.RelayMultiAddressHolePunchResults {
+			queries.SetScanner(&rel.RelayMultiAddressID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RelayMultiAddress = nil
+		}
+		o.R.RelayMultiAddressHolePunchResults = nil
 	}
 
-	return o.AddHolePunchAttempts(ctx, exec, insert, related...)
+	return o.AddRelayMultiAddressHolePunchResults(ctx, exec, insert, related...)
 }
 
-// RemoveHolePunchAttempts relationships from objects passed in.
-// Removes related items from R.HolePunchAttempts (uses pointer comparison, removal does not keep order)
-// Sets related.R.MultiAddresses.
-func (o *MultiAddress) RemoveHolePunchAttempts(ctx context.Context, exec boil.ContextExecutor, related ...*HolePunchAttempt) error {
+// RemoveRelayMultiAddressHolePunchResults relationships from objects passed in.
+// Removes related items from R.RelayMultiAddressHolePunchResults (uses pointer comparison, removal does not keep order)
+// Sets related.R.RelayMultiAddress.
+func (o *MultiAddress) RemoveRelayMultiAddressHolePunchResults(ctx context.Context, exec boil.ContextExecutor, related ...*HolePunchResult) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	query := fmt.Sprintf(
-		"delete from \"hole_punch_attempt_x_multi_addresses\" where \"multi_address_id\" = $1 and \"hole_punch_attempt\" in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ID}
 	for _, rel := range related {
-		values = append(values, rel.ID)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+		queries.SetScanner(&rel.RelayMultiAddressID, nil)
+		if rel.R != nil {
+			rel.R.RelayMultiAddress = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("relay_multi_address_id")); err != nil {
+			return err
+		}
 	}
-	removeHolePunchAttemptsFromMultiAddressesSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.HolePunchAttempts {
+		for i, ri := range o.R.RelayMultiAddressHolePunchResults {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.HolePunchAttempts)
+			ln := len(o.R.RelayMultiAddressHolePunchResults)
 			if ln > 1 && i < ln-1 {
-				o.R.HolePunchAttempts[i] = o.R.HolePunchAttempts[ln-1]
+				o.R.RelayMultiAddressHolePunchResults[i] = o.R.RelayMultiAddressHolePunchResults[ln-1]
 			}
-			o.R.HolePunchAttempts = o.R.HolePunchAttempts[:ln-1]
+			o.R.RelayMultiAddressHolePunchResults = o.R.RelayMultiAddressHolePunchResults[:ln-1]
 			break
 		}
 	}
@@ -1641,26 +1813,6 @@ func (o *MultiAddress) RemoveHolePunchAttempts(ctx context.Context, exec boil.Co
 	return nil
 }
 
-func removeHolePunchAttemptsFromMultiAddressesSlice(o *MultiAddress, related []*HolePunchAttempt) {
-	for _, rel := range related {
-		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.MultiAddresses {
-			if o.ID != ri.ID {
-				continue
-			}
-
-			ln := len(rel.R.MultiAddresses)
-			if ln > 1 && i < ln-1 {
-				rel.R.MultiAddresses[i] = rel.R.MultiAddresses[ln-1]
-			}
-			rel.R.MultiAddresses = rel.R.MultiAddresses[:ln-1]
-			break
-		}
-	}
-}
-
 // AddHolePunchResultsXMultiAddresses adds the given related objects to the existing relationships
 // of the multi_address, optionally inserting them as new records.
 // Appends related to o.R.HolePunchResultsXMultiAddresses.
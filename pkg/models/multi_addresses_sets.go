@@ -75,13 +75,16 @@ var MultiAddressesSetWhere = struct {
 
 // MultiAddressesSetRels is where relationship names are stored.
 var MultiAddressesSetRels = struct {
+	Allocations                             string
 	ListenMultiAddressesSetHolePunchResults string
 }{
+	Allocations:                             "Allocations",
 	ListenMultiAddressesSetHolePunchResults: "ListenMultiAddressesSetHolePunchResults",
 }
 
 // multiAddressesSetR is where relationships are stored.
 type multiAddressesSetR struct {
+	Allocations                             AllocationSlice      `boil:"Allocations" json:"Allocations" toml:"Allocations" yaml:"Allocations"`
 	ListenMultiAddressesSetHolePunchResults HolePunchResultSlice `boil:"ListenMultiAddressesSetHolePunchResults" json:"ListenMultiAddressesSetHolePunchResults" toml:"ListenMultiAddressesSetHolePunchResults" yaml:"ListenMultiAddressesSetHolePunchResults"`
 }
 
@@ -90,6 +93,13 @@ func (*multiAddressesSetR) NewStruct() *multiAddressesSetR {
 	return &multiAddressesSetR{}
 }
 
+func (r *multiAddressesSetR) GetAllocations() AllocationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Allocations
+}
+
 func (r *multiAddressesSetR) GetListenMultiAddressesSetHolePunchResults() HolePunchResultSlice {
 	if r == nil {
 		return nil
@@ -386,6 +396,20 @@ func (q multiAddressesSetQuery) Exists(ctx context.Context, exec boil.ContextExe
 	return count > 0, nil
 }
 
+// Allocations retrieves all the allocation's Allocations with an executor.
+func (o *MultiAddressesSet) Allocations(mods ...qm.QueryMod) allocationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"allocations\".\"multi_addresses_set_id\"=?", o.ID),
+	)
+
+	return Allocations(queryMods...)
+}
+
 // ListenMultiAddressesSetHolePunchResults retrieves all the hole_punch_result's HolePunchResults with an executor via listen_multi_addresses_set_id column.
 func (o *MultiAddressesSet) ListenMultiAddressesSetHolePunchResults(mods ...qm.QueryMod) holePunchResultQuery {
 	var queryMods []qm.QueryMod
@@ -400,6 +424,120 @@ func (o *MultiAddressesSet) ListenMultiAddressesSetHolePunchResults(mods ...qm.Q
 	return HolePunchResults(queryMods...)
 }
 
+// LoadAllocations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (multiAddressesSetL) LoadAllocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMultiAddressesSet interface{}, mods queries.Applicator) error {
+	var slice []*MultiAddressesSet
+	var object *MultiAddressesSet
+
+	if singular {
+		var ok bool
+		object, ok = maybeMultiAddressesSet.(*MultiAddressesSet)
+		if !ok {
+			object = new(MultiAddressesSet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMultiAddressesSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMultiAddressesSet))
+			}
+		}
+	} else {
+		s, ok := maybeMultiAddressesSet.(*[]*MultiAddressesSet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMultiAddressesSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMultiAddressesSet))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &multiAddressesSetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &multiAddressesSetR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`allocations`),
+		qm.WhereIn(`allocations.multi_addresses_set_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load allocations")
+	}
+
+	var resultSlice []*Allocation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice allocations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on allocations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for allocations")
+	}
+
+	if len(allocationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Allocations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &allocationR{}
+			}
+			foreign.R.MultiAddressesSet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.MultiAddressesSetID) {
+				local.R.Allocations = append(local.R.Allocations, foreign)
+				if foreign.R == nil {
+					foreign.R = &allocationR{}
+				}
+				foreign.R.MultiAddressesSet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadListenMultiAddressesSetHolePunchResults allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (multiAddressesSetL) LoadListenMultiAddressesSetHolePunchResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMultiAddressesSet interface{}, mods queries.Applicator) error {
@@ -514,6 +652,133 @@ func (multiAddressesSetL) LoadListenMultiAddressesSetHolePunchResults(ctx contex
 	return nil
 }
 
+// AddAllocations adds the given related objects to the existing relationships
+// of the multi_addresses_set, optionally inserting them as new records.
+// Appends related to o.R.Allocations.
+// Sets related.R.MultiAddressesSet appropriately.
+func (o *MultiAddressesSet) AddAllocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Allocation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.MultiAddressesSetID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"allocations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"multi_addresses_set_id"}),
+				strmangle.WhereClause("\"", "\"", 2, allocationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.MultiAddressesSetID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &multiAddressesSetR{
+			Allocations: related,
+		}
+	} else {
+		o.R.Allocations = append(o.R.Allocations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &allocationR{
+				MultiAddressesSet: o,
+			}
+		} else {
+			rel.R.MultiAddressesSet = o
+		}
+	}
+	return nil
+}
+
+// SetAllocations removes all previously related items of the
+// multi_addresses_set replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.MultiAddressesSet's Allocations accordingly.
+// Replaces o.R.Allocations with related.
+// Sets related.R.MultiAddressesSet's Allocations accordingly.
+func (o *MultiAddressesSet) SetAllocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Allocation) error {
+	query := "update \"allocations\" set \"multi_addresses_set_id\" = null where \"multi_addresses_set_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Allocations {
+			queries.SetScanner(&rel.MultiAddressesSetID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.MultiAddressesSet = nil
+		}
+		o.R.Allocations = nil
+	}
+
+	return o.AddAllocations(ctx, exec, insert, related...)
+}
+
+// RemoveAllocations relationships from objects passed in.
+// Removes related items from R.Allocations (uses pointer comparison, removal does not keep order)
+// Sets related.R.MultiAddressesSet.
+func (o *MultiAddressesSet) RemoveAllocations(ctx context.Context, exec boil.ContextExecutor, related ...*Allocation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.MultiAddressesSetID, nil)
+		if rel.R != nil {
+			rel.R.MultiAddressesSet = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("multi_addresses_set_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Allocations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Allocations)
+			if ln > 1 && i < ln-1 {
+				o.R.Allocations[i] = o.R.Allocations[ln-1]
+			}
+			o.R.Allocations = o.R.Allocations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddListenMultiAddressesSetHolePunchResults adds the given related objects to the existing relationships
 // of the multi_addresses_set, optionally inserting them as new records.
 // Appends related to o.R.ListenMultiAddressesSetHolePunchResults.
@@ -494,6 +494,83 @@ func testMultiAddressesSetsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testMultiAddressesSetToManyAllocations(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MultiAddressesSet
+	var b, c Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, multiAddressesSetDBTypes, true, multiAddressesSetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MultiAddressesSet struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, allocationDBTypes, false, allocationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.MultiAddressesSetID, a.ID)
+	queries.Assign(&c.MultiAddressesSetID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Allocations().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.MultiAddressesSetID, b.MultiAddressesSetID) {
+			bFound = true
+		}
+		if queries.Equal(v.MultiAddressesSetID, c.MultiAddressesSetID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := MultiAddressesSetSlice{&a}
+	if err = a.L.LoadAllocations(ctx, tx, false, (*[]*MultiAddressesSet)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Allocations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Allocations = nil
+	if err = a.L.LoadAllocations(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Allocations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testMultiAddressesSetToManyListenMultiAddressesSetHolePunchResults(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -572,6 +649,257 @@ func testMultiAddressesSetToManyListenMultiAddressesSetHolePunchResults(t *testi
 	}
 }
 
+func testMultiAddressesSetToManyAddOpAllocations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MultiAddressesSet
+	var b, c, d, e Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, multiAddressesSetDBTypes, false, strmangle.SetComplement(multiAddressesSetPrimaryKeyColumns, multiAddressesSetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Allocation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Allocation{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAllocations(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.MultiAddressesSetID) {
+			t.Error("foreign key was wrong value", a.ID, first.MultiAddressesSetID)
+		}
+		if !queries.Equal(a.ID, second.MultiAddressesSetID) {
+			t.Error("foreign key was wrong value", a.ID, second.MultiAddressesSetID)
+		}
+
+		if first.R.MultiAddressesSet != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.MultiAddressesSet != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Allocations[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Allocations[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Allocations().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testMultiAddressesSetToManySetOpAllocations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MultiAddressesSet
+	var b, c, d, e Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, multiAddressesSetDBTypes, false, strmangle.SetComplement(multiAddressesSetPrimaryKeyColumns, multiAddressesSetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Allocation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAllocations(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAllocations(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.MultiAddressesSetID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.MultiAddressesSetID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.MultiAddressesSetID) {
+		t.Error("foreign key was wrong value", a.ID, d.MultiAddressesSetID)
+	}
+	if !queries.Equal(a.ID, e.MultiAddressesSetID) {
+		t.Error("foreign key was wrong value", a.ID, e.MultiAddressesSetID)
+	}
+
+	if b.R.MultiAddressesSet != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.MultiAddressesSet != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.MultiAddressesSet != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.MultiAddressesSet != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Allocations[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Allocations[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testMultiAddressesSetToManyRemoveOpAllocations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MultiAddressesSet
+	var b, c, d, e Allocation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, multiAddressesSetDBTypes, false, strmangle.SetComplement(multiAddressesSetPrimaryKeyColumns, multiAddressesSetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Allocation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, allocationDBTypes, false, strmangle.SetComplement(allocationPrimaryKeyColumns, allocationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAllocations(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAllocations(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Allocations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.MultiAddressesSetID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.MultiAddressesSetID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.MultiAddressesSet != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.MultiAddressesSet != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.MultiAddressesSet != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.MultiAddressesSet != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Allocations) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Allocations[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Allocations[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testMultiAddressesSetToManyAddOpListenMultiAddressesSetHolePunchResults(t *testing.T) {
 	var err error
 
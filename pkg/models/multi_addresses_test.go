@@ -572,14 +572,14 @@ func testMultiAddressToManyConnMultiAddressConnectionEvents(t *testing.T) {
 	}
 }
 
-func testMultiAddressToManyConnectionEvents(t *testing.T) {
+func testMultiAddressToManyConnectionEventsXMultiAddresses(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a MultiAddress
-	var b, c ConnectionEvent
+	var b, c ConnectionEventsXMultiAddress
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, multiAddressDBTypes, true, multiAddressColumnsWithDefault...); err != nil {
@@ -590,13 +590,16 @@ func testMultiAddressToManyConnectionEvents(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, connectionEventDBTypes, false, connectionEventColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, connectionEventsXMultiAddressDBTypes, false, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, connectionEventDBTypes, false, connectionEventColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, connectionEventsXMultiAddressDBTypes, false, connectionEventsXMultiAddressColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
+	b.MultiAddressID = a.ID
+	c.MultiAddressID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -604,26 +607,17 @@ func testMultiAddressToManyConnectionEvents(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, err = tx.Exec("insert into \"connection_events_x_multi_addresses\" (\"multi_address_id\", \"connection_event_id\") values ($1, $2)", a.ID, b.ID)
-	if err != nil {
-		t.Fatal(err)
-	}
-	_, err = tx.Exec("insert into \"connection_events_x_multi_addresses\" (\"multi_address_id\", \"connection_event_id\") values ($1, $2)", a.ID, c.ID)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	check, err := a.ConnectionEvents().All(ctx, tx)
+	check, err := a.ConnectionEventsXMultiAddresses().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if v.ID == b.ID {
+		if v.MultiAddressID == b.MultiAddressID {
 			bFound = true
 		}
-		if v.ID == c.ID {
+		if v.MultiAddressID == c.MultiAddressID {
 			cFound = true
 		}
 	}
@@ -636,18 +630,18 @@ func testMultiAddressToManyConnectionEvents(t *testing.T) {
 	}
 
 	slice := MultiAddressSlice{&a}
-	if err = a.L.LoadConnectionEvents(ctx, tx, false, (*[]*MultiAddress)(&slice), nil); err != nil {
+	if err = a.L.LoadConnectionEventsXMultiAddresses(ctx, tx, false, (*[]*MultiAddress)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ConnectionEvents); got != 2 {
+	if got := len(a.R.ConnectionEventsXMultiAddresses); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.ConnectionEvents = nil
-	if err = a.L.LoadConnectionEvents(ctx, tx, true, &a, nil); err != nil {
+	a.R.ConnectionEventsXMultiAddresses = nil
+	if err = a.L.LoadConnectionEventsXMultiAddresses(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ConnectionEvents); got != 2 {
+	if got := len(a.R.ConnectionEventsXMultiAddresses); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -740,6 +734,83 @@ func testMultiAddressToManyHolePunchAttempts(t *testing.T) {
 	}
 }
 
+func testMultiAddressToManyRelayMultiAddressHolePunchResults(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MultiAddress
+	var b, c HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, multiAddressDBTypes, true, multiAddressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MultiAddress struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.RelayMultiAddressID, a.ID)
+	queries.Assign(&c.RelayMultiAddressID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RelayMultiAddressHolePunchResults().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.RelayMultiAddressID, b.RelayMultiAddressID) {
+			bFound = true
+		}
+		if queries.Equal(v.RelayMultiAddressID, c.RelayMultiAddressID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := MultiAddressSlice{&a}
+	if err = a.L.LoadRelayMultiAddressHolePunchResults(ctx, tx, false, (*[]*MultiAddress)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RelayMultiAddressHolePunchResults); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RelayMultiAddressHolePunchResults = nil
+	if err = a.L.LoadRelayMultiAddressHolePunchResults(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RelayMultiAddressHolePunchResults); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testMultiAddressToManyHolePunchResultsXMultiAddresses(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -1049,7 +1120,7 @@ func testMultiAddressToManyAddOpConnMultiAddressConnectionEvents(t *testing.T) {
 		}
 	}
 }
-func testMultiAddressToManyAddOpConnectionEvents(t *testing.T) {
+func testMultiAddressToManyAddOpConnectionEventsXMultiAddresses(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1057,15 +1128,15 @@ func testMultiAddressToManyAddOpConnectionEvents(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a MultiAddress
-	var b, c, d, e ConnectionEvent
+	var b, c, d, e ConnectionEventsXMultiAddress
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*ConnectionEvent{&b, &c, &d, &e}
+	foreigners := []*ConnectionEventsXMultiAddress{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, connectionEventDBTypes, false, strmangle.SetComplement(connectionEventPrimaryKeyColumns, connectionEventColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, connectionEventsXMultiAddressDBTypes, false, strmangle.SetComplement(connectionEventsXMultiAddressPrimaryKeyColumns, connectionEventsXMultiAddressColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1080,13 +1151,88 @@ func testMultiAddressToManyAddOpConnectionEvents(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*ConnectionEvent{
+	foreignersSplitByInsertion := [][]*ConnectionEventsXMultiAddress{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddConnectionEvents(ctx, tx, i != 0, x...)
+		err = a.AddConnectionEventsXMultiAddresses(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.MultiAddressID {
+			t.Error("foreign key was wrong value", a.ID, first.MultiAddressID)
+		}
+		if a.ID != second.MultiAddressID {
+			t.Error("foreign key was wrong value", a.ID, second.MultiAddressID)
+		}
+
+		if first.R.MultiAddress != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.MultiAddress != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ConnectionEventsXMultiAddresses[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ConnectionEventsXMultiAddresses[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ConnectionEventsXMultiAddresses().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testMultiAddressToManyAddOpHolePunchAttempts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MultiAddress
+	var b, c, d, e HolePunchAttempt
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*HolePunchAttempt{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, holePunchAttemptDBTypes, false, strmangle.SetComplement(holePunchAttemptPrimaryKeyColumns, holePunchAttemptColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*HolePunchAttempt{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddHolePunchAttempts(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1101,14 +1247,14 @@ func testMultiAddressToManyAddOpConnectionEvents(t *testing.T) {
 			t.Error("relationship was not added properly to the slice")
 		}
 
-		if a.R.ConnectionEvents[i*2] != first {
+		if a.R.HolePunchAttempts[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.ConnectionEvents[i*2+1] != second {
+		if a.R.HolePunchAttempts[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.ConnectionEvents().Count(ctx, tx)
+		count, err := a.HolePunchAttempts().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1118,7 +1264,7 @@ func testMultiAddressToManyAddOpConnectionEvents(t *testing.T) {
 	}
 }
 
-func testMultiAddressToManySetOpConnectionEvents(t *testing.T) {
+func testMultiAddressToManySetOpHolePunchAttempts(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1126,15 +1272,15 @@ func testMultiAddressToManySetOpConnectionEvents(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a MultiAddress
-	var b, c, d, e ConnectionEvent
+	var b, c, d, e HolePunchAttempt
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*ConnectionEvent{&b, &c, &d, &e}
+	foreigners := []*HolePunchAttempt{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, connectionEventDBTypes, false, strmangle.SetComplement(connectionEventPrimaryKeyColumns, connectionEventColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, holePunchAttemptDBTypes, false, strmangle.SetComplement(holePunchAttemptPrimaryKeyColumns, holePunchAttemptColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1149,12 +1295,12 @@ func testMultiAddressToManySetOpConnectionEvents(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetConnectionEvents(ctx, tx, false, &b, &c)
+	err = a.SetHolePunchAttempts(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.ConnectionEvents().Count(ctx, tx)
+	count, err := a.HolePunchAttempts().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1162,12 +1308,12 @@ func testMultiAddressToManySetOpConnectionEvents(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetConnectionEvents(ctx, tx, true, &d, &e)
+	err = a.SetHolePunchAttempts(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.ConnectionEvents().Count(ctx, tx)
+	count, err = a.HolePunchAttempts().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1192,15 +1338,15 @@ func testMultiAddressToManySetOpConnectionEvents(t *testing.T) {
 		t.Error("relationship was not added properly to the slice")
 	}
 
-	if a.R.ConnectionEvents[0] != &d {
+	if a.R.HolePunchAttempts[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.ConnectionEvents[1] != &e {
+	if a.R.HolePunchAttempts[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testMultiAddressToManyRemoveOpConnectionEvents(t *testing.T) {
+func testMultiAddressToManyRemoveOpHolePunchAttempts(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1208,15 +1354,15 @@ func testMultiAddressToManyRemoveOpConnectionEvents(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a MultiAddress
-	var b, c, d, e ConnectionEvent
+	var b, c, d, e HolePunchAttempt
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*ConnectionEvent{&b, &c, &d, &e}
+	foreigners := []*HolePunchAttempt{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, connectionEventDBTypes, false, strmangle.SetComplement(connectionEventPrimaryKeyColumns, connectionEventColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, holePunchAttemptDBTypes, false, strmangle.SetComplement(holePunchAttemptPrimaryKeyColumns, holePunchAttemptColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1225,12 +1371,12 @@ func testMultiAddressToManyRemoveOpConnectionEvents(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddConnectionEvents(ctx, tx, true, foreigners...)
+	err = a.AddHolePunchAttempts(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.ConnectionEvents().Count(ctx, tx)
+	count, err := a.HolePunchAttempts().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1238,12 +1384,12 @@ func testMultiAddressToManyRemoveOpConnectionEvents(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveConnectionEvents(ctx, tx, foreigners[:2]...)
+	err = a.RemoveHolePunchAttempts(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.ConnectionEvents().Count(ctx, tx)
+	count, err = a.HolePunchAttempts().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1264,20 +1410,20 @@ func testMultiAddressToManyRemoveOpConnectionEvents(t *testing.T) {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if len(a.R.ConnectionEvents) != 2 {
+	if len(a.R.HolePunchAttempts) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.ConnectionEvents[1] != &d {
+	if a.R.HolePunchAttempts[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.ConnectionEvents[0] != &e {
+	if a.R.HolePunchAttempts[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testMultiAddressToManyAddOpHolePunchAttempts(t *testing.T) {
+func testMultiAddressToManyAddOpRelayMultiAddressHolePunchResults(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1285,15 +1431,15 @@ func testMultiAddressToManyAddOpHolePunchAttempts(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a MultiAddress
-	var b, c, d, e HolePunchAttempt
+	var b, c, d, e HolePunchResult
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*HolePunchAttempt{&b, &c, &d, &e}
+	foreigners := []*HolePunchResult{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, holePunchAttemptDBTypes, false, strmangle.SetComplement(holePunchAttemptPrimaryKeyColumns, holePunchAttemptColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1308,13 +1454,13 @@ func testMultiAddressToManyAddOpHolePunchAttempts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*HolePunchAttempt{
+	foreignersSplitByInsertion := [][]*HolePunchResult{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddHolePunchAttempts(ctx, tx, i != 0, x...)
+		err = a.AddRelayMultiAddressHolePunchResults(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1322,21 +1468,28 @@ func testMultiAddressToManyAddOpHolePunchAttempts(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if first.R.MultiAddresses[0] != &a {
-			t.Error("relationship was not added properly to the slice")
+		if !queries.Equal(a.ID, first.RelayMultiAddressID) {
+			t.Error("foreign key was wrong value", a.ID, first.RelayMultiAddressID)
 		}
-		if second.R.MultiAddresses[0] != &a {
-			t.Error("relationship was not added properly to the slice")
+		if !queries.Equal(a.ID, second.RelayMultiAddressID) {
+			t.Error("foreign key was wrong value", a.ID, second.RelayMultiAddressID)
 		}
 
-		if a.R.HolePunchAttempts[i*2] != first {
+		if first.R.RelayMultiAddress != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.RelayMultiAddress != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RelayMultiAddressHolePunchResults[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.HolePunchAttempts[i*2+1] != second {
+		if a.R.RelayMultiAddressHolePunchResults[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.HolePunchAttempts().Count(ctx, tx)
+		count, err := a.RelayMultiAddressHolePunchResults().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1346,7 +1499,7 @@ func testMultiAddressToManyAddOpHolePunchAttempts(t *testing.T) {
 	}
 }
 
-func testMultiAddressToManySetOpHolePunchAttempts(t *testing.T) {
+func testMultiAddressToManySetOpRelayMultiAddressHolePunchResults(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1354,15 +1507,15 @@ func testMultiAddressToManySetOpHolePunchAttempts(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a MultiAddress
-	var b, c, d, e HolePunchAttempt
+	var b, c, d, e HolePunchResult
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*HolePunchAttempt{&b, &c, &d, &e}
+	foreigners := []*HolePunchResult{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, holePunchAttemptDBTypes, false, strmangle.SetComplement(holePunchAttemptPrimaryKeyColumns, holePunchAttemptColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1377,12 +1530,12 @@ func testMultiAddressToManySetOpHolePunchAttempts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetHolePunchAttempts(ctx, tx, false, &b, &c)
+	err = a.SetRelayMultiAddressHolePunchResults(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.HolePunchAttempts().Count(ctx, tx)
+	count, err := a.RelayMultiAddressHolePunchResults().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1390,12 +1543,12 @@ func testMultiAddressToManySetOpHolePunchAttempts(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetHolePunchAttempts(ctx, tx, true, &d, &e)
+	err = a.SetRelayMultiAddressHolePunchResults(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.HolePunchAttempts().Count(ctx, tx)
+	count, err = a.RelayMultiAddressHolePunchResults().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1403,32 +1556,41 @@ func testMultiAddressToManySetOpHolePunchAttempts(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	// The following checks cannot be implemented since we have no handle
-	// to these when we call Set(). Leaving them here as wishful thinking
-	// and to let people know there's dragons.
-	//
-	// if len(b.R.MultiAddresses) != 0 {
-	// 	t.Error("relationship was not removed properly from the slice")
-	// }
-	// if len(c.R.MultiAddresses) != 0 {
-	// 	t.Error("relationship was not removed properly from the slice")
-	// }
-	if d.R.MultiAddresses[0] != &a {
-		t.Error("relationship was not added properly to the slice")
+	if !queries.IsValuerNil(b.RelayMultiAddressID) {
+		t.Error("want b's foreign key value to be nil")
 	}
-	if e.R.MultiAddresses[0] != &a {
-		t.Error("relationship was not added properly to the slice")
+	if !queries.IsValuerNil(c.RelayMultiAddressID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.RelayMultiAddressID) {
+		t.Error("foreign key was wrong value", a.ID, d.RelayMultiAddressID)
+	}
+	if !queries.Equal(a.ID, e.RelayMultiAddressID) {
+		t.Error("foreign key was wrong value", a.ID, e.RelayMultiAddressID)
 	}
 
-	if a.R.HolePunchAttempts[0] != &d {
+	if b.R.RelayMultiAddress != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.RelayMultiAddress != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RelayMultiAddress != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.RelayMultiAddress != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.RelayMultiAddressHolePunchResults[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.HolePunchAttempts[1] != &e {
+	if a.R.RelayMultiAddressHolePunchResults[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testMultiAddressToManyRemoveOpHolePunchAttempts(t *testing.T) {
+func testMultiAddressToManyRemoveOpRelayMultiAddressHolePunchResults(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1436,15 +1598,15 @@ func testMultiAddressToManyRemoveOpHolePunchAttempts(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a MultiAddress
-	var b, c, d, e HolePunchAttempt
+	var b, c, d, e HolePunchResult
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, multiAddressDBTypes, false, strmangle.SetComplement(multiAddressPrimaryKeyColumns, multiAddressColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*HolePunchAttempt{&b, &c, &d, &e}
+	foreigners := []*HolePunchResult{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, holePunchAttemptDBTypes, false, strmangle.SetComplement(holePunchAttemptPrimaryKeyColumns, holePunchAttemptColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1453,12 +1615,12 @@ func testMultiAddressToManyRemoveOpHolePunchAttempts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddHolePunchAttempts(ctx, tx, true, foreigners...)
+	err = a.AddRelayMultiAddressHolePunchResults(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.HolePunchAttempts().Count(ctx, tx)
+	count, err := a.RelayMultiAddressHolePunchResults().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1466,12 +1628,12 @@ func testMultiAddressToManyRemoveOpHolePunchAttempts(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveHolePunchAttempts(ctx, tx, foreigners[:2]...)
+	err = a.RemoveRelayMultiAddressHolePunchResults(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.HolePunchAttempts().Count(ctx, tx)
+	count, err = a.RelayMultiAddressHolePunchResults().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1479,28 +1641,35 @@ func testMultiAddressToManyRemoveOpHolePunchAttempts(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if len(b.R.MultiAddresses) != 0 {
-		t.Error("relationship was not removed properly from the slice")
+	if !queries.IsValuerNil(b.RelayMultiAddressID) {
+		t.Error("want b's foreign key value to be nil")
 	}
-	if len(c.R.MultiAddresses) != 0 {
-		t.Error("relationship was not removed properly from the slice")
+	if !queries.IsValuerNil(c.RelayMultiAddressID) {
+		t.Error("want c's foreign key value to be nil")
 	}
-	if d.R.MultiAddresses[0] != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+
+	if b.R.RelayMultiAddress != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if e.R.MultiAddresses[0] != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+	if c.R.RelayMultiAddress != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RelayMultiAddress != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.RelayMultiAddress != &a {
+		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.HolePunchAttempts) != 2 {
+	if len(a.R.RelayMultiAddressHolePunchResults) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.HolePunchAttempts[1] != &d {
+	if a.R.RelayMultiAddressHolePunchResults[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.HolePunchAttempts[0] != &e {
+	if a.R.RelayMultiAddressHolePunchResults[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
@@ -1805,7 +1974,7 @@ func testMultiAddressesSelect(t *testing.T) {
 }
 
 var (
-	multiAddressDBTypes = map[string]string{`ID`: `bigint`, `Asn`: `integer`, `IsCloud`: `integer`, `IsRelay`: `boolean`, `IsPublic`: `boolean`, `Addr`: `inet`, `HasManyAddrs`: `boolean`, `Country`: `character`, `Continent`: `character`, `Maddr`: `text`, `UpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`}
+	multiAddressDBTypes = map[string]string{`ID`: `bigint`, `Asn`: `integer`, `IsCloud`: `integer`, `IsRelay`: `boolean`, `IsPublic`: `boolean`, `Addr`: `inet`, `HasManyAddrs`: `boolean`, `Country`: `character`, `Continent`: `character`, `Maddr`: `text`, `UpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `City`: `text`, `ConnectionType`: `enum.connection_type('MOBILE','RESIDENTIAL','BUSINESS','HOSTING','EDUCATION','SATELLITE')`, `EnrichmentVersion`: `text`}
 	_                   = bytes.MinRead
 )
 
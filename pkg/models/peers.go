@@ -25,47 +25,67 @@ import (
 
 // Peer is an object representing the database table.
 type Peer struct {
-	ID           int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	MultiHash    string            `boil:"multi_hash" json:"multi_hash" toml:"multi_hash" yaml:"multi_hash"`
-	AgentVersion null.String       `boil:"agent_version" json:"agent_version,omitempty" toml:"agent_version" yaml:"agent_version,omitempty"`
-	Protocols    types.StringArray `boil:"protocols" json:"protocols,omitempty" toml:"protocols" yaml:"protocols,omitempty"`
-	UpdatedAt    time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	CreatedAt    time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID                     int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	MultiHash              string            `boil:"multi_hash" json:"multi_hash" toml:"multi_hash" yaml:"multi_hash"`
+	AgentVersion           null.String       `boil:"agent_version" json:"agent_version,omitempty" toml:"agent_version" yaml:"agent_version,omitempty"`
+	Protocols              types.StringArray `boil:"protocols" json:"protocols,omitempty" toml:"protocols" yaml:"protocols,omitempty"`
+	UpdatedAt              time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt              time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Implementation         null.String       `boil:"implementation" json:"implementation,omitempty" toml:"implementation" yaml:"implementation,omitempty"`
+	ImplementationVersion  null.String       `boil:"implementation_version" json:"implementation_version,omitempty" toml:"implementation_version" yaml:"implementation_version,omitempty"`
+	GoLibp2pVersion        null.String       `boil:"go_libp2p_version" json:"go_libp2p_version,omitempty" toml:"go_libp2p_version" yaml:"go_libp2p_version,omitempty"`
+	ClassifiedAgentVersion null.String       `boil:"classified_agent_version" json:"classified_agent_version,omitempty" toml:"classified_agent_version" yaml:"classified_agent_version,omitempty"`
 
 	R *peerR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L peerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PeerColumns = struct {
-	ID           string
-	MultiHash    string
-	AgentVersion string
-	Protocols    string
-	UpdatedAt    string
-	CreatedAt    string
+	ID                     string
+	MultiHash              string
+	AgentVersion           string
+	Protocols              string
+	UpdatedAt              string
+	CreatedAt              string
+	Implementation         string
+	ImplementationVersion  string
+	GoLibp2pVersion        string
+	ClassifiedAgentVersion string
 }{
-	ID:           "id",
-	MultiHash:    "multi_hash",
-	AgentVersion: "agent_version",
-	Protocols:    "protocols",
-	UpdatedAt:    "updated_at",
-	CreatedAt:    "created_at",
+	ID:                     "id",
+	MultiHash:              "multi_hash",
+	AgentVersion:           "agent_version",
+	Protocols:              "protocols",
+	UpdatedAt:              "updated_at",
+	CreatedAt:              "created_at",
+	Implementation:         "implementation",
+	ImplementationVersion:  "implementation_version",
+	GoLibp2pVersion:        "go_libp2p_version",
+	ClassifiedAgentVersion: "classified_agent_version",
 }
 
 var PeerTableColumns = struct {
-	ID           string
-	MultiHash    string
-	AgentVersion string
-	Protocols    string
-	UpdatedAt    string
-	CreatedAt    string
+	ID                     string
+	MultiHash              string
+	AgentVersion           string
+	Protocols              string
+	UpdatedAt              string
+	CreatedAt              string
+	Implementation         string
+	ImplementationVersion  string
+	GoLibp2pVersion        string
+	ClassifiedAgentVersion string
 }{
-	ID:           "peers.id",
-	MultiHash:    "peers.multi_hash",
-	AgentVersion: "peers.agent_version",
-	Protocols:    "peers.protocols",
-	UpdatedAt:    "peers.updated_at",
-	CreatedAt:    "peers.created_at",
+	ID:                     "peers.id",
+	MultiHash:              "peers.multi_hash",
+	AgentVersion:           "peers.agent_version",
+	Protocols:              "peers.protocols",
+	UpdatedAt:              "peers.updated_at",
+	CreatedAt:              "peers.created_at",
+	Implementation:         "peers.implementation",
+	ImplementationVersion:  "peers.implementation_version",
+	GoLibp2pVersion:        "peers.go_libp2p_version",
+	ClassifiedAgentVersion: "peers.classified_agent_version",
 }
 
 // Generated where
@@ -76,52 +96,75 @@ func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
 }
 
 var PeerWhere = struct {
-	ID           whereHelperint64
-	MultiHash    whereHelperstring
-	AgentVersion whereHelpernull_String
-	Protocols    whereHelpertypes_StringArray
-	UpdatedAt    whereHelpertime_Time
-	CreatedAt    whereHelpertime_Time
+	ID                     whereHelperint64
+	MultiHash              whereHelperstring
+	AgentVersion           whereHelpernull_String
+	Protocols              whereHelpertypes_StringArray
+	UpdatedAt              whereHelpertime_Time
+	CreatedAt              whereHelpertime_Time
+	Implementation         whereHelpernull_String
+	ImplementationVersion  whereHelpernull_String
+	GoLibp2pVersion        whereHelpernull_String
+	ClassifiedAgentVersion whereHelpernull_String
 }{
-	ID:           whereHelperint64{field: "\"peers\".\"id\""},
-	MultiHash:    whereHelperstring{field: "\"peers\".\"multi_hash\""},
-	AgentVersion: whereHelpernull_String{field: "\"peers\".\"agent_version\""},
-	Protocols:    whereHelpertypes_StringArray{field: "\"peers\".\"protocols\""},
-	UpdatedAt:    whereHelpertime_Time{field: "\"peers\".\"updated_at\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"peers\".\"created_at\""},
+	ID:                     whereHelperint64{field: "\"peers\".\"id\""},
+	MultiHash:              whereHelperstring{field: "\"peers\".\"multi_hash\""},
+	AgentVersion:           whereHelpernull_String{field: "\"peers\".\"agent_version\""},
+	Protocols:              whereHelpertypes_StringArray{field: "\"peers\".\"protocols\""},
+	UpdatedAt:              whereHelpertime_Time{field: "\"peers\".\"updated_at\""},
+	CreatedAt:              whereHelpertime_Time{field: "\"peers\".\"created_at\""},
+	Implementation:         whereHelpernull_String{field: "\"peers\".\"implementation\""},
+	ImplementationVersion:  whereHelpernull_String{field: "\"peers\".\"implementation_version\""},
+	GoLibp2pVersion:        whereHelpernull_String{field: "\"peers\".\"go_libp2p_version\""},
+	ClassifiedAgentVersion: whereHelpernull_String{field: "\"peers\".\"classified_agent_version\""},
 }
 
 // PeerRels is where relationship names are stored.
 var PeerRels = struct {
+	Relay                     string
+	ClientAllocations         string
+	RemoteAllocations         string
 	Clients                   string
 	LocalConnectionEvents     string
 	RemoteConnectionEvents    string
 	LocalHolePunchResults     string
+	RelayHolePunchResults     string
 	RemoteHolePunchResults    string
 	RemoteLatencyMeasurements string
 	NetworkInformations       string
 	PeerLogs                  string
+	RelayRelayDials           string
 }{
+	Relay:                     "Relay",
+	ClientAllocations:         "ClientAllocations",
+	RemoteAllocations:         "RemoteAllocations",
 	Clients:                   "Clients",
 	LocalConnectionEvents:     "LocalConnectionEvents",
 	RemoteConnectionEvents:    "RemoteConnectionEvents",
 	LocalHolePunchResults:     "LocalHolePunchResults",
+	RelayHolePunchResults:     "RelayHolePunchResults",
 	RemoteHolePunchResults:    "RemoteHolePunchResults",
 	RemoteLatencyMeasurements: "RemoteLatencyMeasurements",
 	NetworkInformations:       "NetworkInformations",
 	PeerLogs:                  "PeerLogs",
+	RelayRelayDials:           "RelayRelayDials",
 }
 
 // peerR is where relationships are stored.
 type peerR struct {
+	Relay                     *Relay                  `boil:"Relay" json:"Relay" toml:"Relay" yaml:"Relay"`
+	ClientAllocations         AllocationSlice         `boil:"ClientAllocations" json:"ClientAllocations" toml:"ClientAllocations" yaml:"ClientAllocations"`
+	RemoteAllocations         AllocationSlice         `boil:"RemoteAllocations" json:"RemoteAllocations" toml:"RemoteAllocations" yaml:"RemoteAllocations"`
 	Clients                   ClientSlice             `boil:"Clients" json:"Clients" toml:"Clients" yaml:"Clients"`
 	LocalConnectionEvents     ConnectionEventSlice    `boil:"LocalConnectionEvents" json:"LocalConnectionEvents" toml:"LocalConnectionEvents" yaml:"LocalConnectionEvents"`
 	RemoteConnectionEvents    ConnectionEventSlice    `boil:"RemoteConnectionEvents" json:"RemoteConnectionEvents" toml:"RemoteConnectionEvents" yaml:"RemoteConnectionEvents"`
 	LocalHolePunchResults     HolePunchResultSlice    `boil:"LocalHolePunchResults" json:"LocalHolePunchResults" toml:"LocalHolePunchResults" yaml:"LocalHolePunchResults"`
+	RelayHolePunchResults     HolePunchResultSlice    `boil:"RelayHolePunchResults" json:"RelayHolePunchResults" toml:"RelayHolePunchResults" yaml:"RelayHolePunchResults"`
 	RemoteHolePunchResults    HolePunchResultSlice    `boil:"RemoteHolePunchResults" json:"RemoteHolePunchResults" toml:"RemoteHolePunchResults" yaml:"RemoteHolePunchResults"`
 	RemoteLatencyMeasurements LatencyMeasurementSlice `boil:"RemoteLatencyMeasurements" json:"RemoteLatencyMeasurements" toml:"RemoteLatencyMeasurements" yaml:"RemoteLatencyMeasurements"`
 	NetworkInformations       NetworkInformationSlice `boil:"NetworkInformations" json:"NetworkInformations" toml:"NetworkInformations" yaml:"NetworkInformations"`
 	PeerLogs                  PeerLogSlice            `boil:"PeerLogs" json:"PeerLogs" toml:"PeerLogs" yaml:"PeerLogs"`
+	RelayRelayDials           RelayDialSlice          `boil:"RelayRelayDials" json:"RelayRelayDials" toml:"RelayRelayDials" yaml:"RelayRelayDials"`
 }
 
 // NewStruct creates a new relationship struct
@@ -129,6 +172,27 @@ func (*peerR) NewStruct() *peerR {
 	return &peerR{}
 }
 
+func (r *peerR) GetRelay() *Relay {
+	if r == nil {
+		return nil
+	}
+	return r.Relay
+}
+
+func (r *peerR) GetClientAllocations() AllocationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ClientAllocations
+}
+
+func (r *peerR) GetRemoteAllocations() AllocationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RemoteAllocations
+}
+
 func (r *peerR) GetClients() ClientSlice {
 	if r == nil {
 		return nil
@@ -157,6 +221,13 @@ func (r *peerR) GetLocalHolePunchResults() HolePunchResultSlice {
 	return r.LocalHolePunchResults
 }
 
+func (r *peerR) GetRelayHolePunchResults() HolePunchResultSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RelayHolePunchResults
+}
+
 func (r *peerR) GetRemoteHolePunchResults() HolePunchResultSlice {
 	if r == nil {
 		return nil
@@ -185,13 +256,20 @@ func (r *peerR) GetPeerLogs() PeerLogSlice {
 	return r.PeerLogs
 }
 
+func (r *peerR) GetRelayRelayDials() RelayDialSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RelayRelayDials
+}
+
 // peerL is where Load methods for each relationship are stored.
 type peerL struct{}
 
 var (
-	peerAllColumns            = []string{"id", "multi_hash", "agent_version", "protocols", "updated_at", "created_at"}
+	peerAllColumns            = []string{"id", "multi_hash", "agent_version", "protocols", "updated_at", "created_at", "implementation", "implementation_version", "go_libp2p_version", "classified_agent_version"}
 	peerColumnsWithoutDefault = []string{"multi_hash", "updated_at", "created_at"}
-	peerColumnsWithDefault    = []string{"id", "agent_version", "protocols"}
+	peerColumnsWithDefault    = []string{"id", "agent_version", "protocols", "implementation", "implementation_version", "go_libp2p_version", "classified_agent_version"}
 	peerPrimaryKeyColumns     = []string{"id"}
 	peerGeneratedColumns      = []string{"id"}
 )
@@ -474,6 +552,45 @@ func (q peerQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Relay pointed to by the foreign key.
+func (o *Peer) Relay(mods ...qm.QueryMod) relayQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"peer_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Relays(queryMods...)
+}
+
+// ClientAllocations retrieves all the allocation's Allocations with an executor via client_id column.
+func (o *Peer) ClientAllocations(mods ...qm.QueryMod) allocationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"allocations\".\"client_id\"=?", o.ID),
+	)
+
+	return Allocations(queryMods...)
+}
+
+// RemoteAllocations retrieves all the allocation's Allocations with an executor via remote_id column.
+func (o *Peer) RemoteAllocations(mods ...qm.QueryMod) allocationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"allocations\".\"remote_id\"=?", o.ID),
+	)
+
+	return Allocations(queryMods...)
+}
+
 // Clients retrieves all the client's Clients with an executor.
 func (o *Peer) Clients(mods ...qm.QueryMod) clientQuery {
 	var queryMods []qm.QueryMod
@@ -530,6 +647,20 @@ func (o *Peer) LocalHolePunchResults(mods ...qm.QueryMod) holePunchResultQuery {
 	return HolePunchResults(queryMods...)
 }
 
+// RelayHolePunchResults retrieves all the hole_punch_result's HolePunchResults with an executor via relay_id column.
+func (o *Peer) RelayHolePunchResults(mods ...qm.QueryMod) holePunchResultQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"hole_punch_results\".\"relay_id\"=?", o.ID),
+	)
+
+	return HolePunchResults(queryMods...)
+}
+
 // RemoteHolePunchResults retrieves all the hole_punch_result's HolePunchResults with an executor via remote_id column.
 func (o *Peer) RemoteHolePunchResults(mods ...qm.QueryMod) holePunchResultQuery {
 	var queryMods []qm.QueryMod
@@ -586,9 +717,23 @@ func (o *Peer) PeerLogs(mods ...qm.QueryMod) peerLogQuery {
 	return PeerLogs(queryMods...)
 }
 
-// LoadClients allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (peerL) LoadClients(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+// RelayRelayDials retrieves all the relay_dial's RelayDials with an executor via relay_id column.
+func (o *Peer) RelayRelayDials(mods ...qm.QueryMod) relayDialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"relay_dials\".\"relay_id\"=?", o.ID),
+	)
+
+	return RelayDials(queryMods...)
+}
+
+// LoadRelay allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (peerL) LoadRelay(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
 	var slice []*Peer
 	var object *Peer
 
@@ -642,8 +787,8 @@ func (peerL) LoadClients(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`clients`),
-		qm.WhereIn(`clients.peer_id in ?`, args...),
+		qm.From(`relays`),
+		qm.WhereIn(`relays.peer_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -651,45 +796,48 @@ func (peerL) LoadClients(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load clients")
+		return errors.Wrap(err, "failed to eager load Relay")
 	}
 
-	var resultSlice []*Client
+	var resultSlice []*Relay
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice clients")
+		return errors.Wrap(err, "failed to bind eager loaded slice Relay")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on clients")
+		return errors.Wrap(err, "failed to close results of eager load for relays")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for clients")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for relays")
 	}
 
-	if len(clientAfterSelectHooks) != 0 {
+	if len(peerAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Clients = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &clientR{}
-			}
-			foreign.R.Peer = object
+		foreign := resultSlice[0]
+		object.R.Relay = foreign
+		if foreign.R == nil {
+			foreign.R = &relayR{}
 		}
-		return nil
+		foreign.R.Peer = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.PeerID {
-				local.R.Clients = append(local.R.Clients, foreign)
+				local.R.Relay = foreign
 				if foreign.R == nil {
-					foreign.R = &clientR{}
+					foreign.R = &relayR{}
 				}
 				foreign.R.Peer = local
 				break
@@ -700,9 +848,9 @@ func (peerL) LoadClients(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadLocalConnectionEvents allows an eager lookup of values, cached into the
+// LoadClientAllocations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (peerL) LoadLocalConnectionEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+func (peerL) LoadClientAllocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
 	var slice []*Peer
 	var object *Peer
 
@@ -756,8 +904,8 @@ func (peerL) LoadLocalConnectionEvents(ctx context.Context, e boil.ContextExecut
 	}
 
 	query := NewQuery(
-		qm.From(`connection_events`),
-		qm.WhereIn(`connection_events.local_id in ?`, args...),
+		qm.From(`allocations`),
+		qm.WhereIn(`allocations.client_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -765,22 +913,22 @@ func (peerL) LoadLocalConnectionEvents(ctx context.Context, e boil.ContextExecut
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load connection_events")
+		return errors.Wrap(err, "failed to eager load allocations")
 	}
 
-	var resultSlice []*ConnectionEvent
+	var resultSlice []*Allocation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice connection_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice allocations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on connection_events")
+		return errors.Wrap(err, "failed to close results in eager load on allocations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for connection_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for allocations")
 	}
 
-	if len(connectionEventAfterSelectHooks) != 0 {
+	if len(allocationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -788,24 +936,24 @@ func (peerL) LoadLocalConnectionEvents(ctx context.Context, e boil.ContextExecut
 		}
 	}
 	if singular {
-		object.R.LocalConnectionEvents = resultSlice
+		object.R.ClientAllocations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &connectionEventR{}
+				foreign.R = &allocationR{}
 			}
-			foreign.R.Local = object
+			foreign.R.Client = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.LocalID {
-				local.R.LocalConnectionEvents = append(local.R.LocalConnectionEvents, foreign)
+			if local.ID == foreign.ClientID {
+				local.R.ClientAllocations = append(local.R.ClientAllocations, foreign)
 				if foreign.R == nil {
-					foreign.R = &connectionEventR{}
+					foreign.R = &allocationR{}
 				}
-				foreign.R.Local = local
+				foreign.R.Client = local
 				break
 			}
 		}
@@ -814,9 +962,9 @@ func (peerL) LoadLocalConnectionEvents(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadRemoteConnectionEvents allows an eager lookup of values, cached into the
+// LoadRemoteAllocations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (peerL) LoadRemoteConnectionEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+func (peerL) LoadRemoteAllocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
 	var slice []*Peer
 	var object *Peer
 
@@ -870,8 +1018,8 @@ func (peerL) LoadRemoteConnectionEvents(ctx context.Context, e boil.ContextExecu
 	}
 
 	query := NewQuery(
-		qm.From(`connection_events`),
-		qm.WhereIn(`connection_events.remote_id in ?`, args...),
+		qm.From(`allocations`),
+		qm.WhereIn(`allocations.remote_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -879,22 +1027,22 @@ func (peerL) LoadRemoteConnectionEvents(ctx context.Context, e boil.ContextExecu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load connection_events")
+		return errors.Wrap(err, "failed to eager load allocations")
 	}
 
-	var resultSlice []*ConnectionEvent
+	var resultSlice []*Allocation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice connection_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice allocations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on connection_events")
+		return errors.Wrap(err, "failed to close results in eager load on allocations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for connection_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for allocations")
 	}
 
-	if len(connectionEventAfterSelectHooks) != 0 {
+	if len(allocationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -902,10 +1050,10 @@ func (peerL) LoadRemoteConnectionEvents(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.RemoteConnectionEvents = resultSlice
+		object.R.RemoteAllocations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &connectionEventR{}
+				foreign.R = &allocationR{}
 			}
 			foreign.R.Remote = object
 		}
@@ -915,9 +1063,9 @@ func (peerL) LoadRemoteConnectionEvents(ctx context.Context, e boil.ContextExecu
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.RemoteID {
-				local.R.RemoteConnectionEvents = append(local.R.RemoteConnectionEvents, foreign)
+				local.R.RemoteAllocations = append(local.R.RemoteAllocations, foreign)
 				if foreign.R == nil {
-					foreign.R = &connectionEventR{}
+					foreign.R = &allocationR{}
 				}
 				foreign.R.Remote = local
 				break
@@ -928,9 +1076,9 @@ func (peerL) LoadRemoteConnectionEvents(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadLocalHolePunchResults allows an eager lookup of values, cached into the
+// LoadClients allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (peerL) LoadLocalHolePunchResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+func (peerL) LoadClients(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
 	var slice []*Peer
 	var object *Peer
 
@@ -984,8 +1132,8 @@ func (peerL) LoadLocalHolePunchResults(ctx context.Context, e boil.ContextExecut
 	}
 
 	query := NewQuery(
-		qm.From(`hole_punch_results`),
-		qm.WhereIn(`hole_punch_results.local_id in ?`, args...),
+		qm.From(`clients`),
+		qm.WhereIn(`clients.peer_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -993,22 +1141,22 @@ func (peerL) LoadLocalHolePunchResults(ctx context.Context, e boil.ContextExecut
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load hole_punch_results")
+		return errors.Wrap(err, "failed to eager load clients")
 	}
 
-	var resultSlice []*HolePunchResult
+	var resultSlice []*Client
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results")
+		return errors.Wrap(err, "failed to bind eager loaded slice clients")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results")
+		return errors.Wrap(err, "failed to close results in eager load on clients")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for clients")
 	}
 
-	if len(holePunchResultAfterSelectHooks) != 0 {
+	if len(clientAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1016,24 +1164,24 @@ func (peerL) LoadLocalHolePunchResults(ctx context.Context, e boil.ContextExecut
 		}
 	}
 	if singular {
-		object.R.LocalHolePunchResults = resultSlice
+		object.R.Clients = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &holePunchResultR{}
+				foreign.R = &clientR{}
 			}
-			foreign.R.Local = object
+			foreign.R.Peer = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.LocalID {
-				local.R.LocalHolePunchResults = append(local.R.LocalHolePunchResults, foreign)
+			if local.ID == foreign.PeerID {
+				local.R.Clients = append(local.R.Clients, foreign)
 				if foreign.R == nil {
-					foreign.R = &holePunchResultR{}
+					foreign.R = &clientR{}
 				}
-				foreign.R.Local = local
+				foreign.R.Peer = local
 				break
 			}
 		}
@@ -1042,9 +1190,9 @@ func (peerL) LoadLocalHolePunchResults(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadRemoteHolePunchResults allows an eager lookup of values, cached into the
+// LoadLocalConnectionEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (peerL) LoadRemoteHolePunchResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+func (peerL) LoadLocalConnectionEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
 	var slice []*Peer
 	var object *Peer
 
@@ -1098,8 +1246,8 @@ func (peerL) LoadRemoteHolePunchResults(ctx context.Context, e boil.ContextExecu
 	}
 
 	query := NewQuery(
-		qm.From(`hole_punch_results`),
-		qm.WhereIn(`hole_punch_results.remote_id in ?`, args...),
+		qm.From(`connection_events`),
+		qm.WhereIn(`connection_events.local_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1107,22 +1255,22 @@ func (peerL) LoadRemoteHolePunchResults(ctx context.Context, e boil.ContextExecu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load hole_punch_results")
+		return errors.Wrap(err, "failed to eager load connection_events")
 	}
 
-	var resultSlice []*HolePunchResult
+	var resultSlice []*ConnectionEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results")
+		return errors.Wrap(err, "failed to bind eager loaded slice connection_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results")
+		return errors.Wrap(err, "failed to close results in eager load on connection_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for connection_events")
 	}
 
-	if len(holePunchResultAfterSelectHooks) != 0 {
+	if len(connectionEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1130,24 +1278,24 @@ func (peerL) LoadRemoteHolePunchResults(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.RemoteHolePunchResults = resultSlice
+		object.R.LocalConnectionEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &holePunchResultR{}
+				foreign.R = &connectionEventR{}
 			}
-			foreign.R.Remote = object
+			foreign.R.Local = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.RemoteID {
-				local.R.RemoteHolePunchResults = append(local.R.RemoteHolePunchResults, foreign)
+			if local.ID == foreign.LocalID {
+				local.R.LocalConnectionEvents = append(local.R.LocalConnectionEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &holePunchResultR{}
+					foreign.R = &connectionEventR{}
 				}
-				foreign.R.Remote = local
+				foreign.R.Local = local
 				break
 			}
 		}
@@ -1156,9 +1304,9 @@ func (peerL) LoadRemoteHolePunchResults(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadRemoteLatencyMeasurements allows an eager lookup of values, cached into the
+// LoadRemoteConnectionEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (peerL) LoadRemoteLatencyMeasurements(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+func (peerL) LoadRemoteConnectionEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
 	var slice []*Peer
 	var object *Peer
 
@@ -1212,8 +1360,8 @@ func (peerL) LoadRemoteLatencyMeasurements(ctx context.Context, e boil.ContextEx
 	}
 
 	query := NewQuery(
-		qm.From(`latency_measurements`),
-		qm.WhereIn(`latency_measurements.remote_id in ?`, args...),
+		qm.From(`connection_events`),
+		qm.WhereIn(`connection_events.remote_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1221,22 +1369,22 @@ func (peerL) LoadRemoteLatencyMeasurements(ctx context.Context, e boil.ContextEx
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load latency_measurements")
+		return errors.Wrap(err, "failed to eager load connection_events")
 	}
 
-	var resultSlice []*LatencyMeasurement
+	var resultSlice []*ConnectionEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice latency_measurements")
+		return errors.Wrap(err, "failed to bind eager loaded slice connection_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on latency_measurements")
+		return errors.Wrap(err, "failed to close results in eager load on connection_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for latency_measurements")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for connection_events")
 	}
 
-	if len(latencyMeasurementAfterSelectHooks) != 0 {
+	if len(connectionEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1244,10 +1392,10 @@ func (peerL) LoadRemoteLatencyMeasurements(ctx context.Context, e boil.ContextEx
 		}
 	}
 	if singular {
-		object.R.RemoteLatencyMeasurements = resultSlice
+		object.R.RemoteConnectionEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &latencyMeasurementR{}
+				foreign.R = &connectionEventR{}
 			}
 			foreign.R.Remote = object
 		}
@@ -1257,9 +1405,9 @@ func (peerL) LoadRemoteLatencyMeasurements(ctx context.Context, e boil.ContextEx
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.RemoteID {
-				local.R.RemoteLatencyMeasurements = append(local.R.RemoteLatencyMeasurements, foreign)
+				local.R.RemoteConnectionEvents = append(local.R.RemoteConnectionEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &latencyMeasurementR{}
+					foreign.R = &connectionEventR{}
 				}
 				foreign.R.Remote = local
 				break
@@ -1270,9 +1418,9 @@ func (peerL) LoadRemoteLatencyMeasurements(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
-// LoadNetworkInformations allows an eager lookup of values, cached into the
+// LoadLocalHolePunchResults allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (peerL) LoadNetworkInformations(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+func (peerL) LoadLocalHolePunchResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
 	var slice []*Peer
 	var object *Peer
 
@@ -1326,8 +1474,8 @@ func (peerL) LoadNetworkInformations(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`network_information`),
-		qm.WhereIn(`network_information.peer_id in ?`, args...),
+		qm.From(`hole_punch_results`),
+		qm.WhereIn(`hole_punch_results.local_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1335,22 +1483,22 @@ func (peerL) LoadNetworkInformations(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load network_information")
+		return errors.Wrap(err, "failed to eager load hole_punch_results")
 	}
 
-	var resultSlice []*NetworkInformation
+	var resultSlice []*HolePunchResult
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice network_information")
+		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on network_information")
+		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for network_information")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
 	}
 
-	if len(networkInformationAfterSelectHooks) != 0 {
+	if len(holePunchResultAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1358,20 +1506,476 @@ func (peerL) LoadNetworkInformations(ctx context.Context, e boil.ContextExecutor
 		}
 	}
 	if singular {
-		object.R.NetworkInformations = resultSlice
+		object.R.LocalHolePunchResults = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &networkInformationR{}
+				foreign.R = &holePunchResultR{}
 			}
-			foreign.R.Peer = object
+			foreign.R.Local = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.PeerID {
-				local.R.NetworkInformations = append(local.R.NetworkInformations, foreign)
+			if local.ID == foreign.LocalID {
+				local.R.LocalHolePunchResults = append(local.R.LocalHolePunchResults, foreign)
+				if foreign.R == nil {
+					foreign.R = &holePunchResultR{}
+				}
+				foreign.R.Local = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRelayHolePunchResults allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (peerL) LoadRelayHolePunchResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+	var slice []*Peer
+	var object *Peer
+
+	if singular {
+		var ok bool
+		object, ok = maybePeer.(*Peer)
+		if !ok {
+			object = new(Peer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePeer))
+			}
+		}
+	} else {
+		s, ok := maybePeer.(*[]*Peer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePeer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &peerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &peerR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_results`),
+		qm.WhereIn(`hole_punch_results.relay_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load hole_punch_results")
+	}
+
+	var resultSlice []*HolePunchResult
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
+	}
+
+	if len(holePunchResultAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RelayHolePunchResults = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holePunchResultR{}
+			}
+			foreign.R.Relay = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RelayID) {
+				local.R.RelayHolePunchResults = append(local.R.RelayHolePunchResults, foreign)
+				if foreign.R == nil {
+					foreign.R = &holePunchResultR{}
+				}
+				foreign.R.Relay = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRemoteHolePunchResults allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (peerL) LoadRemoteHolePunchResults(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+	var slice []*Peer
+	var object *Peer
+
+	if singular {
+		var ok bool
+		object, ok = maybePeer.(*Peer)
+		if !ok {
+			object = new(Peer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePeer))
+			}
+		}
+	} else {
+		s, ok := maybePeer.(*[]*Peer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePeer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &peerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &peerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_results`),
+		qm.WhereIn(`hole_punch_results.remote_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load hole_punch_results")
+	}
+
+	var resultSlice []*HolePunchResult
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice hole_punch_results")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on hole_punch_results")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
+	}
+
+	if len(holePunchResultAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RemoteHolePunchResults = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holePunchResultR{}
+			}
+			foreign.R.Remote = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RemoteID {
+				local.R.RemoteHolePunchResults = append(local.R.RemoteHolePunchResults, foreign)
+				if foreign.R == nil {
+					foreign.R = &holePunchResultR{}
+				}
+				foreign.R.Remote = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRemoteLatencyMeasurements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (peerL) LoadRemoteLatencyMeasurements(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+	var slice []*Peer
+	var object *Peer
+
+	if singular {
+		var ok bool
+		object, ok = maybePeer.(*Peer)
+		if !ok {
+			object = new(Peer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePeer))
+			}
+		}
+	} else {
+		s, ok := maybePeer.(*[]*Peer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePeer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &peerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &peerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`latency_measurements`),
+		qm.WhereIn(`latency_measurements.remote_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load latency_measurements")
+	}
+
+	var resultSlice []*LatencyMeasurement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice latency_measurements")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on latency_measurements")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for latency_measurements")
+	}
+
+	if len(latencyMeasurementAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RemoteLatencyMeasurements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &latencyMeasurementR{}
+			}
+			foreign.R.Remote = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RemoteID {
+				local.R.RemoteLatencyMeasurements = append(local.R.RemoteLatencyMeasurements, foreign)
+				if foreign.R == nil {
+					foreign.R = &latencyMeasurementR{}
+				}
+				foreign.R.Remote = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNetworkInformations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (peerL) LoadNetworkInformations(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+	var slice []*Peer
+	var object *Peer
+
+	if singular {
+		var ok bool
+		object, ok = maybePeer.(*Peer)
+		if !ok {
+			object = new(Peer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePeer))
+			}
+		}
+	} else {
+		s, ok := maybePeer.(*[]*Peer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePeer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &peerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &peerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`network_information`),
+		qm.WhereIn(`network_information.peer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load network_information")
+	}
+
+	var resultSlice []*NetworkInformation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice network_information")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on network_information")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for network_information")
+	}
+
+	if len(networkInformationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NetworkInformations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &networkInformationR{}
+			}
+			foreign.R.Peer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PeerID {
+				local.R.NetworkInformations = append(local.R.NetworkInformations, foreign)
 				if foreign.R == nil {
 					foreign.R = &networkInformationR{}
 				}
@@ -1384,9 +1988,123 @@ func (peerL) LoadNetworkInformations(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadPeerLogs allows an eager lookup of values, cached into the
+// LoadPeerLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (peerL) LoadPeerLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+	var slice []*Peer
+	var object *Peer
+
+	if singular {
+		var ok bool
+		object, ok = maybePeer.(*Peer)
+		if !ok {
+			object = new(Peer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePeer))
+			}
+		}
+	} else {
+		s, ok := maybePeer.(*[]*Peer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePeer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePeer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &peerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &peerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`peer_logs`),
+		qm.WhereIn(`peer_logs.peer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load peer_logs")
+	}
+
+	var resultSlice []*PeerLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice peer_logs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on peer_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for peer_logs")
+	}
+
+	if len(peerLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PeerLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &peerLogR{}
+			}
+			foreign.R.Peer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PeerID {
+				local.R.PeerLogs = append(local.R.PeerLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &peerLogR{}
+				}
+				foreign.R.Peer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRelayRelayDials allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (peerL) LoadPeerLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
+func (peerL) LoadRelayRelayDials(ctx context.Context, e boil.ContextExecutor, singular bool, maybePeer interface{}, mods queries.Applicator) error {
 	var slice []*Peer
 	var object *Peer
 
@@ -1440,8 +2158,8 @@ func (peerL) LoadPeerLogs(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`peer_logs`),
-		qm.WhereIn(`peer_logs.peer_id in ?`, args...),
+		qm.From(`relay_dials`),
+		qm.WhereIn(`relay_dials.relay_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1449,22 +2167,22 @@ func (peerL) LoadPeerLogs(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load peer_logs")
+		return errors.Wrap(err, "failed to eager load relay_dials")
 	}
 
-	var resultSlice []*PeerLog
+	var resultSlice []*RelayDial
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice peer_logs")
+		return errors.Wrap(err, "failed to bind eager loaded slice relay_dials")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on peer_logs")
+		return errors.Wrap(err, "failed to close results in eager load on relay_dials")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for peer_logs")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for relay_dials")
 	}
 
-	if len(peerLogAfterSelectHooks) != 0 {
+	if len(relayDialAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1472,24 +2190,24 @@ func (peerL) LoadPeerLogs(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.PeerLogs = resultSlice
+		object.R.RelayRelayDials = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &peerLogR{}
+				foreign.R = &relayDialR{}
 			}
-			foreign.R.Peer = object
+			foreign.R.Relay = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.PeerID {
-				local.R.PeerLogs = append(local.R.PeerLogs, foreign)
+			if local.ID == foreign.RelayID {
+				local.R.RelayRelayDials = append(local.R.RelayRelayDials, foreign)
 				if foreign.R == nil {
-					foreign.R = &peerLogR{}
+					foreign.R = &relayDialR{}
 				}
-				foreign.R.Peer = local
+				foreign.R.Relay = local
 				break
 			}
 		}
@@ -1498,6 +2216,162 @@ func (peerL) LoadPeerLogs(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// SetRelay of the peer to the related item.
+// Sets o.R.Relay to related.
+// Adds o to related.R.Peer.
+func (o *Peer) SetRelay(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Relay) error {
+	var err error
+
+	if insert {
+		related.PeerID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"relays\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"peer_id"}),
+			strmangle.WhereClause("\"", "\"", 2, relayPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.PeerID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.PeerID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &peerR{
+			Relay: related,
+		}
+	} else {
+		o.R.Relay = related
+	}
+
+	if related.R == nil {
+		related.R = &relayR{
+			Peer: o,
+		}
+	} else {
+		related.R.Peer = o
+	}
+	return nil
+}
+
+// AddClientAllocations adds the given related objects to the existing relationships
+// of the peer, optionally inserting them as new records.
+// Appends related to o.R.ClientAllocations.
+// Sets related.R.Client appropriately.
+func (o *Peer) AddClientAllocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Allocation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ClientID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"allocations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"client_id"}),
+				strmangle.WhereClause("\"", "\"", 2, allocationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ClientID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &peerR{
+			ClientAllocations: related,
+		}
+	} else {
+		o.R.ClientAllocations = append(o.R.ClientAllocations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &allocationR{
+				Client: o,
+			}
+		} else {
+			rel.R.Client = o
+		}
+	}
+	return nil
+}
+
+// AddRemoteAllocations adds the given related objects to the existing relationships
+// of the peer, optionally inserting them as new records.
+// Appends related to o.R.RemoteAllocations.
+// Sets related.R.Remote appropriately.
+func (o *Peer) AddRemoteAllocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Allocation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RemoteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"allocations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"remote_id"}),
+				strmangle.WhereClause("\"", "\"", 2, allocationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RemoteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &peerR{
+			RemoteAllocations: related,
+		}
+	} else {
+		o.R.RemoteAllocations = append(o.R.RemoteAllocations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &allocationR{
+				Remote: o,
+			}
+		} else {
+			rel.R.Remote = o
+		}
+	}
+	return nil
+}
+
 // AddClients adds the given related objects to the existing relationships
 // of the peer, optionally inserting them as new records.
 // Appends related to o.R.Clients.
@@ -1569,7 +2443,7 @@ func (o *Peer) AddLocalConnectionEvents(ctx context.Context, exec boil.ContextEx
 				strmangle.SetParamNames("\"", "\"", 1, []string{"local_id"}),
 				strmangle.WhereClause("\"", "\"", 2, connectionEventPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.ID, rel.OpenedAt}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1622,7 +2496,7 @@ func (o *Peer) AddRemoteConnectionEvents(ctx context.Context, exec boil.ContextE
 				strmangle.SetParamNames("\"", "\"", 1, []string{"remote_id"}),
 				strmangle.WhereClause("\"", "\"", 2, connectionEventPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.ID, rel.OpenedAt}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1710,6 +2584,133 @@ func (o *Peer) AddLocalHolePunchResults(ctx context.Context, exec boil.ContextEx
 	return nil
 }
 
+// AddRelayHolePunchResults adds the given related objects to the existing relationships
+// of the peer, optionally inserting them as new records.
+// Appends related to o.R.RelayHolePunchResults.
+// Sets related.R.Relay appropriately.
+func (o *Peer) AddRelayHolePunchResults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchResult) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RelayID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"hole_punch_results\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"relay_id"}),
+				strmangle.WhereClause("\"", "\"", 2, holePunchResultPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RelayID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &peerR{
+			RelayHolePunchResults: related,
+		}
+	} else {
+		o.R.RelayHolePunchResults = append(o.R.RelayHolePunchResults, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &holePunchResultR{
+				Relay: o,
+			}
+		} else {
+			rel.R.Relay = o
+		}
+	}
+	return nil
+}
+
+// SetRelayHolePunchResults removes all previously related items of the
+// peer replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Relay's RelayHolePunchResults accordingly.
+// Replaces o.R.RelayHolePunchResults with related.
+// Sets related.R.Relay's RelayHolePunchResults accordingly.
+func (o *Peer) SetRelayHolePunchResults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*HolePunchResult) error {
+	query := "update \"hole_punch_results\" set \"relay_id\" = null where \"relay_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RelayHolePunchResults {
+			queries.SetScanner(&rel.RelayID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Relay = nil
+		}
+		o.R.RelayHolePunchResults = nil
+	}
+
+	return o.AddRelayHolePunchResults(ctx, exec, insert, related...)
+}
+
+// RemoveRelayHolePunchResults relationships from objects passed in.
+// Removes related items from R.RelayHolePunchResults (uses pointer comparison, removal does not keep order)
+// Sets related.R.Relay.
+func (o *Peer) RemoveRelayHolePunchResults(ctx context.Context, exec boil.ContextExecutor, related ...*HolePunchResult) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RelayID, nil)
+		if rel.R != nil {
+			rel.R.Relay = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("relay_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RelayHolePunchResults {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RelayHolePunchResults)
+			if ln > 1 && i < ln-1 {
+				o.R.RelayHolePunchResults[i] = o.R.RelayHolePunchResults[ln-1]
+			}
+			o.R.RelayHolePunchResults = o.R.RelayHolePunchResults[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddRemoteHolePunchResults adds the given related objects to the existing relationships
 // of the peer, optionally inserting them as new records.
 // Appends related to o.R.RemoteHolePunchResults.
@@ -1922,6 +2923,59 @@ func (o *Peer) AddPeerLogs(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// AddRelayRelayDials adds the given related objects to the existing relationships
+// of the peer, optionally inserting them as new records.
+// Appends related to o.R.RelayRelayDials.
+// Sets related.R.Relay appropriately.
+func (o *Peer) AddRelayRelayDials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RelayDial) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RelayID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"relay_dials\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"relay_id"}),
+				strmangle.WhereClause("\"", "\"", 2, relayDialPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RelayID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &peerR{
+			RelayRelayDials: related,
+		}
+	} else {
+		o.R.RelayRelayDials = append(o.R.RelayRelayDials, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &relayDialR{
+				Relay: o,
+			}
+		} else {
+			rel.R.Relay = o
+		}
+	}
+	return nil
+}
+
 // Peers retrieves all the records using an executor.
 func Peers(mods ...qm.QueryMod) peerQuery {
 	mods = append(mods, qm.From("\"peers\""))
@@ -6,16 +6,26 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("AggregateRefreshes", testAggregateRefreshesUpsert)
+
+	t.Run("Allocations", testAllocationsUpsert)
+
 	t.Run("Authorizations", testAuthorizationsUpsert)
 
 	t.Run("Clients", testClientsUpsert)
 
 	t.Run("ConnectionEvents", testConnectionEventsUpsert)
 
+	t.Run("ConnectionEventsXMultiAddresses", testConnectionEventsXMultiAddressesUpsert)
+
 	t.Run("HolePunchAttempts", testHolePunchAttemptsUpsert)
 
+	t.Run("HolePunchEvents", testHolePunchEventsUpsert)
+
 	t.Run("HolePunchResults", testHolePunchResultsUpsert)
 
+	t.Run("HolePunchResultsHourlies", testHolePunchResultsHourliesUpsert)
+
 	t.Run("HolePunchResultsXMultiAddresses", testHolePunchResultsXMultiAddressesUpsert)
 
 	t.Run("IPAddresses", testIPAddressesUpsert)
@@ -33,4 +43,8 @@ func TestUpsert(t *testing.T) {
 	t.Run("Peers", testPeersUpsert)
 
 	t.Run("PortMappings", testPortMappingsUpsert)
+
+	t.Run("RelayDials", testRelayDialsUpsert)
+
+	t.Run("Relays", testRelaysUpsert)
 }
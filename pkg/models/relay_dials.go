@@ -0,0 +1,1330 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// RelayDial is an object representing the database table.
+type RelayDial struct {
+	ID                int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	HolePunchResultID int               `boil:"hole_punch_result_id" json:"hole_punch_result_id" toml:"hole_punch_result_id" yaml:"hole_punch_result_id"`
+	RelayID           int64             `boil:"relay_id" json:"relay_id" toml:"relay_id" yaml:"relay_id"`
+	MultiAddresses    types.StringArray `boil:"multi_addresses" json:"multi_addresses" toml:"multi_addresses" yaml:"multi_addresses"`
+	StartedAt         time.Time         `boil:"started_at" json:"started_at" toml:"started_at" yaml:"started_at"`
+	EndedAt           time.Time         `boil:"ended_at" json:"ended_at" toml:"ended_at" yaml:"ended_at"`
+	ElapsedTime       string            `boil:"elapsed_time" json:"elapsed_time" toml:"elapsed_time" yaml:"elapsed_time"`
+	RTT               null.String       `boil:"rtt" json:"rtt,omitempty" toml:"rtt" yaml:"rtt,omitempty"`
+	Error             null.String       `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	Selected          bool              `boil:"selected" json:"selected" toml:"selected" yaml:"selected"`
+
+	R *relayDialR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L relayDialL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RelayDialColumns = struct {
+	ID                string
+	HolePunchResultID string
+	RelayID           string
+	MultiAddresses    string
+	StartedAt         string
+	EndedAt           string
+	ElapsedTime       string
+	RTT               string
+	Error             string
+	Selected          string
+}{
+	ID:                "id",
+	HolePunchResultID: "hole_punch_result_id",
+	RelayID:           "relay_id",
+	MultiAddresses:    "multi_addresses",
+	StartedAt:         "started_at",
+	EndedAt:           "ended_at",
+	ElapsedTime:       "elapsed_time",
+	RTT:               "rtt",
+	Error:             "error",
+	Selected:          "selected",
+}
+
+var RelayDialTableColumns = struct {
+	ID                string
+	HolePunchResultID string
+	RelayID           string
+	MultiAddresses    string
+	StartedAt         string
+	EndedAt           string
+	ElapsedTime       string
+	RTT               string
+	Error             string
+	Selected          string
+}{
+	ID:                "relay_dials.id",
+	HolePunchResultID: "relay_dials.hole_punch_result_id",
+	RelayID:           "relay_dials.relay_id",
+	MultiAddresses:    "relay_dials.multi_addresses",
+	StartedAt:         "relay_dials.started_at",
+	EndedAt:           "relay_dials.ended_at",
+	ElapsedTime:       "relay_dials.elapsed_time",
+	RTT:               "relay_dials.rtt",
+	Error:             "relay_dials.error",
+	Selected:          "relay_dials.selected",
+}
+
+// Generated where
+
+var RelayDialWhere = struct {
+	ID                whereHelperint
+	HolePunchResultID whereHelperint
+	RelayID           whereHelperint64
+	MultiAddresses    whereHelpertypes_StringArray
+	StartedAt         whereHelpertime_Time
+	EndedAt           whereHelpertime_Time
+	ElapsedTime       whereHelperstring
+	RTT               whereHelpernull_String
+	Error             whereHelpernull_String
+	Selected          whereHelperbool
+}{
+	ID:                whereHelperint{field: "\"relay_dials\".\"id\""},
+	HolePunchResultID: whereHelperint{field: "\"relay_dials\".\"hole_punch_result_id\""},
+	RelayID:           whereHelperint64{field: "\"relay_dials\".\"relay_id\""},
+	MultiAddresses:    whereHelpertypes_StringArray{field: "\"relay_dials\".\"multi_addresses\""},
+	StartedAt:         whereHelpertime_Time{field: "\"relay_dials\".\"started_at\""},
+	EndedAt:           whereHelpertime_Time{field: "\"relay_dials\".\"ended_at\""},
+	ElapsedTime:       whereHelperstring{field: "\"relay_dials\".\"elapsed_time\""},
+	RTT:               whereHelpernull_String{field: "\"relay_dials\".\"rtt\""},
+	Error:             whereHelpernull_String{field: "\"relay_dials\".\"error\""},
+	Selected:          whereHelperbool{field: "\"relay_dials\".\"selected\""},
+}
+
+// RelayDialRels is where relationship names are stored.
+var RelayDialRels = struct {
+	HolePunchResult string
+	Relay           string
+}{
+	HolePunchResult: "HolePunchResult",
+	Relay:           "Relay",
+}
+
+// relayDialR is where relationships are stored.
+type relayDialR struct {
+	HolePunchResult *HolePunchResult `boil:"HolePunchResult" json:"HolePunchResult" toml:"HolePunchResult" yaml:"HolePunchResult"`
+	Relay           *Peer            `boil:"Relay" json:"Relay" toml:"Relay" yaml:"Relay"`
+}
+
+// NewStruct creates a new relationship struct
+func (*relayDialR) NewStruct() *relayDialR {
+	return &relayDialR{}
+}
+
+func (r *relayDialR) GetHolePunchResult() *HolePunchResult {
+	if r == nil {
+		return nil
+	}
+	return r.HolePunchResult
+}
+
+func (r *relayDialR) GetRelay() *Peer {
+	if r == nil {
+		return nil
+	}
+	return r.Relay
+}
+
+// relayDialL is where Load methods for each relationship are stored.
+type relayDialL struct{}
+
+var (
+	relayDialAllColumns            = []string{"id", "hole_punch_result_id", "relay_id", "multi_addresses", "started_at", "ended_at", "elapsed_time", "rtt", "error", "selected"}
+	relayDialColumnsWithoutDefault = []string{"hole_punch_result_id", "relay_id", "multi_addresses", "started_at", "ended_at", "elapsed_time", "selected"}
+	relayDialColumnsWithDefault    = []string{"id", "rtt", "error"}
+	relayDialPrimaryKeyColumns     = []string{"id"}
+	relayDialGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// RelayDialSlice is an alias for a slice of pointers to RelayDial.
+	// This should almost always be used instead of []RelayDial.
+	RelayDialSlice []*RelayDial
+	// RelayDialHook is the signature for custom RelayDial hook methods
+	RelayDialHook func(context.Context, boil.ContextExecutor, *RelayDial) error
+
+	relayDialQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	relayDialType                 = reflect.TypeOf(&RelayDial{})
+	relayDialMapping              = queries.MakeStructMapping(relayDialType)
+	relayDialPrimaryKeyMapping, _ = queries.BindMapping(relayDialType, relayDialMapping, relayDialPrimaryKeyColumns)
+	relayDialInsertCacheMut       sync.RWMutex
+	relayDialInsertCache          = make(map[string]insertCache)
+	relayDialUpdateCacheMut       sync.RWMutex
+	relayDialUpdateCache          = make(map[string]updateCache)
+	relayDialUpsertCacheMut       sync.RWMutex
+	relayDialUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var relayDialAfterSelectHooks []RelayDialHook
+
+var relayDialBeforeInsertHooks []RelayDialHook
+var relayDialAfterInsertHooks []RelayDialHook
+
+var relayDialBeforeUpdateHooks []RelayDialHook
+var relayDialAfterUpdateHooks []RelayDialHook
+
+var relayDialBeforeDeleteHooks []RelayDialHook
+var relayDialAfterDeleteHooks []RelayDialHook
+
+var relayDialBeforeUpsertHooks []RelayDialHook
+var relayDialAfterUpsertHooks []RelayDialHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RelayDial) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RelayDial) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RelayDial) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RelayDial) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RelayDial) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RelayDial) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RelayDial) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RelayDial) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RelayDial) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range relayDialAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRelayDialHook registers your hook function for all future operations.
+func AddRelayDialHook(hookPoint boil.HookPoint, relayDialHook RelayDialHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		relayDialAfterSelectHooks = append(relayDialAfterSelectHooks, relayDialHook)
+	case boil.BeforeInsertHook:
+		relayDialBeforeInsertHooks = append(relayDialBeforeInsertHooks, relayDialHook)
+	case boil.AfterInsertHook:
+		relayDialAfterInsertHooks = append(relayDialAfterInsertHooks, relayDialHook)
+	case boil.BeforeUpdateHook:
+		relayDialBeforeUpdateHooks = append(relayDialBeforeUpdateHooks, relayDialHook)
+	case boil.AfterUpdateHook:
+		relayDialAfterUpdateHooks = append(relayDialAfterUpdateHooks, relayDialHook)
+	case boil.BeforeDeleteHook:
+		relayDialBeforeDeleteHooks = append(relayDialBeforeDeleteHooks, relayDialHook)
+	case boil.AfterDeleteHook:
+		relayDialAfterDeleteHooks = append(relayDialAfterDeleteHooks, relayDialHook)
+	case boil.BeforeUpsertHook:
+		relayDialBeforeUpsertHooks = append(relayDialBeforeUpsertHooks, relayDialHook)
+	case boil.AfterUpsertHook:
+		relayDialAfterUpsertHooks = append(relayDialAfterUpsertHooks, relayDialHook)
+	}
+}
+
+// One returns a single relayDial record from the query.
+func (q relayDialQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RelayDial, error) {
+	o := &RelayDial{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for relay_dials")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RelayDial records from the query.
+func (q relayDialQuery) All(ctx context.Context, exec boil.ContextExecutor) (RelayDialSlice, error) {
+	var o []*RelayDial
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RelayDial slice")
+	}
+
+	if len(relayDialAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RelayDial records in the query.
+func (q relayDialQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count relay_dials rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q relayDialQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if relay_dials exists")
+	}
+
+	return count > 0, nil
+}
+
+// HolePunchResult pointed to by the foreign key.
+func (o *RelayDial) HolePunchResult(mods ...qm.QueryMod) holePunchResultQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.HolePunchResultID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return HolePunchResults(queryMods...)
+}
+
+// Relay pointed to by the foreign key.
+func (o *RelayDial) Relay(mods ...qm.QueryMod) peerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RelayID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Peers(queryMods...)
+}
+
+// LoadHolePunchResult allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (relayDialL) LoadHolePunchResult(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRelayDial interface{}, mods queries.Applicator) error {
+	var slice []*RelayDial
+	var object *RelayDial
+
+	if singular {
+		var ok bool
+		object, ok = maybeRelayDial.(*RelayDial)
+		if !ok {
+			object = new(RelayDial)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRelayDial)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRelayDial))
+			}
+		}
+	} else {
+		s, ok := maybeRelayDial.(*[]*RelayDial)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRelayDial)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRelayDial))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &relayDialR{}
+		}
+		args = append(args, object.HolePunchResultID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &relayDialR{}
+			}
+
+			for _, a := range args {
+				if a == obj.HolePunchResultID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.HolePunchResultID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hole_punch_results`),
+		qm.WhereIn(`hole_punch_results.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load HolePunchResult")
+	}
+
+	var resultSlice []*HolePunchResult
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice HolePunchResult")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for hole_punch_results")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hole_punch_results")
+	}
+
+	if len(relayDialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.HolePunchResult = foreign
+		if foreign.R == nil {
+			foreign.R = &holePunchResultR{}
+		}
+		foreign.R.RelayDials = append(foreign.R.RelayDials, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.HolePunchResultID == foreign.ID {
+				local.R.HolePunchResult = foreign
+				if foreign.R == nil {
+					foreign.R = &holePunchResultR{}
+				}
+				foreign.R.RelayDials = append(foreign.R.RelayDials, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRelay allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (relayDialL) LoadRelay(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRelayDial interface{}, mods queries.Applicator) error {
+	var slice []*RelayDial
+	var object *RelayDial
+
+	if singular {
+		var ok bool
+		object, ok = maybeRelayDial.(*RelayDial)
+		if !ok {
+			object = new(RelayDial)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRelayDial)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRelayDial))
+			}
+		}
+	} else {
+		s, ok := maybeRelayDial.(*[]*RelayDial)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRelayDial)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRelayDial))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &relayDialR{}
+		}
+		args = append(args, object.RelayID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &relayDialR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RelayID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RelayID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`peers`),
+		qm.WhereIn(`peers.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Peer")
+	}
+
+	var resultSlice []*Peer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Peer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for peers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for peers")
+	}
+
+	if len(relayDialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Relay = foreign
+		if foreign.R == nil {
+			foreign.R = &peerR{}
+		}
+		foreign.R.RelayRelayDials = append(foreign.R.RelayRelayDials, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RelayID == foreign.ID {
+				local.R.Relay = foreign
+				if foreign.R == nil {
+					foreign.R = &peerR{}
+				}
+				foreign.R.RelayRelayDials = append(foreign.R.RelayRelayDials, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetHolePunchResult of the relayDial to the related item.
+// Sets o.R.HolePunchResult to related.
+// Adds o to related.R.RelayDials.
+func (o *RelayDial) SetHolePunchResult(ctx context.Context, exec boil.ContextExecutor, insert bool, related *HolePunchResult) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"relay_dials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"hole_punch_result_id"}),
+		strmangle.WhereClause("\"", "\"", 2, relayDialPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.HolePunchResultID = related.ID
+	if o.R == nil {
+		o.R = &relayDialR{
+			HolePunchResult: related,
+		}
+	} else {
+		o.R.HolePunchResult = related
+	}
+
+	if related.R == nil {
+		related.R = &holePunchResultR{
+			RelayDials: RelayDialSlice{o},
+		}
+	} else {
+		related.R.RelayDials = append(related.R.RelayDials, o)
+	}
+
+	return nil
+}
+
+// SetRelay of the relayDial to the related item.
+// Sets o.R.Relay to related.
+// Adds o to related.R.RelayRelayDials.
+func (o *RelayDial) SetRelay(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Peer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"relay_dials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"relay_id"}),
+		strmangle.WhereClause("\"", "\"", 2, relayDialPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RelayID = related.ID
+	if o.R == nil {
+		o.R = &relayDialR{
+			Relay: related,
+		}
+	} else {
+		o.R.Relay = related
+	}
+
+	if related.R == nil {
+		related.R = &peerR{
+			RelayRelayDials: RelayDialSlice{o},
+		}
+	} else {
+		related.R.RelayRelayDials = append(related.R.RelayRelayDials, o)
+	}
+
+	return nil
+}
+
+// RelayDials retrieves all the records using an executor.
+func RelayDials(mods ...qm.QueryMod) relayDialQuery {
+	mods = append(mods, qm.From("\"relay_dials\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"relay_dials\".*"})
+	}
+
+	return relayDialQuery{q}
+}
+
+// FindRelayDial retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRelayDial(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*RelayDial, error) {
+	relayDialObj := &RelayDial{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"relay_dials\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, relayDialObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from relay_dials")
+	}
+
+	if err = relayDialObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return relayDialObj, err
+	}
+
+	return relayDialObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RelayDial) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no relay_dials provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(relayDialColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	relayDialInsertCacheMut.RLock()
+	cache, cached := relayDialInsertCache[key]
+	relayDialInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			relayDialAllColumns,
+			relayDialColumnsWithDefault,
+			relayDialColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, relayDialGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(relayDialType, relayDialMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(relayDialType, relayDialMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"relay_dials\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"relay_dials\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into relay_dials")
+	}
+
+	if !cached {
+		relayDialInsertCacheMut.Lock()
+		relayDialInsertCache[key] = cache
+		relayDialInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RelayDial.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RelayDial) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	relayDialUpdateCacheMut.RLock()
+	cache, cached := relayDialUpdateCache[key]
+	relayDialUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			relayDialAllColumns,
+			relayDialPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, relayDialGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update relay_dials, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"relay_dials\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, relayDialPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(relayDialType, relayDialMapping, append(wl, relayDialPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update relay_dials row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for relay_dials")
+	}
+
+	if !cached {
+		relayDialUpdateCacheMut.Lock()
+		relayDialUpdateCache[key] = cache
+		relayDialUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q relayDialQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for relay_dials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for relay_dials")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RelayDialSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), relayDialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"relay_dials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, relayDialPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in relayDial slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all relayDial")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RelayDial) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no relay_dials provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(relayDialColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	relayDialUpsertCacheMut.RLock()
+	cache, cached := relayDialUpsertCache[key]
+	relayDialUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			relayDialAllColumns,
+			relayDialColumnsWithDefault,
+			relayDialColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			relayDialAllColumns,
+			relayDialPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetComplement(insert, relayDialGeneratedColumns)
+		update = strmangle.SetComplement(update, relayDialGeneratedColumns)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert relay_dials, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(relayDialPrimaryKeyColumns))
+			copy(conflict, relayDialPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"relay_dials\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(relayDialType, relayDialMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(relayDialType, relayDialMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert relay_dials")
+	}
+
+	if !cached {
+		relayDialUpsertCacheMut.Lock()
+		relayDialUpsertCache[key] = cache
+		relayDialUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RelayDial record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RelayDial) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RelayDial provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), relayDialPrimaryKeyMapping)
+	sql := "DELETE FROM \"relay_dials\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from relay_dials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for relay_dials")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q relayDialQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no relayDialQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from relay_dials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for relay_dials")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RelayDialSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(relayDialBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), relayDialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"relay_dials\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, relayDialPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from relayDial slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for relay_dials")
+	}
+
+	if len(relayDialAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RelayDial) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRelayDial(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RelayDialSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RelayDialSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), relayDialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"relay_dials\".* FROM \"relay_dials\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, relayDialPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RelayDialSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RelayDialExists checks if the RelayDial row exists.
+func RelayDialExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"relay_dials\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if relay_dials exists")
+	}
+
+	return exists, nil
+}
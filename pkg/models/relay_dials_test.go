@@ -0,0 +1,950 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRelayDials(t *testing.T) {
+	t.Parallel()
+
+	query := RelayDials()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRelayDialsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRelayDialsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RelayDials().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRelayDialsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RelayDialSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRelayDialsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RelayDialExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if RelayDial exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RelayDialExists to return true, but got false.")
+	}
+}
+
+func testRelayDialsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	relayDialFound, err := FindRelayDial(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if relayDialFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRelayDialsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RelayDials().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRelayDialsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RelayDials().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRelayDialsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	relayDialOne := &RelayDial{}
+	relayDialTwo := &RelayDial{}
+	if err = randomize.Struct(seed, relayDialOne, relayDialDBTypes, false, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+	if err = randomize.Struct(seed, relayDialTwo, relayDialDBTypes, false, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = relayDialOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = relayDialTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RelayDials().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRelayDialsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	relayDialOne := &RelayDial{}
+	relayDialTwo := &RelayDial{}
+	if err = randomize.Struct(seed, relayDialOne, relayDialDBTypes, false, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+	if err = randomize.Struct(seed, relayDialTwo, relayDialDBTypes, false, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = relayDialOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = relayDialTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func relayDialBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func relayDialAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func relayDialAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func relayDialBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func relayDialAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func relayDialBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func relayDialAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func relayDialBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func relayDialAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RelayDial) error {
+	*o = RelayDial{}
+	return nil
+}
+
+func testRelayDialsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &RelayDial{}
+	o := &RelayDial{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, relayDialDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize RelayDial object: %s", err)
+	}
+
+	AddRelayDialHook(boil.BeforeInsertHook, relayDialBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	relayDialBeforeInsertHooks = []RelayDialHook{}
+
+	AddRelayDialHook(boil.AfterInsertHook, relayDialAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	relayDialAfterInsertHooks = []RelayDialHook{}
+
+	AddRelayDialHook(boil.AfterSelectHook, relayDialAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	relayDialAfterSelectHooks = []RelayDialHook{}
+
+	AddRelayDialHook(boil.BeforeUpdateHook, relayDialBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	relayDialBeforeUpdateHooks = []RelayDialHook{}
+
+	AddRelayDialHook(boil.AfterUpdateHook, relayDialAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	relayDialAfterUpdateHooks = []RelayDialHook{}
+
+	AddRelayDialHook(boil.BeforeDeleteHook, relayDialBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	relayDialBeforeDeleteHooks = []RelayDialHook{}
+
+	AddRelayDialHook(boil.AfterDeleteHook, relayDialAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	relayDialAfterDeleteHooks = []RelayDialHook{}
+
+	AddRelayDialHook(boil.BeforeUpsertHook, relayDialBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	relayDialBeforeUpsertHooks = []RelayDialHook{}
+
+	AddRelayDialHook(boil.AfterUpsertHook, relayDialAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	relayDialAfterUpsertHooks = []RelayDialHook{}
+}
+
+func testRelayDialsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRelayDialsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(relayDialColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRelayDialToOneHolePunchResultUsingHolePunchResult(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RelayDial
+	var foreign HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, relayDialDBTypes, false, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, holePunchResultDBTypes, false, holePunchResultColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize HolePunchResult struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.HolePunchResultID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.HolePunchResult().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RelayDialSlice{&local}
+	if err = local.L.LoadHolePunchResult(ctx, tx, false, (*[]*RelayDial)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.HolePunchResult == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.HolePunchResult = nil
+	if err = local.L.LoadHolePunchResult(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.HolePunchResult == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRelayDialToOnePeerUsingRelay(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RelayDial
+	var foreign Peer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, relayDialDBTypes, false, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Peer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RelayID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Relay().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RelayDialSlice{&local}
+	if err = local.L.LoadRelay(ctx, tx, false, (*[]*RelayDial)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Relay == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Relay = nil
+	if err = local.L.LoadRelay(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Relay == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRelayDialToOneSetOpHolePunchResultUsingHolePunchResult(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RelayDial
+	var b, c HolePunchResult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, relayDialDBTypes, false, strmangle.SetComplement(relayDialPrimaryKeyColumns, relayDialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, holePunchResultDBTypes, false, strmangle.SetComplement(holePunchResultPrimaryKeyColumns, holePunchResultColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*HolePunchResult{&b, &c} {
+		err = a.SetHolePunchResult(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.HolePunchResult != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RelayDials[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.HolePunchResultID != x.ID {
+			t.Error("foreign key was wrong value", a.HolePunchResultID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.HolePunchResultID))
+		reflect.Indirect(reflect.ValueOf(&a.HolePunchResultID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.HolePunchResultID != x.ID {
+			t.Error("foreign key was wrong value", a.HolePunchResultID, x.ID)
+		}
+	}
+}
+func testRelayDialToOneSetOpPeerUsingRelay(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RelayDial
+	var b, c Peer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, relayDialDBTypes, false, strmangle.SetComplement(relayDialPrimaryKeyColumns, relayDialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Peer{&b, &c} {
+		err = a.SetRelay(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Relay != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RelayRelayDials[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RelayID != x.ID {
+			t.Error("foreign key was wrong value", a.RelayID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RelayID))
+		reflect.Indirect(reflect.ValueOf(&a.RelayID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.RelayID != x.ID {
+			t.Error("foreign key was wrong value", a.RelayID, x.ID)
+		}
+	}
+}
+
+func testRelayDialsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRelayDialsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RelayDialSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRelayDialsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RelayDials().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	relayDialDBTypes = map[string]string{`ID`: `integer`, `HolePunchResultID`: `integer`, `RelayID`: `bigint`, `MultiAddresses`: `ARRAYtext`, `StartedAt`: `timestamp with time zone`, `EndedAt`: `timestamp with time zone`, `ElapsedTime`: `interval`, `RTT`: `interval`, `Error`: `text`, `Selected`: `boolean`}
+	_                = bytes.MinRead
+)
+
+func testRelayDialsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(relayDialPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(relayDialAllColumns) == len(relayDialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRelayDialsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(relayDialAllColumns) == len(relayDialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RelayDial{}
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, relayDialDBTypes, true, relayDialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(relayDialAllColumns, relayDialPrimaryKeyColumns) {
+		fields = relayDialAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			relayDialAllColumns,
+			relayDialPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, relayDialGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RelayDialSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRelayDialsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(relayDialAllColumns) == len(relayDialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RelayDial{}
+	if err = randomize.Struct(seed, &o, relayDialDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RelayDial: %s", err)
+	}
+
+	count, err := RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, relayDialDBTypes, false, relayDialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RelayDial struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RelayDial: %s", err)
+	}
+
+	count, err = RelayDials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
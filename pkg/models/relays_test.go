@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRelays(t *testing.T) {
+	t.Parallel()
+
+	query := Relays()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRelaysDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRelaysQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Relays().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRelaysSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RelaySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRelaysExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RelayExists(ctx, tx, o.PeerID)
+	if err != nil {
+		t.Errorf("Unable to check if Relay exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RelayExists to return true, but got false.")
+	}
+}
+
+func testRelaysFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	relayFound, err := FindRelay(ctx, tx, o.PeerID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if relayFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRelaysBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Relays().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRelaysOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Relays().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRelaysAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	relayOne := &Relay{}
+	relayTwo := &Relay{}
+	if err = randomize.Struct(seed, relayOne, relayDBTypes, false, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+	if err = randomize.Struct(seed, relayTwo, relayDBTypes, false, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = relayOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = relayTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Relays().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRelaysCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	relayOne := &Relay{}
+	relayTwo := &Relay{}
+	if err = randomize.Struct(seed, relayOne, relayDBTypes, false, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+	if err = randomize.Struct(seed, relayTwo, relayDBTypes, false, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = relayOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = relayTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func relayBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func relayAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func relayAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func relayBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func relayAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func relayBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func relayAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func relayBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func relayAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Relay) error {
+	*o = Relay{}
+	return nil
+}
+
+func testRelaysHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Relay{}
+	o := &Relay{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, relayDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Relay object: %s", err)
+	}
+
+	AddRelayHook(boil.BeforeInsertHook, relayBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	relayBeforeInsertHooks = []RelayHook{}
+
+	AddRelayHook(boil.AfterInsertHook, relayAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	relayAfterInsertHooks = []RelayHook{}
+
+	AddRelayHook(boil.AfterSelectHook, relayAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	relayAfterSelectHooks = []RelayHook{}
+
+	AddRelayHook(boil.BeforeUpdateHook, relayBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	relayBeforeUpdateHooks = []RelayHook{}
+
+	AddRelayHook(boil.AfterUpdateHook, relayAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	relayAfterUpdateHooks = []RelayHook{}
+
+	AddRelayHook(boil.BeforeDeleteHook, relayBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	relayBeforeDeleteHooks = []RelayHook{}
+
+	AddRelayHook(boil.AfterDeleteHook, relayAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	relayAfterDeleteHooks = []RelayHook{}
+
+	AddRelayHook(boil.BeforeUpsertHook, relayBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	relayBeforeUpsertHooks = []RelayHook{}
+
+	AddRelayHook(boil.AfterUpsertHook, relayAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	relayAfterUpsertHooks = []RelayHook{}
+}
+
+func testRelaysInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRelaysInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(relayColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRelayToOnePeerUsingPeer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Relay
+	var foreign Peer
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, relayDBTypes, false, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, peerDBTypes, false, peerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Peer struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PeerID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Peer().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RelaySlice{&local}
+	if err = local.L.LoadPeer(ctx, tx, false, (*[]*Relay)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Peer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Peer = nil
+	if err = local.L.LoadPeer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Peer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRelayToOneSetOpPeerUsingPeer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Relay
+	var b, c Peer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, relayDBTypes, false, strmangle.SetComplement(relayPrimaryKeyColumns, relayColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, peerDBTypes, false, strmangle.SetComplement(peerPrimaryKeyColumns, peerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Peer{&b, &c} {
+		err = a.SetPeer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Peer != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Relay != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PeerID != x.ID {
+			t.Error("foreign key was wrong value", a.PeerID)
+		}
+
+		if exists, err := RelayExists(ctx, tx, a.PeerID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testRelaysReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRelaysReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RelaySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRelaysSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Relays().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	relayDBTypes = map[string]string{`PeerID`: `bigint`, `Pings`: `integer`, `PingsReachable`: `integer`, `Reachability`: `double precision`, `RTT`: `double precision`, `Circuits`: `integer`, `HolePunches`: `integer`, `HolePunchesSuccessful`: `integer`, `HolePunchSuccessRate`: `double precision`, `UpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`}
+	_            = bytes.MinRead
+)
+
+func testRelaysUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(relayPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(relayAllColumns) == len(relayPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRelaysSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(relayAllColumns) == len(relayPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Relay{}
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, relayDBTypes, true, relayPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(relayAllColumns, relayPrimaryKeyColumns) {
+		fields = relayAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			relayAllColumns,
+			relayPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RelaySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRelaysUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(relayAllColumns) == len(relayPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Relay{}
+	if err = randomize.Struct(seed, &o, relayDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Relay: %s", err)
+	}
+
+	count, err := Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, relayDBTypes, false, relayPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Relay struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Relay: %s", err)
+	}
+
+	count, err = Relays().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -181,6 +181,156 @@ func (HolePunchAttemptOutcome) EnumDescriptor() ([]byte, []int) {
 	return file_punchr_proto_rawDescGZIP(), []int{1}
 }
 
+type HolePunchEventType int32
+
+const (
+	HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_UNKNOWN HolePunchEventType = 0
+	// The raw events of the go-libp2p hole punch tracer (holepunch.Event)
+	HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_DIRECT_DIAL        HolePunchEventType = 1
+	HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_PROTOCOL_ERROR     HolePunchEventType = 2
+	HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_START_HOLE_PUNCH   HolePunchEventType = 3
+	HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_END_HOLE_PUNCH     HolePunchEventType = 4
+	HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_HOLE_PUNCH_ATTEMPT HolePunchEventType = 5
+	// A connection to the remote peer was opened
+	HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_CONNECTION_OPENED HolePunchEventType = 6
+	// A connection to the remote peer was closed
+	HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_CONNECTION_CLOSED HolePunchEventType = 7
+)
+
+// Enum value maps for HolePunchEventType.
+var (
+	HolePunchEventType_name = map[int32]string{
+		0: "HOLE_PUNCH_EVENT_TYPE_UNKNOWN",
+		1: "HOLE_PUNCH_EVENT_TYPE_DIRECT_DIAL",
+		2: "HOLE_PUNCH_EVENT_TYPE_PROTOCOL_ERROR",
+		3: "HOLE_PUNCH_EVENT_TYPE_START_HOLE_PUNCH",
+		4: "HOLE_PUNCH_EVENT_TYPE_END_HOLE_PUNCH",
+		5: "HOLE_PUNCH_EVENT_TYPE_HOLE_PUNCH_ATTEMPT",
+		6: "HOLE_PUNCH_EVENT_TYPE_CONNECTION_OPENED",
+		7: "HOLE_PUNCH_EVENT_TYPE_CONNECTION_CLOSED",
+	}
+	HolePunchEventType_value = map[string]int32{
+		"HOLE_PUNCH_EVENT_TYPE_UNKNOWN":            0,
+		"HOLE_PUNCH_EVENT_TYPE_DIRECT_DIAL":        1,
+		"HOLE_PUNCH_EVENT_TYPE_PROTOCOL_ERROR":     2,
+		"HOLE_PUNCH_EVENT_TYPE_START_HOLE_PUNCH":   3,
+		"HOLE_PUNCH_EVENT_TYPE_END_HOLE_PUNCH":     4,
+		"HOLE_PUNCH_EVENT_TYPE_HOLE_PUNCH_ATTEMPT": 5,
+		"HOLE_PUNCH_EVENT_TYPE_CONNECTION_OPENED":  6,
+		"HOLE_PUNCH_EVENT_TYPE_CONNECTION_CLOSED":  7,
+	}
+)
+
+func (x HolePunchEventType) Enum() *HolePunchEventType {
+	p := new(HolePunchEventType)
+	*p = x
+	return p
+}
+
+func (x HolePunchEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HolePunchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_punchr_proto_enumTypes[2].Descriptor()
+}
+
+func (HolePunchEventType) Type() protoreflect.EnumType {
+	return &file_punchr_proto_enumTypes[2]
+}
+
+func (x HolePunchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *HolePunchEventType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = HolePunchEventType(num)
+	return nil
+}
+
+// Deprecated: Use HolePunchEventType.Descriptor instead.
+func (HolePunchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{2}
+}
+
+type RelayStrategy int32
+
+const (
+	RelayStrategy_RELAY_STRATEGY_UNKNOWN RelayStrategy = 0
+	// All relayed multi addresses were dialed at once and libp2p kept the first connection
+	RelayStrategy_RELAY_STRATEGY_ALL RelayStrategy = 1
+	// The relays were tried in the order of the multi addresses
+	RelayStrategy_RELAY_STRATEGY_FIRST RelayStrategy = 2
+	// The relay with the lowest round trip time was chosen
+	RelayStrategy_RELAY_STRATEGY_LOWEST_RTT RelayStrategy = 3
+	// The relays were tried in random order
+	RelayStrategy_RELAY_STRATEGY_RANDOM RelayStrategy = 4
+	// The server chose the relay (relay_multi_address of the GetAddrInfoResponse)
+	RelayStrategy_RELAY_STRATEGY_SERVER RelayStrategy = 5
+)
+
+// Enum value maps for RelayStrategy.
+var (
+	RelayStrategy_name = map[int32]string{
+		0: "RELAY_STRATEGY_UNKNOWN",
+		1: "RELAY_STRATEGY_ALL",
+		2: "RELAY_STRATEGY_FIRST",
+		3: "RELAY_STRATEGY_LOWEST_RTT",
+		4: "RELAY_STRATEGY_RANDOM",
+		5: "RELAY_STRATEGY_SERVER",
+	}
+	RelayStrategy_value = map[string]int32{
+		"RELAY_STRATEGY_UNKNOWN":    0,
+		"RELAY_STRATEGY_ALL":        1,
+		"RELAY_STRATEGY_FIRST":      2,
+		"RELAY_STRATEGY_LOWEST_RTT": 3,
+		"RELAY_STRATEGY_RANDOM":     4,
+		"RELAY_STRATEGY_SERVER":     5,
+	}
+)
+
+func (x RelayStrategy) Enum() *RelayStrategy {
+	p := new(RelayStrategy)
+	*p = x
+	return p
+}
+
+func (x RelayStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelayStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_punchr_proto_enumTypes[3].Descriptor()
+}
+
+func (RelayStrategy) Type() protoreflect.EnumType {
+	return &file_punchr_proto_enumTypes[3]
+}
+
+func (x RelayStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *RelayStrategy) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = RelayStrategy(num)
+	return nil
+}
+
+// Deprecated: Use RelayStrategy.Descriptor instead.
+func (RelayStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{3}
+}
+
 type LatencyMeasurementType int32
 
 const (
@@ -214,11 +364,11 @@ func (x LatencyMeasurementType) String() string {
 }
 
 func (LatencyMeasurementType) Descriptor() protoreflect.EnumDescriptor {
-	return file_punchr_proto_enumTypes[2].Descriptor()
+	return file_punchr_proto_enumTypes[4].Descriptor()
 }
 
 func (LatencyMeasurementType) Type() protoreflect.EnumType {
-	return &file_punchr_proto_enumTypes[2]
+	return &file_punchr_proto_enumTypes[4]
 }
 
 func (x LatencyMeasurementType) Number() protoreflect.EnumNumber {
@@ -237,7 +387,72 @@ func (x *LatencyMeasurementType) UnmarshalJSON(b []byte) error {
 
 // Deprecated: Use LatencyMeasurementType.Descriptor instead.
 func (LatencyMeasurementType) EnumDescriptor() ([]byte, []int) {
-	return file_punchr_proto_rawDescGZIP(), []int{2}
+	return file_punchr_proto_rawDescGZIP(), []int{4}
+}
+
+type AuthorizationRole int32
+
+const (
+	AuthorizationRole_AUTHORIZATION_ROLE_UNKNOWN AuthorizationRole = 0
+	// May register clients, request peers to hole punch and report results.
+	AuthorizationRole_AUTHORIZATION_ROLE_CONTRIBUTOR AuthorizationRole = 1
+	// May do everything a contributor may do and manage API keys.
+	AuthorizationRole_AUTHORIZATION_ROLE_ADMIN AuthorizationRole = 2
+	// May authenticate but not register clients, request peers to hole punch or report results.
+	AuthorizationRole_AUTHORIZATION_ROLE_READ_ONLY AuthorizationRole = 3
+)
+
+// Enum value maps for AuthorizationRole.
+var (
+	AuthorizationRole_name = map[int32]string{
+		0: "AUTHORIZATION_ROLE_UNKNOWN",
+		1: "AUTHORIZATION_ROLE_CONTRIBUTOR",
+		2: "AUTHORIZATION_ROLE_ADMIN",
+		3: "AUTHORIZATION_ROLE_READ_ONLY",
+	}
+	AuthorizationRole_value = map[string]int32{
+		"AUTHORIZATION_ROLE_UNKNOWN":     0,
+		"AUTHORIZATION_ROLE_CONTRIBUTOR": 1,
+		"AUTHORIZATION_ROLE_ADMIN":       2,
+		"AUTHORIZATION_ROLE_READ_ONLY":   3,
+	}
+)
+
+func (x AuthorizationRole) Enum() *AuthorizationRole {
+	p := new(AuthorizationRole)
+	*p = x
+	return p
+}
+
+func (x AuthorizationRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuthorizationRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_punchr_proto_enumTypes[5].Descriptor()
+}
+
+func (AuthorizationRole) Type() protoreflect.EnumType {
+	return &file_punchr_proto_enumTypes[5]
+}
+
+func (x AuthorizationRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *AuthorizationRole) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = AuthorizationRole(num)
+	return nil
+}
+
+// Deprecated: Use AuthorizationRole.Descriptor instead.
+func (AuthorizationRole) EnumDescriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{5}
 }
 
 type RegisterRequest struct {
@@ -248,7 +463,8 @@ type RegisterRequest struct {
 	ClientId     []byte   `protobuf:"bytes,1,req,name=client_id,json=clientId" json:"client_id,omitempty"`
 	AgentVersion *string  `protobuf:"bytes,2,req,name=agent_version,json=agentVersion" json:"agent_version,omitempty"`
 	Protocols    []string `protobuf:"bytes,3,rep,name=protocols" json:"protocols,omitempty"`
-	ApiKey       *string  `protobuf:"bytes,4,req,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	// Deprecated: Send the API key as a bearer token in the authorization metadata instead.
+	ApiKey *string `protobuf:"bytes,4,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
 }
 
 func (x *RegisterRequest) Reset() {
@@ -373,7 +589,12 @@ type GetAddrInfoRequest struct {
 	// All host IDs that the client is managing
 	AllHostIds [][]byte `protobuf:"bytes,2,rep,name=all_host_ids,json=allHostIds" json:"all_host_ids,omitempty"`
 	// An authentication key for this request
-	ApiKey *string `protobuf:"bytes,3,req,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	// Deprecated: Send the API key as a bearer token in the authorization metadata instead.
+	ApiKey *string `protobuf:"bytes,3,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	// The libp2p network (e.g., ipfs, filecoin) of the peer to hole punch. Defaults to ipfs.
+	Network *string `protobuf:"bytes,4,opt,name=network" json:"network,omitempty"`
+	// Only hole punch peers of these implementations (e.g., kubo, rust-libp2p). All implementations if empty.
+	Implementations []string `protobuf:"bytes,5,rep,name=implementations" json:"implementations,omitempty"`
 }
 
 func (x *GetAddrInfoRequest) Reset() {
@@ -429,6 +650,20 @@ func (x *GetAddrInfoRequest) GetApiKey() string {
 	return ""
 }
 
+func (x *GetAddrInfoRequest) GetNetwork() string {
+	if x != nil && x.Network != nil {
+		return *x.Network
+	}
+	return ""
+}
+
+func (x *GetAddrInfoRequest) GetImplementations() []string {
+	if x != nil {
+		return x.Implementations
+	}
+	return nil
+}
+
 type GetAddrInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -437,6 +672,16 @@ type GetAddrInfoResponse struct {
 	RemoteId       []byte   `protobuf:"bytes,1,req,name=remote_id,json=remoteId" json:"remote_id,omitempty"`
 	MultiAddresses [][]byte `protobuf:"bytes,2,rep,name=multi_addresses,json=multiAddresses" json:"multi_addresses,omitempty"`
 	Protocols      []int32  `protobuf:"varint,3,rep,name=protocols" json:"protocols,omitempty"`
+	// The ID of this allocation. Clients must report it together with the hole punch result.
+	AllocationId *int64 `protobuf:"varint,4,opt,name=allocation_id,json=allocationId" json:"allocation_id,omitempty"`
+	// Unix timestamp in nanoseconds until which the server accepts a result for this allocation
+	AllocationExpiresAt *uint64 `protobuf:"varint,5,opt,name=allocation_expires_at,json=allocationExpiresAt" json:"allocation_expires_at,omitempty"`
+	// The relayed multi address through which the client should connect to the remote peer.
+	// If it's not set, the client chooses the relay according to its relay strategy.
+	RelayMultiAddress []byte `protobuf:"bytes,6,opt,name=relay_multi_address,json=relayMultiAddress" json:"relay_multi_address,omitempty"`
+	// The timing parameters of the DCUtR protocol that the client should use for this hole punch.
+	// Parameters that aren't set are taken from the configuration of the client.
+	DcutrParameters *DCUtRParameters `protobuf:"bytes,7,opt,name=dcutr_parameters,json=dcutrParameters" json:"dcutr_parameters,omitempty"`
 }
 
 func (x *GetAddrInfoResponse) Reset() {
@@ -492,6 +737,34 @@ func (x *GetAddrInfoResponse) GetProtocols() []int32 {
 	return nil
 }
 
+func (x *GetAddrInfoResponse) GetAllocationId() int64 {
+	if x != nil && x.AllocationId != nil {
+		return *x.AllocationId
+	}
+	return 0
+}
+
+func (x *GetAddrInfoResponse) GetAllocationExpiresAt() uint64 {
+	if x != nil && x.AllocationExpiresAt != nil {
+		return *x.AllocationExpiresAt
+	}
+	return 0
+}
+
+func (x *GetAddrInfoResponse) GetRelayMultiAddress() []byte {
+	if x != nil {
+		return x.RelayMultiAddress
+	}
+	return nil
+}
+
+func (x *GetAddrInfoResponse) GetDcutrParameters() *DCUtRParameters {
+	if x != nil {
+		return x.DcutrParameters
+	}
+	return nil
+}
+
 type TrackHolePunchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -525,7 +798,8 @@ type TrackHolePunchRequest struct {
 	// All multi addresses the client is listening on
 	ListenMultiAddresses [][]byte `protobuf:"bytes,12,rep,name=listen_multi_addresses,json=listenMultiAddresses" json:"listen_multi_addresses,omitempty"`
 	// An authentication key for this request
-	ApiKey *string `protobuf:"bytes,13,req,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	// Deprecated: Send the API key as a bearer token in the authorization metadata instead.
+	ApiKey *string `protobuf:"bytes,13,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
 	// Protocols filtered by
 	Protocols []int32 `protobuf:"varint,14,rep,name=protocols" json:"protocols,omitempty"`
 	// Information about the relays
@@ -534,6 +808,18 @@ type TrackHolePunchRequest struct {
 	NetworkInformation *NetworkInformation `protobuf:"bytes,16,opt,name=network_information,json=networkInformation" json:"network_information,omitempty"`
 	// AutoNAT port mappings
 	NatMappings []*NATMapping `protobuf:"bytes,17,rep,name=nat_mappings,json=natMappings" json:"nat_mappings,omitempty"`
+	// The timeline of all hole punch tracer events and connection events of the remote peer
+	HolePunchEvents []*HolePunchEvent `protobuf:"bytes,18,rep,name=hole_punch_events,json=holePunchEvents" json:"hole_punch_events,omitempty"`
+	// The allocation_id of the GetAddrInfoResponse that handed out the remote peer
+	AllocationId *int64 `protobuf:"varint,19,opt,name=allocation_id,json=allocationId" json:"allocation_id,omitempty"`
+	// How the client chose the relay through which it connected to the remote peer
+	RelayStrategy *RelayStrategy `protobuf:"varint,20,opt,name=relay_strategy,json=relayStrategy,enum=RelayStrategy" json:"relay_strategy,omitempty"`
+	// The connection attempts to the relays of the remote peer before the client connected to the remote peer
+	RelayDials []*RelayDial `protobuf:"bytes,21,rep,name=relay_dials,json=relayDials" json:"relay_dials,omitempty"`
+	// The multi address of the relayed connection to the remote peer before the hole punch
+	RelayMultiAddress []byte `protobuf:"bytes,22,opt,name=relay_multi_address,json=relayMultiAddress" json:"relay_multi_address,omitempty"`
+	// The timing parameters of the DCUtR protocol that the client used for this hole punch
+	DcutrParameters *DCUtRParameters `protobuf:"bytes,23,opt,name=dcutr_parameters,json=dcutrParameters" json:"dcutr_parameters,omitempty"`
 }
 
 func (x *TrackHolePunchRequest) Reset() {
@@ -687,6 +973,48 @@ func (x *TrackHolePunchRequest) GetNatMappings() []*NATMapping {
 	return nil
 }
 
+func (x *TrackHolePunchRequest) GetHolePunchEvents() []*HolePunchEvent {
+	if x != nil {
+		return x.HolePunchEvents
+	}
+	return nil
+}
+
+func (x *TrackHolePunchRequest) GetAllocationId() int64 {
+	if x != nil && x.AllocationId != nil {
+		return *x.AllocationId
+	}
+	return 0
+}
+
+func (x *TrackHolePunchRequest) GetRelayStrategy() RelayStrategy {
+	if x != nil && x.RelayStrategy != nil {
+		return *x.RelayStrategy
+	}
+	return RelayStrategy_RELAY_STRATEGY_UNKNOWN
+}
+
+func (x *TrackHolePunchRequest) GetRelayDials() []*RelayDial {
+	if x != nil {
+		return x.RelayDials
+	}
+	return nil
+}
+
+func (x *TrackHolePunchRequest) GetRelayMultiAddress() []byte {
+	if x != nil {
+		return x.RelayMultiAddress
+	}
+	return nil
+}
+
+func (x *TrackHolePunchRequest) GetDcutrParameters() *DCUtRParameters {
+	if x != nil {
+		return x.DcutrParameters
+	}
+	return nil
+}
+
 type TrackHolePunchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -847,29 +1175,34 @@ func (x *HolePunchAttempt) GetMultiAddresses() [][]byte {
 	return nil
 }
 
-type LatencyMeasurement struct {
+type HolePunchEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The remote peer ID
-	RemoteId []byte `protobuf:"bytes,1,req,name=remote_id,json=remoteId" json:"remote_id,omitempty"`
-	// The remote's agent_version
-	AgentVersion *string `protobuf:"bytes,2,req,name=agent_version,json=agentVersion" json:"agent_version,omitempty"`
-	// The remote's protocols
-	Protocols []string `protobuf:"bytes,3,rep,name=protocols" json:"protocols,omitempty"`
-	// The used multi address to run the ping stream over
-	MultiAddress []byte `protobuf:"bytes,4,req,name=multi_address,json=multiAddress" json:"multi_address,omitempty"`
-	// rtt measurements to the remote peer
-	Rtts []float32 `protobuf:"fixed32,5,rep,name=rtts" json:"rtts,omitempty"`
-	// rtt measurement errors to the remote peer
-	RttErrs []string `protobuf:"bytes,6,rep,name=rtt_errs,json=rttErrs" json:"rtt_errs,omitempty"`
-	// the type of latency measurement
-	Mtype *LatencyMeasurementType `protobuf:"varint,7,req,name=mtype,enum=LatencyMeasurementType" json:"mtype,omitempty"`
-}
-
-func (x *LatencyMeasurement) Reset() {
-	*x = LatencyMeasurement{}
+	// The type of this event
+	Type *HolePunchEventType `protobuf:"varint,1,req,name=type,enum=HolePunchEventType" json:"type,omitempty"`
+	// Unix timestamp in nanoseconds of when this event occurred
+	Timestamp *uint64 `protobuf:"varint,2,req,name=timestamp" json:"timestamp,omitempty"`
+	// The remote addresses of a START_HOLE_PUNCH event or
+	// the remote multi address of a CONNECTION_OPENED/CLOSED event
+	MultiAddresses [][]byte `protobuf:"bytes,3,rep,name=multi_addresses,json=multiAddresses" json:"multi_addresses,omitempty"`
+	// The attempt number of a HOLE_PUNCH_ATTEMPT event
+	Attempt *int32 `protobuf:"varint,4,opt,name=attempt" json:"attempt,omitempty"`
+	// Whether the DIRECT_DIAL or END_HOLE_PUNCH event indicated a success
+	Success *bool `protobuf:"varint,5,opt,name=success" json:"success,omitempty"`
+	// The elapsed time in seconds of a DIRECT_DIAL or END_HOLE_PUNCH event
+	ElapsedTime *float32 `protobuf:"fixed32,6,opt,name=elapsed_time,json=elapsedTime" json:"elapsed_time,omitempty"`
+	// The round trip time in seconds of a START_HOLE_PUNCH event
+	Rtt *float32 `protobuf:"fixed32,7,opt,name=rtt" json:"rtt,omitempty"`
+	// The error of a DIRECT_DIAL, PROTOCOL_ERROR or END_HOLE_PUNCH event
+	Error *string `protobuf:"bytes,8,opt,name=error" json:"error,omitempty"`
+	// The direction (inbound, outbound) of a CONNECTION_OPENED/CLOSED event
+	Direction *string `protobuf:"bytes,9,opt,name=direction" json:"direction,omitempty"`
+}
+
+func (x *HolePunchEvent) Reset() {
+	*x = HolePunchEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_punchr_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -877,13 +1210,13 @@ func (x *LatencyMeasurement) Reset() {
 	}
 }
 
-func (x *LatencyMeasurement) String() string {
+func (x *HolePunchEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatencyMeasurement) ProtoMessage() {}
+func (*HolePunchEvent) ProtoMessage() {}
 
-func (x *LatencyMeasurement) ProtoReflect() protoreflect.Message {
+func (x *HolePunchEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_punchr_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -895,77 +1228,97 @@ func (x *LatencyMeasurement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatencyMeasurement.ProtoReflect.Descriptor instead.
-func (*LatencyMeasurement) Descriptor() ([]byte, []int) {
+// Deprecated: Use HolePunchEvent.ProtoReflect.Descriptor instead.
+func (*HolePunchEvent) Descriptor() ([]byte, []int) {
 	return file_punchr_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *LatencyMeasurement) GetRemoteId() []byte {
-	if x != nil {
-		return x.RemoteId
+func (x *HolePunchEvent) GetType() HolePunchEventType {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
-	return nil
+	return HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_UNKNOWN
 }
 
-func (x *LatencyMeasurement) GetAgentVersion() string {
-	if x != nil && x.AgentVersion != nil {
-		return *x.AgentVersion
+func (x *HolePunchEvent) GetTimestamp() uint64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *LatencyMeasurement) GetProtocols() []string {
+func (x *HolePunchEvent) GetMultiAddresses() [][]byte {
 	if x != nil {
-		return x.Protocols
+		return x.MultiAddresses
 	}
 	return nil
 }
 
-func (x *LatencyMeasurement) GetMultiAddress() []byte {
-	if x != nil {
-		return x.MultiAddress
+func (x *HolePunchEvent) GetAttempt() int32 {
+	if x != nil && x.Attempt != nil {
+		return *x.Attempt
 	}
-	return nil
+	return 0
 }
 
-func (x *LatencyMeasurement) GetRtts() []float32 {
-	if x != nil {
-		return x.Rtts
+func (x *HolePunchEvent) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *LatencyMeasurement) GetRttErrs() []string {
-	if x != nil {
-		return x.RttErrs
+func (x *HolePunchEvent) GetElapsedTime() float32 {
+	if x != nil && x.ElapsedTime != nil {
+		return *x.ElapsedTime
 	}
-	return nil
+	return 0
 }
 
-func (x *LatencyMeasurement) GetMtype() LatencyMeasurementType {
-	if x != nil && x.Mtype != nil {
-		return *x.Mtype
+func (x *HolePunchEvent) GetRtt() float32 {
+	if x != nil && x.Rtt != nil {
+		return *x.Rtt
 	}
-	return LatencyMeasurementType_TO_RELAY
+	return 0
 }
 
-type NetworkInformation struct {
+func (x *HolePunchEvent) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *HolePunchEvent) GetDirection() string {
+	if x != nil && x.Direction != nil {
+		return *x.Direction
+	}
+	return ""
+}
+
+type RelayDial struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The HTML login page of the router if it has changed
-	RouterLoginHtml *string `protobuf:"bytes,1,opt,name=router_login_html,json=routerLoginHtml" json:"router_login_html,omitempty"`
-	// The error that occurred when looking up the router login page
-	RouterLoginHtmlError *string `protobuf:"bytes,2,opt,name=router_login_html_error,json=routerLoginHtmlError" json:"router_login_html_error,omitempty"`
-	// Indicates whether the current network supports IPv6
-	SupportsIpv6 *bool `protobuf:"varint,3,opt,name=supports_ipv6,json=supportsIpv6" json:"supports_ipv6,omitempty"`
-	// The error that occurred when looking up the support for IPv6
-	SupportsIpv6Error *string `protobuf:"bytes,4,opt,name=supports_ipv6_error,json=supportsIpv6Error" json:"supports_ipv6_error,omitempty"`
-}
-
-func (x *NetworkInformation) Reset() {
-	*x = NetworkInformation{}
+	// The peer ID of the relay
+	RelayId []byte `protobuf:"bytes,1,req,name=relay_id,json=relayId" json:"relay_id,omitempty"`
+	// The multi addresses of the relay that were dialed
+	MultiAddresses [][]byte `protobuf:"bytes,2,rep,name=multi_addresses,json=multiAddresses" json:"multi_addresses,omitempty"`
+	// Unix timestamp in nanoseconds of when the connection to the relay was initiated
+	StartedAt *uint64 `protobuf:"varint,3,req,name=started_at,json=startedAt" json:"started_at,omitempty"`
+	// Unix timestamp in nanoseconds of when the connection to the relay was established (or has failed)
+	EndedAt *uint64 `protobuf:"varint,4,req,name=ended_at,json=endedAt" json:"ended_at,omitempty"`
+	// The error that occurred if the connection to the relay failed
+	Error *string `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
+	// The round trip time in seconds to the relay if it was measured to choose the relay
+	Rtt *float32 `protobuf:"fixed32,6,opt,name=rtt" json:"rtt,omitempty"`
+	// Whether the client connected to the remote peer through this relay
+	Selected *bool `protobuf:"varint,7,req,name=selected" json:"selected,omitempty"`
+}
+
+func (x *RelayDial) Reset() {
+	*x = RelayDial{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_punchr_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -973,13 +1326,13 @@ func (x *NetworkInformation) Reset() {
 	}
 }
 
-func (x *NetworkInformation) String() string {
+func (x *RelayDial) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkInformation) ProtoMessage() {}
+func (*RelayDial) ProtoMessage() {}
 
-func (x *NetworkInformation) ProtoReflect() protoreflect.Message {
+func (x *RelayDial) ProtoReflect() protoreflect.Message {
 	mi := &file_punchr_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -991,68 +1344,932 @@ func (x *NetworkInformation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkInformation.ProtoReflect.Descriptor instead.
-func (*NetworkInformation) Descriptor() ([]byte, []int) {
+// Deprecated: Use RelayDial.ProtoReflect.Descriptor instead.
+func (*RelayDial) Descriptor() ([]byte, []int) {
 	return file_punchr_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *NetworkInformation) GetRouterLoginHtml() string {
-	if x != nil && x.RouterLoginHtml != nil {
-		return *x.RouterLoginHtml
+func (x *RelayDial) GetRelayId() []byte {
+	if x != nil {
+		return x.RelayId
 	}
-	return ""
+	return nil
 }
 
-func (x *NetworkInformation) GetRouterLoginHtmlError() string {
-	if x != nil && x.RouterLoginHtmlError != nil {
-		return *x.RouterLoginHtmlError
-	}
+func (x *RelayDial) GetMultiAddresses() [][]byte {
+	if x != nil {
+		return x.MultiAddresses
+	}
+	return nil
+}
+
+func (x *RelayDial) GetStartedAt() uint64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
+}
+
+func (x *RelayDial) GetEndedAt() uint64 {
+	if x != nil && x.EndedAt != nil {
+		return *x.EndedAt
+	}
+	return 0
+}
+
+func (x *RelayDial) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *RelayDial) GetRtt() float32 {
+	if x != nil && x.Rtt != nil {
+		return *x.Rtt
+	}
+	return 0
+}
+
+func (x *RelayDial) GetSelected() bool {
+	if x != nil && x.Selected != nil {
+		return *x.Selected
+	}
+	return false
+}
+
+type DCUtRParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long in seconds the client waits for the /libp2p/dcutr stream and for the events of a hole punch attempt
+	CommunicationTimeout *float32 `protobuf:"fixed32,1,opt,name=communication_timeout,json=communicationTimeout" json:"communication_timeout,omitempty"`
+	// How many hole punch attempts the client waits for. This is an observation window and doesn't
+	// change how many attempts the remote peer makes.
+	RetryCount *int32 `protobuf:"varint,2,opt,name=retry_count,json=retryCount" json:"retry_count,omitempty"`
+	// How long in seconds the client measures the round trip time to a peer
+	PingDuration *float32 `protobuf:"fixed32,3,opt,name=ping_duration,json=pingDuration" json:"ping_duration,omitempty"`
+	// How many pings the client sends to a peer to measure the round trip time
+	MaxPingCount *int32 `protobuf:"varint,4,opt,name=max_ping_count,json=maxPingCount" json:"max_ping_count,omitempty"`
+}
+
+func (x *DCUtRParameters) Reset() {
+	*x = DCUtRParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DCUtRParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DCUtRParameters) ProtoMessage() {}
+
+func (x *DCUtRParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DCUtRParameters.ProtoReflect.Descriptor instead.
+func (*DCUtRParameters) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DCUtRParameters) GetCommunicationTimeout() float32 {
+	if x != nil && x.CommunicationTimeout != nil {
+		return *x.CommunicationTimeout
+	}
+	return 0
+}
+
+func (x *DCUtRParameters) GetRetryCount() int32 {
+	if x != nil && x.RetryCount != nil {
+		return *x.RetryCount
+	}
+	return 0
+}
+
+func (x *DCUtRParameters) GetPingDuration() float32 {
+	if x != nil && x.PingDuration != nil {
+		return *x.PingDuration
+	}
+	return 0
+}
+
+func (x *DCUtRParameters) GetMaxPingCount() int32 {
+	if x != nil && x.MaxPingCount != nil {
+		return *x.MaxPingCount
+	}
+	return 0
+}
+
+type LatencyMeasurement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The remote peer ID
+	RemoteId []byte `protobuf:"bytes,1,req,name=remote_id,json=remoteId" json:"remote_id,omitempty"`
+	// The remote's agent_version
+	AgentVersion *string `protobuf:"bytes,2,req,name=agent_version,json=agentVersion" json:"agent_version,omitempty"`
+	// The remote's protocols
+	Protocols []string `protobuf:"bytes,3,rep,name=protocols" json:"protocols,omitempty"`
+	// The used multi address to run the ping stream over
+	MultiAddress []byte `protobuf:"bytes,4,req,name=multi_address,json=multiAddress" json:"multi_address,omitempty"`
+	// rtt measurements to the remote peer
+	Rtts []float32 `protobuf:"fixed32,5,rep,name=rtts" json:"rtts,omitempty"`
+	// rtt measurement errors to the remote peer
+	RttErrs []string `protobuf:"bytes,6,rep,name=rtt_errs,json=rttErrs" json:"rtt_errs,omitempty"`
+	// the type of latency measurement
+	Mtype *LatencyMeasurementType `protobuf:"varint,7,req,name=mtype,enum=LatencyMeasurementType" json:"mtype,omitempty"`
+}
+
+func (x *LatencyMeasurement) Reset() {
+	*x = LatencyMeasurement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyMeasurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyMeasurement) ProtoMessage() {}
+
+func (x *LatencyMeasurement) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyMeasurement.ProtoReflect.Descriptor instead.
+func (*LatencyMeasurement) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LatencyMeasurement) GetRemoteId() []byte {
+	if x != nil {
+		return x.RemoteId
+	}
+	return nil
+}
+
+func (x *LatencyMeasurement) GetAgentVersion() string {
+	if x != nil && x.AgentVersion != nil {
+		return *x.AgentVersion
+	}
+	return ""
+}
+
+func (x *LatencyMeasurement) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *LatencyMeasurement) GetMultiAddress() []byte {
+	if x != nil {
+		return x.MultiAddress
+	}
+	return nil
+}
+
+func (x *LatencyMeasurement) GetRtts() []float32 {
+	if x != nil {
+		return x.Rtts
+	}
+	return nil
+}
+
+func (x *LatencyMeasurement) GetRttErrs() []string {
+	if x != nil {
+		return x.RttErrs
+	}
+	return nil
+}
+
+func (x *LatencyMeasurement) GetMtype() LatencyMeasurementType {
+	if x != nil && x.Mtype != nil {
+		return *x.Mtype
+	}
+	return LatencyMeasurementType_TO_RELAY
+}
+
+type NetworkInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The HTML login page of the router if it has changed
+	RouterLoginHtml *string `protobuf:"bytes,1,opt,name=router_login_html,json=routerLoginHtml" json:"router_login_html,omitempty"`
+	// The error that occurred when looking up the router login page
+	RouterLoginHtmlError *string `protobuf:"bytes,2,opt,name=router_login_html_error,json=routerLoginHtmlError" json:"router_login_html_error,omitempty"`
+	// Indicates whether the current network supports IPv6
+	SupportsIpv6 *bool `protobuf:"varint,3,opt,name=supports_ipv6,json=supportsIpv6" json:"supports_ipv6,omitempty"`
+	// The error that occurred when looking up the support for IPv6
+	SupportsIpv6Error *string `protobuf:"bytes,4,opt,name=supports_ipv6_error,json=supportsIpv6Error" json:"supports_ipv6_error,omitempty"`
+}
+
+func (x *NetworkInformation) Reset() {
+	*x = NetworkInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInformation) ProtoMessage() {}
+
+func (x *NetworkInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInformation.ProtoReflect.Descriptor instead.
+func (*NetworkInformation) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NetworkInformation) GetRouterLoginHtml() string {
+	if x != nil && x.RouterLoginHtml != nil {
+		return *x.RouterLoginHtml
+	}
+	return ""
+}
+
+func (x *NetworkInformation) GetRouterLoginHtmlError() string {
+	if x != nil && x.RouterLoginHtmlError != nil {
+		return *x.RouterLoginHtmlError
+	}
+	return ""
+}
+
+func (x *NetworkInformation) GetSupportsIpv6() bool {
+	if x != nil && x.SupportsIpv6 != nil {
+		return *x.SupportsIpv6
+	}
+	return false
+}
+
+func (x *NetworkInformation) GetSupportsIpv6Error() string {
+	if x != nil && x.SupportsIpv6Error != nil {
+		return *x.SupportsIpv6Error
+	}
+	return ""
+}
+
+type NATMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InternalPort *int32  `protobuf:"varint,1,req,name=internal_port,json=internalPort" json:"internal_port,omitempty"`
+	ExternalPort *int32  `protobuf:"varint,2,req,name=external_port,json=externalPort" json:"external_port,omitempty"`
+	Protocol     *string `protobuf:"bytes,3,req,name=protocol" json:"protocol,omitempty"`
+	Addr         *string `protobuf:"bytes,4,req,name=addr" json:"addr,omitempty"`
+	AddrNetwork  *string `protobuf:"bytes,5,req,name=addr_network,json=addrNetwork" json:"addr_network,omitempty"`
+}
+
+func (x *NATMapping) Reset() {
+	*x = NATMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NATMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NATMapping) ProtoMessage() {}
+
+func (x *NATMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NATMapping.ProtoReflect.Descriptor instead.
+func (*NATMapping) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NATMapping) GetInternalPort() int32 {
+	if x != nil && x.InternalPort != nil {
+		return *x.InternalPort
+	}
+	return 0
+}
+
+func (x *NATMapping) GetExternalPort() int32 {
+	if x != nil && x.ExternalPort != nil {
+		return *x.ExternalPort
+	}
+	return 0
+}
+
+func (x *NATMapping) GetProtocol() string {
+	if x != nil && x.Protocol != nil {
+		return *x.Protocol
+	}
+	return ""
+}
+
+func (x *NATMapping) GetAddr() string {
+	if x != nil && x.Addr != nil {
+		return *x.Addr
+	}
+	return ""
+}
+
+func (x *NATMapping) GetAddrNetwork() string {
+	if x != nil && x.AddrNetwork != nil {
+		return *x.AddrNetwork
+	}
+	return ""
+}
+
+type ApiKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The database ID of the authorization.
+	Id *int64 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	// The full API key for create and rotate responses. Otherwise only the first characters.
+	ApiKey   *string            `protobuf:"bytes,2,req,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	Username *string            `protobuf:"bytes,3,req,name=username" json:"username,omitempty"`
+	Role     *AuthorizationRole `protobuf:"varint,4,req,name=role,enum=AuthorizationRole" json:"role,omitempty"`
+	// Unix timestamp in nanoseconds
+	CreatedAt *uint64 `protobuf:"varint,5,req,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	// Unix timestamp in nanoseconds. Not set if the key wasn't revoked.
+	RevokedAt *uint64 `protobuf:"varint,6,opt,name=revoked_at,json=revokedAt" json:"revoked_at,omitempty"`
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApiKey) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *ApiKey) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
+	return ""
+}
+
+func (x *ApiKey) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRole() AuthorizationRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return AuthorizationRole_AUTHORIZATION_ROLE_UNKNOWN
+}
+
+func (x *ApiKey) GetCreatedAt() uint64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ApiKey) GetRevokedAt() uint64 {
+	if x != nil && x.RevokedAt != nil {
+		return *x.RevokedAt
+	}
+	return 0
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Send the API key as a bearer token in the authorization metadata instead.
+	ApiKey   *string            `protobuf:"bytes,1,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	Username *string            `protobuf:"bytes,2,req,name=username" json:"username,omitempty"`
+	Role     *AuthorizationRole `protobuf:"varint,3,opt,name=role,enum=AuthorizationRole" json:"role,omitempty"`
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateApiKeyRequest) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetRole() AuthorizationRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return AuthorizationRole_AUTHORIZATION_ROLE_UNKNOWN
+}
+
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *ApiKey `protobuf:"bytes,1,req,name=api_key,json=apiKey" json:"api_key,omitempty"`
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Send the API key as a bearer token in the authorization metadata instead.
+	ApiKey *string `protobuf:"bytes,1,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	// Whether revoked keys should be included.
+	IncludeRevoked *bool `protobuf:"varint,2,opt,name=include_revoked,json=includeRevoked" json:"include_revoked,omitempty"`
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListApiKeysRequest) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
+	return ""
+}
+
+func (x *ListApiKeysRequest) GetIncludeRevoked() bool {
+	if x != nil && x.IncludeRevoked != nil {
+		return *x.IncludeRevoked
+	}
+	return false
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKeys []*ApiKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys" json:"api_keys,omitempty"`
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RotateApiKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Send the API key as a bearer token in the authorization metadata instead.
+	ApiKey *string `protobuf:"bytes,1,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	Id     *int64  `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
+}
+
+func (x *RotateApiKeyRequest) Reset() {
+	*x = RotateApiKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyRequest) ProtoMessage() {}
+
+func (x *RotateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RotateApiKeyRequest) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
+	return ""
+}
+
+func (x *RotateApiKeyRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type RotateApiKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *ApiKey `protobuf:"bytes,1,req,name=api_key,json=apiKey" json:"api_key,omitempty"`
+}
+
+func (x *RotateApiKeyResponse) Reset() {
+	*x = RotateApiKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyResponse) ProtoMessage() {}
+
+func (x *RotateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RotateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Send the API key as a bearer token in the authorization metadata instead.
+	ApiKey *string `protobuf:"bytes,1,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	Id     *int64  `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RevokeApiKeyRequest) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
 	return ""
 }
 
-func (x *NetworkInformation) GetSupportsIpv6() bool {
-	if x != nil && x.SupportsIpv6 != nil {
-		return *x.SupportsIpv6
+func (x *RevokeApiKeyRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *NetworkInformation) GetSupportsIpv6Error() string {
-	if x != nil && x.SupportsIpv6Error != nil {
-		return *x.SupportsIpv6Error
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *ApiKey `protobuf:"bytes,1,req,name=api_key,json=apiKey" json:"api_key,omitempty"`
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type NATMapping struct {
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type RenameApiKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InternalPort *int32  `protobuf:"varint,1,req,name=internal_port,json=internalPort" json:"internal_port,omitempty"`
-	ExternalPort *int32  `protobuf:"varint,2,req,name=external_port,json=externalPort" json:"external_port,omitempty"`
-	Protocol     *string `protobuf:"bytes,3,req,name=protocol" json:"protocol,omitempty"`
-	Addr         *string `protobuf:"bytes,4,req,name=addr" json:"addr,omitempty"`
-	AddrNetwork  *string `protobuf:"bytes,5,req,name=addr_network,json=addrNetwork" json:"addr_network,omitempty"`
+	// Deprecated: Send the API key as a bearer token in the authorization metadata instead.
+	ApiKey   *string `protobuf:"bytes,1,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	Id       *int64  `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
+	Username *string `protobuf:"bytes,3,req,name=username" json:"username,omitempty"`
 }
 
-func (x *NATMapping) Reset() {
-	*x = NATMapping{}
+func (x *RenameApiKeyRequest) Reset() {
+	*x = RenameApiKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_punchr_proto_msgTypes[9]
+		mi := &file_punchr_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NATMapping) String() string {
+func (x *RenameApiKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NATMapping) ProtoMessage() {}
+func (*RenameApiKeyRequest) ProtoMessage() {}
 
-func (x *NATMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_punchr_proto_msgTypes[9]
+func (x *RenameApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,44 +2280,77 @@ func (x *NATMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NATMapping.ProtoReflect.Descriptor instead.
-func (*NATMapping) Descriptor() ([]byte, []int) {
-	return file_punchr_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RenameApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RenameApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *NATMapping) GetInternalPort() int32 {
-	if x != nil && x.InternalPort != nil {
-		return *x.InternalPort
+func (x *RenameApiKeyRequest) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
 	}
-	return 0
+	return ""
 }
 
-func (x *NATMapping) GetExternalPort() int32 {
-	if x != nil && x.ExternalPort != nil {
-		return *x.ExternalPort
+func (x *RenameApiKeyRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return 0
 }
 
-func (x *NATMapping) GetProtocol() string {
-	if x != nil && x.Protocol != nil {
-		return *x.Protocol
+func (x *RenameApiKeyRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
 	}
 	return ""
 }
 
-func (x *NATMapping) GetAddr() string {
-	if x != nil && x.Addr != nil {
-		return *x.Addr
+type RenameApiKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *ApiKey `protobuf:"bytes,1,req,name=api_key,json=apiKey" json:"api_key,omitempty"`
+}
+
+func (x *RenameApiKeyResponse) Reset() {
+	*x = RenameApiKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_punchr_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *NATMapping) GetAddrNetwork() string {
-	if x != nil && x.AddrNetwork != nil {
-		return *x.AddrNetwork
+func (x *RenameApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameApiKeyResponse) ProtoMessage() {}
+
+func (x *RenameApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_punchr_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RenameApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_punchr_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RenameApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
 }
 
 var File_punchr_proto protoreflect.FileDescriptor
@@ -1115,192 +2365,390 @@ var file_punchr_proto_rawDesc = []byte{
 	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
 	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
 	0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x30, 0x0a, 0x10, 0x52,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x30, 0x0a, 0x10, 0x52,
 	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x1c, 0x0a, 0x0a, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x03, 0x52, 0x08, 0x64, 0x62, 0x50, 0x65, 0x65, 0x72, 0x49, 0x64, 0x22, 0x68, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x02, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c,
-	0x61, 0x6c, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x17,
-	0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28,
-	0x0c, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x73, 0x22, 0x87, 0x06, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65,
-	0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0c, 0x52,
-	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x04, 0x20, 0x02, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
-	0x20, 0x02, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x64,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x41, 0x0a, 0x13, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x6e,
-	0x63, 0x68, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x41, 0x74, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x52, 0x11, 0x68, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x41,
-	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73,
-	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x08, 0x20,
-	0x02, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f,
-	0x6e, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x48, 0x6f, 0x6c,
-	0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f,
-	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x0b, 0x20, 0x02, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x14, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x0d, 0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79,
-	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0e, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x46,
-	0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c,
+	0x02, 0x28, 0x03, 0x52, 0x08, 0x64, 0x62, 0x50, 0x65, 0x65, 0x72, 0x49, 0x64, 0x22, 0xac, 0x01,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbf, 0x02, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11,
+	0x72, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x3b, 0x0a, 0x10, 0x64, 0x63, 0x75, 0x74, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x44, 0x43,
+	0x55, 0x74, 0x52, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0f, 0x64,
+	0x63, 0x75, 0x74, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xba,
+	0x08, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x02, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x02, 0x28, 0x04,
+	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x41, 0x0a, 0x13, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x52, 0x11, 0x68, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x02, 0x28, 0x08, 0x52,
+	0x0e, 0x68, 0x61, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e,
+	0x63, 0x68, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x02, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a,
+	0x16, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x14, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x46, 0x0a, 0x14, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x13, 0x6c,
 	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x13, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0c,
-	0x6e, 0x61, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x11, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4e, 0x41, 0x54, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
-	0x0b, 0x6e, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x18, 0x0a, 0x16,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x48, 0x6f, 0x6c, 0x65, 0x50,
-	0x75, 0x6e, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
-	0x70, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28, 0x04, 0x52, 0x08,
-	0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x74, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x74, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x02, 0x28, 0x02, 0x52, 0x0b, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x02, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x41,
-	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f,
-	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44,
-	0x69, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x22, 0xf7, 0x01, 0x0a, 0x12, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x02, 0x28, 0x0c, 0x52,
-	0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x74, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x02, 0x52, 0x04, 0x72, 0x74, 0x74,
-	0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x74, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x74, 0x74, 0x45, 0x72, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x05,
-	0x6d, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x4c, 0x61,
-	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x74, 0x79, 0x70, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x12,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x35,
-	0x0a, 0x17, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x68,
-	0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x74, 0x6d, 0x6c,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x75,
-	0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x70, 0x76, 0x36, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75,
-	0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x49, 0x70, 0x76, 0x36, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x0a, 0x4e,
-	0x41, 0x54, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05,
-	0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50,
-	0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
-	0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2a, 0x87, 0x02, 0x0a, 0x10, 0x48, 0x6f, 0x6c, 0x65, 0x50,
-	0x75, 0x6e, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x48,
-	0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x48,
-	0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d,
-	0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x01, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f,
-	0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41,
-	0x4d, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43,
-	0x48, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x20, 0x0a, 0x1c, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x4f, 0x55,
-	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
-	0x04, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f,
-	0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05,
+	0x74, 0x73, 0x12, 0x44, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0c, 0x6e, 0x61, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x4e, 0x41, 0x54, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6e, 0x61, 0x74,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3b, 0x0a, 0x11, 0x68, 0x6f, 0x6c, 0x65,
+	0x5f, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x68, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x2b, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x69,
+	0x61, 0x6c, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x72, 0x65, 0x6c,
+	0x61, 0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3b,
+	0x0a, 0x10, 0x64, 0x63, 0x75, 0x74, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x44, 0x43, 0x55, 0x74, 0x52,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0f, 0x64, 0x63, 0x75, 0x74,
+	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75,
+	0x6e, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28, 0x04, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x74, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x02, 0x28, 0x02, 0x52, 0x0b, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x02,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x69,
+	0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x22, 0x9d, 0x02, 0x0a, 0x0e, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x02, 0x28, 0x04, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70, 0x73,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x65,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x74,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x72, 0x74, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xcd, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x69, 0x61, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0c,
+	0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x02, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x02, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x74, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x03, 0x72, 0x74, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x02, 0x28, 0x08, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x44, 0x43, 0x55, 0x74, 0x52, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0c, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x12, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0c, 0x52,
+	0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x02, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x74, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x02, 0x52,
+	0x04, 0x72, 0x74, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x74, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x74, 0x74, 0x45, 0x72, 0x72, 0x73,
+	0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x02, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0xcc, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x74,
+	0x6d, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x48, 0x74, 0x6d, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x70, 0x76, 0x36, 0x12, 0x2e,
+	0x0a, 0x13, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x70, 0x76, 0x36, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa9,
+	0x01, 0x0a, 0x0a, 0x4e, 0x41, 0x54, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x5f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x64, 0x64, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0xb3, 0x01, 0x0a, 0x06, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x02, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x72, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x38, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x07,
+	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x56,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x08, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x73, 0x22, 0x3e, 0x0a, 0x13, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x38, 0x0a, 0x14, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x61, 0x70, 0x69,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x13, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x14, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x61,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x5a, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x38, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x61, 0x70, 0x69,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x2a, 0x87, 0x02, 0x0a, 0x10,
+	0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
 	0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x4f,
-	0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x06,
-	0x2a, 0xbd, 0x02, 0x0a, 0x17, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x41, 0x74,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x22,
-	0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d,
-	0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e,
-	0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f,
-	0x4d, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x49, 0x41, 0x4c, 0x10, 0x01,
-	0x12, 0x2d, 0x0a, 0x29, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41,
-	0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12,
-	0x28, 0x0a, 0x24, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54,
-	0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43, 0x41,
-	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x48, 0x4f, 0x4c,
-	0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f,
-	0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
-	0x04, 0x12, 0x25, 0x0a, 0x21, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f,
+	0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x24, 0x0a, 0x20, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x4f,
+	0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50,
+	0x55, 0x4e, 0x43, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4e, 0x4f, 0x5f,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x48, 0x4f, 0x4c, 0x45,
+	0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e,
+	0x43, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50,
+	0x55, 0x4e, 0x43, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55,
+	0x4e, 0x43, 0x48, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0x06, 0x2a, 0xbd, 0x02, 0x0a, 0x17, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75,
+	0x6e, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x22, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f,
 	0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x26, 0x0a, 0x22, 0x48, 0x4f, 0x4c, 0x45,
-	0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f,
-	0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x06,
-	0x2a, 0x63, 0x0a, 0x16, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x4f,
-	0x5f, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x4f, 0x5f, 0x52,
-	0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x5f, 0x52, 0x45,
-	0x4c, 0x41, 0x59, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
-	0x54, 0x45, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55,
-	0x4e, 0x43, 0x48, 0x10, 0x02, 0x32, 0xbd, 0x01, 0x0a, 0x0d, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65, 0x50,
-	0x75, 0x6e, 0x63, 0x68, 0x12, 0x16, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65,
-	0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65, 0x6e, 0x6e, 0x69, 0x73, 0x2d, 0x74, 0x72, 0x61, 0x2f, 0x70,
-	0x75, 0x6e, 0x63, 0x68, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x48, 0x4f, 0x4c,
+	0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f,
+	0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44,
+	0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55,
+	0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e,
+	0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f,
+	0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x26,
+	0x0a, 0x22, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54,
+	0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50,
+	0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54,
+	0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x26, 0x0a,
+	0x22, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45,
+	0x4d, 0x50, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0x06, 0x2a, 0xe6, 0x02, 0x0a, 0x12, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75,
+	0x6e, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d,
+	0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x25, 0x0a, 0x21, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f,
+	0x44, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50,
+	0x55, 0x4e, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02,
+	0x12, 0x2a, 0x0a, 0x26, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f,
+	0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24,
+	0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50,
+	0x55, 0x4e, 0x43, 0x48, 0x10, 0x04, 0x12, 0x2c, 0x0a, 0x28, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50,
+	0x55, 0x4e, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d,
+	0x50, 0x54, 0x10, 0x05, 0x12, 0x2b, 0x0a, 0x27, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e,
+	0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x45, 0x44, 0x10,
+	0x06, 0x12, 0x2b, 0x0a, 0x27, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x07, 0x2a, 0xb2,
+	0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x41,
+	0x4c, 0x4c, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1d,
+	0x0a, 0x19, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59,
+	0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x54, 0x54, 0x10, 0x03, 0x12, 0x19, 0x0a,
+	0x15, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f,
+	0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x4c, 0x41,
+	0x59, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45,
+	0x52, 0x10, 0x05, 0x2a, 0x63, 0x0a, 0x16, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a,
+	0x08, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x54,
+	0x4f, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48,
+	0x5f, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x4f, 0x4c, 0x45,
+	0x5f, 0x50, 0x55, 0x4e, 0x43, 0x48, 0x10, 0x02, 0x2a, 0x97, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x1a, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x22,
+	0x0a, 0x1e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x4f, 0x52,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02,
+	0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
+	0x10, 0x03, 0x32, 0xbd, 0x01, 0x0a, 0x0d, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x10, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63,
+	0x68, 0x12, 0x16, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x48, 0x6f, 0x6c, 0x65, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xc2, 0x02, 0x0a, 0x12, 0x50, 0x75, 0x6e, 0x63, 0x68, 0x72, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x12, 0x14, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65, 0x6e, 0x6e, 0x69, 0x73, 0x2d, 0x74, 0x72, 0x61,
+	0x2f, 0x70, 0x75, 0x6e, 0x63, 0x68, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
 }
 
 var (
@@ -1315,42 +2763,82 @@ func file_punchr_proto_rawDescGZIP() []byte {
 	return file_punchr_proto_rawDescData
 }
 
-var file_punchr_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_punchr_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_punchr_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_punchr_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_punchr_proto_goTypes = []interface{}{
 	(HolePunchOutcome)(0),          // 0: HolePunchOutcome
 	(HolePunchAttemptOutcome)(0),   // 1: HolePunchAttemptOutcome
-	(LatencyMeasurementType)(0),    // 2: LatencyMeasurementType
-	(*RegisterRequest)(nil),        // 3: RegisterRequest
-	(*RegisterResponse)(nil),       // 4: RegisterResponse
-	(*GetAddrInfoRequest)(nil),     // 5: GetAddrInfoRequest
-	(*GetAddrInfoResponse)(nil),    // 6: GetAddrInfoResponse
-	(*TrackHolePunchRequest)(nil),  // 7: TrackHolePunchRequest
-	(*TrackHolePunchResponse)(nil), // 8: TrackHolePunchResponse
-	(*HolePunchAttempt)(nil),       // 9: HolePunchAttempt
-	(*LatencyMeasurement)(nil),     // 10: LatencyMeasurement
-	(*NetworkInformation)(nil),     // 11: NetworkInformation
-	(*NATMapping)(nil),             // 12: NATMapping
+	(HolePunchEventType)(0),        // 2: HolePunchEventType
+	(RelayStrategy)(0),             // 3: RelayStrategy
+	(LatencyMeasurementType)(0),    // 4: LatencyMeasurementType
+	(AuthorizationRole)(0),         // 5: AuthorizationRole
+	(*RegisterRequest)(nil),        // 6: RegisterRequest
+	(*RegisterResponse)(nil),       // 7: RegisterResponse
+	(*GetAddrInfoRequest)(nil),     // 8: GetAddrInfoRequest
+	(*GetAddrInfoResponse)(nil),    // 9: GetAddrInfoResponse
+	(*TrackHolePunchRequest)(nil),  // 10: TrackHolePunchRequest
+	(*TrackHolePunchResponse)(nil), // 11: TrackHolePunchResponse
+	(*HolePunchAttempt)(nil),       // 12: HolePunchAttempt
+	(*HolePunchEvent)(nil),         // 13: HolePunchEvent
+	(*RelayDial)(nil),              // 14: RelayDial
+	(*DCUtRParameters)(nil),        // 15: DCUtRParameters
+	(*LatencyMeasurement)(nil),     // 16: LatencyMeasurement
+	(*NetworkInformation)(nil),     // 17: NetworkInformation
+	(*NATMapping)(nil),             // 18: NATMapping
+	(*ApiKey)(nil),                 // 19: ApiKey
+	(*CreateApiKeyRequest)(nil),    // 20: CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),   // 21: CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),     // 22: ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),    // 23: ListApiKeysResponse
+	(*RotateApiKeyRequest)(nil),    // 24: RotateApiKeyRequest
+	(*RotateApiKeyResponse)(nil),   // 25: RotateApiKeyResponse
+	(*RevokeApiKeyRequest)(nil),    // 26: RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),   // 27: RevokeApiKeyResponse
+	(*RenameApiKeyRequest)(nil),    // 28: RenameApiKeyRequest
+	(*RenameApiKeyResponse)(nil),   // 29: RenameApiKeyResponse
 }
 var file_punchr_proto_depIdxs = []int32{
-	9,  // 0: TrackHolePunchRequest.hole_punch_attempts:type_name -> HolePunchAttempt
-	0,  // 1: TrackHolePunchRequest.outcome:type_name -> HolePunchOutcome
-	10, // 2: TrackHolePunchRequest.latency_measurements:type_name -> LatencyMeasurement
-	11, // 3: TrackHolePunchRequest.network_information:type_name -> NetworkInformation
-	12, // 4: TrackHolePunchRequest.nat_mappings:type_name -> NATMapping
-	1,  // 5: HolePunchAttempt.outcome:type_name -> HolePunchAttemptOutcome
-	2,  // 6: LatencyMeasurement.mtype:type_name -> LatencyMeasurementType
-	3,  // 7: PunchrService.Register:input_type -> RegisterRequest
-	5,  // 8: PunchrService.GetAddrInfo:input_type -> GetAddrInfoRequest
-	7,  // 9: PunchrService.TrackHolePunch:input_type -> TrackHolePunchRequest
-	4,  // 10: PunchrService.Register:output_type -> RegisterResponse
-	6,  // 11: PunchrService.GetAddrInfo:output_type -> GetAddrInfoResponse
-	8,  // 12: PunchrService.TrackHolePunch:output_type -> TrackHolePunchResponse
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	15, // 0: GetAddrInfoResponse.dcutr_parameters:type_name -> DCUtRParameters
+	12, // 1: TrackHolePunchRequest.hole_punch_attempts:type_name -> HolePunchAttempt
+	0,  // 2: TrackHolePunchRequest.outcome:type_name -> HolePunchOutcome
+	16, // 3: TrackHolePunchRequest.latency_measurements:type_name -> LatencyMeasurement
+	17, // 4: TrackHolePunchRequest.network_information:type_name -> NetworkInformation
+	18, // 5: TrackHolePunchRequest.nat_mappings:type_name -> NATMapping
+	13, // 6: TrackHolePunchRequest.hole_punch_events:type_name -> HolePunchEvent
+	3,  // 7: TrackHolePunchRequest.relay_strategy:type_name -> RelayStrategy
+	14, // 8: TrackHolePunchRequest.relay_dials:type_name -> RelayDial
+	15, // 9: TrackHolePunchRequest.dcutr_parameters:type_name -> DCUtRParameters
+	1,  // 10: HolePunchAttempt.outcome:type_name -> HolePunchAttemptOutcome
+	2,  // 11: HolePunchEvent.type:type_name -> HolePunchEventType
+	4,  // 12: LatencyMeasurement.mtype:type_name -> LatencyMeasurementType
+	5,  // 13: ApiKey.role:type_name -> AuthorizationRole
+	5,  // 14: CreateApiKeyRequest.role:type_name -> AuthorizationRole
+	19, // 15: CreateApiKeyResponse.api_key:type_name -> ApiKey
+	19, // 16: ListApiKeysResponse.api_keys:type_name -> ApiKey
+	19, // 17: RotateApiKeyResponse.api_key:type_name -> ApiKey
+	19, // 18: RevokeApiKeyResponse.api_key:type_name -> ApiKey
+	19, // 19: RenameApiKeyResponse.api_key:type_name -> ApiKey
+	6,  // 20: PunchrService.Register:input_type -> RegisterRequest
+	8,  // 21: PunchrService.GetAddrInfo:input_type -> GetAddrInfoRequest
+	10, // 22: PunchrService.TrackHolePunch:input_type -> TrackHolePunchRequest
+	20, // 23: PunchrAdminService.CreateApiKey:input_type -> CreateApiKeyRequest
+	22, // 24: PunchrAdminService.ListApiKeys:input_type -> ListApiKeysRequest
+	24, // 25: PunchrAdminService.RotateApiKey:input_type -> RotateApiKeyRequest
+	26, // 26: PunchrAdminService.RevokeApiKey:input_type -> RevokeApiKeyRequest
+	28, // 27: PunchrAdminService.RenameApiKey:input_type -> RenameApiKeyRequest
+	7,  // 28: PunchrService.Register:output_type -> RegisterResponse
+	9,  // 29: PunchrService.GetAddrInfo:output_type -> GetAddrInfoResponse
+	11, // 30: PunchrService.TrackHolePunch:output_type -> TrackHolePunchResponse
+	21, // 31: PunchrAdminService.CreateApiKey:output_type -> CreateApiKeyResponse
+	23, // 32: PunchrAdminService.ListApiKeys:output_type -> ListApiKeysResponse
+	25, // 33: PunchrAdminService.RotateApiKey:output_type -> RotateApiKeyResponse
+	27, // 34: PunchrAdminService.RevokeApiKey:output_type -> RevokeApiKeyResponse
+	29, // 35: PunchrAdminService.RenameApiKey:output_type -> RenameApiKeyResponse
+	28, // [28:36] is the sub-list for method output_type
+	20, // [20:28] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_punchr_proto_init() }
@@ -1444,7 +2932,7 @@ func file_punchr_proto_init() {
 			}
 		}
 		file_punchr_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatencyMeasurement); i {
+			switch v := v.(*HolePunchEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1456,7 +2944,7 @@ func file_punchr_proto_init() {
 			}
 		}
 		file_punchr_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkInformation); i {
+			switch v := v.(*RelayDial); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1468,6 +2956,42 @@ func file_punchr_proto_init() {
 			}
 		}
 		file_punchr_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DCUtRParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatencyMeasurement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NATMapping); i {
 			case 0:
 				return &v.state
@@ -1479,16 +3003,148 @@ func file_punchr_proto_init() {
 				return nil
 			}
 		}
+		file_punchr_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateApiKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateApiKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApiKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApiKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateApiKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateApiKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeApiKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeApiKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameApiKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_punchr_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameApiKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_punchr_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   10,
+			NumEnums:      6,
+			NumMessages:   24,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_punchr_proto_goTypes,
 		DependencyIndexes: file_punchr_proto_depIdxs,
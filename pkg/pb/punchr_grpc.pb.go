@@ -185,3 +185,245 @@ var PunchrService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "punchr.proto",
 }
+
+// PunchrAdminServiceClient is the client API for PunchrAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PunchrAdminServiceClient interface {
+	// CreateApiKey creates a new API key with the given username and role.
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	// ListApiKeys returns all API keys. The keys themselves are only returned abbreviated.
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	// RotateApiKey replaces the key of an authorization with a newly generated one.
+	// The old key stops working immediately. Registered clients stay associated with the authorization.
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error)
+	// RevokeApiKey permanently disables an API key.
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	// RenameApiKey changes the username of an API key.
+	RenameApiKey(ctx context.Context, in *RenameApiKeyRequest, opts ...grpc.CallOption) (*RenameApiKeyResponse, error)
+}
+
+type punchrAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPunchrAdminServiceClient(cc grpc.ClientConnInterface) PunchrAdminServiceClient {
+	return &punchrAdminServiceClient{cc}
+}
+
+func (c *punchrAdminServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/PunchrAdminService/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *punchrAdminServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/PunchrAdminService/ListApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *punchrAdminServiceClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error) {
+	out := new(RotateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/PunchrAdminService/RotateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *punchrAdminServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/PunchrAdminService/RevokeApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *punchrAdminServiceClient) RenameApiKey(ctx context.Context, in *RenameApiKeyRequest, opts ...grpc.CallOption) (*RenameApiKeyResponse, error) {
+	out := new(RenameApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/PunchrAdminService/RenameApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PunchrAdminServiceServer is the server API for PunchrAdminService service.
+// All implementations must embed UnimplementedPunchrAdminServiceServer
+// for forward compatibility
+type PunchrAdminServiceServer interface {
+	// CreateApiKey creates a new API key with the given username and role.
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	// ListApiKeys returns all API keys. The keys themselves are only returned abbreviated.
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	// RotateApiKey replaces the key of an authorization with a newly generated one.
+	// The old key stops working immediately. Registered clients stay associated with the authorization.
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error)
+	// RevokeApiKey permanently disables an API key.
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	// RenameApiKey changes the username of an API key.
+	RenameApiKey(context.Context, *RenameApiKeyRequest) (*RenameApiKeyResponse, error)
+	mustEmbedUnimplementedPunchrAdminServiceServer()
+}
+
+// UnimplementedPunchrAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPunchrAdminServiceServer struct {
+}
+
+func (UnimplementedPunchrAdminServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedPunchrAdminServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedPunchrAdminServiceServer) RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedPunchrAdminServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedPunchrAdminServiceServer) RenameApiKey(context.Context, *RenameApiKeyRequest) (*RenameApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameApiKey not implemented")
+}
+func (UnimplementedPunchrAdminServiceServer) mustEmbedUnimplementedPunchrAdminServiceServer() {}
+
+// UnsafePunchrAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PunchrAdminServiceServer will
+// result in compilation errors.
+type UnsafePunchrAdminServiceServer interface {
+	mustEmbedUnimplementedPunchrAdminServiceServer()
+}
+
+func RegisterPunchrAdminServiceServer(s grpc.ServiceRegistrar, srv PunchrAdminServiceServer) {
+	s.RegisterService(&PunchrAdminService_ServiceDesc, srv)
+}
+
+func _PunchrAdminService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PunchrAdminServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PunchrAdminService/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PunchrAdminServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PunchrAdminService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PunchrAdminServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PunchrAdminService/ListApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PunchrAdminServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PunchrAdminService_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PunchrAdminServiceServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PunchrAdminService/RotateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PunchrAdminServiceServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PunchrAdminService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PunchrAdminServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PunchrAdminService/RevokeApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PunchrAdminServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PunchrAdminService_RenameApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PunchrAdminServiceServer).RenameApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PunchrAdminService/RenameApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PunchrAdminServiceServer).RenameApiKey(ctx, req.(*RenameApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PunchrAdminService_ServiceDesc is the grpc.ServiceDesc for PunchrAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PunchrAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "PunchrAdminService",
+	HandlerType: (*PunchrAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _PunchrAdminService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _PunchrAdminService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _PunchrAdminService_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _PunchrAdminService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "RenameApiKey",
+			Handler:    _PunchrAdminService_RenameApiKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "punchr.proto",
+}
@@ -0,0 +1,285 @@
+package retention
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// Format is the file format of the archives.
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat returns the archive format with the given name.
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case FormatCSV, FormatParquet:
+		return Format(name), nil
+	default:
+		return "", fmt.Errorf("unknown archive format %s (csv, parquet)", name)
+	}
+}
+
+// extension returns the file extension of the archives.
+func (f Format) extension() string {
+	if f == FormatParquet {
+		return ".parquet"
+	}
+	return ".csv.gz"
+}
+
+// querier is implemented by *sql.DB and *sql.Conn.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// rowScanner is implemented by *sql.Rows.
+type rowScanner interface {
+	Columns() ([]string, error)
+	Next() bool
+	Scan(dest ...interface{}) error
+	Err() error
+}
+
+// tableWriter writes the rows of a table to an archive file. NULL values are nil.
+type tableWriter interface {
+	Write(record []*string) error
+	Close() error
+}
+
+// Archiver exports rows to files in a directory before they are deleted. The archive
+// of a table and month is stored at <dir>/<table>/<table>_<YYYY-MM>.<ext>. All values
+// are stored in their Postgres text representation. Files are written with a .tmp suffix
+// and only renamed to their final name after they were completely written. An existing
+// archive is replaced, e.g., if a previous run archived a month but couldn't delete it.
+type Archiver struct {
+	dir    string
+	format Format
+}
+
+// NewArchiver initializes an archiver that writes files to the given directory.
+func NewArchiver(dir string, format Format) (*Archiver, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "create archive directory")
+	}
+
+	return &Archiver{dir: dir, format: format}, nil
+}
+
+// path returns the location of the archive of the given table and month.
+func (a *Archiver) path(table string, month time.Time) string {
+	return filepath.Join(a.dir, table, fmt.Sprintf("%s_%s%s", table, month.Format("2006-01"), a.format.extension()))
+}
+
+// Archive writes the result of the given query to the archive of the table and month.
+// It returns the number of archived rows.
+func (a *Archiver) Archive(ctx context.Context, q querier, table string, month time.Time, query string, args ...interface{}) (int, error) {
+	path := a.path(table, month)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, errors.Wrap(err, "create table directory")
+	}
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "query %s", table)
+	}
+	defer rows.Close()
+
+	count, err := a.write(path+".tmp", rows)
+	if err != nil {
+		_ = os.Remove(path + ".tmp")
+		return 0, errors.Wrapf(err, "write archive of %s", table)
+	}
+
+	if err = os.Rename(path+".tmp", path); err != nil {
+		return 0, errors.Wrap(err, "rename archive")
+	}
+
+	return count, nil
+}
+
+// write writes all rows to the file at the given path.
+func (a *Archiver) write(path string, rows rowScanner) (int, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, errors.Wrap(err, "get columns")
+	}
+
+	var tw tableWriter
+	switch a.format {
+	case FormatParquet:
+		file, err := local.NewLocalFileWriter(path)
+		if err != nil {
+			return 0, errors.Wrap(err, "new local file writer")
+		}
+		if tw, err = newParquetWriter(file, columns); err != nil {
+			_ = file.Close()
+			return 0, err
+		}
+	default:
+		file, err := os.Create(path)
+		if err != nil {
+			return 0, errors.Wrap(err, "create file")
+		}
+		tw = newCSVWriter(file, columns)
+	}
+
+	count, err := writeRows(tw, rows, len(columns))
+	if err != nil {
+		_ = tw.Close()
+		return 0, err
+	}
+
+	return count, tw.Close()
+}
+
+// writeRows writes all rows to the table writer and returns their number.
+func writeRows(tw tableWriter, rows rowScanner, columns int) (int, error) {
+	values := make([]interface{}, columns)
+	dest := make([]interface{}, columns)
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	count := 0
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return 0, errors.Wrap(err, "scan row")
+		}
+
+		record := make([]*string, columns)
+		for i, value := range values {
+			record[i] = formatValue(value)
+		}
+
+		if err := tw.Write(record); err != nil {
+			return 0, errors.Wrap(err, "write row")
+		}
+		count++
+	}
+
+	return count, errors.Wrap(rows.Err(), "iterate rows")
+}
+
+// formatValue converts a value of the database driver to its text representation.
+func formatValue(value interface{}) *string {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case time.Time:
+		s = v.UTC().Format(time.RFC3339Nano)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		s = strconv.FormatBool(v)
+	default:
+		s = fmt.Sprint(v)
+	}
+	return &s
+}
+
+// csvWriter writes gzip compressed CSV files with a header row. NULL values are empty.
+type csvWriter struct {
+	file *os.File
+	gz   *gzip.Writer
+	csv  *csv.Writer
+	err  error
+}
+
+func newCSVWriter(file *os.File, columns []string) *csvWriter {
+	gz := gzip.NewWriter(file)
+	w := &csvWriter{file: file, gz: gz, csv: csv.NewWriter(gz)}
+	w.err = w.csv.Write(columns)
+	return w
+}
+
+func (w *csvWriter) Write(record []*string) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	fields := make([]string, len(record))
+	for i, field := range record {
+		if field != nil {
+			fields[i] = *field
+		}
+	}
+	return w.csv.Write(fields)
+}
+
+func (w *csvWriter) Close() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		_ = w.file.Close()
+		return errors.Wrap(err, "flush csv")
+	}
+
+	if err := w.gz.Close(); err != nil {
+		_ = w.file.Close()
+		return errors.Wrap(err, "close gzip writer")
+	}
+
+	if err := w.file.Sync(); err != nil {
+		_ = w.file.Close()
+		return errors.Wrap(err, "sync file")
+	}
+
+	return errors.Wrap(w.file.Close(), "close file")
+}
+
+// parquetWriter writes snappy compressed parquet files with an optional string column per table column.
+type parquetWriter struct {
+	file source.ParquetFile
+	pw   *writer.CSVWriter
+}
+
+func newParquetWriter(file source.ParquetFile, columns []string) (*parquetWriter, error) {
+	md := make([]string, len(columns))
+	for i, column := range columns {
+		md[i] = fmt.Sprintf("name=%s, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL", column)
+	}
+
+	pw, err := writer.NewCSVWriter(md, file, 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "new parquet writer")
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	return &parquetWriter{file: file, pw: pw}, nil
+}
+
+func (w *parquetWriter) Write(record []*string) error {
+	return w.pw.WriteString(record)
+}
+
+func (w *parquetWriter) Close() error {
+	if err := w.pw.WriteStop(); err != nil {
+		_ = w.file.Close()
+		return errors.Wrap(err, "write parquet footer")
+	}
+
+	return errors.Wrap(w.file.Close(), "close parquet file")
+}
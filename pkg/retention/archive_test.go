@@ -0,0 +1,146 @@
+package retention
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// fakeRows mimics *sql.Rows with the values that lib/pq returns.
+type fakeRows struct {
+	columns []string
+	rows    [][]interface{}
+	idx     int
+}
+
+func (f *fakeRows) Columns() ([]string, error) {
+	return f.columns, nil
+}
+
+func (f *fakeRows) Next() bool {
+	f.idx++
+	return f.idx <= len(f.rows)
+}
+
+func (f *fakeRows) Scan(dest ...interface{}) error {
+	row := f.rows[f.idx-1]
+	if len(dest) != len(row) {
+		return fmt.Errorf("expected %d destinations, got %d", len(row), len(dest))
+	}
+	for i, value := range row {
+		*dest[i].(*interface{}) = value
+	}
+	return nil
+}
+
+func (f *fakeRows) Err() error {
+	return nil
+}
+
+func newFakeRows() *fakeRows {
+	return &fakeRows{
+		columns: []string{"id", "opened_at", "error", "has_direct_conns", "rtt_avg", "protocol_filters"},
+		rows: [][]interface{}{
+			{int64(1), time.Date(2022, 9, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)), nil, true, 1.5, []byte("{1,2}")},
+			{int64(2), time.Date(2022, 9, 2, 12, 0, 0, 0, time.UTC), []byte("dial backoff"), false, 0.25, []byte("{}")},
+		},
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  *string
+	}{
+		{value: nil, want: nil},
+		{value: []byte("text"), want: strPtr("text")},
+		{value: "text", want: strPtr("text")},
+		{value: int64(-42), want: strPtr("-42")},
+		{value: 0.1, want: strPtr("0.1")},
+		{value: true, want: strPtr("true")},
+		{value: time.Date(2022, 9, 1, 2, 0, 0, 500, time.FixedZone("CEST", 2*60*60)), want: strPtr("2022-09-01T00:00:00.0000005Z")},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%T", tt.value), func(t *testing.T) {
+			assert.Equal(t, tt.want, formatValue(tt.value))
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestArchiver_write_csv(t *testing.T) {
+	a, err := NewArchiver(t.TempDir(), FormatCSV)
+	require.NoError(t, err)
+
+	path := a.path("hole_punch_results", month(2022, 9))
+	assert.Equal(t, filepath.Join(a.dir, "hole_punch_results", "hole_punch_results_2022-09.csv.gz"), path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+
+	count, err := a.write(path, newFakeRows())
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(gz).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"id", "opened_at", "error", "has_direct_conns", "rtt_avg", "protocol_filters"},
+		{"1", "2022-09-01T10:00:00Z", "", "true", "1.5", "{1,2}"},
+		{"2", "2022-09-02T12:00:00Z", "dial backoff", "false", "0.25", "{}"},
+	}, records)
+}
+
+func TestArchiver_write_parquet(t *testing.T) {
+	a, err := NewArchiver(t.TempDir(), FormatParquet)
+	require.NoError(t, err)
+
+	path := a.path("connection_events", month(2022, 9))
+	assert.Equal(t, filepath.Join(a.dir, "connection_events", "connection_events_2022-09.parquet"), path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+
+	count, err := a.write(path, newFakeRows())
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	file, err := local.NewLocalFileReader(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	pr, err := reader.NewParquetReader(file, nil, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	assert.EqualValues(t, 2, pr.GetNumRows())
+	assert.Len(t, pr.SchemaHandler.ValueColumns, 6)
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("csv")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatCSV, format)
+
+	format, err = ParseFormat("parquet")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatParquet, format)
+
+	_, err = ParseFormat("xlsx")
+	assert.Error(t, err)
+}
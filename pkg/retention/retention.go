@@ -0,0 +1,333 @@
+// Package retention deletes measurements that are older than the configured retention
+// windows and optionally archives them to files before. Data is removed a month at a
+// time once the whole month is older than the window. Connection events are partitioned
+// by month, so their partitions are dropped. Hole punch results and all data that
+// references them are deleted in batches.
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dennis-tra/punchr/pkg/db"
+)
+
+var expiredRowsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "retention_expired_rows_total",
+	Help: "The number of rows that were deleted because they are older than the retention window",
+}, []string{"table"})
+
+const (
+	// runInterval is the time between two retention runs.
+	runInterval = time.Hour
+
+	// deleteBatchSize is the number of hole punch results that are deleted in one statement.
+	deleteBatchSize = 5000
+
+	// lockID is the key of the advisory lock that prevents multiple
+	// servers from running the retention job at the same time.
+	lockID int64 = 7_406_192_836
+)
+
+// Policy configures how long measurements are kept. A zero window keeps them forever.
+type Policy struct {
+	// ConnectionEvents is the retention window of the connection events
+	// that the honeypot recorded and their multi addresses.
+	ConnectionEvents time.Duration
+
+	// HolePunchResults is the retention window of hole punch results, their attempts,
+	// events, multi addresses, latency measurements and port mappings as well as
+	// the allocations of the server.
+	HolePunchResults time.Duration
+}
+
+// Enabled returns true if any data expires.
+func (p Policy) Enabled() bool {
+	return p.ConnectionEvents > 0 || p.HolePunchResults > 0
+}
+
+// archive is a table whose rows are archived with the given query. The query selects the
+// rows that belong to the hole punch results or allocations of the month between $1 and $2.
+type archive struct {
+	table string
+	query string
+}
+
+// holePunchResultArchives are archived before the hole punch results of a month are deleted.
+var holePunchResultArchives = []archive{
+	{
+		table: "hole_punch_results",
+		query: "SELECT * FROM hole_punch_results WHERE created_at >= $1 AND created_at < $2",
+	},
+	{
+		table: "hole_punch_results_x_multi_addresses",
+		query: "SELECT hprxma.* FROM hole_punch_results_x_multi_addresses hprxma INNER JOIN hole_punch_results hpr ON hpr.id = hprxma.hole_punch_result_id WHERE hpr.created_at >= $1 AND hpr.created_at < $2",
+	},
+	{
+		table: "hole_punch_attempt",
+		query: "SELECT hpa.* FROM hole_punch_attempt hpa INNER JOIN hole_punch_results hpr ON hpr.id = hpa.hole_punch_result_id WHERE hpr.created_at >= $1 AND hpr.created_at < $2",
+	},
+	{
+		table: "hole_punch_attempt_x_multi_addresses",
+		query: "SELECT hpaxma.* FROM hole_punch_attempt_x_multi_addresses hpaxma INNER JOIN hole_punch_attempt hpa ON hpa.id = hpaxma.hole_punch_attempt INNER JOIN hole_punch_results hpr ON hpr.id = hpa.hole_punch_result_id WHERE hpr.created_at >= $1 AND hpr.created_at < $2",
+	},
+	{
+		table: "hole_punch_events",
+		query: "SELECT hpe.* FROM hole_punch_events hpe INNER JOIN hole_punch_results hpr ON hpr.id = hpe.hole_punch_result_id WHERE hpr.created_at >= $1 AND hpr.created_at < $2",
+	},
+	{
+		table: "latency_measurements",
+		query: "SELECT lm.* FROM latency_measurements lm INNER JOIN hole_punch_results hpr ON hpr.id = lm.hole_punch_result_id WHERE hpr.created_at >= $1 AND hpr.created_at < $2",
+	},
+	{
+		table: "port_mappings",
+		query: "SELECT pm.* FROM port_mappings pm INNER JOIN hole_punch_results hpr ON hpr.id = pm.hole_punch_result_id WHERE hpr.created_at >= $1 AND hpr.created_at < $2",
+	},
+	{
+		table: "relay_dials",
+		query: "SELECT rd.* FROM relay_dials rd INNER JOIN hole_punch_results hpr ON hpr.id = rd.hole_punch_result_id WHERE hpr.created_at >= $1 AND hpr.created_at < $2",
+	},
+	{
+		table: "allocations",
+		query: "SELECT * FROM allocations WHERE created_at >= $1 AND created_at < $2",
+	},
+}
+
+// Job periodically applies the retention policy.
+type Job struct {
+	dbClient *db.Client
+	policy   Policy
+
+	// archiver is nil if expired data isn't archived.
+	archiver *Archiver
+
+	// now returns the current time. It's overridden in tests.
+	now func() time.Time
+}
+
+// NewJob initializes a retention job. If archiver is nil, expired data is deleted without archiving it.
+func NewJob(dbClient *db.Client, policy Policy, archiver *Archiver) *Job {
+	return &Job{
+		dbClient: dbClient,
+		policy:   policy,
+		archiver: archiver,
+		now:      time.Now,
+	}
+}
+
+// Run applies the retention policy now and then every runInterval until the context is cancelled.
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(runInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			log.WithError(err).Warnln("Could not apply retention policy")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce archives and deletes all data that expired. It does nothing if another
+// process is running the job. Data is only deleted if it was archived successfully.
+func (j *Job) RunOnce(ctx context.Context) error {
+	conn, err := j.dbClient.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get connection")
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&locked); err != nil {
+		return errors.Wrap(err, "acquire retention lock")
+	} else if !locked {
+		log.Debugln("Retention job is running in another process")
+		return nil
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockID); err != nil {
+			log.WithError(err).Warnln("Could not release retention lock")
+		}
+	}()
+
+	// Archiving a month may take longer than the statement timeout of the pool.
+	// The connection goes back to the pool afterwards, so the timeout is restored.
+	if _, err = conn.ExecContext(ctx, "SET statement_timeout = 0"); err != nil {
+		return errors.Wrap(err, "disable statement timeout")
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "RESET statement_timeout"); err != nil {
+			log.WithError(err).Warnln("Could not reset statement timeout")
+		}
+	}()
+
+	if j.policy.ConnectionEvents > 0 {
+		if err = j.expireConnectionEvents(ctx, conn); err != nil {
+			return errors.Wrap(err, "expire connection events")
+		}
+	}
+
+	if j.policy.HolePunchResults > 0 {
+		if err = j.expireHolePunchResults(ctx, conn); err != nil {
+			return errors.Wrap(err, "expire hole punch results")
+		}
+	}
+
+	return nil
+}
+
+// cutoff returns the start of the first month that isn't completely older than the window.
+func (j *Job) cutoff(window time.Duration) time.Time {
+	return monthStart(j.now().Add(-window))
+}
+
+// monthStart returns the start of the month of t in UTC.
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// expiredPartitions returns all partitions that end before the cutoff.
+func expiredPartitions(partitions []db.Partition, cutoff time.Time) []db.Partition {
+	var expired []db.Partition
+	for _, partition := range partitions {
+		if !partition.End().After(cutoff) {
+			expired = append(expired, partition)
+		}
+	}
+	return expired
+}
+
+// expireConnectionEvents archives and drops all expired partitions of the connection event tables.
+func (j *Job) expireConnectionEvents(ctx context.Context, conn *sql.Conn) error {
+	cutoff := j.cutoff(j.policy.ConnectionEvents)
+
+	for _, table := range db.PartitionedTables {
+		partitions, err := j.dbClient.Partitions(ctx, table)
+		if err != nil {
+			return err
+		}
+
+		for _, partition := range expiredPartitions(partitions, cutoff) {
+			logEntry := log.WithFields(log.Fields{"table": table, "month": partition.Month.Format("2006-01")})
+
+			if j.archiver != nil {
+				count, err := j.archiver.Archive(ctx, conn, table, partition.Month, "SELECT * FROM "+pq.QuoteIdentifier(partition.Name))
+				if err != nil {
+					return errors.Wrapf(err, "archive partition %s", partition.Name)
+				}
+				logEntry = logEntry.WithField("archived", count)
+			}
+
+			var count int64
+			if err = conn.QueryRowContext(ctx, "SELECT count(*) FROM "+pq.QuoteIdentifier(partition.Name)).Scan(&count); err != nil {
+				return errors.Wrapf(err, "count rows of partition %s", partition.Name)
+			}
+
+			if err = j.dbClient.DropPartition(ctx, partition); err != nil {
+				return err
+			}
+
+			expiredRowsCounter.WithLabelValues(table).Add(float64(count))
+			logEntry.WithField("deleted", count).Infoln("Dropped expired partition")
+		}
+	}
+
+	return nil
+}
+
+// expireHolePunchResults archives and deletes the hole punch results and allocations of all expired months.
+func (j *Job) expireHolePunchResults(ctx context.Context, conn *sql.Conn) error {
+	cutoff := j.cutoff(j.policy.HolePunchResults)
+
+	var oldest sql.NullTime
+	query := `SELECT least((SELECT min(created_at) FROM hole_punch_results), (SELECT min(created_at) FROM allocations))`
+	if err := conn.QueryRowContext(ctx, query).Scan(&oldest); err != nil {
+		return errors.Wrap(err, "query oldest hole punch result")
+	} else if !oldest.Valid {
+		return nil
+	}
+
+	for month := monthStart(oldest.Time); month.Before(cutoff); month = month.AddDate(0, 1, 0) {
+		if err := j.expireHolePunchResultsMonth(ctx, conn, month); err != nil {
+			return errors.Wrapf(err, "month %s", month.Format("2006-01"))
+		}
+	}
+
+	return nil
+}
+
+func (j *Job) expireHolePunchResultsMonth(ctx context.Context, conn *sql.Conn, month time.Time) error {
+	start, end := month, month.AddDate(0, 1, 0)
+	logEntry := log.WithField("month", month.Format("2006-01"))
+
+	if j.archiver != nil {
+		for _, a := range holePunchResultArchives {
+			count, err := j.archiver.Archive(ctx, conn, a.table, month, a.query, start, end)
+			if err != nil {
+				return errors.Wrapf(err, "archive %s", a.table)
+			}
+			logEntry = logEntry.WithField(a.table, count)
+		}
+		logEntry.Infoln("Archived expired hole punch results")
+	}
+
+	// Deleting the hole punch results cascades to all data that references them
+	deleted, err := deleteBatched(ctx, conn, "hole_punch_results", start, end)
+	if err != nil {
+		return err
+	}
+	expiredRowsCounter.WithLabelValues("hole_punch_results").Add(float64(deleted))
+
+	deletedAllocations, err := deleteBatched(ctx, conn, "allocations", start, end)
+	if err != nil {
+		return err
+	}
+	expiredRowsCounter.WithLabelValues("allocations").Add(float64(deletedAllocations))
+
+	logEntry.WithFields(log.Fields{
+		"hole_punch_results": deleted,
+		"allocations":        deletedAllocations,
+	}).Infoln("Deleted expired hole punch results")
+
+	return nil
+}
+
+// deleteBatched deletes the rows of the given table that were created between start and end
+// in batches of deleteBatchSize, so that no single statement holds locks for long.
+func deleteBatched(ctx context.Context, conn *sql.Conn, table string, start time.Time, end time.Time) (int64, error) {
+	query := fmt.Sprintf(`
+DELETE FROM %[1]s
+WHERE id IN (SELECT id FROM %[1]s WHERE created_at >= $1 AND created_at < $2 LIMIT $3)`, pq.QuoteIdentifier(table))
+
+	var total int64
+	for {
+		res, err := conn.ExecContext(ctx, query, start, end, deleteBatchSize)
+		if err != nil {
+			return total, errors.Wrapf(err, "delete from %s", table)
+		}
+
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return total, errors.Wrap(err, "get affected rows")
+		}
+
+		total += deleted
+		if deleted < deleteBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennis-tra/punchr/pkg/db"
+)
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestJob_cutoff(t *testing.T) {
+	tests := []struct {
+		name   string
+		now    time.Time
+		window time.Duration
+		want   time.Time
+	}{
+		{name: "mid month", now: time.Date(2022, 10, 15, 12, 0, 0, 0, time.UTC), window: 30 * 24 * time.Hour, want: month(2022, 9)},
+		{name: "crosses year", now: time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC), window: 90 * 24 * time.Hour, want: month(2022, 10)},
+		{name: "short window", now: time.Date(2022, 10, 15, 0, 0, 0, 0, time.UTC), window: time.Hour, want: month(2022, 10)},
+		{name: "other time zone", now: time.Date(2022, 10, 1, 1, 0, 0, 0, time.FixedZone("CEST", 2*60*60)), window: time.Minute, want: month(2022, 9)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := &Job{now: func() time.Time { return tt.now }}
+			assert.Equal(t, tt.want, j.cutoff(tt.window))
+		})
+	}
+}
+
+func TestExpiredPartitions(t *testing.T) {
+	partitions := []db.Partition{
+		{Name: "connection_events_y2022m08", Month: month(2022, 8)},
+		{Name: "connection_events_y2022m09", Month: month(2022, 9)},
+		{Name: "connection_events_y2022m10", Month: month(2022, 10)},
+		{Name: "connection_events_y2022m11", Month: month(2022, 11)},
+	}
+
+	assert.Empty(t, expiredPartitions(partitions, month(2022, 8)))
+	assert.Equal(t, partitions[:2], expiredPartitions(partitions, month(2022, 10)))
+	assert.Equal(t, partitions, expiredPartitions(partitions, month(2023, 1)))
+}
+
+func TestPolicy_Enabled(t *testing.T) {
+	assert.False(t, Policy{}.Enabled())
+	assert.True(t, Policy{ConnectionEvents: time.Hour}.Enabled())
+	assert.True(t, Policy{HolePunchResults: time.Hour}.Enabled())
+}
@@ -0,0 +1,78 @@
+package sink
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+var sinkResultsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "sink_results_total",
+	Help: "The number of hole punch results written to result sinks",
+}, []string{"sink", "status"})
+
+// writeTimeout is the maximum time an asynchronous sink may take to write a single result.
+const writeTimeout = 30 * time.Second
+
+// Async decouples a sink from the caller. Results are queued and written in
+// the background. If the queue is full, results are dropped instead of
+// blocking the caller. Write errors are logged and tracked but not returned.
+type Async struct {
+	sink  ResultSink
+	queue chan *Result
+	done  chan struct{}
+}
+
+var _ ResultSink = (*Async)(nil)
+
+// NewAsync starts a background worker that writes the results to the given sink.
+func NewAsync(sink ResultSink, queueSize int) *Async {
+	a := &Async{
+		sink:  sink,
+		queue: make(chan *Result, queueSize),
+		done:  make(chan struct{}),
+	}
+
+	go a.loop()
+
+	return a
+}
+
+func (a *Async) Name() string {
+	return a.sink.Name()
+}
+
+func (a *Async) Write(_ context.Context, result *Result) error {
+	select {
+	case a.queue <- result:
+	default:
+		log.WithField("sink", a.sink.Name()).Warnln("Result queue is full, dropping result")
+		sinkResultsCounter.WithLabelValues(a.sink.Name(), "dropped").Inc()
+	}
+	return nil
+}
+
+func (a *Async) loop() {
+	defer close(a.done)
+
+	for result := range a.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+		if err := a.sink.Write(ctx, result); err != nil {
+			log.WithError(err).WithField("sink", a.sink.Name()).Warnln("Could not write result")
+			sinkResultsCounter.WithLabelValues(a.sink.Name(), "error").Inc()
+		} else {
+			sinkResultsCounter.WithLabelValues(a.sink.Name(), "ok").Inc()
+		}
+		cancel()
+	}
+}
+
+// Close writes all queued results and closes the underlying sink.
+func (a *Async) Close() error {
+	close(a.queue)
+	<-a.done
+	return a.sink.Close()
+}
@@ -0,0 +1,60 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// JSONL appends every result as a single JSON encoded line to a file.
+type JSONL struct {
+	lk   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+var _ ResultSink = (*JSONL)(nil)
+
+// NewJSONL opens the given file in append mode and creates it if it doesn't exist.
+func NewJSONL(path string) (*JSONL, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, errors.Wrap(err, "open jsonl file")
+	}
+
+	return &JSONL{
+		file: f,
+		enc:  json.NewEncoder(f),
+	}, nil
+}
+
+func (j *JSONL) Name() string {
+	return "jsonl"
+}
+
+func (j *JSONL) Write(_ context.Context, result *Result) error {
+	record, err := NewRecord(result)
+	if err != nil {
+		return errors.Wrap(err, "new record")
+	}
+
+	j.lk.Lock()
+	defer j.lk.Unlock()
+
+	// The encoder terminates every value with a newline
+	return errors.Wrap(j.enc.Encode(record), "encode record")
+}
+
+func (j *JSONL) Close() error {
+	j.lk.Lock()
+	defer j.lk.Unlock()
+
+	if err := j.file.Sync(); err != nil {
+		return errors.Wrap(err, "sync jsonl file")
+	}
+
+	return j.file.Close()
+}
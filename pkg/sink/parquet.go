@@ -0,0 +1,235 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetRow is the flat schema of the parquet files. Nested structures
+// are stored as JSON encoded string columns.
+type parquetRow struct {
+	ReceivedAt           int64    `parquet:"name=received_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	AuthorizationID      int64    `parquet:"name=authorization_id, type=INT64"`
+	AllocationID         *int64   `parquet:"name=allocation_id, type=INT64, repetitiontype=OPTIONAL"`
+	ClientID             string   `parquet:"name=client_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	RemoteID             string   `parquet:"name=remote_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	RemoteMultiAddresses []string `parquet:"name=remote_multi_addresses, type=MAP, convertedtype=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ListenMultiAddresses []string `parquet:"name=listen_multi_addresses, type=MAP, convertedtype=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	OpenMultiAddresses   []string `parquet:"name=open_multi_addresses, type=MAP, convertedtype=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ConnectStartedAt     int64    `parquet:"name=connect_started_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	ConnectEndedAt       int64    `parquet:"name=connect_ended_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	EndedAt              int64    `parquet:"name=ended_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	HasDirectConns       bool     `parquet:"name=has_direct_conns, type=BOOLEAN"`
+	Outcome              string   `parquet:"name=outcome, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Error                *string  `parquet:"name=error, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	ProtocolFilters      []int32  `parquet:"name=protocol_filters, type=MAP, convertedtype=LIST, valuetype=INT32"`
+	HolePunchAttempts    string   `parquet:"name=hole_punch_attempts, type=BYTE_ARRAY, convertedtype=UTF8"`
+	HolePunchEvents      string   `parquet:"name=hole_punch_events, type=BYTE_ARRAY, convertedtype=UTF8"`
+	LatencyMeasurements  string   `parquet:"name=latency_measurements, type=BYTE_ARRAY, convertedtype=UTF8"`
+	NATMappings          string   `parquet:"name=nat_mappings, type=BYTE_ARRAY, convertedtype=UTF8"`
+	NetworkInformation   *string  `parquet:"name=network_information, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	ValidationStatus     string   `parquet:"name=validation_status, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ValidationReasons    []string `parquet:"name=validation_reasons, type=MAP, convertedtype=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	RelayStrategy        *string  `parquet:"name=relay_strategy, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	RelayMultiAddress    *string  `parquet:"name=relay_multi_address, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	RelayDials           string   `parquet:"name=relay_dials, type=BYTE_ARRAY, convertedtype=UTF8"`
+	DCUtRParameters      *string  `parquet:"name=dcutr_parameters, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+}
+
+func newParquetRow(r *Record) (*parquetRow, error) {
+	row := &parquetRow{
+		ReceivedAt:           r.ReceivedAt.UnixMilli(),
+		AuthorizationID:      int64(r.AuthorizationID),
+		AllocationID:         r.AllocationID,
+		ClientID:             r.ClientID,
+		RemoteID:             r.RemoteID,
+		RemoteMultiAddresses: r.RemoteMultiAddresses,
+		ListenMultiAddresses: r.ListenMultiAddresses,
+		OpenMultiAddresses:   r.OpenMultiAddresses,
+		ConnectStartedAt:     r.ConnectStartedAt.UnixMilli(),
+		ConnectEndedAt:       r.ConnectEndedAt.UnixMilli(),
+		EndedAt:              r.EndedAt.UnixMilli(),
+		HasDirectConns:       r.HasDirectConns,
+		Outcome:              r.Outcome,
+		Error:                r.Error,
+		ProtocolFilters:      r.ProtocolFilters,
+		ValidationStatus:     r.ValidationStatus,
+		ValidationReasons:    r.ValidationReasons,
+		RelayStrategy:        r.RelayStrategy,
+		RelayMultiAddress:    r.RelayMultiAddress,
+	}
+
+	var err error
+	if row.HolePunchAttempts, err = jsonString(r.HolePunchAttempts); err != nil {
+		return nil, errors.Wrap(err, "encode hole punch attempts")
+	}
+
+	if row.HolePunchEvents, err = jsonString(r.HolePunchEvents); err != nil {
+		return nil, errors.Wrap(err, "encode hole punch events")
+	}
+
+	if row.LatencyMeasurements, err = jsonString(r.LatencyMeasurements); err != nil {
+		return nil, errors.Wrap(err, "encode latency measurements")
+	}
+
+	if row.NATMappings, err = jsonString(r.NATMappings); err != nil {
+		return nil, errors.Wrap(err, "encode nat mappings")
+	}
+
+	if row.RelayDials, err = jsonString(r.RelayDials); err != nil {
+		return nil, errors.Wrap(err, "encode relay dials")
+	}
+
+	if r.DCUtRParameters != nil {
+		params, err := jsonString(r.DCUtRParameters)
+		if err != nil {
+			return nil, errors.Wrap(err, "encode dcutr parameters")
+		}
+		row.DCUtRParameters = &params
+	}
+
+	if r.NetworkInformation != nil {
+		netInfo, err := jsonString(r.NetworkInformation)
+		if err != nil {
+			return nil, errors.Wrap(err, "encode network information")
+		}
+		row.NetworkInformation = &netInfo
+	}
+
+	return row, nil
+}
+
+func jsonString(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	return string(data), err
+}
+
+// Parquet writes results to parquet files in a directory. A new file is
+// started after the configured rotation interval. Files are written with a
+// .tmp suffix and only renamed to their final name after they were
+// completely written. Hence, all *.parquet files in the directory are
+// safe to be picked up by other tools.
+type Parquet struct {
+	dir      string
+	rotation time.Duration
+
+	lk       sync.Mutex
+	file     source.ParquetFile
+	writer   *writer.ParquetWriter
+	path     string
+	openedAt time.Time
+}
+
+var _ ResultSink = (*Parquet)(nil)
+
+// NewParquet initializes a parquet sink that writes files to the given directory.
+func NewParquet(dir string, rotation time.Duration) (*Parquet, error) {
+	if rotation <= 0 {
+		return nil, fmt.Errorf("invalid rotation interval %s", rotation)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "create parquet directory")
+	}
+
+	return &Parquet{
+		dir:      dir,
+		rotation: rotation,
+	}, nil
+}
+
+func (p *Parquet) Name() string {
+	return "parquet"
+}
+
+func (p *Parquet) Write(_ context.Context, result *Result) error {
+	record, err := NewRecord(result)
+	if err != nil {
+		return errors.Wrap(err, "new record")
+	}
+
+	row, err := newParquetRow(record)
+	if err != nil {
+		return errors.Wrap(err, "new parquet row")
+	}
+
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	if p.writer != nil && time.Since(p.openedAt) >= p.rotation {
+		if err = p.finalize(); err != nil {
+			return errors.Wrap(err, "rotate parquet file")
+		}
+	}
+
+	if p.writer == nil {
+		if err = p.open(); err != nil {
+			return errors.Wrap(err, "open parquet file")
+		}
+	}
+
+	return errors.Wrap(p.writer.Write(row), "write parquet row")
+}
+
+// open starts a new temporary parquet file. Must be called with the lock held.
+func (p *Parquet) open() error {
+	now := time.Now().UTC()
+	path := filepath.Join(p.dir, fmt.Sprintf("results-%s.parquet", now.Format("20060102T150405.000000000")))
+
+	file, err := local.NewLocalFileWriter(path + ".tmp")
+	if err != nil {
+		return errors.Wrap(err, "new local file writer")
+	}
+
+	pw, err := writer.NewParquetWriter(file, new(parquetRow), 1)
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "new parquet writer")
+	}
+
+	p.file = file
+	p.writer = pw
+	p.path = path
+	p.openedAt = now
+
+	return nil
+}
+
+// finalize flushes the parquet footer and moves the temporary file to
+// its final location. Must be called with the lock held.
+func (p *Parquet) finalize() error {
+	if p.writer == nil {
+		return nil
+	}
+
+	pw, file, path := p.writer, p.file, p.path
+	p.writer, p.file, p.path = nil, nil, ""
+
+	if err := pw.WriteStop(); err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "write parquet footer")
+	}
+
+	if err := file.Close(); err != nil {
+		return errors.Wrap(err, "close parquet file")
+	}
+
+	return errors.Wrap(os.Rename(path+".tmp", path), "rename parquet file")
+}
+
+func (p *Parquet) Close() error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	return p.finalize()
+}
@@ -0,0 +1,310 @@
+package sink
+
+import (
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+)
+
+// Record is the self-contained representation of a hole punch result that
+// is written by the file and stream sinks. Peer IDs and multi addresses are
+// encoded as strings and enums by their name without the common prefix.
+// The API key of the client is deliberately left out.
+type Record struct {
+	ReceivedAt           time.Time                   `json:"received_at"`
+	AuthorizationID      int                         `json:"authorization_id"`
+	AllocationID         *int64                      `json:"allocation_id,omitempty"`
+	ClientID             string                      `json:"client_id"`
+	RemoteID             string                      `json:"remote_id"`
+	RemoteMultiAddresses []string                    `json:"remote_multi_addresses"`
+	ListenMultiAddresses []string                    `json:"listen_multi_addresses"`
+	OpenMultiAddresses   []string                    `json:"open_multi_addresses"`
+	ConnectStartedAt     time.Time                   `json:"connect_started_at"`
+	ConnectEndedAt       time.Time                   `json:"connect_ended_at"`
+	EndedAt              time.Time                   `json:"ended_at"`
+	HasDirectConns       bool                        `json:"has_direct_conns"`
+	Outcome              string                      `json:"outcome"`
+	Error                *string                     `json:"error,omitempty"`
+	ProtocolFilters      []int32                     `json:"protocol_filters"`
+	HolePunchAttempts    []*HolePunchAttemptRecord   `json:"hole_punch_attempts"`
+	HolePunchEvents      []*HolePunchEventRecord     `json:"hole_punch_events"`
+	LatencyMeasurements  []*LatencyMeasurementRecord `json:"latency_measurements"`
+	NATMappings          []*NATMappingRecord         `json:"nat_mappings"`
+	NetworkInformation   *NetworkInformationRecord   `json:"network_information,omitempty"`
+	RelayStrategy        *string                     `json:"relay_strategy,omitempty"`
+	RelayMultiAddress    *string                     `json:"relay_multi_address,omitempty"`
+	RelayDials           []*RelayDialRecord          `json:"relay_dials"`
+	DCUtRParameters      *DCUtRParametersRecord      `json:"dcutr_parameters,omitempty"`
+	ValidationStatus     string                      `json:"validation_status"`
+	ValidationReasons    []string                    `json:"validation_reasons"`
+}
+
+type HolePunchAttemptRecord struct {
+	OpenedAt        time.Time  `json:"opened_at"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	EndedAt         time.Time  `json:"ended_at"`
+	StartRTT        *float32   `json:"start_rtt,omitempty"`
+	ElapsedTime     float32    `json:"elapsed_time"`
+	Outcome         string     `json:"outcome"`
+	Error           *string    `json:"error,omitempty"`
+	DirectDialError *string    `json:"direct_dial_error,omitempty"`
+	MultiAddresses  []string   `json:"multi_addresses"`
+}
+
+type HolePunchEventRecord struct {
+	Type           string    `json:"type"`
+	Timestamp      time.Time `json:"timestamp"`
+	MultiAddresses []string  `json:"multi_addresses"`
+	Attempt        *int32    `json:"attempt,omitempty"`
+	Success        *bool     `json:"success,omitempty"`
+	ElapsedTime    *float32  `json:"elapsed_time,omitempty"`
+	RTT            *float32  `json:"rtt,omitempty"`
+	Error          *string   `json:"error,omitempty"`
+	Direction      *string   `json:"direction,omitempty"`
+}
+
+type LatencyMeasurementRecord struct {
+	RemoteID     string    `json:"remote_id"`
+	AgentVersion string    `json:"agent_version"`
+	Protocols    []string  `json:"protocols"`
+	MultiAddress string    `json:"multi_address"`
+	Type         string    `json:"type"`
+	RTTs         []float32 `json:"rtts"`
+	RTTErrs      []string  `json:"rtt_errs"`
+}
+
+type RelayDialRecord struct {
+	RelayID        string    `json:"relay_id"`
+	MultiAddresses []string  `json:"multi_addresses"`
+	StartedAt      time.Time `json:"started_at"`
+	EndedAt        time.Time `json:"ended_at"`
+	Error          *string   `json:"error,omitempty"`
+	RTT            *float32  `json:"rtt,omitempty"`
+	Selected       bool      `json:"selected"`
+}
+
+type DCUtRParametersRecord struct {
+	CommunicationTimeout *float32 `json:"communication_timeout,omitempty"`
+	RetryCount           *int32   `json:"retry_count,omitempty"`
+	PingDuration         *float32 `json:"ping_duration,omitempty"`
+	MaxPingCount         *int32   `json:"max_ping_count,omitempty"`
+}
+
+type NATMappingRecord struct {
+	InternalPort int32  `json:"internal_port"`
+	ExternalPort int32  `json:"external_port"`
+	Protocol     string `json:"protocol"`
+	Addr         string `json:"addr"`
+	AddrNetwork  string `json:"addr_network"`
+}
+
+type NetworkInformationRecord struct {
+	RouterLoginHTML      *string `json:"router_login_html,omitempty"`
+	RouterLoginHTMLError *string `json:"router_login_html_error,omitempty"`
+	SupportsIPv6         *bool   `json:"supports_ipv6,omitempty"`
+	SupportsIPv6Error    *string `json:"supports_ipv6_error,omitempty"`
+}
+
+// NewRecord converts the given result into its self-contained representation.
+func NewRecord(result *Result) (*Record, error) {
+	req := result.Request
+
+	clientID, err := peer.IDFromBytes(req.ClientId)
+	if err != nil {
+		return nil, errors.Wrap(err, "peer ID from client ID")
+	}
+
+	remoteID, err := peer.IDFromBytes(req.RemoteId)
+	if err != nil {
+		return nil, errors.Wrap(err, "peer ID from remote ID")
+	}
+
+	r := &Record{
+		ReceivedAt:          result.ReceivedAt,
+		AuthorizationID:     result.AuthorizationID,
+		AllocationID:        result.AllocationID,
+		ClientID:            clientID.String(),
+		RemoteID:            remoteID.String(),
+		ConnectStartedAt:    fromUnixNanos(req.GetConnectStartedAt()),
+		ConnectEndedAt:      fromUnixNanos(req.GetConnectEndedAt()),
+		EndedAt:             fromUnixNanos(req.GetEndedAt()),
+		HasDirectConns:      req.GetHasDirectConns(),
+		Outcome:             strings.TrimPrefix(req.GetOutcome().String(), "HOLE_PUNCH_OUTCOME_"),
+		Error:               req.Error,
+		ProtocolFilters:     req.Protocols,
+		HolePunchAttempts:   []*HolePunchAttemptRecord{},
+		HolePunchEvents:     []*HolePunchEventRecord{},
+		LatencyMeasurements: []*LatencyMeasurementRecord{},
+		NATMappings:         []*NATMappingRecord{},
+		RelayDials:          []*RelayDialRecord{},
+		ValidationStatus:    result.ValidationStatus,
+		ValidationReasons:   result.ValidationReasons,
+	}
+
+	if r.ValidationReasons == nil {
+		r.ValidationReasons = []string{}
+	}
+
+	if r.RemoteMultiAddresses, err = maddrStrings(req.RemoteMultiAddresses); err != nil {
+		return nil, errors.Wrap(err, "remote multi addresses")
+	}
+
+	if r.ListenMultiAddresses, err = maddrStrings(req.ListenMultiAddresses); err != nil {
+		return nil, errors.Wrap(err, "listen multi addresses")
+	}
+
+	if r.OpenMultiAddresses, err = maddrStrings(req.OpenMultiAddresses); err != nil {
+		return nil, errors.Wrap(err, "open multi addresses")
+	}
+
+	for _, hpa := range req.HolePunchAttempts {
+		maddrs, err := maddrStrings(hpa.MultiAddresses)
+		if err != nil {
+			return nil, errors.Wrap(err, "hole punch attempt multi addresses")
+		}
+
+		var startedAt *time.Time
+		if hpa.StartedAt != nil {
+			t := fromUnixNanos(*hpa.StartedAt)
+			startedAt = &t
+		}
+
+		r.HolePunchAttempts = append(r.HolePunchAttempts, &HolePunchAttemptRecord{
+			OpenedAt:        fromUnixNanos(hpa.GetOpenedAt()),
+			StartedAt:       startedAt,
+			EndedAt:         fromUnixNanos(hpa.GetEndedAt()),
+			StartRTT:        hpa.StartRtt,
+			ElapsedTime:     hpa.GetElapsedTime(),
+			Outcome:         strings.TrimPrefix(hpa.GetOutcome().String(), "HOLE_PUNCH_ATTEMPT_OUTCOME_"),
+			Error:           hpa.Error,
+			DirectDialError: hpa.DirectDialError,
+			MultiAddresses:  maddrs,
+		})
+	}
+
+	for _, evt := range req.HolePunchEvents {
+		maddrs, err := maddrStrings(evt.MultiAddresses)
+		if err != nil {
+			return nil, errors.Wrap(err, "hole punch event multi addresses")
+		}
+
+		r.HolePunchEvents = append(r.HolePunchEvents, &HolePunchEventRecord{
+			Type:           strings.TrimPrefix(evt.GetType().String(), "HOLE_PUNCH_EVENT_TYPE_"),
+			Timestamp:      fromUnixNanos(evt.GetTimestamp()),
+			MultiAddresses: maddrs,
+			Attempt:        evt.Attempt,
+			Success:        evt.Success,
+			ElapsedTime:    evt.ElapsedTime,
+			RTT:            evt.Rtt,
+			Error:          evt.Error,
+			Direction:      evt.Direction,
+		})
+	}
+
+	for _, lm := range req.LatencyMeasurements {
+		lmRemoteID, err := peer.IDFromBytes(lm.RemoteId)
+		if err != nil {
+			return nil, errors.Wrap(err, "peer ID from latency measurement remote ID")
+		}
+
+		maddr, err := multiaddr.NewMultiaddrBytes(lm.MultiAddress)
+		if err != nil {
+			return nil, errors.Wrap(err, "latency measurement multi address")
+		}
+
+		r.LatencyMeasurements = append(r.LatencyMeasurements, &LatencyMeasurementRecord{
+			RemoteID:     lmRemoteID.String(),
+			AgentVersion: lm.GetAgentVersion(),
+			Protocols:    lm.Protocols,
+			MultiAddress: maddr.String(),
+			Type:         lm.GetMtype().String(),
+			RTTs:         lm.Rtts,
+			RTTErrs:      lm.RttErrs,
+		})
+	}
+
+	for _, mapping := range req.NatMappings {
+		r.NATMappings = append(r.NATMappings, &NATMappingRecord{
+			InternalPort: mapping.GetInternalPort(),
+			ExternalPort: mapping.GetExternalPort(),
+			Protocol:     mapping.GetProtocol(),
+			Addr:         mapping.GetAddr(),
+			AddrNetwork:  mapping.GetAddrNetwork(),
+		})
+	}
+
+	if req.RelayStrategy != nil {
+		strategy := strings.TrimPrefix(req.GetRelayStrategy().String(), "RELAY_STRATEGY_")
+		r.RelayStrategy = &strategy
+	}
+
+	if req.RelayMultiAddress != nil {
+		maddr, err := multiaddr.NewMultiaddrBytes(req.RelayMultiAddress)
+		if err != nil {
+			return nil, errors.Wrap(err, "relay multi address")
+		}
+		maddrStr := maddr.String()
+		r.RelayMultiAddress = &maddrStr
+	}
+
+	for _, dial := range req.RelayDials {
+		relayID, err := peer.IDFromBytes(dial.RelayId)
+		if err != nil {
+			return nil, errors.Wrap(err, "peer ID from relay dial relay ID")
+		}
+
+		maddrs, err := maddrStrings(dial.MultiAddresses)
+		if err != nil {
+			return nil, errors.Wrap(err, "relay dial multi addresses")
+		}
+
+		r.RelayDials = append(r.RelayDials, &RelayDialRecord{
+			RelayID:        relayID.String(),
+			MultiAddresses: maddrs,
+			StartedAt:      fromUnixNanos(dial.GetStartedAt()),
+			EndedAt:        fromUnixNanos(dial.GetEndedAt()),
+			Error:          dial.Error,
+			RTT:            dial.Rtt,
+			Selected:       dial.GetSelected(),
+		})
+	}
+
+	if params := req.DcutrParameters; params != nil {
+		r.DCUtRParameters = &DCUtRParametersRecord{
+			CommunicationTimeout: params.CommunicationTimeout,
+			RetryCount:           params.RetryCount,
+			PingDuration:         params.PingDuration,
+			MaxPingCount:         params.MaxPingCount,
+		}
+	}
+
+	if ni := req.NetworkInformation; ni != nil {
+		r.NetworkInformation = &NetworkInformationRecord{
+			RouterLoginHTML:      ni.RouterLoginHtml,
+			RouterLoginHTMLError: ni.RouterLoginHtmlError,
+			SupportsIPv6:         ni.SupportsIpv6,
+			SupportsIPv6Error:    ni.SupportsIpv6Error,
+		}
+	}
+
+	return r, nil
+}
+
+func maddrStrings(maddrsBytes [][]byte) ([]string, error) {
+	maddrs := make([]string, len(maddrsBytes))
+	for i, maddrBytes := range maddrsBytes {
+		maddr, err := multiaddr.NewMultiaddrBytes(maddrBytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "multi addr from bytes")
+		}
+		maddrs[i] = maddr.String()
+	}
+	return maddrs, nil
+}
+
+func fromUnixNanos(nanos uint64) time.Time {
+	return time.Unix(0, int64(nanos)).UTC()
+}
@@ -0,0 +1,82 @@
+package sink
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+// ResultSink receives all hole punch results that were reported to the server.
+type ResultSink interface {
+	// Name identifies the sink in logs and metrics.
+	Name() string
+
+	// Write persists or forwards the given result.
+	Write(ctx context.Context, result *Result) error
+
+	// Close flushes all pending results and releases all resources.
+	Close() error
+}
+
+// Result is a single hole punch result as it was reported by a client.
+type Result struct {
+	// ReceivedAt is the time the server received the result.
+	ReceivedAt time.Time
+
+	// AuthorizationID is the database ID of the authorization that reported the result.
+	AuthorizationID int
+
+	// AllocationID is the allocation that the result completes. It's nil if
+	// the result couldn't be matched with a valid allocation.
+	AllocationID *int64
+
+	// Request is the raw request of the client.
+	Request *pb.TrackHolePunchRequest
+
+	// ValidationStatus is the outcome of the plausibility checks of the server: ACCEPTED, FLAGGED, or REJECTED.
+	ValidationStatus string
+
+	// ValidationReasons explains why the result was flagged or rejected.
+	ValidationReasons []string
+}
+
+// Fanout writes results to multiple sinks one after another.
+type Fanout struct {
+	sinks []ResultSink
+}
+
+var _ ResultSink = (*Fanout)(nil)
+
+// NewFanout initializes a sink that writes results to all given sinks. Wrap
+// sinks with NewAsync to not let them block or fail the others.
+func NewFanout(sinks ...ResultSink) *Fanout {
+	return &Fanout{sinks: sinks}
+}
+
+func (f *Fanout) Name() string {
+	return "fanout"
+}
+
+// Write writes the result to all sinks and returns on the first error.
+func (f *Fanout) Write(ctx context.Context, result *Result) error {
+	for _, s := range f.sinks {
+		if err := s.Write(ctx, result); err != nil {
+			return errors.Wrapf(err, "write to %s sink", s.Name())
+		}
+	}
+	return nil
+}
+
+// Close closes all sinks and returns the last error.
+func (f *Fanout) Close() error {
+	var err error
+	for _, s := range f.sinks {
+		if cerr := s.Close(); cerr != nil {
+			err = errors.Wrapf(cerr, "close %s sink", s.Name())
+		}
+	}
+	return err
+}
@@ -0,0 +1,379 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/dennis-tra/punchr/pkg/pb"
+)
+
+func newPeerID(t *testing.T) peer.ID {
+	_, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	pid, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	return pid
+}
+
+func newTestResult(t *testing.T) *Result {
+	clientID := newPeerID(t)
+	remoteID := newPeerID(t)
+
+	listenMaddr := multiaddr.StringCast("/ip4/192.168.1.10/tcp/4001")
+	remoteMaddr := multiaddr.StringCast("/ip4/1.2.3.4/udp/4001/quic")
+
+	now := time.Now()
+	nanos := func(d time.Duration) *uint64 {
+		n := uint64(now.Add(d).UnixNano())
+		return &n
+	}
+
+	apiKey := "secret"
+	hasDirectConns := true
+	outcome := pb.HolePunchOutcome_HOLE_PUNCH_OUTCOME_SUCCESS
+	attemptOutcome := pb.HolePunchAttemptOutcome_HOLE_PUNCH_ATTEMPT_OUTCOME_SUCCESS
+	elapsed := float32(0.5)
+	evtType := pb.HolePunchEventType_HOLE_PUNCH_EVENT_TYPE_START_HOLE_PUNCH
+	relayStrategy := pb.RelayStrategy_RELAY_STRATEGY_LOWEST_RTT
+	selected := true
+	retryCount := int32(3)
+
+	return &Result{
+		ReceivedAt:      now,
+		AuthorizationID: 42,
+		Request: &pb.TrackHolePunchRequest{
+			ClientId:             []byte(clientID),
+			ApiKey:               &apiKey,
+			RemoteId:             []byte(remoteID),
+			RemoteMultiAddresses: [][]byte{remoteMaddr.Bytes()},
+			ConnectStartedAt:     nanos(-3 * time.Second),
+			ConnectEndedAt:       nanos(-2 * time.Second),
+			HolePunchAttempts: []*pb.HolePunchAttempt{
+				{
+					OpenedAt:       nanos(-2 * time.Second),
+					EndedAt:        nanos(-time.Second),
+					ElapsedTime:    &elapsed,
+					Outcome:        &attemptOutcome,
+					MultiAddresses: [][]byte{remoteMaddr.Bytes()},
+				},
+			},
+			OpenMultiAddresses:   [][]byte{remoteMaddr.Bytes()},
+			HasDirectConns:       &hasDirectConns,
+			Outcome:              &outcome,
+			EndedAt:              nanos(0),
+			ListenMultiAddresses: [][]byte{listenMaddr.Bytes()},
+			Protocols:            []int32{4},
+			HolePunchEvents: []*pb.HolePunchEvent{
+				{
+					Type:           &evtType,
+					Timestamp:      nanos(-2 * time.Second),
+					MultiAddresses: [][]byte{remoteMaddr.Bytes()},
+				},
+			},
+			RelayStrategy: &relayStrategy,
+			DcutrParameters: &pb.DCUtRParameters{
+				RetryCount: &retryCount,
+			},
+			RelayDials: []*pb.RelayDial{
+				{
+					RelayId:        []byte(newPeerID(t)),
+					MultiAddresses: [][]byte{remoteMaddr.Bytes()},
+					StartedAt:      nanos(-4 * time.Second),
+					EndedAt:        nanos(-3 * time.Second),
+					Selected:       &selected,
+				},
+			},
+		},
+	}
+}
+
+func TestNewRecord(t *testing.T) {
+	result := newTestResult(t)
+
+	record, err := NewRecord(result)
+	require.NoError(t, err)
+
+	clientID, _ := peer.IDFromBytes(result.Request.ClientId)
+	assert.Equal(t, clientID.String(), record.ClientID)
+	assert.Equal(t, 42, record.AuthorizationID)
+	assert.Equal(t, "SUCCESS", record.Outcome)
+	assert.Equal(t, []string{"/ip4/192.168.1.10/tcp/4001"}, record.ListenMultiAddresses)
+	assert.Equal(t, []string{"/ip4/1.2.3.4/udp/4001/quic"}, record.RemoteMultiAddresses)
+	require.Len(t, record.HolePunchAttempts, 1)
+	assert.Equal(t, "SUCCESS", record.HolePunchAttempts[0].Outcome)
+	require.Len(t, record.HolePunchEvents, 1)
+	assert.Equal(t, "START_HOLE_PUNCH", record.HolePunchEvents[0].Type)
+	assert.Equal(t, "LOWEST_RTT", *record.RelayStrategy)
+	require.Len(t, record.RelayDials, 1)
+	assert.True(t, record.RelayDials[0].Selected)
+	require.NotNil(t, record.DCUtRParameters)
+	assert.Equal(t, int32(3), *record.DCUtRParameters.RetryCount)
+
+	data, err := json.Marshal(record)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+}
+
+func TestNewRecord_invalidPeerID(t *testing.T) {
+	result := newTestResult(t)
+	result.Request.RemoteId = []byte("invalid")
+
+	_, err := NewRecord(result)
+	assert.Error(t, err)
+}
+
+func TestJSONL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.jsonl")
+
+	s, err := NewJSONL(path)
+	require.NoError(t, err)
+
+	results := []*Result{newTestResult(t), newTestResult(t)}
+	for _, result := range results {
+		require.NoError(t, s.Write(context.Background(), result))
+	}
+	require.NoError(t, s.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []*Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		record := &Record{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, records, len(results))
+	for i, result := range results {
+		remoteID, _ := peer.IDFromBytes(result.Request.RemoteId)
+		assert.Equal(t, remoteID.String(), records[i].RemoteID)
+		assert.True(t, result.ReceivedAt.Equal(records[i].ReceivedAt))
+	}
+}
+
+func TestParquet(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewParquet(dir, time.Hour)
+	require.NoError(t, err)
+
+	result := newTestResult(t)
+	require.NoError(t, s.Write(context.Background(), result))
+	require.NoError(t, s.Write(context.Background(), newTestResult(t)))
+
+	// Files are only visible after they were finalized
+	files, err := filepath.Glob(filepath.Join(dir, "*.parquet"))
+	require.NoError(t, err)
+	assert.Len(t, files, 0)
+
+	require.NoError(t, s.Close())
+
+	files, err = filepath.Glob(filepath.Join(dir, "*.parquet"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	fr, err := local.NewLocalFileReader(files[0])
+	require.NoError(t, err)
+	defer fr.Close()
+
+	pr, err := reader.NewParquetReader(fr, new(parquetRow), 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	require.EqualValues(t, 2, pr.GetNumRows())
+
+	rows := make([]parquetRow, 2)
+	require.NoError(t, pr.Read(&rows))
+
+	clientID, _ := peer.IDFromBytes(result.Request.ClientId)
+	assert.Equal(t, clientID.String(), rows[0].ClientID)
+	assert.Equal(t, "SUCCESS", rows[0].Outcome)
+	assert.Equal(t, result.ReceivedAt.UnixMilli(), rows[0].ReceivedAt)
+	assert.Equal(t, []string{"/ip4/192.168.1.10/tcp/4001"}, rows[0].ListenMultiAddresses)
+
+	var attempts []*HolePunchAttemptRecord
+	require.NoError(t, json.Unmarshal([]byte(rows[0].HolePunchAttempts), &attempts))
+	assert.Len(t, attempts, 1)
+}
+
+func TestParquet_rotation(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewParquet(dir, time.Nanosecond)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.Write(context.Background(), newTestResult(t)))
+	}
+	require.NoError(t, s.Close())
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.parquet"))
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+
+	tmpFiles, err := filepath.Glob(filepath.Join(dir, "*.tmp"))
+	require.NoError(t, err)
+	assert.Len(t, tmpFiles, 0)
+}
+
+func TestStream_nats(t *testing.T) {
+	srv := test.RunRandClientPortServer()
+	defer srv.Shutdown()
+
+	nc, err := nats.Connect(srv.ClientURL())
+	require.NoError(t, err)
+	defer nc.Close()
+
+	sub, err := nc.SubscribeSync("punchr.results")
+	require.NoError(t, err)
+	require.NoError(t, nc.Flush())
+
+	publisher, err := NewNATSPublisher(srv.ClientURL(), "punchr.results")
+	require.NoError(t, err)
+
+	s := NewStream("nats", publisher)
+
+	result := newTestResult(t)
+	require.NoError(t, s.Write(context.Background(), result))
+	require.NoError(t, s.Close())
+
+	msg, err := sub.NextMsg(5 * time.Second)
+	require.NoError(t, err)
+
+	record := &Record{}
+	require.NoError(t, json.Unmarshal(msg.Data, record))
+
+	clientID, _ := peer.IDFromBytes(result.Request.ClientId)
+	assert.Equal(t, clientID.String(), record.ClientID)
+}
+
+type testPublisher struct {
+	lk       sync.Mutex
+	keys     []string
+	messages [][]byte
+	err      error
+	closed   bool
+	block    chan struct{}
+}
+
+func (tp *testPublisher) Publish(_ context.Context, key []byte, value []byte) error {
+	if tp.block != nil {
+		<-tp.block
+	}
+
+	tp.lk.Lock()
+	defer tp.lk.Unlock()
+
+	if tp.err != nil {
+		return tp.err
+	}
+
+	tp.keys = append(tp.keys, string(key))
+	tp.messages = append(tp.messages, value)
+	return nil
+}
+
+func (tp *testPublisher) Close() error {
+	tp.closed = true
+	return nil
+}
+
+func TestStream_key(t *testing.T) {
+	tp := &testPublisher{}
+	s := NewStream("test", tp)
+
+	result := newTestResult(t)
+	require.NoError(t, s.Write(context.Background(), result))
+	require.NoError(t, s.Close())
+
+	clientID, _ := peer.IDFromBytes(result.Request.ClientId)
+	assert.Equal(t, []string{clientID.String()}, tp.keys)
+	assert.True(t, tp.closed)
+}
+
+func TestFanout(t *testing.T) {
+	tp1 := &testPublisher{}
+	tp2 := &testPublisher{err: fmt.Errorf("unavailable")}
+	tp3 := &testPublisher{}
+
+	f := NewFanout(NewStream("first", tp1), NewStream("second", tp2), NewStream("third", tp3))
+
+	err := f.Write(context.Background(), newTestResult(t))
+	assert.ErrorContains(t, err, "second")
+	assert.Len(t, tp1.messages, 1)
+	assert.Len(t, tp3.messages, 0)
+
+	require.NoError(t, f.Close())
+	assert.True(t, tp1.closed)
+	assert.True(t, tp2.closed)
+	assert.True(t, tp3.closed)
+}
+
+func TestAsync(t *testing.T) {
+	tp := &testPublisher{err: fmt.Errorf("unavailable")}
+	a := NewAsync(NewStream("test", tp), 10)
+
+	// Errors of the wrapped sink are not returned
+	require.NoError(t, a.Write(context.Background(), newTestResult(t)))
+
+	tp.lk.Lock()
+	tp.err = nil
+	tp.lk.Unlock()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, a.Write(context.Background(), newTestResult(t)))
+	}
+
+	// Close drains the queue
+	require.NoError(t, a.Close())
+	assert.True(t, tp.closed)
+	assert.GreaterOrEqual(t, len(tp.messages), 5)
+}
+
+func TestAsync_stream(t *testing.T) {
+	tp := &testPublisher{block: make(chan struct{})}
+	a := NewAsync(NewStream("test-stream", tp), 1)
+
+	dropped := sinkResultsCounter.WithLabelValues("test-stream", "dropped")
+	droppedBefore := testutil.ToFloat64(dropped)
+
+	// The worker takes the first result and blocks in the publisher
+	require.NoError(t, a.Write(context.Background(), newTestResult(t)))
+	require.Eventually(t, func() bool { return len(a.queue) == 0 }, time.Second, time.Millisecond)
+
+	// The second result fills the queue and the third one is dropped
+	require.NoError(t, a.Write(context.Background(), newTestResult(t)))
+	require.NoError(t, a.Write(context.Background(), newTestResult(t)))
+	assert.Equal(t, droppedBefore+1, testutil.ToFloat64(dropped))
+
+	// Close flushes the queued result before it closes the publisher
+	close(tp.block)
+	require.NoError(t, a.Close())
+	assert.Len(t, tp.messages, 2)
+	assert.True(t, tp.closed)
+}
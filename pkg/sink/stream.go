@@ -0,0 +1,144 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+)
+
+// Publisher abstracts a message stream like a Kafka topic or a NATS subject.
+type Publisher interface {
+	// Publish sends the given value to the stream. The key may be used
+	// by the stream to partition the messages.
+	Publish(ctx context.Context, key []byte, value []byte) error
+
+	// Close flushes all pending messages and closes the connection to the stream.
+	Close() error
+}
+
+// Stream publishes every result as a JSON encoded message. The ID of the
+// client that reported the result is used as the message key, so that all
+// results of a single client end up in the same partition.
+type Stream struct {
+	name      string
+	publisher Publisher
+}
+
+var _ ResultSink = (*Stream)(nil)
+
+// NewStream initializes a sink that publishes results with the given publisher.
+func NewStream(name string, publisher Publisher) *Stream {
+	return &Stream{
+		name:      name,
+		publisher: publisher,
+	}
+}
+
+func (s *Stream) Name() string {
+	return s.name
+}
+
+func (s *Stream) Write(ctx context.Context, result *Result) error {
+	record, err := NewRecord(result)
+	if err != nil {
+		return errors.Wrap(err, "new record")
+	}
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "marshal record")
+	}
+
+	return errors.Wrap(s.publisher.Publish(ctx, []byte(record.ClientID), value), "publish record")
+}
+
+func (s *Stream) Close() error {
+	return s.publisher.Close()
+}
+
+// KafkaPublisher publishes messages to a Kafka topic. It works with every
+// broker that speaks the Kafka protocol, e.g., Redpanda. Messages are
+// batched and written in the background, so Publish doesn't wait for the
+// brokers. Failed writes are logged and tracked in the sink results metric.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+var _ Publisher = (*KafkaPublisher)(nil)
+
+// NewKafkaPublisher initializes a publisher for the given comma separated list of brokers and topic.
+func NewKafkaPublisher(brokers string, topic string) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(strings.Split(brokers, ",")...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchSize:    100,
+			BatchTimeout: 100 * time.Millisecond,
+			Async:        true,
+			Completion: func(messages []kafka.Message, err error) {
+				if err == nil {
+					return
+				}
+				log.WithError(err).WithField("count", len(messages)).Warnln("Could not write messages to kafka")
+				sinkResultsCounter.WithLabelValues("kafka", "error").Add(float64(len(messages)))
+			},
+		},
+	}
+}
+
+func (k *KafkaPublisher) Publish(ctx context.Context, key []byte, value []byte) error {
+	return k.writer.WriteMessages(ctx, kafka.Message{Key: key, Value: value})
+}
+
+// Close flushes all batched messages and waits until they were written.
+func (k *KafkaPublisher) Close() error {
+	return k.writer.Close()
+}
+
+// NATSPublisher publishes messages to a NATS subject.
+type NATSPublisher struct {
+	conn    *nats.Conn
+	subject string
+}
+
+var _ Publisher = (*NATSPublisher)(nil)
+
+// NewNATSPublisher connects to the NATS server at the given URL.
+func NewNATSPublisher(url string, subject string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url, nats.Name("punchrserver"), nats.Timeout(10*time.Second))
+	if err != nil {
+		return nil, errors.Wrap(err, "connect to nats")
+	}
+
+	return &NATSPublisher{
+		conn:    conn,
+		subject: subject,
+	}, nil
+}
+
+// Publish sends the value to the configured subject. NATS has no notion of
+// message keys, so the key is ignored.
+func (n *NATSPublisher) Publish(ctx context.Context, _ []byte, value []byte) error {
+	if err := n.conn.Publish(n.subject, value); err != nil {
+		return err
+	}
+
+	// FlushWithContext refuses contexts without a deadline
+	if _, ok := ctx.Deadline(); !ok {
+		return n.conn.Flush()
+	}
+	return n.conn.FlushWithContext(ctx)
+}
+
+func (n *NATSPublisher) Close() error {
+	defer n.conn.Close()
+	return n.conn.Flush()
+}
@@ -0,0 +1,116 @@
+package udger
+
+import (
+	"encoding/binary"
+	"net"
+	"sort"
+)
+
+// addr is an IP address as a 128 bit integer. IPv4 addresses only use the lower bits.
+type addr struct {
+	hi, lo uint64
+}
+
+func (a addr) less(b addr) bool {
+	return a.hi < b.hi || (a.hi == b.hi && a.lo < b.lo)
+}
+
+// sub returns a - b.
+func (a addr) sub(b addr) addr {
+	lo := a.lo - b.lo
+	hi := a.hi - b.hi
+	if a.lo < b.lo {
+		hi--
+	}
+	return addr{hi: hi, lo: lo}
+}
+
+// toAddr converts the IP address and reports whether it's an IPv4 address.
+func toAddr(ip net.IP) (addr, bool) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return addr{lo: uint64(binary.BigEndian.Uint32(ip4))}, true
+	}
+	ip16 := ip.To16()
+	return addr{hi: binary.BigEndian.Uint64(ip16[:8]), lo: binary.BigEndian.Uint64(ip16[8:])}, false
+}
+
+// ipRange is a range of IP addresses that belongs to a data center. From and To are inclusive.
+type ipRange struct {
+	from, to   addr
+	datacenter int
+}
+
+// intervalTree finds the ranges that contain an address. It's a balanced binary search tree
+// over the ranges sorted by their start that is stored implicitly in the sorted slice: the
+// root of the subtree of the ranges [lo, hi) is at (lo+hi)/2. maxTo holds the largest end
+// of all ranges in the subtree of each node, so that subtrees that end before the address
+// are skipped. A lookup takes O(log n + k) for k matching ranges.
+type intervalTree struct {
+	ranges []ipRange
+	maxTo  []addr
+}
+
+// newIntervalTree builds the tree. It takes ownership of the ranges.
+func newIntervalTree(ranges []ipRange) *intervalTree {
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].from.less(ranges[j].from)
+	})
+
+	t := &intervalTree{ranges: ranges, maxTo: make([]addr, len(ranges))}
+	if len(ranges) > 0 {
+		t.build(0, len(ranges))
+	}
+
+	return t
+}
+
+// build computes maxTo of the subtree [lo, hi) and returns it.
+func (t *intervalTree) build(lo, hi int) addr {
+	mid := (lo + hi) / 2
+	maxTo := t.ranges[mid].to
+	if lo < mid {
+		if left := t.build(lo, mid); maxTo.less(left) {
+			maxTo = left
+		}
+	}
+	if mid+1 < hi {
+		if right := t.build(mid+1, hi); maxTo.less(right) {
+			maxTo = right
+		}
+	}
+	t.maxTo[mid] = maxTo
+	return maxTo
+}
+
+// lookup returns the narrowest range that contains the address.
+func (t *intervalTree) lookup(a addr) (*ipRange, bool) {
+	var best *ipRange
+	t.stab(0, len(t.ranges), a, &best)
+	return best, best != nil
+}
+
+func (t *intervalTree) stab(lo, hi int, a addr, best **ipRange) {
+	if lo >= hi {
+		return
+	}
+
+	mid := (lo + hi) / 2
+	if t.maxTo[mid].less(a) {
+		// All ranges of this subtree end before the address
+		return
+	}
+
+	t.stab(lo, mid, a, best)
+
+	r := &t.ranges[mid]
+	if a.less(r.from) {
+		// This range and all ranges in the right subtree start after the address
+		return
+	}
+
+	if !r.to.less(a) && (*best == nil || r.to.sub(r.from).less((*best).to.sub((*best).from))) {
+		*best = r
+	}
+
+	t.stab(mid+1, hi, a, best)
+}
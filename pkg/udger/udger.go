@@ -1,114 +1,282 @@
+// Package udger looks up whether IP addresses belong to data centers with the Udger
+// database. The data center ranges are loaded into memory, so that lookups don't
+// query SQLite. The database is reloaded when the file changes.
 package udger
 
 import (
-	"bytes"
+	"crypto/sha256"
 	"database/sql"
-	"encoding/binary"
-	"math/big"
+	"encoding/hex"
+	"fmt"
+	"io"
 	"net"
+	"os"
+	"path/filepath"
+	"sync"
 
 	"github.com/friendsofgo/errors"
+	"github.com/fsnotify/fsnotify"
 	_ "github.com/mattn/go-sqlite3"
+	log "github.com/sirupsen/logrus"
 )
 
+// Datacenter is a data center of the Udger database.
+type Datacenter struct {
+	ID   int
+	Name string
+}
+
+// index holds the data center ranges of a version of the Udger database.
+type index struct {
+	ipv4        *intervalTree
+	ipv6        *intervalTree
+	datacenters map[int]*Datacenter
+	version     string
+}
+
 type Client struct {
-	db *sql.DB
+	dbpath string
+
+	mu  sync.RWMutex
+	idx *index
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
 }
 
-// NewClient initializes a new maxmind database client from the embedded database
+// NewClient loads the data center ranges of the Udger database at the given path and starts
+// watching it for changes. A missing database isn't an error because it's optional: no address
+// belongs to a data center until it's created.
 func NewClient(dbpath string) (*Client, error) {
-	db, err := sql.Open("sqlite3", dbpath)
+	c := &Client{
+		dbpath: filepath.Clean(dbpath),
+		idx:    &index{ipv4: newIntervalTree(nil), ipv6: newIntervalTree(nil)},
+		done:   make(chan struct{}),
+	}
+
+	if _, err := os.Stat(c.dbpath); errors.Is(err, os.ErrNotExist) {
+		log.WithField("file", c.dbpath).Warnln("Udger database not found, data centers won't be detected")
+	} else if err = c.Reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
-		return nil, errors.Wrap(err, "open udger db")
+		return nil, errors.Wrap(err, "new file watcher")
+	}
+
+	if err = watcher.Add(filepath.Dir(c.dbpath)); err != nil {
+		_ = watcher.Close()
+		return nil, errors.Wrapf(err, "watch %s", filepath.Dir(c.dbpath))
 	}
+	c.watcher = watcher
+
+	go c.watch()
 
-	return &Client{db: db}, nil
+	return c, nil
 }
 
-func (c *Client) Datacenter(addr string) (int, error) {
-	ip := net.ParseIP(addr)
-	if ip.To4() != nil {
-		return c.handleIPv4(ip)
-	} else {
-		return c.handleIPv6(ip)
+func (c *Client) watch() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case evt, ok := <-c.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if evt.Op == fsnotify.Chmod || filepath.Clean(evt.Name) != c.dbpath {
+				continue
+			}
+
+			// A failed reload keeps the previous ranges, e.g., while the file is being written.
+			if err := c.Reload(); err != nil {
+				log.WithError(err).WithField("file", c.dbpath).Warnln("Could not reload Udger database")
+			} else {
+				log.WithField("file", c.dbpath).Infoln("Reloaded Udger database")
+			}
+		case err, ok := <-c.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.WithError(err).WithField("file", c.dbpath).Warnln("Error watching Udger database")
+		}
 	}
 }
 
-func (c *Client) handleIPv4(ip net.IP) (int, error) {
-	ipint := big.NewInt(0)
-	ipint.SetBytes(ip.To4())
-	packedIp, err := packIpInt(ipint.Int64())
+// Reload loads the data center ranges from the Udger database.
+func (c *Client) Reload() error {
+	idx, err := load(c.dbpath)
 	if err != nil {
-		return 0, errors.Wrap(err, "pack ip")
+		return err
 	}
 
-	rows, err := c.db.Query("SELECT datacenter_id FROM udger_datacenter_range WHERE iplong_from <= ? AND ? <= iplong_to", packedIp, packedIp)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.idx = idx
+
+	return nil
+}
+
+// load reads all data centers and their IPv4 and IPv6 ranges from the Udger database.
+func load(dbpath string) (*index, error) {
+	version, err := fileVersion(dbpath)
 	if err != nil {
-		return 0, errors.Wrap(err, "query ip4 datacenter")
-	} else if rows.Err() != nil {
-		return 0, errors.Wrap(err, "query ip4 datacenter")
+		return nil, err
 	}
 
-	if !rows.Next() {
-		return 0, sql.ErrNoRows
+	db, err := sql.Open("sqlite3", "file:"+dbpath+"?mode=ro")
+	if err != nil {
+		return nil, errors.Wrap(err, "open udger db")
 	}
-	defer rows.Close()
+	defer db.Close()
+
+	idx := &index{datacenters: map[int]*Datacenter{}, version: version}
 
-	var datacenter_id int
-	if err = rows.Scan(&datacenter_id); err != nil {
-		return 0, errors.Wrap(err, "scan ip4 datacenter query")
+	rows, err := db.Query("SELECT id, name FROM udger_datacenter_list")
+	if err != nil {
+		return nil, errors.Wrap(err, "query datacenters")
+	}
+	for rows.Next() {
+		dc := &Datacenter{}
+		if err = rows.Scan(&dc.ID, &dc.Name); err != nil {
+			_ = rows.Close()
+			return nil, errors.Wrap(err, "scan datacenter")
+		}
+		idx.datacenters[dc.ID] = dc
+	}
+	if err = rows.Close(); err != nil {
+		return nil, errors.Wrap(err, "close datacenter rows")
 	}
 
-	return datacenter_id, nil
-}
+	var ranges []ipRange
+	rows, err = db.Query("SELECT datacenter_id, iplong_from, iplong_to FROM udger_datacenter_range")
+	if err != nil {
+		return nil, errors.Wrap(err, "query ip4 datacenter ranges")
+	}
+	for rows.Next() {
+		var r ipRange
+		if err = rows.Scan(&r.datacenter, &r.from.lo, &r.to.lo); err != nil {
+			_ = rows.Close()
+			return nil, errors.Wrap(err, "scan ip4 datacenter range")
+		}
+		ranges = append(ranges, r)
+	}
+	if err = rows.Close(); err != nil {
+		return nil, errors.Wrap(err, "close ip4 datacenter range rows")
+	}
+	idx.ipv4 = newIntervalTree(ranges)
 
-func (c *Client) handleIPv6(ip net.IP) (int, error) {
-	comps := make([]int64, 8)
-	for i := 0; i < 8; i += 2 {
-		ipint := big.NewInt(0)
-		ipint.SetBytes(ip.To16()[i : i+2])
-		packedIp, err := packIpInt(ipint.Int64())
+	// The IPv6 ranges are stored as eight 16 bit groups per address
+	ranges = nil
+	rows, err = db.Query(`
+	SELECT datacenter_id,
+		iplong_from0, iplong_from1, iplong_from2, iplong_from3, iplong_from4, iplong_from5, iplong_from6, iplong_from7,
+		iplong_to0, iplong_to1, iplong_to2, iplong_to3, iplong_to4, iplong_to5, iplong_to6, iplong_to7
+	FROM udger_datacenter_range6`)
+	if err != nil {
+		return nil, errors.Wrap(err, "query ip6 datacenter ranges")
+	}
+	for rows.Next() {
+		var r ipRange
+		var from, to [8]uint64
+		err = rows.Scan(&r.datacenter,
+			&from[0], &from[1], &from[2], &from[3], &from[4], &from[5], &from[6], &from[7],
+			&to[0], &to[1], &to[2], &to[3], &to[4], &to[5], &to[6], &to[7])
 		if err != nil {
-			return 0, errors.Wrap(err, "pack ip")
+			_ = rows.Close()
+			return nil, errors.Wrap(err, "scan ip6 datacenter range")
 		}
-		comps[i/2] = packedIp
-	}
-
-	rows, err := c.db.Query(`
-	SELECT datacenter_id FROM udger_datacenter_range6
-	WHERE
-		iplong_from0 <= ? AND iplong_from1 <= ? AND iplong_from2 <= ? AND iplong_from3 <= ? AND
-		iplong_from4 <= ? AND iplong_from5 <= ? AND iplong_from6 <= ? AND iplong_from7 <= ? AND
-		iplong_to0 >= ? AND iplong_to1 >= ? AND iplong_to2 >= ? AND iplong_to3 >= ? AND
-		iplong_to4 >= ? AND iplong_to5 >= ? AND iplong_to6 >= ? AND iplong_to7 >= ?
-	`, comps[0], comps[1], comps[2], comps[3], comps[4], comps[5], comps[6], comps[7],
-		comps[0], comps[1], comps[2], comps[3], comps[4], comps[5], comps[6], comps[7])
+		r.from, r.to = groupsToAddr(from), groupsToAddr(to)
+		ranges = append(ranges, r)
+	}
+	if err = rows.Close(); err != nil {
+		return nil, errors.Wrap(err, "close ip6 datacenter range rows")
+	}
+	idx.ipv6 = newIntervalTree(ranges)
+
+	return idx, nil
+}
+
+// groupsToAddr joins the eight 16 bit groups of an IPv6 address.
+func groupsToAddr(groups [8]uint64) addr {
+	var a addr
+	for i := 0; i < 4; i++ {
+		a.hi = a.hi<<16 | groups[i]&0xffff
+		a.lo = a.lo<<16 | groups[i+4]&0xffff
+	}
+	return a
+}
+
+// fileVersion returns a short hash of the content of the file.
+func fileVersion(path string) (string, error) {
+	f, err := os.Open(path)
 	if err != nil {
-		return 0, errors.Wrap(err, "query ip6 datacenter")
-	} else if rows.Err() != nil {
-		return 0, errors.Wrap(err, "query ip6 datacenter")
+		return "", errors.Wrap(err, "open udger db")
 	}
-	defer rows.Close()
+	defer f.Close()
 
-	if !rows.Next() {
-		return 0, sql.ErrNoRows
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", errors.Wrap(err, "hash udger db")
 	}
 
-	var datacenter_id int
-	if err = rows.Scan(&datacenter_id); err != nil {
-		return 0, errors.Wrap(err, "scan ip6 datacenter query")
+	return hex.EncodeToString(h.Sum(nil)[:8]), nil
+}
+
+// Lookup returns the data center that the IP address belongs to. If it's in multiple ranges,
+// the data center of the narrowest range is returned. It returns sql.ErrNoRows if the address
+// doesn't belong to a data center.
+func (c *Client) Lookup(addr string) (*Datacenter, error) {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid address %s", addr)
+	}
+
+	c.mu.RLock()
+	idx := c.idx
+	c.mu.RUnlock()
+
+	a, isIPv4 := toAddr(ip)
+	tree := idx.ipv6
+	if isIPv4 {
+		tree = idx.ipv4
+	}
+
+	r, found := tree.lookup(a)
+	if !found {
+		return nil, sql.ErrNoRows
 	}
 
-	return datacenter_id, nil
+	if dc, found := idx.datacenters[r.datacenter]; found {
+		return dc, nil
+	}
+
+	return &Datacenter{ID: r.datacenter}, nil
 }
 
-func packIpInt(ipint int64) (int64, error) {
-	buf := new(bytes.Buffer)
-	err := binary.Write(buf, binary.BigEndian, uint32(ipint))
+// Datacenter returns the ID of the data center that the IP address belongs to. It
+// returns sql.ErrNoRows if the address doesn't belong to a data center.
+func (c *Client) Datacenter(addr string) (int, error) {
+	dc, err := c.Lookup(addr)
 	if err != nil {
-		return 0, errors.Wrap(err, "write ip int to buffer")
+		return 0, err
 	}
-	packedInt := big.NewInt(0)
-	packedInt.SetBytes(buf.Bytes())
-	return packedInt.Int64(), nil
+	return dc.ID, nil
+}
+
+// Version identifies the loaded Udger database. It changes whenever the database is updated.
+func (c *Client) Version() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.idx.version
+}
+
+// Close stops watching the database.
+func (c *Client) Close() error {
+	close(c.done)
+	return c.watcher.Close()
 }
@@ -1,8 +1,15 @@
 package udger
 
 import (
+	"database/sql"
+	"encoding/binary"
 	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,3 +62,158 @@ func TestClient_Datacenter(t *testing.T) {
 		})
 	}
 }
+
+// writeTestDB creates an Udger database with the given data centers and IPv4 and IPv6 ranges.
+func writeTestDB(t testing.TB, path string, datacenters map[int]string, ranges ...[3]string) {
+	db, err := sql.Open("sqlite3", path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+CREATE TABLE udger_datacenter_list (id INTEGER PRIMARY KEY, name TEXT, name_code TEXT, homepage TEXT);
+CREATE TABLE udger_datacenter_range (datacenter_id INTEGER, ip_from TEXT, ip_to TEXT, iplong_from INTEGER, iplong_to INTEGER);
+CREATE TABLE udger_datacenter_range6 (datacenter_id INTEGER, ip_from TEXT, ip_to TEXT,
+	iplong_from0 INTEGER, iplong_from1 INTEGER, iplong_from2 INTEGER, iplong_from3 INTEGER,
+	iplong_from4 INTEGER, iplong_from5 INTEGER, iplong_from6 INTEGER, iplong_from7 INTEGER,
+	iplong_to0 INTEGER, iplong_to1 INTEGER, iplong_to2 INTEGER, iplong_to3 INTEGER,
+	iplong_to4 INTEGER, iplong_to5 INTEGER, iplong_to6 INTEGER, iplong_to7 INTEGER);`)
+	require.NoError(t, err)
+
+	for id, name := range datacenters {
+		_, err = db.Exec("INSERT INTO udger_datacenter_list (id, name) VALUES (?, ?)", id, name)
+		require.NoError(t, err)
+	}
+
+	for _, r := range ranges {
+		from, to := net.ParseIP(r[1]), net.ParseIP(r[2])
+		if from.To4() != nil {
+			_, err = db.Exec("INSERT INTO udger_datacenter_range VALUES (?, ?, ?, ?, ?)",
+				r[0], r[1], r[2], binary.BigEndian.Uint32(from.To4()), binary.BigEndian.Uint32(to.To4()))
+			require.NoError(t, err)
+			continue
+		}
+
+		args := []interface{}{r[0], r[1], r[2]}
+		for _, ip := range []net.IP{from, to} {
+			for i := 0; i < 16; i += 2 {
+				args = append(args, binary.BigEndian.Uint16(ip[i:i+2]))
+			}
+		}
+		_, err = db.Exec("INSERT INTO udger_datacenter_range6 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", args...)
+		require.NoError(t, err)
+	}
+}
+
+func TestClient_Lookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "udgerdb_v3.dat")
+	writeTestDB(t, path, map[int]string{1277: "Tencent Cloud", 485: "Hetzner", 630: "Leaseweb", 777: "Linode"},
+		[3]string{"1277", "1.12.0.0", "1.15.255.255"},
+		[3]string{"485", "5.175.0.0", "5.175.255.255"},
+		[3]string{"777", "5.175.219.0", "5.175.219.255"},
+		[3]string{"630", "2001:1be0:1000::", "2001:1be0:1000:ffff:ffff:ffff:ffff:ffff"},
+		[3]string{"777", "2a01:7e00::", "2a01:7e00:ffff:ffff:ffff:ffff:ffff:ffff"},
+	)
+
+	client, err := NewClient(path)
+	require.NoError(t, err)
+	defer client.Close()
+
+	tests := []struct {
+		addr    string
+		want    *Datacenter
+		wantErr error
+	}{
+		{addr: "1.12.0.0", want: &Datacenter{ID: 1277, Name: "Tencent Cloud"}},
+		{addr: "1.15.255.255", want: &Datacenter{ID: 1277, Name: "Tencent Cloud"}},
+		{addr: "1.16.0.0", wantErr: sql.ErrNoRows},
+		{addr: "1.11.255.255", wantErr: sql.ErrNoRows},
+		{addr: "5.175.1.2", want: &Datacenter{ID: 485, Name: "Hetzner"}},
+		{addr: "5.175.219.1", want: &Datacenter{ID: 777, Name: "Linode"}},
+		{addr: "2001:1be0:1000:100::", want: &Datacenter{ID: 630, Name: "Leaseweb"}},
+		{addr: "2001:1be0:1001::", wantErr: sql.ErrNoRows},
+		{addr: "2a01:7e00::1", want: &Datacenter{ID: 777, Name: "Linode"}},
+		{addr: "::ffff:1.12.0.1", want: &Datacenter{ID: 1277, Name: "Tencent Cloud"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			got, err := client.Lookup(tt.addr)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err = client.Lookup("invalid")
+	assert.Error(t, err)
+}
+
+func TestClient_missingDatabase(t *testing.T) {
+	client, err := NewClient(filepath.Join(t.TempDir(), "udgerdb_v3.dat"))
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Datacenter("1.12.0.0")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestClient_reload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "udgerdb_v3.dat")
+	writeTestDB(t, path, map[int]string{1: "old"}, [3]string{"1", "1.0.0.0", "1.0.0.255"})
+
+	client, err := NewClient(path)
+	require.NoError(t, err)
+	defer client.Close()
+
+	did, err := client.Datacenter("1.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, did)
+	version := client.Version()
+
+	// Replace the database like an update would
+	tmpPath := filepath.Join(dir, "udgerdb_v3.tmp")
+	writeTestDB(t, tmpPath, map[int]string{2: "new"}, [3]string{"2", "1.0.0.0", "1.0.0.255"})
+	require.NoError(t, os.Rename(tmpPath, path))
+
+	assert.Eventually(t, func() bool {
+		did, err = client.Datacenter("1.0.0.1")
+		return err == nil && did == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NotEqual(t, version, client.Version())
+}
+
+// BenchmarkClient_Datacenter looks up random addresses in 100k IPv4 and 100k IPv6 ranges,
+// which is about the size of the Udger database.
+func BenchmarkClient_Datacenter(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+
+	var ranges4, ranges6 []ipRange
+	for i := 0; i < 100_000; i++ {
+		from4 := addr{lo: uint64(rng.Uint32() &^ 0xff)}
+		ranges4 = append(ranges4, ipRange{from: from4, to: addr{lo: from4.lo | 0xff}, datacenter: i})
+
+		from6 := addr{hi: rng.Uint64() &^ 0xffff}
+		ranges6 = append(ranges6, ipRange{from: from6, to: addr{hi: from6.hi | 0xffff, lo: ^uint64(0)}, datacenter: i})
+	}
+
+	client := &Client{idx: &index{ipv4: newIntervalTree(ranges4), ipv6: newIntervalTree(ranges6)}}
+
+	addrs := make([]string, 1024)
+	for i := range addrs {
+		ip := make(net.IP, 16)
+		rng.Read(ip)
+		if i%2 == 0 {
+			ip = ip[:4]
+		}
+		addrs[i] = ip.String()
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = client.Datacenter(addrs[i%len(addrs)])
+	}
+}
@@ -22,7 +22,7 @@ use std::net::{Ipv4Addr, Ipv6Addr};
 use std::num::NonZeroU32;
 use std::ops::ControlFlow;
 use std::time::{SystemTime, UNIX_EPOCH};
-use tonic::transport::{Certificate, ClientTlsConfig, Endpoint};
+use tonic::transport::{Certificate, ClientTlsConfig, Endpoint, Identity};
 
 #[allow(clippy::derive_partial_eq_without_eq)]
 pub mod grpc {
@@ -53,6 +53,14 @@ struct Opt {
     #[clap(long, name = "PATH_TO_PEM_FILE")]
     pem: Option<String>,
 
+    /// Path to PEM encoded client certificate to authenticate against servers that require one
+    #[clap(long, name = "PATH_TO_CLIENT_CERT", requires = "PATH_TO_CLIENT_KEY")]
+    client_cert: Option<String>,
+
+    /// Path to PEM encoded private key of the client certificate
+    #[clap(long, name = "PATH_TO_CLIENT_KEY", requires = "PATH_TO_CLIENT_CERT")]
+    client_key: Option<String>,
+
     /// Fixed value to generate a deterministic peer id.
     #[clap(long, name = "SECRET_KEY_SEED")]
     seed: Option<u8>,
@@ -85,7 +93,12 @@ async fn main() -> Result<(), Box<dyn std::error::Error>> {
 
     // We have to manually set the CA certificate again which the server certificate is
     // verified, otherwise the rustls WebPKI verifier will reject the server certificate.
-    let tls = ClientTlsConfig::new().ca_certificate(Certificate::from_pem(pem));
+    let mut tls = ClientTlsConfig::new().ca_certificate(Certificate::from_pem(pem));
+    if let (Some(cert), Some(key)) = (opt.client_cert, opt.client_key) {
+        let cert = tokio::fs::read(cert).await?;
+        let key = tokio::fs::read(key).await?;
+        tls = tls.identity(Identity::from_pem(cert, key));
+    }
     let channel = Endpoint::from_shared(opt.server.clone())?
         .tls_config(tls)?
         .connect()
@@ -119,7 +132,7 @@ async fn main() -> Result<(), Box<dyn std::error::Error>> {
             client_id: local_peer_id.to_bytes(),
             agent_version: agent_version(),
             protocols: protocols.clone().unwrap(),
-            api_key: api_key.clone(),
+            api_key: Some(api_key.clone()),
         });
 
         client.register(request).await?;
@@ -127,7 +140,9 @@ async fn main() -> Result<(), Box<dyn std::error::Error>> {
         let request = tonic::Request::new(grpc::GetAddrInfoRequest {
             host_id: local_peer_id.to_bytes(),
             all_host_ids: vec![local_peer_id.to_bytes()],
-            api_key: api_key.clone(),
+            api_key: Some(api_key.clone()),
+            network: None,
+            implementations: Vec::new(),
         });
 
         let response = client.get_addr_info(request).await?.into_inner();
@@ -152,6 +167,7 @@ async fn main() -> Result<(), Box<dyn std::error::Error>> {
             remote_peer_id,
             remote_addrs.iter().map(|a| a.to_vec()).collect(),
             api_key.clone(),
+            response.allocation_id,
         );
 
         if remote_addrs.is_empty() {
@@ -345,6 +361,7 @@ impl HolePunchState {
         remote_id: PeerId,
         remote_multi_addresses: Vec<Vec<u8>>,
         api_key: String,
+        allocation_id: Option<i64>,
     ) -> Self {
         let request = grpc::TrackHolePunchRequest {
             client_id: client_id.into(),
@@ -359,11 +376,13 @@ impl HolePunchState {
             outcome: grpc::HolePunchOutcome::Unknown.into(),
             ended_at: 0,
             listen_multi_addresses: client_listen_addrs.map(|a| a.to_vec()).collect(),
-            api_key,
+            api_key: Some(api_key),
             protocols: Vec::new(),
             latency_measurements: Vec::new(),
             network_information: None,
             nat_mappings: Vec::new(),
+            hole_punch_events: Vec::new(),
+            allocation_id,
         };
         HolePunchState {
             request,